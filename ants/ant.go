@@ -102,7 +102,7 @@ func startCommon(ctx context.Context, antCfg *ant_config.AntConfig,
 	}
 
 	// starts subscriber to listen for incoming requests
-	if err = handler.NewRequestHandler(
+	requestHandler := handler.NewRequestHandler(
 		antCfg,
 		queueClient,
 		webClient,
@@ -110,7 +110,8 @@ func startCommon(ctx context.Context, antCfg *ant_config.AntConfig,
 		antContainersRegistry,
 		metricsRegistry,
 		executor,
-		requestTopic).Start(ctx); err != nil {
+		requestTopic)
+	if err = requestHandler.Start(ctx); err != nil {
 		return fmt.Errorf("failed to create request handler due to %w", err)
 	}
 
@@ -124,8 +125,7 @@ func startCommon(ctx context.Context, antCfg *ant_config.AntConfig,
 		return err
 	}
 
-	// listen for signal to cleanly shutdown by finishing the work first before exit
-	antCfg.Common.AddSignalHandlerForShutdown(func() {
+	shutdown := func() {
 		go func() {
 			// base tasklet will automatically stop registering the ant so that it won't receive any work
 			// wait until all current requests are done
@@ -152,6 +152,15 @@ func startCommon(ctx context.Context, antCfg *ant_config.AntConfig,
 				"ID":        antCfg.Common.ID,
 			}).Warnf("shutting down, finished waiting for requests, exiting...")
 		}()
-	})
+	}
+
+	// listen for signal to cleanly shutdown by finishing the work first before exit
+	antCfg.Common.AddSignalHandlerForShutdown(shutdown)
+
+	// a drained ant no longer receives work so it shuts down the same way
+	requestHandler.OnDrained = func() {
+		antCfg.Common.ShuttingDown = true
+		shutdown()
+	}
 	return nil
 }
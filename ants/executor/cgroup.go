@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"plexobject.com/formicary/internal/types"
+)
+
+// cgroupStatFiles are files of cgroup v2 followed by cgroup v1 files that are used for profiling a task
+var cgroupStatFiles = []string{
+	"cpu.stat",
+	"memory.stat",
+	"io.stat",
+	"cpuacct/cpuacct.usage",
+	"memory/memory.stat",
+	"blkio/blkio.throttle.io_service_bytes",
+}
+
+// CgroupStatsCommand prints cgroup stats of the container with the name of the file before each line
+var CgroupStatsCommand = "cd /sys/fs/cgroup && grep -H . " + strings.Join(cgroupStatFiles, " ") + " 2>/dev/null; true"
+
+// ReadCgroupStats reads stats of cgroup v2 or v1 from the directory
+func ReadCgroupStats(dir string) (*types.ResourceStats, error) {
+	var sb strings.Builder
+	for _, file := range cgroupStatFiles {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			sb.WriteString(file + ":" + line + "\n")
+		}
+	}
+	return ParseCgroupStats(sb.String())
+}
+
+// ParseCgroupStats parses output of CgroupStatsCommand where resident memory is the anonymous memory of
+// cgroup v2 or rss of cgroup v1 so that page cache isn't counted.
+func ParseCgroupStats(out string) (*types.ResourceStats, error) {
+	stats := &types.ResourceStats{}
+	found := false
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		file, line, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch file {
+		case "cpu.stat":
+			if len(fields) == 2 && fields[0] == "usage_usec" {
+				stats.CPUNanos = parseUint(fields[1]) * 1000
+				found = true
+			}
+		case "cpuacct/cpuacct.usage":
+			if len(fields) == 1 {
+				stats.CPUNanos = parseUint(fields[0])
+				found = true
+			}
+		case "memory.stat", "memory/memory.stat":
+			if len(fields) == 2 && (fields[0] == "anon" || fields[0] == "total_rss") {
+				stats.MemoryBytes = int64(parseUint(fields[1]))
+				found = true
+			}
+		case "io.stat":
+			// e.g. 8:0 rbytes=1 wbytes=2 rios=3 wios=4 dbytes=0 dios=0
+			for _, field := range fields[1:] {
+				if name, val, ok := strings.Cut(field, "="); ok {
+					if name == "rbytes" {
+						stats.IOReadBytes += int64(parseUint(val))
+					} else if name == "wbytes" {
+						stats.IOWriteBytes += int64(parseUint(val))
+					}
+				}
+			}
+		case "blkio/blkio.throttle.io_service_bytes":
+			// e.g. 8:0 Read 1
+			if len(fields) == 3 && fields[1] == "Read" {
+				stats.IOReadBytes += int64(parseUint(fields[2]))
+			} else if len(fields) == 3 && fields[1] == "Write" {
+				stats.IOWriteBytes += int64(parseUint(fields[2]))
+			}
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("cgroup stats are not available")
+	}
+	return stats, nil
+}
+
+func parseUint(val string) uint64 {
+	n, _ := strconv.ParseUint(val, 10, 64)
+	return n
+}
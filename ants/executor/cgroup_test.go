@@ -0,0 +1,48 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldParseCgroupV2Stats(t *testing.T) {
+	// GIVEN output of cgroup v2 stats command
+	out := "cpu.stat:usage_usec 2000\ncpu.stat:user_usec 1500\n" +
+		"memory.stat:anon 4096\nmemory.stat:file 8192\n" +
+		"io.stat:8:0 rbytes=100 wbytes=200 rios=1 wios=2\nio.stat:8:16 rbytes=1 wbytes=2\n"
+	// WHEN parsing the output
+	stats, err := ParseCgroupStats(out)
+	// THEN it should return usage without page cache
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000000), stats.CPUNanos)
+	require.Equal(t, int64(4096), stats.MemoryBytes)
+	require.Equal(t, int64(101), stats.IOReadBytes)
+	require.Equal(t, int64(202), stats.IOWriteBytes)
+}
+
+func Test_ShouldReadCgroupV1Stats(t *testing.T) {
+	// GIVEN cgroup v1 files
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "cpuacct"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "memory"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "blkio"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cpuacct/cpuacct.usage"), []byte("5000\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "memory/memory.stat"), []byte("cache 10\ntotal_rss 20\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "blkio/blkio.throttle.io_service_bytes"),
+		[]byte("8:0 Read 30\n8:0 Write 40\n8:0 Total 70\n"), 0644))
+	// WHEN reading stats from the directory
+	stats, err := ReadCgroupStats(dir)
+	// THEN it should return usage of v1 files
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000), stats.CPUNanos)
+	require.Equal(t, int64(20), stats.MemoryBytes)
+	require.Equal(t, int64(30), stats.IOReadBytes)
+	require.Equal(t, int64(40), stats.IOWriteBytes)
+
+	// AND missing stats should fail
+	_, err = ReadCgroupStats(t.TempDir())
+	require.Error(t, err)
+}
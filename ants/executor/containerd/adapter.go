@@ -0,0 +1,478 @@
+package containerd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"plexobject.com/formicary/internal/ant_config"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"plexobject.com/formicary/ants/executor"
+	domain "plexobject.com/formicary/internal/types"
+)
+
+// Adapter for containerd APIs
+type Adapter interface {
+	GetConfigInfo() map[string]any
+	Pull(ctx context.Context, image string) error
+	Stop(
+		ctx context.Context,
+		id string,
+		opts *domain.ExecutorOptions,
+		timeout time.Duration) error
+	Build(
+		ctx context.Context,
+		opts *domain.ExecutorOptions,
+		name string,
+		image string,
+		entrypoint []string,
+		helper bool) (string, error)
+	BuildService(
+		ctx context.Context,
+		opts *domain.ExecutorOptions,
+		parentID string,
+		name string,
+		svc domain.Service) (string, error)
+	List(ctx context.Context) ([]executor.Info, error)
+	Execute(
+		ctx context.Context,
+		opts *domain.ExecutorOptions,
+		containerID string,
+		cmd string,
+		executeCommandWithoutShell bool,
+		helper bool) *exec.Cmd
+	GetRuntimeInfo(ctx context.Context, container string) string
+}
+
+// NerdctlUtils defines helper methods for managing containerd containers using nerdctl CLI
+type NerdctlUtils struct {
+	config *ant_config.ContainerdConfig
+}
+
+// NewNerdctlUtils creates new adapter for containerd
+func NewNerdctlUtils(config *ant_config.ContainerdConfig) (*NerdctlUtils, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &NerdctlUtils{config: config}, nil
+}
+
+// Execute - prepares a command for execution within container, caller is responsible for starting it
+func (u *NerdctlUtils) Execute(
+	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	containerID string,
+	cmd string,
+	executeCommandWithoutShell bool,
+	helper bool) *exec.Cmd {
+	args := u.buildExecArgs(opts, containerID, cmd, executeCommandWithoutShell, helper)
+	if logrus.IsLevelEnabled(logrus.DebugLevel) {
+		logrus.WithFields(logrus.Fields{
+			"Component":                  "ContainerdAdapter",
+			"Container":                  containerID,
+			"Command":                    cmd,
+			"Args":                       args,
+			"ExecuteCommandWithoutShell": executeCommandWithoutShell,
+		}).Debug("executing...")
+	}
+	return exec.CommandContext(ctx, u.config.Nerdctl, args...)
+}
+
+// Build method creates and starts container
+func (u *NerdctlUtils) Build(
+	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	name string,
+	image string,
+	entrypoint []string,
+	helper bool) (string, error) {
+	started := time.Now()
+	// using fresh context so that it doesn't time out
+	ctx = context.Background()
+	if err := u.pullIfNeeded(ctx, image); err != nil {
+		return "", err
+	}
+
+	if opts.MainContainer.HasDockerBindVolumes() {
+		if err := u.createVolumes(ctx, opts); err != nil {
+			return "", err
+		}
+	}
+
+	out, err := u.run(ctx, u.buildRunArgs(opts, name, image, entrypoint, helper)...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create container %s due to %w, elapsed %s",
+			name, err, time.Since(started))
+	}
+	id := strings.TrimSpace(string(out))
+
+	logrus.WithFields(logrus.Fields{
+		"Component":   "ContainerdAdapter",
+		"Container":   name,
+		"Image":       image,
+		"ContainerID": id,
+		"Options":     opts.String(),
+	}).Info("creating container...")
+	return id, nil
+}
+
+// BuildService creates a service container that shares network namespace with the parent container
+func (u *NerdctlUtils) BuildService(
+	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	parentID string,
+	name string,
+	svc domain.Service) (string, error) {
+	ctx = context.Background()
+	if err := u.pullIfNeeded(ctx, svc.Image); err != nil {
+		return "", err
+	}
+	out, err := u.run(ctx, u.buildServiceArgs(opts, parentID, name, svc)...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create service container %s due to %w", name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Pull method fetches images from registry
+func (u *NerdctlUtils) Pull(ctx context.Context, image string) error {
+	logrus.WithFields(logrus.Fields{
+		"Component": "ContainerdAdapter",
+		"Image":     image,
+		"Server":    u.config.Server,
+	}).Info("pulling image...")
+	if u.config.Username != "" && u.config.Server != "" && strings.Contains(image, u.config.Server) {
+		cmd := exec.CommandContext(ctx, u.config.Nerdctl,
+			append(u.config.GlobalArgs(), "login", "--username", u.config.Username, "--password-stdin", u.config.Server)...)
+		cmd.Stdin = strings.NewReader(u.config.Password)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to login to %s due to %w: %s", u.config.Server, err, out)
+		}
+	}
+	_, err := u.run(ctx, "pull", "--quiet", image)
+	return err
+}
+
+// Stop stops and removes container along with its volumes, where container and volumes are removed
+// even if the container could not be stopped
+func (u *NerdctlUtils) Stop(
+	ctx context.Context,
+	id string,
+	opts *domain.ExecutorOptions,
+	timeout time.Duration) error {
+	logrus.WithFields(logrus.Fields{
+		"Component": "ContainerdAdapter",
+		"ID":        id,
+	}).Info("✋ stopping containerd container...")
+	var stopErr error
+	if _, err := u.run(ctx, "stop", "--time", fmt.Sprintf("%d", int(timeout.Seconds())), id); err != nil {
+		stopErr = fmt.Errorf("failed to stop containerd container %s due to %w, timeout=%s",
+			id, err, timeout)
+	}
+	_, rmErr := u.run(ctx, "rm", "--force", "--volumes", id)
+	volErr := u.removeVolumes(ctx, opts)
+	if volErr != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "ContainerdAdapter",
+			"ID":        id,
+			"Error":     volErr,
+		}).Error("failed to remove volume...")
+	}
+	return errors.Join(stopErr, rmErr, volErr)
+}
+
+// List containers
+func (u *NerdctlUtils) List(ctx context.Context) ([]executor.Info, error) {
+	out, err := u.run(ctx, "ps", "--no-trunc", "--format", "{{json .}}")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers due to %w", err)
+	}
+	return parseContainers(out)
+}
+
+// GetRuntimeInfo returns runtime info
+func (u *NerdctlUtils) GetRuntimeInfo(ctx context.Context, container string) string {
+	var sb strings.Builder
+	if out, err := u.run(ctx, "logs", "--tail", "100", container); err == nil {
+		sb.Write(out)
+	}
+	if out, err := u.run(ctx, "inspect", container); err == nil {
+		sb.Write(out)
+	} else {
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// GetConfigInfo returns config info
+func (u *NerdctlUtils) GetConfigInfo() map[string]any {
+	return map[string]any{
+		"Address":     u.config.Address,
+		"Namespace":   u.config.Namespace,
+		"Snapshotter": u.config.Snapshotter,
+		"Server":      u.config.Server,
+		"PullPolicy":  u.config.PullPolicy,
+	}
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+func (u *NerdctlUtils) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, u.config.Nerdctl, append(u.config.GlobalArgs(), args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return out, fmt.Errorf("nerdctl %s failed due to %w: %s",
+			args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func (u *NerdctlUtils) pullIfNeeded(ctx context.Context, image string) error {
+	if u.config.PullPolicy.Always() {
+		if err := u.Pull(ctx, image); err != nil {
+			return fmt.Errorf("failed to pull image %s due to %w", image, err)
+		}
+	} else if u.config.PullPolicy.IfNotPresent() {
+		if _, err := u.run(ctx, "image", "inspect", image); err != nil {
+			if err = u.Pull(ctx, image); err != nil {
+				return fmt.Errorf("failed to pull image %s due to %w", image, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (u *NerdctlUtils) createVolumes(ctx context.Context, opts *domain.ExecutorOptions) error {
+	for vol := range opts.MainContainer.GetDockerVolumeNames() {
+		if strings.Contains(vol, "bind-mount") {
+			continue
+		}
+		if _, err := u.run(ctx, "volume", "create", "--label", "type=shared", vol); err != nil {
+			return fmt.Errorf("failed to create containerd volume %s: %w", vol, err)
+		}
+	}
+	return nil
+}
+
+func (u *NerdctlUtils) removeVolumes(ctx context.Context, opts *domain.ExecutorOptions) error {
+	if opts == nil || opts.MainContainer == nil {
+		return nil
+	}
+	for vol := range opts.MainContainer.GetDockerVolumeNames() {
+		if strings.Contains(vol, "bind-mount") {
+			continue
+		}
+		var err error
+		for i := 0; i < 10; i++ {
+			if _, err = u.run(ctx, "volume", "rm", "--force", vol); err == nil ||
+				!strings.Contains(err.Error(), "in use") {
+				break
+			}
+			time.Sleep(1 * time.Second)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to remove containerd volume %s: %w", vol, err)
+		}
+	}
+	return nil
+}
+
+func (u *NerdctlUtils) buildRunArgs(
+	opts *domain.ExecutorOptions,
+	name string,
+	image string,
+	entrypoint []string,
+	helper bool) []string {
+	args := []string{"run", "--detach", "--tty", "--name", name}
+	args = append(args, labelArgs(opts.PodLabels)...)
+	if helper {
+		args = append(args, envArgs(opts.HelperEnvironment)...)
+	} else {
+		args = append(args, envArgs(opts.Environment)...)
+	}
+	if opts.WorkingDirectory != "" {
+		args = append(args, "--workdir", opts.WorkingDirectory)
+	}
+	if opts.Privileged {
+		args = append(args, "--privileged")
+	}
+	if opts.NetworkMode != "" {
+		args = append(args, "--network", opts.NetworkMode)
+	} else if u.config.Network != "" {
+		args = append(args, "--network", u.config.Network)
+	}
+	if !helper {
+		if opts.MainContainer.CPULimit != "" {
+			args = append(args, "--cpus", opts.MainContainer.CPULimit)
+		}
+		if opts.MainContainer.MemoryLimit != "" {
+			args = append(args, "--memory", opts.MainContainer.MemoryLimit)
+		}
+	}
+	args = append(args, volumeArgs(opts.MainContainer)...)
+	if len(entrypoint) > 0 {
+		args = append(args, "--entrypoint", entrypoint[0], image)
+		args = append(args, entrypoint[1:]...)
+	} else {
+		args = append(args, image)
+	}
+	return args
+}
+
+func (u *NerdctlUtils) buildServiceArgs(
+	opts *domain.ExecutorOptions,
+	parentID string,
+	name string,
+	svc domain.Service) []string {
+	args := []string{"run", "--detach", "--name", name,
+		"--network", "container:" + parentID}
+	args = append(args, labelArgs(opts.PodLabels)...)
+	args = append(args, envArgs(opts.Environment)...)
+	if svc.WorkingDirectory != "" {
+		args = append(args, "--workdir", svc.WorkingDirectory)
+	}
+	if svc.CPULimit != "" {
+		args = append(args, "--cpus", svc.CPULimit)
+	}
+	if svc.MemoryLimit != "" {
+		args = append(args, "--memory", svc.MemoryLimit)
+	}
+	// --entrypoint only takes the executable so the rest of entrypoint is passed before the command
+	if len(svc.Entrypoint) > 0 {
+		args = append(args, "--entrypoint", svc.Entrypoint[0], svc.Image)
+		args = append(args, svc.Entrypoint[1:]...)
+	} else {
+		args = append(args, svc.Image)
+	}
+	return append(args, svc.Command...)
+}
+
+func (u *NerdctlUtils) buildExecArgs(
+	opts *domain.ExecutorOptions,
+	containerID string,
+	cmd string,
+	executeCommandWithoutShell bool,
+	helper bool) []string {
+	args := append(u.config.GlobalArgs(), "exec")
+	if helper {
+		args = append(args, envArgs(opts.HelperEnvironment)...)
+	} else {
+		args = append(args, envArgs(opts.Environment)...)
+	}
+	if opts.WorkingDirectory != "" {
+		args = append(args, "--workdir", opts.WorkingDirectory)
+	}
+	args = append(args, containerID)
+	if executeCommandWithoutShell {
+		return append(args, strings.Split(cmd, " ")...)
+	}
+	return append(args, "/bin/sh", "-c", cmd)
+}
+
+func labelArgs(labels map[string]string) []string {
+	args := make([]string, 0)
+	for _, k := range sortedKeys(labels) {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", k, labels[k]))
+	}
+	return args
+}
+
+func envArgs(env domain.EnvironmentMap) []string {
+	kvs := env.AsArray()
+	sort.Strings(kvs)
+	args := make([]string, 0)
+	for _, kv := range kvs {
+		args = append(args, "--env", kv)
+	}
+	return args
+}
+
+func volumeArgs(cd *domain.ContainerDefinition) []string {
+	args := make([]string, 0)
+	if cd == nil || !cd.HasDockerBindVolumes() {
+		return args
+	}
+	vols := cd.GetDockerVolumeNames()
+	for _, k := range sortedKeys(vols) {
+		if strings.Contains(k, "bind-mount") {
+			args = append(args, "--volume", fmt.Sprintf("%s:%s", vols[k], k))
+		} else {
+			args = append(args, "--volume", fmt.Sprintf("%s:%s", k, vols[k]))
+		}
+	}
+	return args
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// psEntry defines output of nerdctl ps in json format
+type psEntry struct {
+	ID        string `json:"ID"`
+	Names     string `json:"Names"`
+	Labels    string `json:"Labels"`
+	Status    string `json:"Status"`
+	CreatedAt string `json:"CreatedAt"`
+}
+
+func parseContainers(out []byte) ([]executor.Info, error) {
+	arr := make([]executor.Info, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry psEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse container %s due to %w", line, err)
+		}
+		labels := parseLabels(entry.Labels)
+		opts := domain.NewExecutorOptions("", "")
+		opts.PodLabels = labels
+		startedAt, err := time.Parse("2006-01-02 15:04:05 -0700 MST", entry.CreatedAt)
+		if err != nil {
+			startedAt = time.Now()
+		}
+		state := executor.Unknown
+		if strings.HasPrefix(entry.Status, "Up") {
+			state = executor.Running
+		} else if strings.HasPrefix(entry.Status, "Created") {
+			state = executor.Pending
+		}
+		arr = append(arr, &executor.BaseExecutor{
+			ExecutorOptions: opts,
+			ID:              entry.ID,
+			Name:            entry.Names,
+			StartedAt:       startedAt,
+			State:           state,
+			Labels:          labels,
+		})
+	}
+	return arr, scanner.Err()
+}
+
+func parseLabels(str string) map[string]string {
+	labels := make(map[string]string)
+	for _, kv := range strings.Split(str, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 && parts[0] != "" {
+			labels[parts[0]] = parts[1]
+		}
+	}
+	return labels
+}
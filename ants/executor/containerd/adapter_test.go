@@ -0,0 +1,132 @@
+package containerd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/ant_config"
+	domain "plexobject.com/formicary/internal/types"
+)
+
+func newTestUtils(t *testing.T) *NerdctlUtils {
+	u, err := NewNerdctlUtils(&ant_config.ContainerdConfig{Address: "/run/containerd/containerd.sock"})
+	require.NoError(t, err)
+	return u
+}
+
+// Test_ShouldBuildRunArgs verifies that executor options are mapped to nerdctl run flags
+func Test_ShouldBuildRunArgs(t *testing.T) {
+	// GIVEN executor options with labels, env, volumes and limits
+	u := newTestUtils(t)
+	opts := domain.NewExecutorOptions("frm-1-build", domain.Containerd)
+	opts.PodLabels["UserID"] = "u1"
+	opts.Environment["B"] = "2"
+	opts.Environment["A"] = "1"
+	opts.WorkingDirectory = "/work"
+	opts.MainContainer.CPULimit = "1"
+	opts.MainContainer.MemoryLimit = "1g"
+	opts.MainContainer.GetDockerVolumeNames()["frm-1-build-cache"] = "/cache"
+
+	// WHEN run args are built
+	args := u.buildRunArgs(opts, "frm-1-build", "alpine", []string{"sh", "-c", "sleep"}, false)
+
+	// THEN flags should be deterministic and image should follow the entrypoint
+	require.Equal(t, []string{
+		"run", "--detach", "--tty", "--name", "frm-1-build",
+		"--label", "UserID=u1",
+		"--env", "A=1", "--env", "B=2",
+		"--workdir", "/work",
+		"--cpus", "1", "--memory", "1g",
+		"--volume", "frm-1-build-cache:/cache",
+		"--entrypoint", "sh", "alpine", "-c", "sleep",
+	}, args)
+}
+
+// Test_ShouldBuildExecArgs verifies exec arguments for helper and shell-less commands
+func Test_ShouldBuildExecArgs(t *testing.T) {
+	u := newTestUtils(t)
+	opts := domain.NewExecutorOptions("frm-1-build", domain.Containerd)
+	opts.HelperEnvironment["AWS_URL"] = "http://s3"
+
+	args := u.buildExecArgs(opts, "cid", "ls -l", false, true)
+	require.Equal(t, []string{
+		"--namespace", "formicary", "--address", "/run/containerd/containerd.sock",
+		"exec", "--env", "AWS_URL=http://s3", "cid", "/bin/sh", "-c", "ls -l"}, args)
+
+	args = u.buildExecArgs(opts, "cid", "ls -l", true, false)
+	require.Equal(t, []string{"ls", "-l"}, args[len(args)-2:])
+}
+
+// Test_ShouldBuildServiceArgs verifies that services join network of main container
+func Test_ShouldBuildServiceArgs(t *testing.T) {
+	u := newTestUtils(t)
+	opts := domain.NewExecutorOptions("frm-1-build", domain.Containerd)
+	svc := domain.Service{Name: "redis", Image: "redis:6", Command: []string{"redis-server"}}
+
+	args := u.buildServiceArgs(opts, "cid", "frm-1-build-svc-redis-0", svc)
+	require.Equal(t, []string{
+		"run", "--detach", "--name", "frm-1-build-svc-redis-0",
+		"--network", "container:cid", "redis:6", "redis-server"}, args)
+
+	// WHEN service has entrypoint with arguments
+	svc.Entrypoint = []string{"docker-entrypoint.sh", "--verbose"}
+	args = u.buildServiceArgs(opts, "cid", "frm-1-build-svc-redis-0", svc)
+
+	// THEN first element should be the entrypoint and the rest should be passed before the command
+	require.Equal(t, []string{
+		"run", "--detach", "--name", "frm-1-build-svc-redis-0",
+		"--network", "container:cid", "--entrypoint", "docker-entrypoint.sh", "redis:6",
+		"--verbose", "redis-server"}, args)
+}
+
+// Test_ShouldParseContainers verifies parsing of nerdctl ps output
+func Test_ShouldParseContainers(t *testing.T) {
+	out := []byte(`{"ID":"abc","Names":"frm-1-build","Labels":"UserID=u1,JobID=1","Status":"Up 3 minutes","CreatedAt":"2024-01-02 10:11:12 +0000 UTC"}
+{"ID":"def","Names":"other","Labels":"","Status":"Created","CreatedAt":"bad"}
+`)
+	infos, err := parseContainers(out)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	require.Equal(t, "frm-1-build", infos[0].GetName())
+	require.Equal(t, executor.Running, infos[0].GetState())
+	require.Equal(t, "u1", infos[0].GetLabels()["UserID"])
+	require.Equal(t, 2024, infos[0].GetStartedAt().Year())
+	require.Equal(t, executor.Pending, infos[1].GetState())
+	require.Len(t, infos[1].GetLabels(), 0)
+}
+
+// Test_ShouldRemoveContainerAndVolumesWhenStopFails verifies cleanup isn't skipped when container can't be stopped
+func Test_ShouldRemoveContainerAndVolumesWhenStopFails(t *testing.T) {
+	// GIVEN nerdctl that fails to stop containers and records other commands
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	nerdctl := filepath.Join(dir, "nerdctl")
+	require.NoError(t, os.WriteFile(nerdctl, []byte(`#!/bin/sh
+echo "$@" >> `+calls+`
+case "$*" in *" stop "*) echo "stop failed" >&2; exit 1;; esac
+`), 0755))
+	u, err := NewNerdctlUtils(&ant_config.ContainerdConfig{Nerdctl: nerdctl})
+	require.NoError(t, err)
+	opts := domain.NewExecutorOptions("frm-1-build", domain.Containerd)
+	opts.MainContainer.GetDockerVolumeNames()["frm-1-build-cache"] = "/cache"
+
+	// WHEN container is stopped
+	err = u.Stop(context.Background(), "cid", opts, time.Second)
+
+	// THEN it should return the stop error
+	require.ErrorContains(t, err, "failed to stop containerd container cid")
+	// AND container and its volumes should still be removed
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"--namespace formicary stop --time 1 cid",
+		"--namespace formicary rm --force --volumes cid",
+		"--namespace formicary volume rm --force frm-1-build-cache",
+	}, strings.Split(strings.TrimSpace(string(data)), "\n"))
+}
@@ -0,0 +1,218 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+	"sync"
+	"time"
+)
+
+const helperSuffix = "-helper"
+
+// Executor for containerd
+type Executor struct {
+	executor.BaseExecutor
+	adapter    Adapter
+	lock       sync.RWMutex
+	helperID   string
+	serviceIDs []string
+}
+
+// NewContainerdExecutor creates new containerd executor
+func NewContainerdExecutor(
+	ctx context.Context,
+	cfg *ant_config.AntConfig,
+	trace trace.JobTrace,
+	opts *types.ExecutorOptions,
+	adapter Adapter) (exec *Executor, err error) {
+	base, err := executor.NewBaseExecutor(cfg, trace, opts)
+	if err != nil {
+		return nil, err
+	}
+	exec = &Executor{
+		BaseExecutor: base,
+		adapter:      adapter,
+		serviceIDs:   make([]string, 0),
+	}
+	if opts.MainContainer.Image == "" {
+		return nil, fmt.Errorf("image not specified")
+	}
+
+	// create helper container
+	if opts.HelperContainer.Image != "" {
+		exec.helperID, err = adapter.Build(
+			ctx,
+			opts,
+			opts.Name+helperSuffix,
+			opts.HelperContainer.Image,
+			cfg.DefaultShell,
+			true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// creating main container
+	exec.ID, err = adapter.Build(
+		ctx,
+		opts,
+		opts.Name,
+		opts.MainContainer.Image,
+		cfg.DefaultShell,
+		false)
+	if err != nil {
+		exec.stopContainers(ctx)
+		return nil, err
+	}
+
+	// creating services that share network with the main container
+	for _, svc := range opts.Services {
+		instances := svc.Instances
+		if instances <= 0 {
+			instances = 1
+		}
+		for i := 0; i < instances; i++ {
+			svcID, err := adapter.BuildService(
+				ctx,
+				opts,
+				exec.ID,
+				fmt.Sprintf("%s-svc-%s-%d", opts.Name, svc.Name, i),
+				svc)
+			if err != nil {
+				exec.stopContainers(ctx)
+				return nil, err
+			}
+			exec.serviceIDs = append(exec.serviceIDs, svcID)
+		}
+	}
+
+	exec.Name = opts.Name
+
+	hostName, _ := os.Hostname()
+	_ = base.WriteTrace(ctx, fmt.Sprintf(
+		"[%s CONTAINERD %s] ✅ running with formicary %s on %s",
+		time.Now().Format(time.RFC3339), opts.Name, cfg.Common.ID, hostName))
+	_ = exec.WriteTraceInfo(ctx, fmt.Sprintf(
+		"[%s CONTAINERD %s] 📦 preparing containerd container with image %s services=%d",
+		time.Now().Format(time.RFC3339), opts.Name, opts.MainContainer.Image, len(exec.serviceIDs)))
+	return
+}
+
+// GetConfigInfo returns config info
+func (ce *Executor) GetConfigInfo() map[string]any {
+	return ce.adapter.GetConfigInfo()
+}
+
+// GetRuntimeInfo - runtime info by containerd executor
+func (ce *Executor) GetRuntimeInfo(ctx context.Context) string {
+	ce.lock.RLock()
+	defer ce.lock.RUnlock()
+	return fmt.Sprintf("[%s] container ID=%s Image=%s Helper=%s HelperImage=%s Services=%v Labels=%s\n%v",
+		ce.BaseExecutor.ExecutorOptions.Name,
+		ce.ID,
+		ce.BaseExecutor.ExecutorOptions.MainContainer.Image,
+		ce.helperID,
+		ce.BaseExecutor.ExecutorOptions.HelperContainer.Image,
+		ce.serviceIDs,
+		ce.BaseExecutor.ExecutorOptions.PodLabels,
+		ce.adapter.GetRuntimeInfo(ctx, ce.ID),
+	)
+}
+
+// AsyncHelperExecute for executing command on helper container
+func (ce *Executor) AsyncHelperExecute(
+	ctx context.Context,
+	cmd string,
+	variables map[string]types.VariableValue,
+) (executor.CommandRunner, error) {
+	return ce.doAsyncExecute(ctx, ce.helperID, cmd, true, variables)
+}
+
+// AsyncExecute - executing command by containerd executor
+func (ce *Executor) AsyncExecute(
+	ctx context.Context,
+	cmd string,
+	variables map[string]types.VariableValue,
+) (executor.CommandRunner, error) {
+	return ce.doAsyncExecute(ctx, ce.ID, cmd, false, variables)
+}
+
+// Stop - stop executing command by containerd executor
+func (ce *Executor) Stop(ctx context.Context) error {
+	ce.lock.Lock()
+	defer ce.lock.Unlock()
+	if ce.State == executor.Removing {
+		_ = ce.WriteTraceError(ctx, fmt.Sprintf("⛔ cannot remove container as it's already stopped"))
+		return fmt.Errorf("container [%s %s] is already stopped", ce.ID, ce.Name)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = ce.BaseExecutor.WriteTraceInfo(ctx, fmt.Sprintf("✋ stopping container"))
+
+	err := ce.stopContainers(ctx)
+	now := time.Now()
+	ce.EndedAt = &now
+	ce.State = executor.Removing
+	if err != nil {
+		_ = ce.WriteTraceError(ctx, fmt.Sprintf("⛔ failed to stop container: Error=%v Elapsed=%v, StopWait=%v",
+			err, ce.Elapsed(), ce.AntConfig.GetShutdownTimeout()))
+	} else {
+		_ = ce.WriteTraceInfo(ctx, fmt.Sprintf("🛑 stopped containerd-container: Elapsed=%v, StopWait=%v",
+			ce.Elapsed(), ce.AntConfig.GetShutdownTimeout()))
+	}
+	return err
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+// stopContainers stops services before main container because they share its network namespace
+func (ce *Executor) stopContainers(ctx context.Context) (err error) {
+	for _, svcID := range ce.serviceIDs {
+		_ = ce.adapter.Stop(
+			ctx,
+			svcID,
+			nil,
+			ce.AntConfig.GetShutdownTimeout())
+	}
+	if ce.ID != "" {
+		err = ce.adapter.Stop(
+			ctx,
+			ce.ID,
+			ce.ExecutorOptions,
+			ce.AntConfig.GetShutdownTimeout())
+	}
+	if ce.helperID != "" {
+		_ = ce.adapter.Stop(
+			ctx,
+			ce.helperID,
+			nil,
+			ce.AntConfig.GetShutdownTimeout())
+	}
+	return
+}
+
+// doAsyncExecute - executing command by containerd executor
+func (ce *Executor) doAsyncExecute(
+	ctx context.Context,
+	containerID string,
+	cmd string,
+	helper bool,
+	_ map[string]types.VariableValue,
+) (executor.CommandRunner, error) {
+	ce.lock.Lock()
+	defer ce.lock.Unlock()
+	if ce.State == executor.Removing {
+		_ = ce.WriteTraceError(ctx, fmt.Sprintf("❌ failed to execute '%s' because container is already stopped", cmd))
+		return nil, fmt.Errorf("failed to execute '%s' because container is already stopped", cmd)
+	}
+	ce.State = executor.Running
+	runner, err := NewCommandRunner(ce, ce.adapter, containerID, cmd, helper)
+	if err != nil {
+		return nil, err
+	}
+	return runner, runner.run(ctx)
+}
@@ -0,0 +1,100 @@
+package containerd
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+	"sync"
+)
+
+// ExecutorProvider defines base structure for containerd executor provider
+type ExecutorProvider struct {
+	executor.BaseExecutorProvider
+	adapter   Adapter
+	executors map[string]*Executor
+	lock      sync.RWMutex
+}
+
+// NewExecutorProvider creates executor-provider for local containerd based execution
+func NewExecutorProvider(
+	config *ant_config.AntConfig) (executor.Provider, error) {
+	adapter, err := NewNerdctlUtils(&config.Containerd)
+	if err != nil {
+		return nil, err
+	}
+	return NewExecutorProviderWithAdapter(config, adapter)
+}
+
+// NewExecutorProviderWithAdapter creates executor-provider with given adapter
+func NewExecutorProviderWithAdapter(
+	config *ant_config.AntConfig,
+	adapter Adapter) (executor.Provider, error) {
+	return &ExecutorProvider{
+		BaseExecutorProvider: executor.BaseExecutorProvider{
+			AntConfig: config,
+		},
+		executors: make(map[string]*Executor),
+		adapter:   adapter,
+	}, nil
+}
+
+// ListExecutors lists current executors
+func (cep *ExecutorProvider) ListExecutors(
+	context.Context) ([]executor.Info, error) {
+	cep.lock.RLock()
+	defer cep.lock.RUnlock()
+	execs := make([]executor.Info, 0)
+	for _, e := range cep.executors {
+		execs = append(execs, e)
+	}
+	return execs, nil
+}
+
+// AllRunningExecutors returns running executors
+func (cep *ExecutorProvider) AllRunningExecutors(
+	ctx context.Context) ([]executor.Info, error) {
+	cep.lock.RLock()
+	defer cep.lock.RUnlock()
+	return cep.adapter.List(ctx)
+}
+
+// StopExecutor stops executor
+func (cep *ExecutorProvider) StopExecutor(
+	ctx context.Context,
+	id string,
+	opts *types.ExecutorOptions) error {
+	cep.lock.Lock()
+	defer cep.lock.Unlock()
+	exec := cep.executors[id]
+	if exec == nil {
+		log.WithFields(log.Fields{
+			"Component": "ContainerdExecutorProvider",
+			"Name":      id,
+		}).Warn("✋ stopping unknown container")
+		return cep.adapter.Stop(
+			ctx,
+			id,
+			opts,
+			cep.AntConfig.GetShutdownTimeout())
+	}
+	delete(cep.executors, id)
+	return exec.Stop(ctx)
+}
+
+// NewExecutor creates new executor
+func (cep *ExecutorProvider) NewExecutor(
+	ctx context.Context,
+	trace trace.JobTrace,
+	opts *types.ExecutorOptions) (executor.Executor, error) {
+	cep.lock.Lock()
+	defer cep.lock.Unlock()
+	exec, err := NewContainerdExecutor(ctx, cep.AntConfig, trace, opts, cep.adapter)
+	if err != nil {
+		return nil, err
+	}
+	cep.executors[exec.ID] = exec
+	return exec, nil
+}
@@ -0,0 +1,172 @@
+package containerd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	common "plexobject.com/formicary/internal/types"
+	cutils "plexobject.com/formicary/internal/utils"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/sirupsen/logrus"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/async"
+)
+
+// CommandRunner command runner for containerd
+type CommandRunner struct {
+	executor.BaseCommandRunner
+	exec        *Executor
+	cmd         *exec.Cmd
+	cancel      context.CancelFunc
+	containerID string
+	done        chan struct{}
+}
+
+// NewCommandRunner constructor
+func NewCommandRunner(
+	exec *Executor,
+	adapter Adapter,
+	containerID string,
+	cmd string,
+	helper bool) (*CommandRunner, error) {
+	base := executor.NewBaseCommandRunner(&exec.BaseExecutor, cmd, helper)
+	runner := &CommandRunner{
+		BaseCommandRunner: base,
+		exec:              exec,
+		containerID:       containerID,
+		done:              make(chan struct{}),
+	}
+	// command is bound to its own context so that it can be killed on stop or timeout
+	var ctx context.Context
+	ctx, runner.cancel = context.WithCancel(context.Background())
+	runner.cmd = adapter.Execute(
+		ctx,
+		exec.ExecutorOptions,
+		containerID,
+		cmd,
+		exec.ExecutorOptions.ExecuteCommandWithoutShell,
+		helper)
+	runner.cmd.Stdout = &runner.Stdout
+	runner.cmd.Stderr = &runner.Stderr
+	runner.ID = ulid.Make().String()
+	runner.Host, _ = os.Hostname()
+	return runner, nil
+}
+
+// Await waits for completion
+func (ccr *CommandRunner) Await(ctx context.Context) ([]byte, []byte, error) {
+	handler := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		<-ccr.done
+		return nil, ccr.Err
+	}
+	abort := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, ccr.Stop(ctx, 0)
+	}
+	_, err := async.Execute(ctx, handler, abort, nil).Await(ctx)
+	if ccr.ExecutorOptions.Debug || !ccr.IsHelper(ctx) {
+		if len(ccr.Stdout.Bytes()) > 0 {
+			_, _ = ccr.Trace.Write(ccr.Stdout.Bytes(), common.StdoutTags)
+		}
+		if len(ccr.Stderr.Bytes()) > 0 {
+			_, _ = ccr.Trace.Write(ccr.Stderr.Bytes(), common.StderrTags)
+		}
+	}
+
+	if err == nil && ccr.ExitCode == 0 {
+		logrus.WithFields(logrus.Fields{
+			"Component": "ContainerdCommandRunner",
+			"Command":   ccr.Command,
+			"Container": ccr.containerID,
+			"StdoutLen": len(ccr.Stdout.Bytes()),
+			"ID":        ccr.ID,
+			"Name":      ccr.Name,
+			"Host":      ccr.Host,
+			"Elapsed":   ccr.BaseExecutor.Elapsed(),
+			"Memory":    cutils.MemUsageMiBString(),
+		}).Info("succeeded in executing command")
+		if ccr.ExecutorOptions.Debug || !ccr.IsHelper(ctx) {
+			_ = ccr.BaseExecutor.WriteTraceSuccess(ctx,
+				fmt.Sprintf("✅ %s Duration=%v",
+					ccr.Command, ccr.BaseExecutor.Elapsed()))
+		}
+		return ccr.Stdout.Bytes(), ccr.Stderr.Bytes(), nil
+	}
+	ccr.ExitMessage = fmt.Sprintf("command terminated with Message=%d", ccr.ExitCode)
+	logrus.WithFields(logrus.Fields{
+		"Component": "ContainerdCommandRunner",
+		"Command":   ccr.Command,
+		"Container": ccr.containerID,
+		"ID":        ccr.ID,
+		"StderrLen": len(ccr.Stderr.Bytes()),
+		"Name":      ccr.Name,
+		"Host":      ccr.Host,
+		"ExitCode":  ccr.ExitCode,
+		"Error":     err,
+		"Elapsed":   ccr.BaseExecutor.Elapsed(),
+		"Memory":    cutils.MemUsageMiBString(),
+	}).Warn("failed to execute command in containerd")
+	if ccr.ExecutorOptions.Debug || !ccr.IsHelper(ctx) {
+		_ = ccr.BaseExecutor.WriteTraceError(ctx,
+			fmt.Sprintf("❌ %s failed to execute Message=%s ExitCode=%d Error=%v Duration=%v",
+				ccr.Command, ccr.ExitMessage, ccr.ExitCode, err, ccr.BaseExecutor.Elapsed()))
+		if !ccr.DumpedRuntimeInfo && !ccr.IsHelper(ctx) {
+			ccr.DumpedRuntimeInfo = true
+			_, _ = ccr.Trace.Write([]byte("*********************** <<CONTAINERD RUNTIME-INFO BEGIN>> **************************"), common.DumpTags)
+			_, _ = ccr.Trace.Write([]byte(ccr.exec.GetRuntimeInfo(ctx)), common.DumpTags)
+			_, _ = ccr.Trace.Write([]byte("*********************** <<CONTAINERD RUNTIME-INFO END>>  **************************"), common.DumpTags)
+		}
+	}
+	if err == nil {
+		err = fmt.Errorf("failed to execute command '%s' exit-code=%d", ccr.Command, ccr.ExitCode)
+	}
+	return ccr.Stdout.Bytes(), ccr.Stderr.Bytes(), err
+}
+
+// Stop kills nerdctl exec process, which terminates the command within container
+func (ccr *CommandRunner) Stop(
+	context.Context,
+	time.Duration) error {
+	if ccr.cancel != nil {
+		ccr.cancel()
+		return nil
+	}
+	return fmt.Errorf("cannot cancel command")
+}
+
+// IsRunning returns true if command is running
+func (ccr *CommandRunner) IsRunning(context.Context) (bool, error) {
+	select {
+	case <-ccr.done:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+func (ccr *CommandRunner) run(ctx context.Context) error {
+	if ccr.ExecutorOptions.Debug || !ccr.IsHelper(ctx) {
+		_ = ccr.WriteTrace(ctx, fmt.Sprintf("🔄 $ %s", ccr.Command))
+	}
+	if err := ccr.cmd.Start(); err != nil {
+		_ = ccr.BaseExecutor.WriteTraceError(ctx, fmt.Sprintf(
+			"⛔ $ %s Error=%v", ccr.Command, err))
+		return err
+	}
+	_ = ccr.ExecutorOptions.Environment.AddFromEnvCommand(ccr.Command)
+	go func() {
+		defer close(ccr.done)
+		ccr.Err = ccr.cmd.Wait()
+		ccr.cancel()
+		var exitErr *exec.ExitError
+		if errors.As(ccr.Err, &exitErr) {
+			ccr.ExitCode = exitErr.ExitCode()
+			ccr.Err = nil
+		}
+	}()
+	return nil
+}
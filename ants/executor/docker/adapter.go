@@ -1,12 +1,9 @@
 package docker
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"github.com/docker/docker/api/types/registry"
 	"io"
 	"plexobject.com/formicary/internal/ant_config"
 	"strings"
@@ -57,6 +54,13 @@ type Adapter interface {
 		helper bool) (ExecuteInfo, error)
 	GetLogs(ctx context.Context, name string, waitForNotRunning bool) (io.ReadCloser, error)
 	GetRuntimeInfo(ctx context.Context, container string) string
+	Stats(ctx context.Context, containerID string) (*domain.ResourceStats, error)
+	BuildImage(
+		ctx context.Context,
+		opts *domain.ExecutorOptions,
+		containerID string,
+		imageBuild *domain.ImageBuild,
+		progress func(string)) (*domain.ImageBuildResult, error)
 }
 
 // Utils defines helper methods using docker API
@@ -307,25 +311,18 @@ func (u *Utils) removeDockerVolumes(ctx context.Context, opts *domain.ExecutorOp
 
 // Pull method fetches images from docker registry
 func (u *Utils) Pull(ctx context.Context, image string) (io.ReadCloser, error) {
-	authConfig := registry.AuthConfig{
-		Username:      u.config.Username,
-		Password:      u.config.Password,
-		ServerAddress: u.config.Server,
-	}
 	logrus.WithFields(logrus.Fields{
 		"Component": "DockerAdapter",
 		"Image":     image,
 		"Server":    u.config.Server,
 	}).Info("pulling docker image...")
-	//
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(authConfig); err != nil {
+	auth, err := u.encodeRegistryAuth()
+	if err != nil {
 		return nil, err
 	}
-	//
 	options := dockerimage.PullOptions{}
 	if strings.Contains(image, u.config.Server) {
-		options.RegistryAuth = base64.URLEncoding.EncodeToString(buf.Bytes())
+		options.RegistryAuth = auth
 	}
 	return u.cli.ImagePull(ctx, image, options)
 }
@@ -450,6 +447,40 @@ func (u *Utils) GetRuntimeInfo(ctx context.Context, container string) string {
 	return sb.String()
 }
 
+// Stats returns cpu, resident memory and block i/o used by the container
+func (u *Utils) Stats(ctx context.Context, containerID string) (*domain.ResourceStats, error) {
+	reader, err := u.cli.ContainerStatsOneShot(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Body.Close()
+	}()
+	var resp container.StatsResponse
+	if err = json.NewDecoder(reader.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("failed to decode stats of container %s due to %w", containerID, err)
+	}
+	stats := &domain.ResourceStats{
+		CPUNanos:    resp.CPUStats.CPUUsage.TotalUsage,
+		MemoryBytes: int64(resp.MemoryStats.Usage),
+	}
+	// resident memory without page cache is anon for cgroup v2 and rss for cgroup v1
+	for _, name := range []string{"anon", "total_rss", "rss"} {
+		if rss, ok := resp.MemoryStats.Stats[name]; ok {
+			stats.MemoryBytes = int64(rss)
+			break
+		}
+	}
+	for _, entry := range resp.BlkioStats.IoServiceBytesRecursive {
+		if strings.EqualFold(entry.Op, "read") {
+			stats.IOReadBytes += int64(entry.Value)
+		} else if strings.EqualFold(entry.Op, "write") {
+			stats.IOWriteBytes += int64(entry.Value)
+		}
+	}
+	return stats, nil
+}
+
 func attachOptions() container.AttachOptions {
 	return container.AttachOptions{
 		Stream: true,
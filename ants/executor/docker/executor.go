@@ -78,11 +78,11 @@ func NewDockerExecutor(
 
 	hostName, _ := os.Hostname()
 	_ = base.WriteTrace(ctx, fmt.Sprintf(
-		"[%s DOCKER %s] ✅ running with formicary %s on %s",
-		time.Now().Format(time.RFC3339), opts.Name, cfg.Common.ID, hostName))
+		"[%s %s %s] ✅ running with formicary %s on %s",
+		time.Now().Format(time.RFC3339), opts.Method, opts.Name, cfg.Common.ID, hostName))
 	_ = exec.WriteTraceInfo(ctx, fmt.Sprintf(
-		"[%s DOCKER %s] 🐳 preparing docker container with image %s",
-		time.Now().Format(time.RFC3339), opts.Name, opts.MainContainer.Image))
+		"[%s %s %s] 🐳 preparing container with image %s",
+		time.Now().Format(time.RFC3339), opts.Method, opts.Name, opts.MainContainer.Image))
 	return
 }
 
@@ -162,6 +162,38 @@ func (de *Executor) Stop(ctx context.Context) error {
 	return err
 }
 
+// SampleResources - reads resources used by the main container
+func (de *Executor) SampleResources(ctx context.Context) (*types.ResourceStats, error) {
+	de.lock.RLock()
+	defer de.lock.RUnlock()
+	if de.State == executor.Removing {
+		return nil, fmt.Errorf("container [%s %s] is already stopped", de.ID, de.Name)
+	}
+	return de.adapter.Stats(ctx, de.ID)
+}
+
+// BuildImage - builds image from the working directory of main container
+func (de *Executor) BuildImage(
+	ctx context.Context,
+	imageBuild *types.ImageBuild) (*types.ImageBuildResult, error) {
+	de.lock.RLock()
+	defer de.lock.RUnlock()
+	if de.State == executor.Removing {
+		return nil, fmt.Errorf("failed to build image because container is already stopped")
+	}
+	_ = de.WriteTraceInfo(ctx, fmt.Sprintf("[%s %s %s] 🏗️ building image Tags=%v Context=%s Dockerfile=%s Push=%v",
+		time.Now().Format(time.RFC3339), de.ExecutorOptions.Method, de.Name,
+		imageBuild.Tags, imageBuild.Context, imageBuild.Dockerfile, imageBuild.Push))
+	return de.adapter.BuildImage(
+		ctx,
+		de.ExecutorOptions,
+		de.ID,
+		imageBuild,
+		func(line string) {
+			_ = de.WriteTrace(ctx, line)
+		})
+}
+
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
 // doAsyncExecute - executing command by docker executor
 func (de *Executor) doAsyncExecute(
@@ -0,0 +1,216 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/build"
+	dockerimage "github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/sirupsen/logrus"
+	domain "plexobject.com/formicary/internal/types"
+)
+
+// BuildImage builds image from the build context inside the container with BuildKit so that
+// tasks don't need privileged docker-in-docker, and pushes tags when requested.
+func (u *Utils) BuildImage(
+	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	containerID string,
+	imageBuild *domain.ImageBuild,
+	progress func(string)) (*domain.ImageBuildResult, error) {
+	started := time.Now()
+	contextDir := imageBuild.ContextDir(opts.WorkingDirectory)
+	reader, _, err := u.cli.CopyFromContainer(ctx, containerID, contextDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy build context %s from container due to %w", contextDir, err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	// docker archives directory under its base name but build context must be at the root
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(rebaseTar(reader, pw))
+	}()
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	buildArgs := make(map[string]*string)
+	for k, v := range imageBuild.BuildArgs {
+		val := v
+		buildArgs[k] = &val
+	}
+	buildOpts := build.ImageBuildOptions{
+		Tags:        imageBuild.Tags,
+		Dockerfile:  imageBuild.Dockerfile,
+		Target:      imageBuild.Target,
+		Platform:    imageBuild.Platform,
+		BuildArgs:   buildArgs,
+		CacheFrom:   imageBuild.CacheFrom,
+		Labels:      opts.PodLabels,
+		Remove:      true,
+		ForceRemove: true,
+	}
+	if opts.Method != domain.Podman {
+		// podman builds with buildah and doesn't understand builder version
+		buildOpts.Version = build.BuilderBuildKit
+	}
+	res, err := u.cli.ImageBuild(ctx, pr, buildOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image %v due to %w", imageBuild.Tags, err)
+	}
+	imageID, err := readImageStream(res.Body, progress)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image %v due to %w", imageBuild.Tags, err)
+	}
+	result := &domain.ImageBuildResult{
+		Digest: imageID,
+		Tags:   imageBuild.Tags,
+	}
+
+	if imageBuild.Push {
+		for _, tag := range imageBuild.Tags {
+			digest, err := u.pushImage(ctx, tag, progress)
+			if err != nil {
+				return nil, err
+			}
+			if digest != "" {
+				result.Digest = digest
+			}
+		}
+		result.Pushed = true
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component": "DockerAdapter",
+		"Container": containerID,
+		"Context":   contextDir,
+		"Tags":      imageBuild.Tags,
+		"Digest":    result.Digest,
+		"Pushed":    result.Pushed,
+		"Elapsed":   time.Since(started),
+	}).Info("built image")
+	return result, nil
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ///////////////////////////////////////////
+func (u *Utils) pushImage(
+	ctx context.Context,
+	tag string,
+	progress func(string)) (string, error) {
+	auth, err := u.encodeRegistryAuth()
+	if err != nil {
+		return "", err
+	}
+	out, err := u.cli.ImagePush(ctx, tag, dockerimage.PushOptions{RegistryAuth: auth})
+	if err != nil {
+		return "", fmt.Errorf("failed to push image %s due to %w", tag, err)
+	}
+	defer func() {
+		_ = out.Close()
+	}()
+	digest, err := readImageStream(out, progress)
+	if err != nil {
+		return "", fmt.Errorf("failed to push image %s due to %w", tag, err)
+	}
+	return digest, nil
+}
+
+func (u *Utils) encodeRegistryAuth() (string, error) {
+	authConfig := registry.AuthConfig{
+		Username:      u.config.Username,
+		Password:      u.config.Password,
+		ServerAddress: u.config.Server,
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(authConfig); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// readImageStream reads json messages from build or push and returns image-id or pushed digest
+func readImageStream(
+	reader io.Reader,
+	progress func(string)) (string, error) {
+	var digest string
+	dec := json.NewDecoder(reader)
+	for {
+		var msg jsonmessage.JSONMessage
+		if err := dec.Decode(&msg); errors.Is(err, io.EOF) {
+			return digest, nil
+		} else if err != nil {
+			return "", err
+		}
+		if msg.Error != nil {
+			return "", msg.Error
+		}
+		if line := strings.TrimSpace(msg.Stream); line != "" && progress != nil {
+			progress(line)
+		}
+		if msg.Aux == nil {
+			continue
+		}
+		var aux struct {
+			ID     string `json:"ID"`
+			Digest string `json:"Digest"`
+		}
+		// buildkit also sends trace records as aux that are not json objects
+		if err := json.Unmarshal(*msg.Aux, &aux); err != nil {
+			continue
+		}
+		if aux.Digest != "" {
+			digest = aux.Digest
+		} else if aux.ID != "" {
+			digest = aux.ID
+		}
+	}
+}
+
+// rebaseTar strips top-level directory added by docker when archiving a directory
+func rebaseTar(src io.Reader, dst io.Writer) error {
+	tr := tar.NewReader(src)
+	tw := tar.NewWriter(dst)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return tw.Close()
+		} else if err != nil {
+			return err
+		}
+		name := stripFirstDir(hdr.Name)
+		if name == "" {
+			continue
+		}
+		hdr.Name = name
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = stripFirstDir(hdr.Linkname)
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func stripFirstDir(name string) string {
+	name = strings.TrimPrefix(name, "./")
+	if idx := strings.Index(name, "/"); idx >= 0 {
+		return strings.TrimPrefix(name[idx+1:], "/")
+	}
+	return ""
+}
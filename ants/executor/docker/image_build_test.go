@@ -0,0 +1,62 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldRebaseBuildContextTar(t *testing.T) {
+	// GIVEN tar archived by docker under base name of directory
+	var src bytes.Buffer
+	tw := tar.NewWriter(&src)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "workspace/", Typeflag: tar.TypeDir, Mode: 0755}))
+	data := []byte("FROM alpine\n")
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "workspace/Dockerfile", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(data))}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	// WHEN rebasing tar
+	var dst bytes.Buffer
+	require.NoError(t, rebaseTar(&src, &dst))
+
+	// THEN files should be at the root of build context
+	tr := tar.NewReader(&dst)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	require.Equal(t, []string{"Dockerfile"}, names)
+}
+
+func Test_ShouldReadImageStream(t *testing.T) {
+	// GIVEN build output with buildkit trace and image id
+	stream := `{"stream":"Step 1/1 : FROM alpine\n"}
+{"id":"moby.buildkit.trace","aux":"CgQKAmlk"}
+{"aux":{"ID":"sha256:abc"}}
+`
+	var lines []string
+	// WHEN reading stream
+	digest, err := readImageStream(strings.NewReader(stream), func(line string) {
+		lines = append(lines, line)
+	})
+	// THEN image id and progress should be returned
+	require.NoError(t, err)
+	require.Equal(t, "sha256:abc", digest)
+	require.Equal(t, []string{"Step 1/1 : FROM alpine"}, lines)
+
+	// GIVEN stream with error WHEN reading THEN it should fail
+	_, err = readImageStream(strings.NewReader(`{"errorDetail":{"message":"boom"},"error":"boom"}`), nil)
+	require.Error(t, err)
+}
@@ -120,6 +120,46 @@ type Executor interface {
 	GetLabels() map[string]string // returns labels
 }
 
+// ImageBuilder is implemented by executors that can build container images from the task working directory
+// swagger:ignore
+type ImageBuilder interface {
+	BuildImage(
+		ctx context.Context,
+		build *types.ImageBuild) (*types.ImageBuildResult, error)
+}
+
+// FileTransfer is implemented by executors that don't share filesystem with the ant so that
+// artifacts and cache can be copied between the ant and the remote host
+// swagger:ignore
+type FileTransfer interface {
+	// DownloadFiles copies remote files, directories or globs into local directory and
+	// returns their paths relative to the local directory
+	DownloadFiles(
+		ctx context.Context,
+		paths []string,
+		localDir string) ([]string, error)
+	// UploadDirectory copies local directory tree into remote directory
+	UploadDirectory(
+		ctx context.Context,
+		localDir string,
+		remoteDir string) error
+}
+
+// PathResolver is implemented by executors that run commands in a private working directory so that
+// relative artifact paths can be resolved against it without changing the options of the request
+// swagger:ignore
+type PathResolver interface {
+	ResolvePaths(paths []string) []string
+}
+
+// ResourceSampler is implemented by executors that can read resources used by containers or processes of
+// the task so that the task can be profiled during its execution
+// swagger:ignore
+type ResourceSampler interface {
+	SampleResources(
+		ctx context.Context) (*types.ResourceStats, error)
+}
+
 // BaseExecutor struct defines attributes for the executor
 // swagger:ignore
 type BaseExecutor struct {
@@ -17,7 +17,9 @@ import (
 	"plexobject.com/formicary/internal/utils/trace"
 
 	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
 	api "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -62,6 +64,17 @@ type Adapter interface {
 		opts *domain.ExecutorOptions,
 		initContainers []api.Container,
 		credentials *api.Secret) (*api.Pod, []string, []string, float64, error)
+	BuildJob(
+		ctx context.Context,
+		opts *domain.ExecutorOptions,
+		initContainers []api.Container,
+		credentials *api.Secret) (*batchv1.Job, []string, []string, float64, error)
+	AwaitJobRunning(
+		ctx context.Context,
+		trace trace.JobTrace,
+		job *batchv1.Job,
+		timeout time.Duration) ([]*api.Pod, error)
+	StopJob(ctx context.Context, name string) error
 	Execute(
 		ctx context.Context,
 		base *executor.BaseCommandRunner,
@@ -80,6 +93,10 @@ type Adapter interface {
 	GetRuntimeInfo(
 		ctx context.Context,
 		podName string) string
+	ReadCgroupStats(
+		ctx context.Context,
+		podName string,
+		containerName string) (*domain.ResourceStats, error)
 	Dispose(
 		ctx context.Context,
 		namespace string,
@@ -96,13 +113,13 @@ type Adapter interface {
 type Utils struct {
 	config     *ant_config.AntConfig
 	restConfig *restclient.Config
-	cli        *kubernetes.Clientset
+	cli        kubernetes.Interface
 }
 
 // NewKubernetesUtils - creates new adapter for kubernetes
 func NewKubernetesUtils(
 	config *ant_config.AntConfig,
-	cli *kubernetes.Clientset,
+	cli kubernetes.Interface,
 	restConfig *restclient.Config) (*Utils, error) {
 	return &Utils{
 		config:     config,
@@ -362,6 +379,53 @@ func (u *Utils) GetEvents(
 // BuildPod - builds pod definition
 func (u *Utils) BuildPod(
 	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	initContainers []api.Container,
+	credentials *api.Secret) (*api.Pod, []string, []string, float64, error) {
+	podConfig, serviceNames, aliasNames, totalCost, err := u.buildPodConfig(opts, initContainers, credentials)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	labels := podConfig.Labels
+	annotations := podConfig.Annotations
+
+	pod, err := u.cli.CoreV1().Pods(u.config.Kubernetes.Namespace).Create(ctx, podConfig, metav1.CreateOptions{})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component":    "KubernetesAdapter",
+			"POD":          podConfig.Name,
+			"Options":      opts.String(),
+			"Labels":       labels,
+			"CWD":          opts.WorkingDirectory,
+			"Annotations":  annotations,
+			"Error":        err,
+			"Namespace":    u.config.Kubernetes.Namespace,
+			"Memory":       utils.MemUsageMiBString(),
+			"ResourceType": u.getResourceConfigType(),
+			"TotalCost":    totalCost,
+		}).Warnf("failed to create pod with enhanced configuration: %s", opts.Name)
+	} else {
+		logrus.WithFields(logrus.Fields{
+			"Component":     "KubernetesAdapter",
+			"POD":           podConfig.Name,
+			"Options":       opts.String(),
+			"Labels":        labels,
+			"Services":      serviceNames,
+			"ServicesCount": len(podConfig.Spec.Containers),
+			"CWD":           opts.WorkingDirectory,
+			"Annotations":   annotations,
+			"Namespace":     u.config.Kubernetes.Namespace,
+			"Memory":        utils.MemUsageMiBString(),
+			"ResourceType":  u.getResourceConfigType(),
+			"TotalCost":     totalCost,
+		}).Infof("created pod with enhanced configuration: %s", opts.Name)
+	}
+
+	return pod, serviceNames, aliasNames, totalCost, err
+}
+
+// buildPodConfig builds pod specification with services, main and helper containers without creating it
+func (u *Utils) buildPodConfig(
 	opts *domain.ExecutorOptions,
 	initContainers []api.Container,
 	credentials *api.Secret) (*api.Pod, []string, []string, float64, error) {
@@ -477,6 +541,10 @@ func (u *Utils) BuildPod(
 		}
 	}
 
+	if opts.ImageBuild != nil && opts.WorkingDirectory == "" {
+		// builder shares working directory with main container
+		opts.WorkingDirectory = defaultImageBuildWorkingDir
+	}
 	// Main Container
 	{
 		volumes = opts.MainContainer.GetKubernetesVolumes().AddVolumes(volumes)
@@ -694,39 +762,10 @@ func (u *Utils) BuildPod(
 		return nil, nil, nil, 0, fmt.Errorf("failed to create pod config for %s due to %w", opts.Name, err)
 	}
 
-	pod, err := u.cli.CoreV1().Pods(u.config.Kubernetes.Namespace).Create(ctx, podConfig, metav1.CreateOptions{})
-	if err != nil {
-		logrus.WithFields(logrus.Fields{
-			"Component":    "KubernetesAdapter",
-			"POD":          podConfig.Name,
-			"Options":      opts.String(),
-			"Labels":       labels,
-			"CWD":          opts.WorkingDirectory,
-			"Annotations":  annotations,
-			"Error":        err,
-			"Namespace":    u.config.Kubernetes.Namespace,
-			"Memory":       utils.MemUsageMiBString(),
-			"ResourceType": u.getResourceConfigType(),
-			"TotalCost":    totalCost,
-		}).Warnf("failed to create pod with enhanced configuration: %s", opts.Name)
-	} else {
-		logrus.WithFields(logrus.Fields{
-			"Component":     "KubernetesAdapter",
-			"POD":           podConfig.Name,
-			"Options":       opts.String(),
-			"Labels":        labels,
-			"Services":      serviceNames,
-			"ServicesCount": len(podServices),
-			"CWD":           opts.WorkingDirectory,
-			"Annotations":   annotations,
-			"Namespace":     u.config.Kubernetes.Namespace,
-			"Memory":        utils.MemUsageMiBString(),
-			"ResourceType":  u.getResourceConfigType(),
-			"TotalCost":     totalCost,
-		}).Infof("created pod with enhanced configuration: %s", opts.Name)
+	if opts.ImageBuild != nil {
+		addImageBuilder(&u.config.Kubernetes, podConfig, opts)
 	}
-
-	return pod, serviceNames, aliasNames, totalCost, err
+	return podConfig, serviceNames, aliasNames, totalCost, nil
 }
 
 // BuildRegistryCredentials creates a Kubernetes docker pull secret for the configured
@@ -863,6 +902,36 @@ func (u *Utils) Execute(
 	return pod, nil
 }
 
+// ReadCgroupStats - reads cgroup stats of the container by executing a command in the container
+func (u *Utils) ReadCgroupStats(
+	ctx context.Context,
+	podName string,
+	containerName string) (*domain.ResourceStats, error) {
+	req := u.cli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace(u.config.Kubernetes.Namespace).
+		SubResource("exec").
+		VersionedParams(&api.PodExecOptions{
+			Container: containerName,
+			Command:   []string{"/bin/sh", "-c", executor.CgroupStatsCommand},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(u.restConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create spdy executor for %s due to %w", podName, err)
+	}
+	var stdout, stderr strings.Builder
+	if err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read cgroup stats in pod %s due to %w", podName, err)
+	}
+	return executor.ParseCgroupStats(stdout.String())
+}
+
 // GetRuntimeInfo returns runtime info
 func (u *Utils) GetRuntimeInfo(
 	ctx context.Context,
@@ -905,6 +974,15 @@ func (u *Utils) GetRuntimeInfo(
 func (u *Utils) Stop(
 	ctx context.Context,
 	containerID string) error {
+	// pods of a job are replaced by the job controller so the job is deleted instead, and executors
+	// in job mode are named after their job
+	if pod, err := u.GetPod(ctx, containerID); err == nil && pod.Labels[batchv1.JobNameLabel] != "" {
+		return u.StopJob(ctx, pod.Labels[batchv1.JobNameLabel])
+	} else if k8errors.IsNotFound(err) {
+		if jobErr := u.StopJob(ctx, containerID); jobErr == nil {
+			return nil
+		}
+	}
 	return u.cli.CoreV1().Pods(u.config.Kubernetes.Namespace).
 		Delete(ctx, containerID, metav1.DeleteOptions{})
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	batchv1 "k8s.io/api/batch/v1"
 	api "k8s.io/api/core/v1"
 
 	"github.com/oklog/ulid/v2"
@@ -26,6 +27,8 @@ type Executor struct {
 	lock                sync.RWMutex
 	adapter             Adapter
 	pod                 *api.Pod
+	job                 *batchv1.Job
+	indexedPods         []*api.Pod
 	registryCredentials *api.Secret
 	services            []api.Service // TODO add proxy services
 	serviceNames        []string
@@ -115,13 +118,25 @@ func (ke *Executor) AsyncExecute(
 	return ke.doAsyncExecute(ctx, ke.BaseExecutor.Name, cmd, false, variables)
 }
 
+// SampleResources - reads resources used by the main container of the pod
+func (ke *Executor) SampleResources(
+	ctx context.Context) (*types.ResourceStats, error) {
+	ke.lock.RLock()
+	defer ke.lock.RUnlock()
+	if ke.pod == nil || ke.State != executor.Running {
+		return nil, fmt.Errorf("pod is not running")
+	}
+	return ke.adapter.ReadCgroupStats(ctx, ke.pod.Name, ke.BaseExecutor.Name)
+}
+
 // Stop - stop executing command by kubernetes executor
 func (ke *Executor) Stop(
 	ctx context.Context,
 ) error {
 	ke.lock.Lock()
 	defer ke.lock.Unlock()
-	if ke.pod == nil {
+	// job is created before its pods are running so it must be stopped even if awaiting its pods failed
+	if ke.pod == nil && ke.job == nil {
 		return fmt.Errorf("no pod is running")
 	}
 	started := time.Now()
@@ -129,7 +144,7 @@ func (ke *Executor) Stop(
 	if ke.State == executor.Removing {
 		_ = ke.WriteTrace(ctx,
 			fmt.Sprintf("☸️ cannot remove container as it's already stopped"))
-		return fmt.Errorf("container [%s %s] is already stopped", ke.pod.UID, ke.Name)
+		return fmt.Errorf("container [%s] is already stopped", ke.Name)
 	}
 	_ = ke.BaseExecutor.WriteTraceInfo(ctx, fmt.Sprintf("✋ stopping container"))
 
@@ -138,10 +153,19 @@ func (ke *Executor) Stop(
 	ke.State = executor.Removing
 	now := time.Now()
 	ke.EndedAt = &now
-	err := ke.adapter.Stop(cancelCtx, ke.pod.Name)
+	var err error
+	if ke.job != nil {
+		err = ke.adapter.StopJob(cancelCtx, ke.job.Name)
+	} else {
+		err = ke.adapter.Stop(cancelCtx, ke.pod.Name)
+	}
+	namespace := ke.AntConfig.Kubernetes.Namespace
+	if ke.pod != nil {
+		namespace = ke.pod.Namespace
+	}
 	errors := ke.adapter.Dispose(
 		cancelCtx,
-		ke.pod.Namespace,
+		namespace,
 		ke.services,
 		nil,
 		nil,
@@ -163,7 +187,7 @@ func (ke *Executor) Stop(
 	for _, err := range errors {
 		_ = ke.BaseExecutor.WriteTrace(ctx, fmt.Sprintf("dispose failed %v", err.Error()))
 	}
-	if err == nil && ke.AntConfig.Kubernetes.AwaitShutdownPod {
+	if err == nil && ke.pod != nil && ke.AntConfig.Kubernetes.AwaitShutdownPod {
 		_ = ke.BaseExecutor.WriteTrace(ctx, fmt.Sprintf("awaiting for container to stop"))
 		if _, err = ke.adapter.AwaitPodTerminating(
 			cancelCtx,
@@ -208,6 +232,9 @@ func (ke *Executor) ensurePodsConfigured() (err error) {
 
 	// TODO setup config-map
 	initContainers := ke.AntConfig.Kubernetes.GetInitContainers()
+	if ke.useJob() {
+		return ke.ensureJobConfigured(ctx, initContainers, started)
+	}
 	// retry build pod if we can
 	var aliases []string
 	for i := 0; i < maxBuildPodTries; i++ {
@@ -290,6 +317,55 @@ func (ke *Executor) ensurePodsConfigured() (err error) {
 	return nil
 }
 
+// ensureJobConfigured submits batch job and waits until pods of all completion indexes are running
+func (ke *Executor) ensureJobConfigured(
+	ctx context.Context,
+	initContainers []api.Container,
+	started time.Time) (err error) {
+	var aliases []string
+	ke.job, ke.serviceNames, aliases, ke.ExecutorOptions.CostFactor, err = ke.adapter.BuildJob(
+		ctx,
+		ke.ExecutorOptions,
+		initContainers,
+		ke.registryCredentials)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component":    "KubernetesExecutor",
+			"Elapsed":      time.Since(started),
+			"Name":         ke.ExecutorOptions.Name,
+			"Memory":       cutils.MemUsageMiBString(),
+			"ExecutorOpts": ke.ExecutorOptions}).
+			Warnf("setting up failed for job due to %s", err)
+		return fmt.Errorf("setting up failed for job due to %w (%s)", err, ke.ExecutorOptions.Name)
+	}
+
+	_, _ = ke.Trace.Writeln(fmt.Sprintf("[%s KUBERNETES %s] ☸️ creating job Image=%s %s Services=%v Aliases=%v Cost=%v",
+		time.Now().Format(time.RFC3339),
+		ke.job.Name,
+		ke.ExecutorOptions.MainContainer.Image,
+		describeJob(ke.job),
+		ke.serviceNames,
+		aliases,
+		ke.ExecutorOptions.CostFactor), types.ExecTags)
+
+	ke.indexedPods, err = ke.adapter.AwaitJobRunning(
+		ctx,
+		ke.Trace,
+		ke.job,
+		ke.AntConfig.GetPollTimeout(),
+	)
+	if err != nil {
+		return fmt.Errorf("waiting for job running: %w, AwaitRunningPeriod=%v, Timeout=%v, Elapsed=%s",
+			err, ke.AntConfig.GetAwaitRunningPeriod(), ke.AntConfig.GetPollTimeout(), time.Since(started))
+	}
+	ke.pod = ke.indexedPods[0]
+	return nil
+}
+
+func (ke *Executor) useJob() bool {
+	return ke.ExecutorOptions.KubernetesJob != nil || ke.AntConfig.Kubernetes.UseJobs
+}
+
 // doAsyncExecute - executing command by kubernetes executor
 func (ke *Executor) doAsyncExecute(
 	ctx context.Context,
@@ -317,8 +393,15 @@ func (ke *Executor) doAsyncExecute(
 		ke.State = executor.ContainerFailed
 		return nil, err
 	}
-	ke.Name = ke.pod.Name
+	// pods of a job have generated names so executor keeps job name that matches container names
+	if ke.job == nil {
+		ke.Name = ke.pod.Name
+	}
 	ke.State = executor.Running
+	// helper commands transfer artifacts so they only run on the first index, env commands update shared options
+	if !helper && len(ke.indexedPods) > 1 && !strings.HasPrefix(cmd, "env ") {
+		return ke.doAsyncIndexedExecute(ctx, containerName, cmd)
+	}
 	runner, err := NewCommandRunner(
 		ke,
 		ke.adapter,
@@ -331,3 +414,31 @@ func (ke *Executor) doAsyncExecute(
 	}
 	return runner, runner.run(ctx)
 }
+
+func (ke *Executor) doAsyncIndexedExecute(
+	ctx context.Context,
+	containerName string,
+	cmd string) (executor.CommandRunner, error) {
+	runners := make([]*CommandRunner, len(ke.indexedPods))
+	for i, pod := range ke.indexedPods {
+		runner, err := NewCommandRunner(
+			ke,
+			ke.adapter,
+			pod.Name,
+			containerName,
+			cmd,
+			false)
+		if err == nil {
+			err = runner.run(ctx)
+		}
+		if err != nil {
+			// commands already started on earlier indexes are cancelled so that they don't keep running
+			for _, started := range runners[:i] {
+				_ = started.Stop(ctx, ke.AntConfig.GetShutdownTimeout())
+			}
+			return nil, fmt.Errorf("failed to execute on index %d due to %w", i, err)
+		}
+		runners[i] = runner
+	}
+	return NewIndexedCommandRunner(runners)
+}
@@ -0,0 +1,201 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	api "k8s.io/api/core/v1"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+)
+
+const (
+	imageBuilderSuffix          = "-builder"
+	imageBuildWorkspaceVolume   = "image-build-workspace"
+	imageBuildRegistryVolume    = "image-build-registry"
+	defaultImageBuildWorkingDir = "/workspace"
+	buildkitUID                 = int64(1000)
+)
+
+var imageDigestRegex = regexp.MustCompile(`sha256:[a-f0-9]{64}`)
+
+// BuildImage - builds image with the rootless builder container that shares workspace with main container
+func (ke *Executor) BuildImage(
+	ctx context.Context,
+	imageBuild *types.ImageBuild) (*types.ImageBuildResult, error) {
+	builder := &ke.AntConfig.Kubernetes.ImageBuilder
+	cmd := buildImageCommand(builder, imageBuild, imageBuild.ContextDir(ke.ExecutorOptions.WorkingDirectory))
+	_ = ke.WriteTraceInfo(ctx, fmt.Sprintf("[%s KUBERNETES %s] 🏗️ building image with %s Tags=%v Context=%s Dockerfile=%s Push=%v",
+		time.Now().Format(time.RFC3339), ke.Name, builder.Type,
+		imageBuild.Tags, imageBuild.Context, imageBuild.Dockerfile, imageBuild.Push))
+	runner, err := ke.doAsyncExecute(ctx, ke.ExecutorOptions.Name+imageBuilderSuffix, cmd, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	stdout, _, err := runner.Await(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build image %v due to %w", imageBuild.Tags, err)
+	}
+	digest, err := parseImageDigest(stdout)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ImageBuildResult{
+		Digest: digest,
+		Tags:   imageBuild.Tags,
+		Pushed: imageBuild.Push,
+	}, nil
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ///////////////////////////////////////////
+// addImageBuilder adds builder container to the pod that shares working directory of main container
+// through an empty-dir volume so that images are built without privileged docker-in-docker.
+func addImageBuilder(
+	config *ant_config.KubernetesConfig,
+	pod *api.Pod,
+	opts *types.ExecutorOptions) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, api.Volume{
+		Name:         imageBuildWorkspaceVolume,
+		VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}},
+	})
+	workspace := api.VolumeMount{Name: imageBuildWorkspaceVolume, MountPath: opts.WorkingDirectory}
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == opts.Name {
+			pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, workspace)
+		}
+	}
+
+	name := opts.Name + imageBuilderSuffix
+	builder := api.Container{
+		Name:            name,
+		Image:           config.ImageBuilder.Image,
+		ImagePullPolicy: api.PullPolicy(config.PullPolicy.GetKubernetesPullPolicy()),
+		WorkingDir:      opts.WorkingDirectory,
+		VolumeMounts:    []api.VolumeMount{workspace},
+		Stdin:           true,
+	}
+	dockerConfigDir := "/kaniko/.docker"
+	if config.ImageBuilder.IsBuildkit() {
+		// rootless buildkit needs unconfined seccomp and apparmor but no privileges
+		dockerConfigDir = "/home/user/.docker"
+		uid := buildkitUID
+		builder.Command = []string{"/bin/sh"}
+		builder.Env = []api.EnvVar{{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"}}
+		builder.SecurityContext = &api.SecurityContext{
+			RunAsUser:       &uid,
+			RunAsGroup:      &uid,
+			SeccompProfile:  &api.SeccompProfile{Type: api.SeccompProfileTypeUnconfined},
+			AppArmorProfile: &api.AppArmorProfile{Type: api.AppArmorProfileTypeUnconfined},
+		}
+	} else {
+		// kaniko debug image only has busybox shell so /bin/sh is linked for command execution
+		builder.Command = []string{"/busybox/sh", "-c", "ln -sf /busybox/sh /bin/sh && exec /busybox/sh"}
+	}
+
+	// generated registry credentials use legacy dockercfg format so push secret must be of dockerconfigjson type
+	secretName := config.ImageBuilder.PushSecret
+	if secretName == "" && len(config.ImagePullSecrets) > 0 {
+		secretName = config.ImagePullSecrets[0]
+	}
+	if secretName != "" {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, api.Volume{
+			Name: imageBuildRegistryVolume,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: secretName,
+					Items:      []api.KeyToPath{{Key: api.DockerConfigJsonKey, Path: "config.json"}},
+				},
+			},
+		})
+		builder.VolumeMounts = append(builder.VolumeMounts, api.VolumeMount{
+			Name:      imageBuildRegistryVolume,
+			MountPath: dockerConfigDir,
+			ReadOnly:  true,
+		})
+		builder.Env = append(builder.Env, api.EnvVar{Name: "DOCKER_CONFIG", Value: dockerConfigDir})
+	}
+	pod.Spec.Containers = append(pod.Spec.Containers, builder)
+}
+
+// buildImageCommand builds command for builder container that prints digest of the image to stdout
+func buildImageCommand(
+	builder *ant_config.KubernetesImageBuilderConfig,
+	imageBuild *types.ImageBuild,
+	contextDir string) string {
+	dockerfile := path.Join(contextDir, imageBuild.Dockerfile)
+	var sb strings.Builder
+	if builder.IsBuildkit() {
+		sb.WriteString("buildctl-daemonless.sh build --frontend=dockerfile.v0")
+		sb.WriteString(" --local context=" + shellQuote(contextDir))
+		sb.WriteString(" --local dockerfile=" + shellQuote(path.Dir(dockerfile)))
+		sb.WriteString(" --opt filename=" + shellQuote(path.Base(dockerfile)))
+		if imageBuild.Target != "" {
+			sb.WriteString(" --opt target=" + shellQuote(imageBuild.Target))
+		}
+		if imageBuild.Platform != "" {
+			sb.WriteString(" --opt platform=" + shellQuote(imageBuild.Platform))
+		}
+		for _, arg := range imageBuild.SortedBuildArgs() {
+			sb.WriteString(" --opt " + shellQuote("build-arg:"+arg))
+		}
+		for _, cache := range imageBuild.CacheFrom {
+			sb.WriteString(" --import-cache " + shellQuote("type=registry,ref="+cache))
+		}
+		sb.WriteString(" --output " + shellQuote(fmt.Sprintf(`type=image,"name=%s",push=%v`,
+			strings.Join(imageBuild.Tags, ","), imageBuild.Push)))
+		sb.WriteString(" --metadata-file /tmp/image-metadata.json && cat /tmp/image-metadata.json")
+		return sb.String()
+	}
+	sb.WriteString("/kaniko/executor --context=" + shellQuote("dir://"+contextDir))
+	sb.WriteString(" --dockerfile=" + shellQuote(dockerfile))
+	for _, tag := range imageBuild.Tags {
+		sb.WriteString(" --destination=" + shellQuote(tag))
+	}
+	if !imageBuild.Push {
+		sb.WriteString(" --no-push")
+	}
+	if imageBuild.Target != "" {
+		sb.WriteString(" --target=" + shellQuote(imageBuild.Target))
+	}
+	if imageBuild.Platform != "" {
+		sb.WriteString(" --custom-platform=" + shellQuote(imageBuild.Platform))
+	}
+	for _, arg := range imageBuild.SortedBuildArgs() {
+		sb.WriteString(" --build-arg=" + shellQuote(arg))
+	}
+	if len(imageBuild.CacheFrom) > 0 {
+		// kaniko caches layers in a single repository
+		sb.WriteString(" --cache=true --cache-repo=" + shellQuote(imageBuild.CacheFrom[0]))
+	}
+	sb.WriteString(" --digest-file=/tmp/image-digest && cat /tmp/image-digest")
+	return sb.String()
+}
+
+// parseImageDigest parses digest file of kaniko or metadata file of buildkit
+func parseImageDigest(stdout []byte) (string, error) {
+	out := strings.TrimSpace(string(stdout))
+	if idx := strings.LastIndex(out, "\n{"); idx >= 0 {
+		out = out[idx+1:]
+	}
+	if strings.HasPrefix(out, "{") {
+		metadata := make(map[string]any)
+		if err := json.Unmarshal([]byte(out), &metadata); err == nil {
+			if digest, ok := metadata["containerimage.digest"].(string); ok && digest != "" {
+				return digest, nil
+			}
+		}
+	}
+	if matches := imageDigestRegex.FindAllString(out, -1); len(matches) > 0 {
+		return matches[len(matches)-1], nil
+	}
+	return "", fmt.Errorf("failed to find image digest in builder output")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
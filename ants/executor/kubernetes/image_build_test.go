@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/internal/ant_config"
+	domain "plexobject.com/formicary/internal/types"
+)
+
+func Test_ShouldBuildKanikoImageCommand(t *testing.T) {
+	// GIVEN kaniko builder and image build
+	builder := &ant_config.KubernetesImageBuilderConfig{Type: "kaniko"}
+	build := &domain.ImageBuild{
+		Tags:      []string{"registry/app:1.0"},
+		BuildArgs: map[string]string{"VERSION": "1.0"},
+		CacheFrom: []string{"registry/app-cache"},
+	}
+	require.NoError(t, build.Validate())
+	// WHEN building command
+	cmd := buildImageCommand(builder, build, build.ContextDir("/workspace"))
+	// THEN it should build without pushing and print digest
+	require.True(t, strings.HasPrefix(cmd, "/kaniko/executor --context='dir:///workspace'"))
+	require.Contains(t, cmd, "--dockerfile='/workspace/Dockerfile'")
+	require.Contains(t, cmd, "--destination='registry/app:1.0'")
+	require.Contains(t, cmd, "--no-push")
+	require.Contains(t, cmd, "--build-arg='VERSION=1.0'")
+	require.Contains(t, cmd, "--cache-repo='registry/app-cache'")
+	require.True(t, strings.HasSuffix(cmd, "cat /tmp/image-digest"))
+}
+
+func Test_ShouldBuildBuildkitImageCommand(t *testing.T) {
+	// GIVEN buildkit builder and image build
+	builder := &ant_config.KubernetesImageBuilderConfig{Type: "buildkit"}
+	build := &domain.ImageBuild{Tags: []string{"registry/app:1.0"}, Push: true, Context: "app"}
+	require.NoError(t, build.Validate())
+	// WHEN building command
+	cmd := buildImageCommand(builder, build, build.ContextDir("/workspace"))
+	// THEN it should push with buildctl
+	require.True(t, strings.HasPrefix(cmd, "buildctl-daemonless.sh build"))
+	require.Contains(t, cmd, "--local context='/workspace/app'")
+	require.Contains(t, cmd, `--output 'type=image,"name=registry/app:1.0",push=true'`)
+}
+
+func Test_ShouldParseImageDigest(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	// GIVEN kaniko output
+	res, err := parseImageDigest([]byte("INFO pushed\n" + digest + "\n"))
+	require.NoError(t, err)
+	require.Equal(t, digest, res)
+	// GIVEN buildkit metadata
+	res, err = parseImageDigest([]byte(`#1 done` + "\n" + `{"containerimage.digest":"` + digest + `"}`))
+	require.NoError(t, err)
+	require.Equal(t, digest, res)
+	// GIVEN output without digest
+	_, err = parseImageDigest([]byte("failed"))
+	require.Error(t, err)
+}
+
+func Test_ShouldAddImageBuilderToPod(t *testing.T) {
+	// GIVEN kubernetes utils with push secret
+	cfg := newConfig()
+	cfg.Kubernetes.ImageBuilder.PushSecret = "registry-push"
+	require.NoError(t, cfg.Kubernetes.Validate())
+	u, _ := newJobTestUtils(t)
+	u.config = cfg
+	opts := domain.NewExecutorOptions("build-task", domain.Kubernetes)
+	opts.MainContainer.Image = "alpine"
+	opts.ImageBuild = &domain.ImageBuild{Tags: []string{"registry/app:1.0"}}
+	require.NoError(t, opts.Validate())
+
+	// WHEN building pod config
+	pod, _, _, _, err := u.buildPodConfig(opts, nil, nil)
+	require.NoError(t, err)
+
+	// THEN builder container should share workspace with main container
+	require.Equal(t, defaultImageBuildWorkingDir, opts.WorkingDirectory)
+	var builderFound bool
+	for _, c := range pod.Spec.Containers {
+		if c.Name == opts.Name+imageBuilderSuffix {
+			builderFound = true
+			require.Equal(t, "gcr.io/kaniko-project/executor:debug", c.Image)
+			require.Equal(t, 2, len(c.VolumeMounts))
+			require.Nil(t, c.SecurityContext)
+		}
+	}
+	require.True(t, builderFound)
+	var secretFound bool
+	for _, v := range pod.Spec.Volumes {
+		if v.Secret != nil && v.Secret.SecretName == "registry-push" {
+			secretFound = true
+		}
+	}
+	require.True(t, secretFound)
+}
@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+// IndexedCommandRunner executes same command on every pod of an indexed job for fan-out tasks
+type IndexedCommandRunner struct {
+	runners []*CommandRunner
+	failed  *CommandRunner
+}
+
+// NewIndexedCommandRunner constructor
+func NewIndexedCommandRunner(runners []*CommandRunner) (*IndexedCommandRunner, error) {
+	if len(runners) == 0 {
+		return nil, fmt.Errorf("runners not specified")
+	}
+	return &IndexedCommandRunner{runners: runners}, nil
+}
+
+// Await awaits for completion of all indexes, output of each index is appended in index order
+func (icr *IndexedCommandRunner) Await(ctx context.Context) ([]byte, []byte, error) {
+	var stdout, stderr bytes.Buffer
+	var firstErr error
+	for i, r := range icr.runners {
+		out, errOut, err := r.Await(ctx)
+		stdout.Write(out)
+		stderr.Write(errOut)
+		if err != nil && firstErr == nil {
+			icr.failed = r
+			firstErr = fmt.Errorf("index %d failed due to %w", i, err)
+		}
+	}
+	return stdout.Bytes(), stderr.Bytes(), firstErr
+}
+
+// IsRunning checks if any index is still running
+func (icr *IndexedCommandRunner) IsRunning(ctx context.Context) (bool, error) {
+	for _, r := range icr.runners {
+		running, err := r.IsRunning(ctx)
+		if err != nil || running {
+			return running, err
+		}
+	}
+	return false, nil
+}
+
+// IsHelper returns true if command is executed on helper container
+func (icr *IndexedCommandRunner) IsHelper(ctx context.Context) bool {
+	return icr.runners[0].IsHelper(ctx)
+}
+
+// Stop stops all indexes
+func (icr *IndexedCommandRunner) Stop(ctx context.Context, timeout time.Duration) error {
+	var firstErr error
+	for _, r := range icr.runners {
+		if err := r.Stop(ctx, timeout); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// GetExitCode returns exit code of first failed index
+func (icr *IndexedCommandRunner) GetExitCode() int {
+	return icr.result().GetExitCode()
+}
+
+// GetExitMessage returns exit message of first failed index
+func (icr *IndexedCommandRunner) GetExitMessage() string {
+	return icr.result().GetExitMessage()
+}
+
+// Elapsed time
+func (icr *IndexedCommandRunner) Elapsed() string {
+	return icr.runners[0].Elapsed()
+}
+
+func (icr *IndexedCommandRunner) result() *CommandRunner {
+	if icr.failed != nil {
+		return icr.failed
+	}
+	return icr.runners[0]
+}
@@ -0,0 +1,298 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	api "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	domain "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+// BuildJob - builds batch/v1 Job that wraps the task pod, commands are executed in its pods by the ant
+// so the job never completes on its own and it's deleted when the executor is stopped
+// See https://kubernetes.io/docs/concepts/workloads/controllers/job/
+func (u *Utils) BuildJob(
+	ctx context.Context,
+	opts *domain.ExecutorOptions,
+	initContainers []api.Container,
+	credentials *api.Secret) (*batchv1.Job, []string, []string, float64, error) {
+	podConfig, serviceNames, aliasNames, totalCost, err := u.buildPodConfig(opts, initContainers, credentials)
+	if err != nil {
+		return nil, nil, nil, 0, err
+	}
+	jobConfig := buildJobConfig(podConfig, opts.KubernetesJob.WithDefaults(&u.config.Kubernetes.Job))
+
+	job, err := u.cli.BatchV1().Jobs(u.config.Kubernetes.Namespace).Create(ctx, jobConfig, metav1.CreateOptions{})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "KubernetesAdapter",
+			"Job":       jobConfig.Name,
+			"Options":   opts.String(),
+			"Error":     err,
+			"Namespace": u.config.Kubernetes.Namespace,
+			"Memory":    utils.MemUsageMiBString(),
+			"TotalCost": totalCost,
+		}).Warnf("failed to create job: %s", opts.Name)
+	} else {
+		logrus.WithFields(logrus.Fields{
+			"Component": "KubernetesAdapter",
+			"Job":       jobConfig.Name,
+			"Spec":      describeJob(job),
+			"Services":  serviceNames,
+			"Namespace": u.config.Kubernetes.Namespace,
+			"Memory":    utils.MemUsageMiBString(),
+			"TotalCost": totalCost,
+		}).Infof("created job: %s", opts.Name)
+	}
+	return job, serviceNames, aliasNames, totalCost, err
+}
+
+// AwaitJobRunning - watches pods of the job using informers until a pod for every completion index
+// is running. Pods that fail while starting are replaced by the job controller within backoff-limit.
+func (u *Utils) AwaitJobRunning(
+	ctx context.Context,
+	trace trace.JobTrace,
+	job *batchv1.Job,
+	timeout time.Duration) ([]*api.Pod, error) {
+	if job == nil {
+		return nil, fmt.Errorf("job cannot be empty when awaiting for running")
+	}
+	started := time.Now()
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	podFactory := informers.NewSharedInformerFactoryWithOptions(u.cli, 0,
+		informers.WithNamespace(job.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = jobPodSelector(job.Name).String()
+		}))
+	jobFactory := informers.NewSharedInformerFactoryWithOptions(u.cli, 0,
+		informers.WithNamespace(job.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", job.Name).String()
+		}))
+	defer func() {
+		cancel()
+		podFactory.Shutdown()
+		jobFactory.Shutdown()
+	}()
+	podInformer := podFactory.Core().V1().Pods()
+	jobInformer := jobFactory.Batch().V1().Jobs()
+
+	changed := make(chan struct{}, 1)
+	notify := func(any) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, obj any) { notify(obj) },
+		DeleteFunc: notify,
+	}
+	if _, err := podInformer.Informer().AddEventHandler(handler); err != nil {
+		return nil, fmt.Errorf("failed to watch pods of job %s due to %w", job.Name, err)
+	}
+	if _, err := jobInformer.Informer().AddEventHandler(handler); err != nil {
+		return nil, fmt.Errorf("failed to watch job %s due to %w", job.Name, err)
+	}
+	podFactory.Start(ctx.Done())
+	jobFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), podInformer.Informer().HasSynced, jobInformer.Informer().HasSynced) {
+		return nil, fmt.Errorf("failed to sync informers for job %s", job.Name)
+	}
+
+	expected := 1
+	if job.Spec.Completions != nil && *job.Spec.Completions > 1 {
+		expected = int(*job.Spec.Completions)
+	}
+	lastRunning := -1
+	for {
+		current, err := jobInformer.Lister().Jobs(job.Namespace).Get(job.Name)
+		if k8errors.IsNotFound(err) {
+			return nil, fmt.Errorf("⛔ job %s was deleted while waiting for running state", job.Name)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get job %s due to %w", job.Name, err)
+		}
+		pods, err := podInformer.Lister().Pods(job.Namespace).List(jobPodSelector(job.Name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods of job %s due to %w", job.Name, err)
+		}
+		running, count, err := runningJobPods(current, pods, expected)
+		if err != nil {
+			_, _ = trace.Writeln(fmt.Sprintf("[%s KUBERNETES %s] ⛔ waiting for job-running failed Error=%v",
+				time.Now().Format(time.RFC3339), job.Name, err), domain.ExecTags)
+			return nil, err
+		}
+		if running != nil {
+			_, _ = trace.Writeln(fmt.Sprintf("[%s KUBERNETES %s] ✅ job-running ready with Pods=%d Elapsed=%s",
+				time.Now().Format(time.RFC3339), job.Name, len(running), time.Since(started)), domain.ExecTags)
+			return running, nil
+		}
+		if count != lastRunning {
+			lastRunning = count
+			_, _ = trace.Writeln(fmt.Sprintf("[%s KUBERNETES %s] ⌛ waiting for job-running Running=%d/%d Pods=%d",
+				time.Now().Format(time.RFC3339), job.Name, count, expected, len(pods)), domain.ExecTags)
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			_, _ = trace.Writeln(fmt.Sprintf("[%s KUBERNETES %s] ⌛ waiting for job-running but timeout %v elapsed %s",
+				time.Now().Format(time.RFC3339), job.Name, timeout, time.Since(started)), domain.ExecTags)
+			return nil, fmt.Errorf("timed out waiting for job %s running, Running=%d/%d due to %w",
+				job.Name, count, expected, ctx.Err())
+		}
+	}
+}
+
+// StopJob - deletes job along with its pods
+func (u *Utils) StopJob(
+	ctx context.Context,
+	name string) error {
+	propagation := metav1.DeletePropagationBackground
+	return u.cli.BatchV1().Jobs(u.config.Kubernetes.Namespace).
+		Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ///////////////////////////////////////////
+func buildJobConfig(
+	pod *api.Pod,
+	spec *domain.KubernetesJob) *batchv1.Job {
+	completions := int32(1)
+	var completionMode *batchv1.CompletionMode
+	if spec.IsIndexed() {
+		// commands are executed on every index so all indexes are scheduled together
+		completions = spec.Completions
+		indexed := batchv1.IndexedCompletion
+		completionMode = &indexed
+	}
+	parallelism := completions
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pod.Name,
+			Namespace:   pod.Namespace,
+			Labels:      pod.Labels,
+			Annotations: pod.Annotations,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:             &completions,
+			Parallelism:             &parallelism,
+			CompletionMode:          completionMode,
+			BackoffLimit:            spec.BackoffLimit,
+			ActiveDeadlineSeconds:   spec.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished: spec.TTLSecondsAfterFinished,
+			Template: api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      pod.Labels,
+					Annotations: pod.Annotations,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+}
+
+// runningJobPods returns running pods ordered by completion index once every index has a running pod,
+// otherwise it returns number of indexes running so far
+func runningJobPods(
+	job *batchv1.Job,
+	pods []*api.Pod,
+	expected int) ([]*api.Pod, int, error) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobFailed:
+			return nil, 0, fmt.Errorf("⛔ job %s failed Reason=%s Message=%s", job.Name, c.Reason, c.Message)
+		case batchv1.JobComplete:
+			return nil, 0, fmt.Errorf("⛔ failed to wait for running state, job %s is already completed", job.Name)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+	})
+	running := make([]*api.Pod, expected)
+	count := 0
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.State.Waiting == nil {
+				continue
+			}
+			switch container.State.Waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff":
+				return nil, 0, fmt.Errorf("⛔ image pull failed for pod %s: %s", pod.Name, container.State.Waiting.Message)
+			}
+		}
+		if pod.Status.Phase != api.PodRunning {
+			continue
+		}
+		idx := 0
+		if expected > 1 {
+			var err error
+			if idx, err = strconv.Atoi(pod.Annotations[batchv1.JobCompletionIndexAnnotation]); err != nil ||
+				idx < 0 || idx >= expected {
+				continue
+			}
+		}
+		if running[idx] == nil {
+			running[idx] = pod
+			count++
+		}
+	}
+	if count < expected {
+		return nil, count, nil
+	}
+	return running, count, nil
+}
+
+func jobPodSelector(name string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{batchv1.JobNameLabel: name})
+}
+
+func describeJob(job *batchv1.Job) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Completions=%d", int32Value(job.Spec.Completions)))
+	if job.Spec.CompletionMode != nil {
+		sb.WriteString(fmt.Sprintf(" Mode=%s", *job.Spec.CompletionMode))
+	}
+	if job.Spec.BackoffLimit != nil {
+		sb.WriteString(fmt.Sprintf(" BackoffLimit=%d", *job.Spec.BackoffLimit))
+	}
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		sb.WriteString(fmt.Sprintf(" ActiveDeadlineSeconds=%d", *job.Spec.ActiveDeadlineSeconds))
+	}
+	if job.Spec.TTLSecondsAfterFinished != nil {
+		sb.WriteString(fmt.Sprintf(" TTLSecondsAfterFinished=%d", *job.Spec.TTLSecondsAfterFinished))
+	}
+	return sb.String()
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,196 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	domain "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+func newJobTestUtils(t *testing.T) (*Utils, *fake.Clientset) {
+	cfg := newConfig()
+	ttl := int32(120)
+	cfg.Kubernetes.Job.TTLSecondsAfterFinished = &ttl
+	cli := fake.NewSimpleClientset()
+	u, err := NewKubernetesUtils(cfg, cli, nil)
+	require.NoError(t, err)
+	return u, cli
+}
+
+func newJobTestOptions(completions int32) *domain.ExecutorOptions {
+	opts := domain.NewExecutorOptions("job-task", domain.Kubernetes)
+	opts.MainContainer.Image = "alpine"
+	backoff := int32(2)
+	deadline := int64(600)
+	opts.KubernetesJob = &domain.KubernetesJob{
+		BackoffLimit:          &backoff,
+		ActiveDeadlineSeconds: &deadline,
+		Completions:           completions,
+	}
+	return opts
+}
+
+func newJobTestPod(job *batchv1.Job, index int, phase api.PodPhase) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%d-abc", job.Name, index),
+			Namespace:   job.Namespace,
+			Labels:      map[string]string{batchv1.JobNameLabel: job.Name},
+			Annotations: map[string]string{batchv1.JobCompletionIndexAnnotation: strconv.Itoa(index)},
+		},
+		Status: api.PodStatus{Phase: phase},
+	}
+}
+
+func newJobTestTrace(t *testing.T) trace.JobTrace {
+	jobTrace, err := trace.NewJobTrace(func(bytes []byte, tags string) {}, 1000, make([]string, 0))
+	require.NoError(t, err)
+	return jobTrace
+}
+
+// Test_ShouldBuildIndexedJob verifies job spec is derived from task options and ant defaults
+func Test_ShouldBuildIndexedJob(t *testing.T) {
+	// GIVEN adapter with fake clientset
+	u, cli := newJobTestUtils(t)
+
+	// WHEN building indexed job
+	job, _, _, _, err := u.BuildJob(context.Background(), newJobTestOptions(3), nil, nil)
+
+	// THEN job should be created with batch settings
+	require.NoError(t, err)
+	saved, err := cli.BatchV1().Jobs("default").Get(context.Background(), job.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), *saved.Spec.Completions)
+	require.Equal(t, int32(3), *saved.Spec.Parallelism)
+	require.Equal(t, batchv1.IndexedCompletion, *saved.Spec.CompletionMode)
+	require.Equal(t, int32(2), *saved.Spec.BackoffLimit)
+	require.Equal(t, int64(600), *saved.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, int32(120), *saved.Spec.TTLSecondsAfterFinished)
+	require.Equal(t, api.RestartPolicyNever, saved.Spec.Template.Spec.RestartPolicy)
+	require.NotEmpty(t, saved.Spec.Template.Spec.Containers)
+}
+
+// Test_ShouldAwaitIndexedJobRunning verifies informer returns pods ordered by completion index
+func Test_ShouldAwaitIndexedJobRunning(t *testing.T) {
+	// GIVEN indexed job
+	u, cli := newJobTestUtils(t)
+	job, _, _, _, err := u.BuildJob(context.Background(), newJobTestOptions(2), nil, nil)
+	require.NoError(t, err)
+
+	// WHEN pods of the job start running after a while
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = cli.CoreV1().Pods("default").Create(context.Background(), newJobTestPod(job, 1, api.PodRunning), metav1.CreateOptions{})
+		_, _ = cli.CoreV1().Pods("default").Create(context.Background(), newJobTestPod(job, 0, api.PodPending), metav1.CreateOptions{})
+		time.Sleep(50 * time.Millisecond)
+		_, _ = cli.CoreV1().Pods("default").Update(context.Background(), newJobTestPod(job, 0, api.PodRunning), metav1.UpdateOptions{})
+	}()
+	pods, err := u.AwaitJobRunning(context.Background(), newJobTestTrace(t), job, 5*time.Second)
+
+	// THEN all indexes should be returned in order
+	require.NoError(t, err)
+	require.Len(t, pods, 2)
+	require.Equal(t, job.Name+"-0-abc", pods[0].Name)
+	require.Equal(t, job.Name+"-1-abc", pods[1].Name)
+}
+
+// Test_ShouldFailAwaitJobRunningWhenBackoffExceeded verifies failed job condition stops waiting
+func Test_ShouldFailAwaitJobRunningWhenBackoffExceeded(t *testing.T) {
+	// GIVEN job
+	u, cli := newJobTestUtils(t)
+	job, _, _, _, err := u.BuildJob(context.Background(), newJobTestOptions(0), nil, nil)
+	require.NoError(t, err)
+
+	// WHEN job controller marks job as failed
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		failed := job.DeepCopy()
+		failed.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: api.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}
+		_, _ = cli.BatchV1().Jobs("default").UpdateStatus(context.Background(), failed, metav1.UpdateOptions{})
+	}()
+	_, err = u.AwaitJobRunning(context.Background(), newJobTestTrace(t), job, 5*time.Second)
+
+	// THEN it should fail without waiting for timeout
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "BackoffLimitExceeded")
+}
+
+// Test_ShouldStopJob verifies job is deleted
+func Test_ShouldStopJob(t *testing.T) {
+	// GIVEN job
+	u, cli := newJobTestUtils(t)
+	job, _, _, _, err := u.BuildJob(context.Background(), newJobTestOptions(0), nil, nil)
+	require.NoError(t, err)
+
+	// WHEN stopping job
+	require.NoError(t, u.StopJob(context.Background(), job.Name))
+
+	// THEN job should be removed
+	_, err = cli.BatchV1().Jobs("default").Get(context.Background(), job.Name, metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+// Test_ShouldStopJobWhenItsPodsNeverStarted verifies job is deleted even if awaiting its pods failed
+func Test_ShouldStopJobWhenItsPodsNeverStarted(t *testing.T) {
+	// GIVEN executor with a job whose pods never started running
+	u, cli := newJobTestUtils(t)
+	opts := newJobTestOptions(0)
+	ke, err := NewKubernetesExecutor(context.Background(), u.config, newJobTestTrace(t), u, nil, opts)
+	require.NoError(t, err)
+	ke.job, _, _, _, err = u.BuildJob(context.Background(), opts, nil, nil)
+	require.NoError(t, err)
+
+	// WHEN stopping executor
+	require.NoError(t, ke.Stop(context.Background()))
+
+	// THEN job should be removed
+	_, err = cli.BatchV1().Jobs("default").Get(context.Background(), ke.job.Name, metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+// Test_ShouldStopJobOfPod verifies that stopping a pod or executor of a job deletes the job
+func Test_ShouldStopJobOfPod(t *testing.T) {
+	// GIVEN jobs with pods
+	u, cli := newJobTestUtils(t)
+	ctx := context.Background()
+	first, _, _, _, err := u.BuildJob(ctx, newJobTestOptions(0), nil, nil)
+	require.NoError(t, err)
+	pod, err := cli.CoreV1().Pods("default").Create(ctx, newJobTestPod(first, 0, api.PodRunning), metav1.CreateOptions{})
+	require.NoError(t, err)
+	opts := newJobTestOptions(0)
+	opts.Name = "other-job-task"
+	second, _, _, _, err := u.BuildJob(ctx, opts, nil, nil)
+	require.NoError(t, err)
+
+	// WHEN stopping pod of the first job and container named after the second job
+	require.NoError(t, u.Stop(ctx, pod.Name))
+	require.NoError(t, u.Stop(ctx, second.Name))
+
+	// THEN both jobs should be removed instead of pods that would be replaced by the job controller
+	_, err = cli.BatchV1().Jobs("default").Get(ctx, first.Name, metav1.GetOptions{})
+	require.Error(t, err)
+	_, err = cli.BatchV1().Jobs("default").Get(ctx, second.Name, metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+// Test_ShouldValidateKubernetesJob verifies invalid job settings are rejected
+func Test_ShouldValidateKubernetesJob(t *testing.T) {
+	backoff := int32(-1)
+	require.Error(t, (&domain.KubernetesJob{BackoffLimit: &backoff}).Validate())
+	require.Error(t, (&domain.KubernetesJob{Completions: -1}).Validate())
+	require.NoError(t, (&domain.KubernetesJob{Completions: 3}).Validate())
+	ttl := int32(10)
+	merged := (*domain.KubernetesJob)(nil).WithDefaults(&domain.KubernetesJob{TTLSecondsAfterFinished: &ttl})
+	require.Equal(t, int32(10), *merged.TTLSecondsAfterFinished)
+}
@@ -71,12 +71,12 @@ func _defaultKubectlConfig() (*restclient.Config, error) {
 	return clientcmd.NewDefaultClientConfig(*load, &clientcmd.ConfigOverrides{}).ClientConfig()
 }
 
-func closeKubeClient(client *kubernetes.Clientset) bool {
+func closeKubeClient(client kubernetes.Interface) bool {
 	if client == nil {
 		return false
 	}
 	rest, ok := client.CoreV1().RESTClient().(*restclient.RESTClient)
-	if !ok || rest.Client == nil || rest.Client.Transport == nil {
+	if !ok || rest == nil || rest.Client == nil || rest.Client.Transport == nil {
 		return false
 	}
 	if transport, ok := rest.Client.Transport.(*http.Transport); ok {
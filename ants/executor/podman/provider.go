@@ -0,0 +1,108 @@
+package podman
+
+import (
+	"context"
+	"github.com/docker/docker/client"
+	log "github.com/sirupsen/logrus"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/ants/executor/docker"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+	"sync"
+)
+
+// ExecutorProvider defines base structure for podman executor provider. Podman exposes a
+// docker-compatible API so containers are managed using docker adapter against podman socket,
+// which allows rootless hosts to run container tasks without docker daemon.
+type ExecutorProvider struct {
+	executor.BaseExecutorProvider
+	cli       *client.Client
+	adapter   docker.Adapter
+	executors map[string]*docker.Executor
+	lock      sync.RWMutex
+}
+
+// NewExecutorProvider creates executor-provider for local podman based execution
+func NewExecutorProvider(
+	config *ant_config.AntConfig) (executor.Provider, error) {
+	if err := config.Podman.Validate(); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{
+		"Component": "PodmanExecutorProvider",
+		"Host":      config.Podman.Host,
+	}).Info("podman client connecting...")
+	cli, err := client.NewClientWithOpts(
+		client.WithHost(config.Podman.Host),
+		client.WithVersion(config.Podman.APIVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutorProvider{
+		BaseExecutorProvider: executor.BaseExecutorProvider{
+			AntConfig: config,
+		},
+		executors: make(map[string]*docker.Executor),
+		cli:       cli,
+		adapter:   docker.NewDockerUtils(config.Podman.ToDockerConfig(), cli),
+	}, nil
+}
+
+// ListExecutors lists current executors
+func (pep *ExecutorProvider) ListExecutors(
+	context.Context) ([]executor.Info, error) {
+	pep.lock.RLock()
+	defer pep.lock.RUnlock()
+	execs := make([]executor.Info, 0)
+	for _, e := range pep.executors {
+		execs = append(execs, e)
+	}
+	return execs, nil
+}
+
+// AllRunningExecutors returns running executors
+func (pep *ExecutorProvider) AllRunningExecutors(
+	ctx context.Context) ([]executor.Info, error) {
+	pep.lock.RLock()
+	defer pep.lock.RUnlock()
+	return pep.adapter.List(ctx)
+}
+
+// StopExecutor stops executor
+func (pep *ExecutorProvider) StopExecutor(
+	ctx context.Context,
+	id string,
+	opts *types.ExecutorOptions) error {
+	pep.lock.Lock()
+	defer pep.lock.Unlock()
+	exec := pep.executors[id]
+	if exec == nil {
+		log.WithFields(log.Fields{
+			"Component": "PodmanExecutorProvider",
+			"Name":      id,
+		}).Warn("✋ stopping unknown container")
+		return pep.adapter.Stop(
+			ctx,
+			id,
+			opts,
+			pep.AntConfig.GetShutdownTimeout())
+	}
+	delete(pep.executors, id)
+	return exec.Stop(ctx)
+}
+
+// NewExecutor creates new executor
+func (pep *ExecutorProvider) NewExecutor(
+	ctx context.Context,
+	trace trace.JobTrace,
+	opts *types.ExecutorOptions) (executor.Executor, error) {
+	pep.lock.Lock()
+	defer pep.lock.Unlock()
+	exec, err := docker.NewDockerExecutor(ctx, pep.AntConfig, trace, opts, pep.adapter)
+	if err != nil {
+		return nil, err
+	}
+	pep.executors[exec.ID] = exec
+	return exec, nil
+}
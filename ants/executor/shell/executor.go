@@ -20,6 +20,7 @@ import (
 type Executor struct {
 	executor.BaseExecutor
 	runners map[string]*CommandRunner
+	sandbox *Sandbox
 	lock    sync.RWMutex
 }
 
@@ -43,10 +44,29 @@ func NewShellExecutor(
 	_ = base.WriteTraceInfo(ctx, fmt.Sprintf("[%s SHELL %s] 🌱 preparing shell executor",
 		time.Now().Format(time.RFC3339), opts.Name))
 
-	return &Executor{
+	exec := &Executor{
 		BaseExecutor: base,
 		runners:      make(map[string]*CommandRunner),
-	}, nil
+	}
+	if cfg.Shell.Sandbox.Enabled || opts.Sandbox {
+		if exec.sandbox, err = NewSandbox(&cfg.Shell.Sandbox, opts); err != nil {
+			_ = base.WriteTraceError(ctx, fmt.Sprintf("⛔ failed to create sandbox: %v", err))
+			return nil, err
+		}
+		_ = base.WriteTraceInfo(ctx, fmt.Sprintf("[%s SHELL %s] 🔒 running in sandbox Dir=%s CPU=%s Memory=%s IsolateNetwork=%v",
+			time.Now().Format(time.RFC3339), opts.Name, exec.sandbox.WorkDir(),
+			exec.sandbox.cpuMax, exec.sandbox.memoryMax, cfg.Shell.Sandbox.IsolateNetwork))
+	}
+	return exec, nil
+}
+
+// ResolvePaths resolves relative artifact paths against working directory of the sandbox, which is only used
+// when task doesn't define its own working directory
+func (se *Executor) ResolvePaths(paths []string) []string {
+	if se.sandbox == nil || se.ExecutorOptions.WorkingDirectory != "" {
+		return paths
+	}
+	return se.sandbox.ResolvePaths(paths)
 }
 
 func (se *Executor) GetConfigInfo() map[string]any {
@@ -98,6 +118,7 @@ func (se *Executor) doAsyncExecute(ctx context.Context, cmd string, helper bool)
 	if err != nil {
 		return nil, err
 	}
+	r.sandbox = se.sandbox
 	if err = r.run(ctx); err != nil {
 		return nil, err
 	}
@@ -105,6 +126,23 @@ func (se *Executor) doAsyncExecute(ctx context.Context, cmd string, helper bool)
 	return r, nil
 }
 
+// SampleResources reads resources used by the cgroup of sandbox or else by processes of the runners
+func (se *Executor) SampleResources(context.Context) (*types.ResourceStats, error) {
+	se.lock.RLock()
+	defer se.lock.RUnlock()
+	if se.State == executor.Removing {
+		return nil, fmt.Errorf("executor [%s] is already stopped", se.Name)
+	}
+	if se.sandbox != nil && se.sandbox.cgroupPath != "" {
+		return executor.ReadCgroupStats(se.sandbox.cgroupPath)
+	}
+	pgids := make(map[int]bool)
+	for _, r := range se.runners {
+		pgids[r.pid] = true
+	}
+	return readProcessGroupStats(pgids)
+}
+
 // Stop stopping execution by shell executor
 func (se *Executor) Stop(ctx context.Context,
 ) error {
@@ -131,6 +169,11 @@ func (se *Executor) Stop(ctx context.Context,
 			err = rErr
 		}
 	}
+	if se.sandbox != nil {
+		if sErr := se.sandbox.Close(); sErr != nil && err == nil {
+			err = sErr
+		}
+	}
 	_ = se.BaseExecutor.WriteTraceInfo(ctx,
 		fmt.Sprintf("🛑 stopped shell-container: Error=%v Elapsed=%v, StopWait=%v",
 			err, time.Since(started).String(), se.AntConfig.GetShutdownTimeout()))
@@ -22,9 +22,10 @@ import (
 // CommandRunner command runner for shell
 type CommandRunner struct {
 	executor.BaseCommandRunner
-	cancel context.CancelFunc
-	cmd    *exec.Cmd
-	pid    int
+	cancel  context.CancelFunc
+	cmd     *exec.Cmd
+	pid     int
+	sandbox *Sandbox
 }
 
 // NewCommandRunner constructor
@@ -82,7 +83,15 @@ func (scr *CommandRunner) run(ctx context.Context) error {
 		_ = scr.BaseExecutor.WriteTrace(ctx,
 			fmt.Sprintf("🔄 $ %s", scr.Command))
 	}
+	release := func() {}
+	if scr.sandbox != nil {
+		var err error
+		if release, err = scr.sandbox.Prepare(scr.cmd); err != nil {
+			return err
+		}
+	}
 	err := scr.cmd.Start()
+	release()
 	if err != nil {
 		return err
 	}
@@ -160,6 +169,10 @@ func (scr *CommandRunner) Stop(context.Context, time.Duration) error {
 	if scr.cancel != nil {
 		scr.cancel()
 	}
+	// sandboxed commands are killed along with their children so that nothing survives timeout
+	if scr.sandbox != nil && scr.pid > 0 {
+		return killProcessGroup(scr.pid)
+	}
 	return scr.cmd.Process.Kill()
 }
 
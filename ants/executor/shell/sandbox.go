@@ -0,0 +1,190 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+)
+
+// cpuPeriod defines cgroup v2 period in micro-seconds for cpu.max
+const cpuPeriod = 100000
+
+// Sandbox isolates shell commands of a task in its own cgroup v2 slice with a private working directory
+// so that untrusted jobs can be run on bare-metal ants.
+type Sandbox struct {
+	cfg        *ant_config.ShellSandboxConfig
+	cgroupPath string
+	workDir    string
+	cpuMax     string
+	memoryMax  string
+}
+
+// NewSandbox creates cgroup and private working directory for the task
+func NewSandbox(
+	cfg *ant_config.ShellSandboxConfig,
+	opts *types.ExecutorOptions) (sb *Sandbox, err error) {
+	sb = &Sandbox{cfg: cfg}
+	cpuLimit, memoryLimit := cfg.DefaultCPULimit, cfg.DefaultMemoryLimit
+	if opts.MainContainer != nil && opts.MainContainer.CPULimit != "" {
+		cpuLimit = opts.MainContainer.CPULimit
+	}
+	if opts.MainContainer != nil && opts.MainContainer.MemoryLimit != "" {
+		memoryLimit = opts.MainContainer.MemoryLimit
+	}
+	if sb.cpuMax, err = parseCPUMax(cpuLimit); err != nil {
+		return nil, err
+	}
+	if sb.memoryMax, err = parseMemoryMax(memoryLimit); err != nil {
+		return nil, err
+	}
+
+	if sb.workDir, err = os.MkdirTemp(cfg.TempDir, "formicary-"+opts.Name+"-"); err != nil {
+		return nil, fmt.Errorf("failed to create sandbox directory due to %w", err)
+	}
+	if err = sb.createCgroup(opts.Name); err != nil {
+		_ = os.RemoveAll(sb.workDir)
+		return nil, err
+	}
+	return sb, nil
+}
+
+// ResolvePaths returns new paths where relative paths are resolved against private working directory
+func (sb *Sandbox) ResolvePaths(paths []string) []string {
+	resolved := make([]string, len(paths))
+	for i, p := range paths {
+		if filepath.IsAbs(p) {
+			resolved[i] = p
+		} else {
+			resolved[i] = filepath.Join(sb.workDir, p)
+		}
+	}
+	return resolved
+}
+
+// WorkDir returns private working directory
+func (sb *Sandbox) WorkDir() string {
+	return sb.workDir
+}
+
+// Prepare configures command to run within sandbox, returned function must be called once command is started
+func (sb *Sandbox) Prepare(cmd *exec.Cmd) (func(), error) {
+	if cmd.Dir == "" {
+		cmd.Dir = sb.workDir
+	}
+	cmd.Env = append(cmd.Env, "TMPDIR="+sb.workDir, "HOME="+sb.workDir)
+	return sb.applySysProcAttr(cmd)
+}
+
+// Close kills any remaining processes of the task and removes its cgroup and working directory
+func (sb *Sandbox) Close() error {
+	var err error
+	if sb.cgroupPath != "" {
+		sb.killAll()
+		if rmErr := os.Remove(sb.cgroupPath); rmErr != nil && !os.IsNotExist(rmErr) {
+			err = fmt.Errorf("failed to remove cgroup %s due to %w", sb.cgroupPath, rmErr)
+		}
+	}
+	if rmErr := os.RemoveAll(sb.workDir); rmErr != nil {
+		err = rmErr
+	}
+	return err
+}
+
+// ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+func (sb *Sandbox) createCgroup(name string) error {
+	if err := os.MkdirAll(sb.cfg.CgroupRoot, 0755); err != nil {
+		return fmt.Errorf("failed to create cgroup root %s due to %w", sb.cfg.CgroupRoot, err)
+	}
+	// controllers must be delegated to children before limits can be set, it's a no-op if already enabled
+	_ = os.WriteFile(filepath.Join(sb.cfg.CgroupRoot, "cgroup.subtree_control"), []byte("+cpu +memory +pids"), 0644)
+
+	cgroupPath := filepath.Join(sb.cfg.CgroupRoot, fmt.Sprintf("%s-%s.scope", name, filepath.Base(sb.workDir)))
+	if err := os.Mkdir(cgroupPath, 0755); err != nil {
+		return fmt.Errorf("failed to create cgroup %s due to %w", cgroupPath, err)
+	}
+	sb.cgroupPath = cgroupPath
+	if sb.cpuMax != "" {
+		if err := sb.writeCgroup("cpu.max", sb.cpuMax); err != nil {
+			_ = os.Remove(cgroupPath)
+			return err
+		}
+	}
+	if sb.memoryMax != "" {
+		if err := sb.writeCgroup("memory.max", sb.memoryMax); err != nil {
+			_ = os.Remove(cgroupPath)
+			return err
+		}
+		// disable swap so that memory limit can't be bypassed
+		_ = sb.writeCgroup("memory.swap.max", "0")
+	}
+	return nil
+}
+
+func (sb *Sandbox) writeCgroup(file string, value string) error {
+	if err := os.WriteFile(filepath.Join(sb.cgroupPath, file), []byte(value), 0644); err != nil {
+		return fmt.Errorf("failed to set %s=%s for cgroup %s due to %w", file, value, sb.cgroupPath, err)
+	}
+	return nil
+}
+
+// killAll kills all processes in the cgroup including the ones that escaped process group
+func (sb *Sandbox) killAll() {
+	if err := sb.writeCgroup("cgroup.kill", "1"); err == nil {
+		return
+	}
+	// cgroup.kill requires linux 5.14, fallback to killing processes individually
+	data, err := os.ReadFile(filepath.Join(sb.cgroupPath, "cgroup.procs"))
+	if err != nil {
+		return
+	}
+	for _, pid := range strings.Fields(string(data)) {
+		var p int
+		if _, err := fmt.Sscanf(pid, "%d", &p); err == nil {
+			if proc, err := os.FindProcess(p); err == nil {
+				_ = proc.Kill()
+			}
+		}
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component": "ShellSandbox",
+		"Cgroup":    sb.cgroupPath,
+	}).Debug("killed remaining processes")
+}
+
+// parseCPUMax converts kubernetes style cpu quantity such as 500m to cgroup cpu.max value
+func parseCPUMax(limit string) (string, error) {
+	if limit == "" {
+		return "", nil
+	}
+	q, err := resource.ParseQuantity(limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse cpu limit %s due to %w", limit, err)
+	}
+	quota := q.MilliValue() * cpuPeriod / 1000
+	if quota <= 0 {
+		return "", fmt.Errorf("invalid cpu limit %s", limit)
+	}
+	return fmt.Sprintf("%d %d", quota, cpuPeriod), nil
+}
+
+// parseMemoryMax converts kubernetes style memory quantity such as 512Mi to cgroup memory.max value
+func parseMemoryMax(limit string) (string, error) {
+	if limit == "" {
+		return "", nil
+	}
+	q, err := resource.ParseQuantity(limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse memory limit %s due to %w", limit, err)
+	}
+	if q.Value() <= 0 {
+		return "", fmt.Errorf("invalid memory limit %s", limit)
+	}
+	return fmt.Sprintf("%d", q.Value()), nil
+}
@@ -0,0 +1,44 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// applySysProcAttr starts command directly in the cgroup of sandbox and optionally in a new network namespace,
+// the returned function must be called after the command is started to release cgroup file descriptor.
+func (sb *Sandbox) applySysProcAttr(cmd *exec.Cmd) (func(), error) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	release := func() {}
+	if sb.cgroupPath != "" {
+		fd, err := syscall.Open(sb.cgroupPath, syscall.O_DIRECTORY|syscall.O_RDONLY|syscall.O_CLOEXEC, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open cgroup %s due to %w", sb.cgroupPath, err)
+		}
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = fd
+		release = func() {
+			_ = syscall.Close(fd)
+		}
+	}
+	if sb.cfg.IsolateNetwork {
+		cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWNET
+		if uid := os.Getuid(); uid != 0 {
+			// unprivileged ants need a user namespace to create network namespace
+			cmd.SysProcAttr.Cloneflags |= syscall.CLONE_NEWUSER
+			cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: uid, HostID: uid, Size: 1}}
+			cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+		}
+	}
+	return release, nil
+}
+
+// killProcessGroup kills command along with all of its children
+func killProcessGroup(pid int) error {
+	return syscall.Kill(-pid, syscall.SIGKILL)
+}
@@ -0,0 +1,20 @@
+//go:build !linux
+
+package shell
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"syscall"
+)
+
+// applySysProcAttr is not supported because sandbox depends on cgroup v2 and linux namespaces
+func (sb *Sandbox) applySysProcAttr(*exec.Cmd) (func(), error) {
+	return nil, fmt.Errorf("shell sandbox is not supported on %s", runtime.GOOS)
+}
+
+// killProcessGroup kills command along with all of its children
+func killProcessGroup(pid int) error {
+	return syscall.Kill(-pid, syscall.SIGKILL)
+}
@@ -0,0 +1,80 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+)
+
+func Test_ShouldParseCPUMax(t *testing.T) {
+	cpu, err := parseCPUMax("500m")
+	require.NoError(t, err)
+	require.Equal(t, "50000 100000", cpu)
+	cpu, err = parseCPUMax("2")
+	require.NoError(t, err)
+	require.Equal(t, "200000 100000", cpu)
+	cpu, err = parseCPUMax("")
+	require.NoError(t, err)
+	require.Equal(t, "", cpu)
+	_, err = parseCPUMax("abc")
+	require.Error(t, err)
+}
+
+func Test_ShouldParseMemoryMax(t *testing.T) {
+	mem, err := parseMemoryMax("512Mi")
+	require.NoError(t, err)
+	require.Equal(t, "536870912", mem)
+	mem, err = parseMemoryMax("1G")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000", mem)
+	_, err = parseMemoryMax("-1")
+	require.Error(t, err)
+}
+
+// Test_ShouldCreateAndCloseSandbox uses a plain directory in place of cgroup fs to verify limits are written
+func Test_ShouldCreateAndCloseSandbox(t *testing.T) {
+	// GIVEN sandbox config with fake cgroup root
+	root := t.TempDir()
+	cfg := &ant_config.ShellSandboxConfig{
+		CgroupRoot:         filepath.Join(root, "cgroup"),
+		TempDir:            root,
+		DefaultMemoryLimit: "256Mi",
+	}
+	opts := types.NewExecutorOptions("task1", types.Shell)
+	opts.MainContainer.CPULimit = "250m"
+	opts.Artifacts.Paths = []string{"out.txt", "/var/abs.txt"}
+
+	// WHEN sandbox is created
+	sb, err := NewSandbox(cfg, opts)
+	require.NoError(t, err)
+
+	// THEN limits, private directory and artifact paths should be set up
+	cpu, err := os.ReadFile(filepath.Join(sb.cgroupPath, "cpu.max"))
+	require.NoError(t, err)
+	require.Equal(t, "25000 100000", string(cpu))
+	mem, err := os.ReadFile(filepath.Join(sb.cgroupPath, "memory.max"))
+	require.NoError(t, err)
+	require.Equal(t, "268435456", string(mem))
+	require.DirExists(t, sb.WorkDir())
+	require.Equal(t, []string{filepath.Join(sb.WorkDir(), "out.txt"), "/var/abs.txt"},
+		sb.ResolvePaths(opts.Artifacts.Paths))
+	// AND options of the request should not be changed
+	require.Equal(t, []string{"out.txt", "/var/abs.txt"}, opts.Artifacts.Paths)
+
+	// WHEN sandbox is closed
+	// THEN working directory should be removed (cgroup files can't be removed from a plain directory)
+	_ = sb.Close()
+	require.NoDirExists(t, sb.WorkDir())
+}
+
+func Test_ShouldFailSandboxWithBadLimits(t *testing.T) {
+	cfg := &ant_config.ShellSandboxConfig{CgroupRoot: t.TempDir(), TempDir: t.TempDir()}
+	opts := types.NewExecutorOptions("task1", types.Shell)
+	opts.MainContainer.MemoryLimit = "lots"
+	_, err := NewSandbox(cfg, opts)
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package shell
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"plexobject.com/formicary/internal/types"
+)
+
+// clockTicks is USER_HZ used by /proc for cpu times
+const clockTicks = 100
+
+// readProcessGroupStats sums cpu, resident memory and i/o of processes in the process groups from /proc,
+// where cpu of children that were waited for is included in their parents.
+func readProcessGroupStats(pgids map[int]bool) (*types.ResourceStats, error) {
+	stats := &types.ResourceStats{}
+	dirs, err := filepath.Glob("/proc/[0-9]*")
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int64(os.Getpagesize())
+	for _, dir := range dirs {
+		data, err := os.ReadFile(filepath.Join(dir, "stat"))
+		if err != nil {
+			continue
+		}
+		// command name in parentheses may contain spaces so fields are parsed after it
+		idx := strings.LastIndexByte(string(data), ')')
+		if idx < 0 {
+			continue
+		}
+		fields := strings.Fields(string(data[idx+1:]))
+		if len(fields) < 22 {
+			continue
+		}
+		if pgid, _ := strconv.Atoi(fields[2]); !pgids[pgid] {
+			continue
+		}
+		var ticks uint64
+		for _, field := range fields[11:15] {
+			n, _ := strconv.ParseUint(field, 10, 64)
+			ticks += n
+		}
+		stats.CPUNanos += ticks * 1000000000 / clockTicks
+		rss, _ := strconv.ParseInt(fields[21], 10, 64)
+		stats.MemoryBytes += rss * pageSize
+		if io, err := os.ReadFile(filepath.Join(dir, "io")); err == nil {
+			for _, line := range strings.Split(string(io), "\n") {
+				name, val, _ := strings.Cut(line, ":")
+				n, _ := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+				if name == "read_bytes" {
+					stats.IOReadBytes += n
+				} else if name == "write_bytes" {
+					stats.IOWriteBytes += n
+				}
+			}
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,15 @@
+//go:build !linux
+
+package shell
+
+import (
+	"fmt"
+	"runtime"
+
+	"plexobject.com/formicary/internal/types"
+)
+
+// readProcessGroupStats is not supported because it depends on /proc
+func readProcessGroupStats(map[int]bool) (*types.ResourceStats, error) {
+	return nil, fmt.Errorf("profiling of shell tasks is not supported on %s", runtime.GOOS)
+}
@@ -0,0 +1,118 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+)
+
+// buildClientConfig builds client config using private key from job secrets that are passed to the
+// executor as environment and host key of the task or known hosts file of the ant
+func buildClientConfig(
+	cfg *ant_config.SSHConfig,
+	opts *types.ExecutorOptions) (*gossh.ClientConfig, error) {
+	key := strings.TrimSpace(opts.Environment[opts.SSH.PrivateKeyConfig])
+	if key == "" {
+		return nil, fmt.Errorf("failed to find private key in config %s", opts.SSH.PrivateKeyConfig)
+	}
+	var signer gossh.Signer
+	var err error
+	if opts.SSH.PassphraseConfig != "" {
+		passphrase := opts.Environment[opts.SSH.PassphraseConfig]
+		signer, err = gossh.ParsePrivateKeyWithPassphrase([]byte(key+"\n"), []byte(passphrase))
+	} else {
+		signer, err = gossh.ParsePrivateKey([]byte(key + "\n"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key in config %s due to %w", opts.SSH.PrivateKeyConfig, err)
+	}
+	hostKeyCallback, err := buildHostKeyCallback(cfg, opts.SSH)
+	if err != nil {
+		return nil, err
+	}
+	return &gossh.ClientConfig{
+		User:            opts.SSH.User,
+		Auth:            []gossh.AuthMethod{gossh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         cfg.ConnectTimeout,
+	}, nil
+}
+
+func buildHostKeyCallback(
+	cfg *ant_config.SSHConfig,
+	sshOpts *types.SSHOptions) (gossh.HostKeyCallback, error) {
+	if sshOpts.HostKey != "" {
+		hostKey, _, _, _, err := gossh.ParseAuthorizedKey([]byte(sshOpts.HostKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse host_key due to %w", err)
+		}
+		return gossh.FixedHostKey(hostKey), nil
+	}
+	if sshOpts.InsecureIgnoreHostKey {
+		return gossh.InsecureIgnoreHostKey(), nil
+	}
+	if cfg.KnownHostsFile != "" {
+		callback, err := knownhosts.New(cfg.KnownHostsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load known hosts %s due to %w", cfg.KnownHostsFile, err)
+		}
+		return callback, nil
+	}
+	return nil, fmt.Errorf("host_key is not specified for %s and ant has no known_hosts_file", sshOpts.Host)
+}
+
+// dial connects to the remote server and honors cancellation of the context while connecting
+func dial(
+	ctx context.Context,
+	addr string,
+	config *gossh.ClientConfig) (*gossh.Client, error) {
+	dialer := net.Dialer{Timeout: config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s due to %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else if config.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(config.Timeout))
+	}
+	c, chans, reqs, err := gossh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to establish ssh connection with %s due to %w", addr, err)
+	}
+	// deadline only applies to handshake
+	_ = conn.SetDeadline(time.Time{})
+	return gossh.NewClient(c, chans, reqs), nil
+}
+
+// keepAlive sends keep-alive requests so that idle connections aren't dropped during long commands
+func keepAlive(
+	client *gossh.Client,
+	interval time.Duration,
+	done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if _, _, err := client.SendRequest("keepalive@openssh.com", true, nil); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// shellQuote quotes value for posix shell on the remote server
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
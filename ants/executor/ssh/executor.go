@@ -0,0 +1,227 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/pkg/sftp"
+	gossh "golang.org/x/crypto/ssh"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+// Executor for ssh runs commands on a remote server that can't run an ant
+type Executor struct {
+	executor.BaseExecutor
+	client *gossh.Client
+	sftp   *sftp.Client
+	// tempWorkDir is the working directory created under temp directory for tasks without working directory
+	tempWorkDir string
+	runners     map[string]*CommandRunner
+	// onStopped notifies provider that created the executor once it's stopped
+	onStopped func(id string)
+	done      chan struct{}
+	lock      sync.RWMutex
+}
+
+// NewSSHExecutor for creating ssh executor
+func NewSSHExecutor(
+	ctx context.Context,
+	cfg *ant_config.AntConfig,
+	trace trace.JobTrace,
+	opts *types.ExecutorOptions) (*Executor, error) {
+	if opts.SSH == nil {
+		return nil, fmt.Errorf("ssh is not specified for %s", opts.Name)
+	}
+	if err := opts.SSH.Validate(); err != nil {
+		return nil, err
+	}
+	base, err := executor.NewBaseExecutor(cfg, trace, opts)
+	if err != nil {
+		return nil, err
+	}
+	base.ID = ulid.Make().String()
+	base.Name = opts.Name
+	base.Host = opts.SSH.Host
+
+	_ = base.WriteTraceInfo(ctx, fmt.Sprintf("[%s SSH %s] 🌱 connecting to %s@%s",
+		time.Now().Format(time.RFC3339), opts.Name, opts.SSH.User, opts.SSH.Address()))
+	clientConfig, err := buildClientConfig(&cfg.SSH, opts)
+	if err != nil {
+		_ = base.WriteTraceError(ctx, fmt.Sprintf("⛔ failed to configure ssh: %v", err))
+		return nil, err
+	}
+	client, err := dial(ctx, opts.SSH.Address(), clientConfig)
+	if err != nil {
+		_ = base.WriteTraceError(ctx, fmt.Sprintf("⛔ %v", err))
+		return nil, err
+	}
+	if addr, ok := client.RemoteAddr().(*net.TCPAddr); ok {
+		base.ContainerIP = addr.IP.String()
+	}
+	exec := &Executor{
+		BaseExecutor: base,
+		client:       client,
+		runners:      make(map[string]*CommandRunner),
+		done:         make(chan struct{}),
+	}
+	go keepAlive(client, cfg.SSH.KeepAliveInterval, exec.done)
+	if opts.WorkingDirectory == "" {
+		// only the directory created by the executor is removed when it stops
+		exec.tempWorkDir = path.Join(cfg.SSH.TempDir, fmt.Sprintf("formicary-%s", exec.ID))
+		if err = exec.runRemote(ctx, "mkdir -p "+shellQuote(exec.tempWorkDir)); err != nil {
+			_ = base.WriteTraceError(ctx, fmt.Sprintf("⛔ failed to create working directory %s: %v", exec.tempWorkDir, err))
+			close(exec.done)
+			_ = client.Close()
+			return nil, err
+		}
+		opts.WorkingDirectory = exec.tempWorkDir
+	}
+	_ = base.WriteTrace(ctx, fmt.Sprintf(
+		"[%s SSH %s] ✅ running with formicary %s on %s ServerVersion=%s",
+		time.Now().Format(time.RFC3339), opts.Name, cfg.Common.ID, opts.SSH.Address(), client.ServerVersion()))
+	return exec, nil
+}
+
+// GetConfigInfo returns remote server of the executor
+func (se *Executor) GetConfigInfo() map[string]any {
+	return map[string]any{
+		"Host": se.ExecutorOptions.SSH.Host,
+		"Port": se.ExecutorOptions.SSH.Port,
+		"User": se.ExecutorOptions.SSH.User,
+	}
+}
+
+// GetRuntimeInfo for getting runtime info for ssh executor
+func (se *Executor) GetRuntimeInfo(
+	context.Context) string {
+	var buf bytes.Buffer
+	se.lock.RLock()
+	defer se.lock.RUnlock()
+	buf.WriteString(fmt.Sprintf("SSH ID=%s Name=%s Host=%s Runners=%d",
+		se.ID, se.Name, se.ExecutorOptions.SSH.Address(), len(se.runners)))
+	for _, r := range se.runners {
+		buf.WriteString(fmt.Sprintf("🔄 $ %s\n", r.Command))
+	}
+	return buf.String()
+}
+
+// AsyncHelperExecute for executing by ssh executor, helper commands also run on the remote server
+func (se *Executor) AsyncHelperExecute(
+	ctx context.Context,
+	cmd string,
+	_ map[string]types.VariableValue,
+) (executor.CommandRunner, error) {
+	return se.doAsyncExecute(ctx, cmd, true)
+}
+
+// AsyncExecute for executing by ssh executor
+func (se *Executor) AsyncExecute(
+	ctx context.Context,
+	cmd string,
+	_ map[string]types.VariableValue,
+) (executor.CommandRunner, error) {
+	return se.doAsyncExecute(ctx, cmd, false)
+}
+
+func (se *Executor) doAsyncExecute(ctx context.Context, cmd string, helper bool) (executor.CommandRunner, error) {
+	se.lock.Lock()
+	defer se.lock.Unlock()
+	if se.State == executor.Removing {
+		_ = se.WriteTraceError(ctx, fmt.Sprintf("❌ failed to execute '%s' because connection is already closed", cmd))
+		return nil, fmt.Errorf("failed to execute '%s' because connection is already closed", cmd)
+	}
+	se.State = executor.Running
+	r, err := NewCommandRunner(&se.BaseExecutor, se.client, cmd, helper)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.run(ctx); err != nil {
+		_ = r.session.Close()
+		return nil, err
+	}
+	se.runners[r.ID] = r
+	return r, nil
+}
+
+// Stop kills running commands, removes runtime files from the remote server and closes connection
+func (se *Executor) Stop(ctx context.Context) error {
+	se.lock.Lock()
+	defer se.lock.Unlock()
+	if se.State == executor.Removing {
+		_ = se.WriteTraceError(ctx, fmt.Sprintf("⛔ cannot remove executor as it's already stopped"))
+		return fmt.Errorf("executor [%s] is already stopped", se.Name)
+	}
+	started := time.Now()
+	se.State = executor.Removing
+	now := time.Now()
+	se.EndedAt = &now
+	var err error
+	_ = se.WriteTrace(ctx, fmt.Sprintf("✋ stopping runners=%d", len(se.runners)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), se.AntConfig.GetShutdownTimeout())
+	defer cancel()
+	for _, r := range se.runners {
+		if rErr := r.Stop(ctx, se.AntConfig.GetShutdownTimeout()); rErr != nil {
+			err = rErr
+		}
+	}
+	if cErr := se.cleanup(ctx); cErr != nil && err == nil {
+		err = cErr
+	}
+	close(se.done)
+	if se.sftp != nil {
+		_ = se.sftp.Close()
+	}
+	if cErr := se.client.Close(); cErr != nil && err == nil {
+		err = cErr
+	}
+	_ = se.BaseExecutor.WriteTraceInfo(ctx,
+		fmt.Sprintf("🛑 stopped ssh-connection: Error=%v Elapsed=%v, StopWait=%v",
+			err, time.Since(started).String(), se.AntConfig.GetShutdownTimeout()))
+	se.runners = make(map[string]*CommandRunner)
+	if se.onStopped != nil {
+		se.onStopped(se.ID)
+	}
+	return err
+}
+
+// cleanup removes pid files and the working directory created by the executor from remote server, where
+// working directory of the task definition is never removed
+func (se *Executor) cleanup(ctx context.Context) error {
+	files := []string{shellQuote(path.Join(se.AntConfig.SSH.TempDir, fmt.Sprintf("formicary-%s-", se.ID))) + "*.pid"}
+	if se.tempWorkDir != "" {
+		files = append(files, shellQuote(se.tempWorkDir))
+	}
+	return se.runRemote(ctx, "rm -rf "+strings.Join(files, " "))
+}
+
+// runRemote runs helper command on remote server without recording its pid
+func (se *Executor) runRemote(ctx context.Context, cmd string) error {
+	session, err := se.client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(cmd)
+	}()
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,348 @@
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+func Test_ShouldExecuteCommandOverSSH(t *testing.T) {
+	// GIVEN an ssh server and executor with working directory and environment
+	server := newTestServer(t)
+	workDir := t.TempDir()
+	opts := server.options("ssh-exec")
+	opts.WorkingDirectory = workDir
+	opts.Environment["GREETING"] = "hello 'world'"
+	lines := make([]string, 0)
+	var lock sync.Mutex
+	jobTrace, err := trace.NewJobTrace(func(b []byte, tags string) {
+		lock.Lock()
+		defer lock.Unlock()
+		lines = append(lines, string(b))
+	}, 1000, make([]string, 0))
+	require.NoError(t, err)
+	ctx := context.Background()
+	exec, err := NewSSHExecutor(ctx, newConfig(), jobTrace, opts)
+	require.NoError(t, err)
+
+	// WHEN command is executed
+	runner, err := exec.AsyncExecute(ctx, `echo "$GREETING" && pwd && echo $SSH_KEY`, nil)
+	require.NoError(t, err)
+	stdout, _, err := runner.Await(ctx)
+
+	// THEN it should run in working directory with environment but without credentials
+	require.NoError(t, err)
+	require.Equal(t, "hello 'world'\n"+workDir+"\n\n", string(stdout))
+	_, _ = jobTrace.Finish()
+	lock.Lock()
+	require.Contains(t, strings.Join(lines, ""), "hello 'world'")
+	lock.Unlock()
+
+	// WHEN failing command is executed
+	runner, err = exec.AsyncExecute(ctx, "exit 3", nil)
+	require.NoError(t, err)
+	_, _, err = runner.Await(ctx)
+
+	// THEN it should return exit code
+	require.Error(t, err)
+	require.Equal(t, 3, runner.GetExitCode())
+	require.NoError(t, exec.Stop(ctx))
+}
+
+func Test_ShouldKillRemoteCommandOnTimeout(t *testing.T) {
+	// GIVEN an ssh executor
+	server := newTestServer(t)
+	jobTrace, err := trace.NewJobTrace(func(bytes []byte, tags string) {}, 1000, make([]string, 0))
+	require.NoError(t, err)
+	exec, err := NewSSHExecutor(context.Background(), newConfig(), jobTrace, server.options("ssh-timeout"))
+	require.NoError(t, err)
+	marker := filepath.Join(t.TempDir(), "marker")
+	nested := filepath.Join(t.TempDir(), "nested")
+
+	// WHEN a long-running command with a pipeline in a subshell times out
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	runner, err := exec.AsyncExecute(ctx,
+		`(sh -c "sleep 3 && touch `+nested+`" | cat) & sleep 3 && touch `+marker, nil)
+	require.NoError(t, err)
+	started := time.Now()
+	_, _, err = runner.Await(ctx)
+
+	// THEN it should fail without waiting for the command
+	require.Error(t, err)
+	require.True(t, time.Since(started) < 3*time.Second)
+	require.NoError(t, exec.Stop(context.Background()))
+
+	// AND remote process and its grandchildren should be killed
+	time.Sleep(3 * time.Second)
+	_, err = os.Stat(marker)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(nested)
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_ShouldTransferFilesOverSFTP(t *testing.T) {
+	// GIVEN an ssh executor and local directory
+	server := newTestServer(t)
+	jobTrace, err := trace.NewJobTrace(func(bytes []byte, tags string) {}, 1000, make([]string, 0))
+	require.NoError(t, err)
+	opts := server.options("ssh-transfer")
+	opts.WorkingDirectory = t.TempDir()
+	ctx := context.Background()
+	exec, err := NewSSHExecutor(ctx, newConfig(), jobTrace, opts)
+	require.NoError(t, err)
+	localDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(localDir, "out"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "out", "a.txt"), []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "b.log"), []byte("b"), 0600))
+
+	// WHEN local directory is uploaded to relative remote directory
+	require.NoError(t, exec.UploadDirectory(ctx, localDir, "build"))
+
+	// THEN files should be created under working directory
+	data, err := os.ReadFile(filepath.Join(opts.WorkingDirectory, "build", "out", "a.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+
+	// WHEN files and globs are downloaded
+	downloadDir := t.TempDir()
+	files, err := exec.DownloadFiles(ctx, []string{"build/out", "build/*.log"}, downloadDir)
+
+	// THEN relative paths should be preserved
+	require.NoError(t, err)
+	sort.Strings(files)
+	require.Equal(t, []string{"build/b.log", "build/out/a.txt"}, files)
+	data, err = os.ReadFile(filepath.Join(downloadDir, "build", "b.log"))
+	require.NoError(t, err)
+	require.Equal(t, "b", string(data))
+	require.NoError(t, exec.Stop(ctx))
+}
+
+func Test_ShouldRemoveOnlyWorkingDirectoryCreatedByExecutor(t *testing.T) {
+	// GIVEN ssh provider with remote temp directory and a task without working directory
+	server := newTestServer(t)
+	jobTrace, err := trace.NewJobTrace(func(bytes []byte, tags string) {}, 1000, make([]string, 0))
+	require.NoError(t, err)
+	cfg := newConfig()
+	cfg.SSH.TempDir = t.TempDir()
+	opts := server.options("ssh-cleanup")
+	opts.CacheDirectory = t.TempDir()
+	provider, err := NewExecutorProvider(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// WHEN executor is created
+	exec, err := provider.NewExecutor(ctx, jobTrace, opts)
+	require.NoError(t, err)
+
+	// THEN it should run in its own directory under temp directory
+	require.True(t, strings.HasPrefix(opts.WorkingDirectory, cfg.SSH.TempDir+"/formicary-"))
+	runner, err := exec.AsyncExecute(ctx, "pwd", nil)
+	require.NoError(t, err)
+	stdout, _, err := runner.Await(ctx)
+	require.NoError(t, err)
+	require.Equal(t, opts.WorkingDirectory+"\n", string(stdout))
+
+	// AND it should be listed by its provider only
+	execs, err := provider.AllRunningExecutors(ctx)
+	require.NoError(t, err)
+	require.Contains(t, execs, exec)
+	other, err := NewExecutorProvider(cfg)
+	require.NoError(t, err)
+	execs, err = other.AllRunningExecutors(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, execs, exec)
+
+	// WHEN executor is stopped by its provider
+	require.NoError(t, provider.StopExecutor(ctx, exec.GetID(), opts))
+
+	// THEN only its working directory should be removed
+	_, err = os.Stat(opts.WorkingDirectory)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(opts.CacheDirectory)
+	require.NoError(t, err)
+	execs, err = provider.AllRunningExecutors(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, execs, exec)
+}
+
+func Test_ShouldRejectUnknownHostKey(t *testing.T) {
+	// GIVEN an ssh server with different host key than expected
+	server := newTestServer(t)
+	opts := server.options("ssh-host-key")
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pub, err := gossh.NewPublicKey(otherKey)
+	require.NoError(t, err)
+	opts.SSH.HostKey = string(gossh.MarshalAuthorizedKey(pub))
+	jobTrace, err := trace.NewJobTrace(func(bytes []byte, tags string) {}, 1000, make([]string, 0))
+	require.NoError(t, err)
+
+	// WHEN connecting THEN it should fail
+	_, err = NewSSHExecutor(context.Background(), newConfig(), jobTrace, opts)
+	require.Error(t, err)
+
+	// AND it should fail without host key or known hosts
+	opts.SSH.HostKey = ""
+	_, err = NewSSHExecutor(context.Background(), newConfig(), jobTrace, opts)
+	require.Error(t, err)
+}
+
+func newConfig() *ant_config.AntConfig {
+	c := ant_config.AntConfig{}
+	c.OutputLimit = 64 * 1024 * 1024
+	_ = c.SSH.Validate()
+	return &c
+}
+
+// testServer is an in-process ssh server that runs exec requests with local shell and serves sftp
+type testServer struct {
+	addr       string
+	hostKey    gossh.PublicKey
+	privateKey string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := gossh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authorized, err := gossh.NewPublicKey(clientPub)
+	require.NoError(t, err)
+	block, err := gossh.MarshalPrivateKey(clientPriv, "")
+	require.NoError(t, err)
+
+	config := &gossh.ServerConfig{
+		PublicKeyCallback: func(conn gossh.ConnMetadata, key gossh.PublicKey) (*gossh.Permissions, error) {
+			if string(key.Marshal()) == string(authorized.Marshal()) {
+				return nil, nil
+			}
+			return nil, os.ErrPermission
+		},
+	}
+	config.AddHostKey(hostSigner)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn, config)
+		}
+	}()
+	return &testServer{
+		addr:       listener.Addr().String(),
+		hostKey:    hostSigner.PublicKey(),
+		privateKey: string(pem.EncodeToMemory(block)),
+	}
+}
+
+func (s *testServer) options(name string) *types.ExecutorOptions {
+	host, port, _ := net.SplitHostPort(s.addr)
+	opts := types.NewExecutorOptions(name, types.SSH)
+	opts.SSH = &types.SSHOptions{
+		Host:             host,
+		User:             "formicary",
+		PrivateKeyConfig: "SSH_KEY",
+		HostKey:          string(gossh.MarshalAuthorizedKey(s.hostKey)),
+	}
+	opts.SSH.Port, _ = strconv.Atoi(port)
+	opts.Environment["SSH_KEY"] = s.privateKey
+	return opts
+}
+
+func serveConn(conn net.Conn, config *gossh.ServerConfig) {
+	_, chans, reqs, err := gossh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go gossh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(gossh.UnknownChannelType, "unsupported channel")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go serveSession(channel, requests)
+	}
+}
+
+func serveSession(channel gossh.Channel, requests <-chan *gossh.Request) {
+	var cmd *exec.Cmd
+	for req := range requests {
+		switch req.Type {
+		case "exec":
+			size := binary.BigEndian.Uint32(req.Payload[:4])
+			cmd = exec.Command("/bin/sh", "-c", string(req.Payload[4:4+size]))
+			cmd.Stdout = channel
+			cmd.Stderr = channel.Stderr()
+			if err := cmd.Start(); err != nil {
+				_ = req.Reply(false, nil)
+				_ = channel.Close()
+				return
+			}
+			_ = req.Reply(true, nil)
+			go func(cmd *exec.Cmd) {
+				status := uint32(0)
+				if err := cmd.Wait(); err != nil {
+					if exitErr, ok := err.(*exec.ExitError); ok {
+						status = uint32(exitErr.ExitCode())
+					} else {
+						status = 1
+					}
+				}
+				payload := make([]byte, 4)
+				binary.BigEndian.PutUint32(payload, status)
+				_, _ = channel.SendRequest("exit-status", false, payload)
+				_ = channel.Close()
+			}(cmd)
+		case "subsystem":
+			_ = req.Reply(true, nil)
+			go func() {
+				server, err := sftp.NewServer(channel)
+				if err == nil {
+					_ = server.Serve()
+				}
+				_ = channel.Close()
+			}()
+		case "signal":
+			if cmd != nil && cmd.Process != nil {
+				_ = cmd.Process.Signal(syscall.SIGKILL)
+			}
+		default:
+			if req.WantReply {
+				_ = req.Reply(false, nil)
+			}
+		}
+	}
+}
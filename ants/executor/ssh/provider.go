@@ -0,0 +1,86 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+// ExecutorProvider defines base structure for ssh executor provider, which keeps its running executors
+// because ssh connections can't be listed from the remote servers
+type ExecutorProvider struct {
+	executor.BaseExecutorProvider
+	executors map[string]*Executor
+	lock      sync.RWMutex
+}
+
+// NewExecutorProvider creates executor-provider for remote execution over ssh
+func NewExecutorProvider(config *ant_config.AntConfig) (executor.Provider, error) {
+	if err := config.SSH.Validate(); err != nil {
+		return nil, err
+	}
+	return &ExecutorProvider{
+		BaseExecutorProvider: executor.BaseExecutorProvider{
+			AntConfig: config,
+		},
+		executors: make(map[string]*Executor),
+	}, nil
+}
+
+// ListExecutors lists current executors
+func (sep *ExecutorProvider) ListExecutors(context.Context) ([]executor.Info, error) {
+	sep.lock.RLock()
+	defer sep.lock.RUnlock()
+	execs := make([]executor.Info, 0)
+	for _, e := range sep.executors {
+		execs = append(execs, e)
+	}
+	return execs, nil
+}
+
+// AllRunningExecutors returns running executors
+func (sep *ExecutorProvider) AllRunningExecutors(ctx context.Context) ([]executor.Info, error) {
+	return sep.ListExecutors(ctx)
+}
+
+// StopExecutor stops executor
+func (sep *ExecutorProvider) StopExecutor(
+	ctx context.Context,
+	id string,
+	_ *types.ExecutorOptions) error {
+	sep.lock.RLock()
+	exec := sep.executors[id]
+	sep.lock.RUnlock()
+	if exec == nil {
+		return fmt.Errorf("failed to find executor with id %v", id)
+	}
+	return exec.Stop(ctx)
+}
+
+// NewExecutor creates new executor
+func (sep *ExecutorProvider) NewExecutor(
+	ctx context.Context,
+	trace trace.JobTrace,
+	opts *types.ExecutorOptions) (executor.Executor, error) {
+	exec, err := NewSSHExecutor(ctx, sep.AntConfig, trace, opts)
+	if err != nil {
+		return nil, err
+	}
+	exec.onStopped = sep.removeExecutor
+	sep.lock.Lock()
+	sep.executors[exec.ID] = exec
+	sep.lock.Unlock()
+	return exec, nil
+}
+
+// removeExecutor removes executor after it's stopped
+func (sep *ExecutorProvider) removeExecutor(id string) {
+	sep.lock.Lock()
+	defer sep.lock.Unlock()
+	delete(sep.executors, id)
+}
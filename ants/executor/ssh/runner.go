@@ -0,0 +1,274 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/sirupsen/logrus"
+	gossh "golang.org/x/crypto/ssh"
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/async"
+	common "plexobject.com/formicary/internal/types"
+	cutils "plexobject.com/formicary/internal/utils"
+	"plexobject.com/formicary/internal/utils/trace"
+)
+
+var envNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CommandRunner command runner for ssh
+type CommandRunner struct {
+	executor.BaseCommandRunner
+	client   *gossh.Client
+	session  *gossh.Session
+	cancel   context.CancelFunc
+	pidFile  string
+	stdout   *traceLineWriter
+	stderr   *traceLineWriter
+	finished bool
+	lock     sync.Mutex
+}
+
+// NewCommandRunner constructor
+func NewCommandRunner(
+	e *executor.BaseExecutor,
+	client *gossh.Client,
+	cmd string,
+	helper bool) (*CommandRunner, error) {
+	base := executor.NewBaseCommandRunner(e, cmd, helper)
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ssh session due to %w", err)
+	}
+	runner := CommandRunner{
+		BaseCommandRunner: base,
+		client:            client,
+		session:           session,
+	}
+	runner.ID = ulid.Make().String()
+	runner.Host = e.Host
+	runner.ContainerIP = e.ContainerIP
+	runner.pidFile = path.Join(e.AntConfig.SSH.TempDir, fmt.Sprintf("formicary-%s-%s.pid", e.ID, runner.ID))
+	if runner.Stdin != nil {
+		session.Stdin = runner.Stdin
+	}
+	return &runner, nil
+}
+
+func (scr *CommandRunner) run(ctx context.Context) error {
+	var stdout io.Writer = &scr.Stdout
+	var stderr io.Writer = &scr.Stderr
+	if scr.ExecutorOptions.Debug || !scr.IsHelper(ctx) {
+		_ = scr.BaseExecutor.WriteTrace(ctx,
+			fmt.Sprintf("🔄 $ %s", scr.Command))
+		// output is streamed to the job trace as it arrives from the remote server
+		lock := &sync.Mutex{}
+		scr.stdout = &traceLineWriter{trace: scr.Trace, tags: common.StdoutTags, lock: lock}
+		scr.stderr = &traceLineWriter{trace: scr.Trace, tags: common.StderrTags, lock: lock}
+		stdout = io.MultiWriter(stdout, scr.stdout)
+		stderr = io.MultiWriter(stderr, scr.stderr)
+	}
+	scr.session.Stdout = stdout
+	scr.session.Stderr = stderr
+	return scr.session.Start(buildRemoteCommand(scr.ExecutorOptions, scr.pidFile, scr.Command))
+}
+
+// Await - awaits for completion
+func (scr *CommandRunner) Await(ctx context.Context) ([]byte, []byte, error) {
+	ctx, scr.cancel = context.WithCancel(ctx)
+	handler := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, scr.session.Wait()
+	}
+	abort := func(ctx context.Context, payload interface{}) (interface{}, error) {
+		return nil, scr.Stop(ctx, 0)
+	}
+	_, err := async.Execute(ctx, handler, abort, nil).Await(ctx)
+	scr.lock.Lock()
+	scr.finished = true
+	scr.lock.Unlock()
+	_ = scr.session.Close()
+	scr.Err = err
+	if scr.stdout != nil {
+		scr.stdout.Flush()
+		scr.stderr.Flush()
+	}
+	if err == nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "SSHCommandRunner",
+			"ID":        scr.ID,
+			"Name":      scr.Name,
+			"StdoutLen": len(scr.Stdout.Bytes()),
+			"Command":   scr.Command,
+			"Host":      scr.Host,
+			"IP":        scr.ContainerIP,
+			"Elapsed":   scr.BaseExecutor.Elapsed(),
+			"Memory":    cutils.MemUsageMiBString(),
+		}).Info("succeeded in executing command")
+		if scr.ExecutorOptions.Debug || !scr.IsHelper(ctx) {
+			_ = scr.BaseExecutor.WriteTraceSuccess(ctx, fmt.Sprintf(
+				"✅ %s on Host=%s Duration=%v",
+				scr.Command, scr.Host, scr.BaseExecutor.Elapsed()))
+		}
+	} else {
+		var exitErr *gossh.ExitError
+		if errors.As(err, &exitErr) {
+			scr.ExitCode = exitErr.ExitStatus()
+		} else {
+			scr.ExitCode = -1
+		}
+		scr.ExitMessage = fmt.Sprintf("command terminated with Message=%d", scr.ExitCode)
+		logrus.WithFields(logrus.Fields{
+			"Component": "SSHCommandRunner",
+			"ID":        scr.ID,
+			"Name":      scr.Name,
+			"StderrLen": len(scr.Stderr.Bytes()),
+			"Command":   scr.Command,
+			"Host":      scr.Host,
+			"IP":        scr.ContainerIP,
+			"Message":   scr.ExitCode,
+			"Error":     err,
+			"Elapsed":   scr.BaseExecutor.Elapsed(),
+			"Memory":    cutils.MemUsageMiBString(),
+		}).Warn("failed to execute command")
+		if scr.ExecutorOptions.Debug || !scr.IsHelper(ctx) {
+			_ = scr.BaseExecutor.WriteTraceError(ctx, fmt.Sprintf(
+				"❌ %s failed to execute on Host=%s Message=%d Error=%s Duration=%v",
+				scr.Command, scr.Host, scr.ExitCode, err, scr.BaseExecutor.Elapsed()))
+		}
+	}
+	return scr.Stdout.Bytes(), scr.Stderr.Bytes(), err
+}
+
+// Stop - stops runner by killing remote process and its children because closing
+// the session doesn't terminate commands that are not attached to a terminal
+func (scr *CommandRunner) Stop(ctx context.Context, timeout time.Duration) error {
+	if scr.cancel != nil {
+		scr.cancel()
+	}
+	scr.lock.Lock()
+	finished := scr.finished
+	scr.lock.Unlock()
+	if finished {
+		return nil
+	}
+	_ = scr.session.Signal(gossh.SIGKILL)
+	err := killRemoteProcess(ctx, scr.client, scr.pidFile, timeout)
+	_ = scr.session.Close()
+	return err
+}
+
+// IsRunning checks if runner is active
+func (scr *CommandRunner) IsRunning(context.Context) (bool, error) {
+	scr.lock.Lock()
+	defer scr.lock.Unlock()
+	return !scr.finished, nil
+}
+
+// buildRemoteCommand starts the command in its own session when setsid is available so that the whole
+// process group can be killed on cancellation, records pid of the group leader, changes to working directory
+// and exports task environment before replacing the shell with the command
+func buildRemoteCommand(
+	opts *common.ExecutorOptions,
+	pidFile string,
+	cmd string) string {
+	var sb strings.Builder
+	sb.WriteString("echo $$ > " + shellQuote(pidFile))
+	if opts.WorkingDirectory != "" {
+		sb.WriteString(" && cd " + shellQuote(opts.WorkingDirectory))
+	}
+	names := make([]string, 0, len(opts.Environment))
+	for k := range opts.Environment {
+		// credentials of the connection are never sent to the remote server
+		if envNameRegex.MatchString(k) && !opts.SSH.IsSecretConfig(k) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > 0 {
+		sb.WriteString(" && export")
+		for _, k := range names {
+			sb.WriteString(" " + k + "=" + shellQuote(opts.Environment[k]))
+		}
+	}
+	sb.WriteString(" && exec /bin/sh -c " + shellQuote(cmd))
+	script := shellQuote(sb.String())
+	return "if setsid -w true >/dev/null 2>&1; then exec setsid -w /bin/sh -c " + script +
+		"; else exec /bin/sh -c " + script + "; fi"
+}
+
+func killRemoteProcess(
+	ctx context.Context,
+	client *gossh.Client,
+	pidFile string,
+	timeout time.Duration) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = session.Close()
+	}()
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	// remote process is killed even when runner is stopped because its context is done
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	quoted := shellQuote(pidFile)
+	// process group of the command is killed so that grandchildren such as pipelines are killed as well,
+	// and only direct children can be found if setsid was not available on the remote server
+	cmd := fmt.Sprintf("if [ -f %s ]; then pid=$(cat %s); kill -KILL -$pid 2>/dev/null || pkill -KILL -P $pid 2>/dev/null; kill -KILL $pid 2>/dev/null; rm -f %s; fi; true",
+		quoted, quoted, quoted)
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(cmd)
+	}()
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// traceLineWriter writes complete lines to the job trace because each trace write is a separate line
+type traceLineWriter struct {
+	trace trace.JobTrace
+	tags  string
+	lock  *sync.Mutex
+	buf   bytes.Buffer
+}
+
+func (w *traceLineWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.buf.Write(p)
+	for {
+		idx := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		line := bytes.TrimRight(w.buf.Next(idx+1), "\r\n")
+		_, _ = w.trace.Write(append([]byte(nil), line...), w.tags)
+	}
+	return len(p), nil
+}
+
+// Flush writes remaining partial line
+func (w *traceLineWriter) Flush() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.buf.Len() > 0 {
+		_, _ = w.trace.Write(append([]byte(nil), w.buf.Bytes()...), w.tags)
+		w.buf.Reset()
+	}
+}
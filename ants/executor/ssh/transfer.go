@@ -0,0 +1,183 @@
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/sftp"
+)
+
+// DownloadFiles copies remote files, directories or globs into local directory over sftp.
+// Relative paths are resolved against working directory of the task on the remote server.
+func (se *Executor) DownloadFiles(
+	ctx context.Context,
+	paths []string,
+	localDir string) ([]string, error) {
+	client, err := se.sftpClient()
+	if err != nil {
+		return nil, err
+	}
+	baseDir, err := se.remoteWorkingDir(client)
+	if err != nil {
+		return nil, err
+	}
+	copied := make([]string, 0)
+	for _, p := range paths {
+		remote := resolveRemotePath(baseDir, p)
+		matches := []string{remote}
+		if strings.ContainsAny(remote, "*?[") {
+			if matches, err = client.Glob(remote); err != nil {
+				return nil, fmt.Errorf("invalid glob pattern %s due to %w", p, err)
+			}
+		}
+		for _, match := range matches {
+			walker := client.Walk(match)
+			for walker.Step() {
+				if err = ctx.Err(); err != nil {
+					return nil, err
+				}
+				if err = walker.Err(); err != nil {
+					return nil, fmt.Errorf("failed to read remote file %s due to %w", walker.Path(), err)
+				}
+				if !walker.Stat().Mode().IsRegular() {
+					continue
+				}
+				rel := relativeRemotePath(baseDir, walker.Path())
+				if err = downloadFile(client, walker.Path(), filepath.Join(localDir, filepath.FromSlash(rel))); err != nil {
+					return nil, err
+				}
+				copied = append(copied, rel)
+			}
+		}
+	}
+	return copied, nil
+}
+
+// UploadDirectory copies local directory tree into remote directory over sftp
+func (se *Executor) UploadDirectory(
+	ctx context.Context,
+	localDir string,
+	remoteDir string) error {
+	client, err := se.sftpClient()
+	if err != nil {
+		return err
+	}
+	baseDir, err := se.remoteWorkingDir(client)
+	if err != nil {
+		return err
+	}
+	remoteDir = resolveRemotePath(baseDir, remoteDir)
+	return filepath.Walk(localDir, func(local string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localDir, local)
+		if err != nil {
+			return err
+		}
+		remote := path.Join(remoteDir, filepath.ToSlash(rel))
+		if info.IsDir() {
+			if err = client.MkdirAll(remote); err != nil {
+				return fmt.Errorf("failed to create remote directory %s due to %w", remote, err)
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return uploadFile(client, local, remote, info.Mode().Perm())
+	})
+}
+
+func (se *Executor) sftpClient() (*sftp.Client, error) {
+	se.lock.Lock()
+	defer se.lock.Unlock()
+	if se.sftp != nil {
+		return se.sftp, nil
+	}
+	client, err := sftp.NewClient(se.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start sftp on %s due to %w", se.Host, err)
+	}
+	se.sftp = client
+	return client, nil
+}
+
+func (se *Executor) remoteWorkingDir(client *sftp.Client) (string, error) {
+	if se.ExecutorOptions.WorkingDirectory != "" {
+		return se.ExecutorOptions.WorkingDirectory, nil
+	}
+	// commands without working directory run in home directory of the user, which is also the sftp directory
+	dir, err := client.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to find remote working directory due to %w", err)
+	}
+	return dir, nil
+}
+
+func resolveRemotePath(baseDir string, p string) string {
+	if path.IsAbs(p) {
+		return path.Clean(p)
+	}
+	return path.Join(baseDir, p)
+}
+
+// relativeRemotePath returns path relative to working directory, or without leading slash for files outside of it
+func relativeRemotePath(baseDir string, p string) string {
+	if rel := strings.TrimPrefix(p, strings.TrimSuffix(baseDir, "/")+"/"); rel != p {
+		return rel
+	}
+	return strings.TrimPrefix(p, "/")
+}
+
+func downloadFile(client *sftp.Client, remote string, local string) error {
+	src, err := client.Open(remote)
+	if err != nil {
+		return fmt.Errorf("failed to open remote file %s due to %w", remote, err)
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	if err = os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return err
+	}
+	dst, err := os.Create(local)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("failed to download remote file %s due to %w", remote, err)
+	}
+	return dst.Close()
+}
+
+func uploadFile(client *sftp.Client, local string, remote string, mode os.FileMode) error {
+	src, err := os.Open(local)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	dst, err := client.OpenFile(remote, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return fmt.Errorf("failed to create remote file %s due to %w", remote, err)
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("failed to upload remote file %s due to %w", remote, err)
+	}
+	if err = dst.Close(); err != nil {
+		return err
+	}
+	return client.Chmod(remote, mode)
+}
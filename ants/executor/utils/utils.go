@@ -6,16 +6,27 @@ import (
 	"plexobject.com/formicary/internal/ant_config"
 	"plexobject.com/formicary/internal/health"
 	"plexobject.com/formicary/internal/utils/trace"
+	"sync"
 
 	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/ants/executor/containerd"
 	"plexobject.com/formicary/ants/executor/docker"
 	"plexobject.com/formicary/ants/executor/http"
 	"plexobject.com/formicary/ants/executor/kubernetes"
+	"plexobject.com/formicary/ants/executor/podman"
 	"plexobject.com/formicary/ants/executor/shell"
+	"plexobject.com/formicary/ants/executor/ssh"
 	"plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/internal/web"
 )
 
+// providers keeps podman, containerd and ssh providers of each ant config so that their clients are created
+// once and reused by requests and the reaper, and executors started by a request can be stopped later
+var providers = struct {
+	byConfig map[*ant_config.AntConfig]map[types.TaskMethod]executor.Provider
+	lock     sync.Mutex
+}{byConfig: make(map[*ant_config.AntConfig]map[types.TaskMethod]executor.Provider)}
+
 // StopContainer stops a running container
 func StopContainer(
 	ctx context.Context,
@@ -56,6 +67,18 @@ func AllRunningContainers(
 		}
 	}
 
+	// podman, containerd and ssh are only reaped when ant supports them as nerdctl/podman socket may not exist
+	for _, method := range []types.TaskMethod{types.Podman, types.Containerd, types.SSH} {
+		if !supportsMethod(antCfg, method) {
+			continue
+		}
+		if provider, err := sharedProvider(antCfg, method); err == nil {
+			if containers, err := provider.AllRunningExecutors(ctx); err == nil {
+				res[method] = containers
+			}
+		}
+	}
+
 	return
 }
 
@@ -73,6 +96,8 @@ func BuildProvider(
 		return docker.NewExecutorProvider(antCfg)
 	} else if opts.Method == types.Kubernetes {
 		return kubernetes.NewExecutorProvider(antCfg)
+	} else if opts.Method == types.Podman || opts.Method == types.Containerd || opts.Method == types.SSH {
+		return sharedProvider(antCfg, opts.Method)
 	} else if opts.Method.IsHTTP() {
 		return http.NewExecutorProvider(antCfg, httpClient)
 	} else {
@@ -94,3 +119,43 @@ func BuildExecutor(
 	}
 	return provider.NewExecutor(ctx, trace, opts)
 }
+
+// sharedProvider returns provider of the method that was created for the ant config or creates it
+func sharedProvider(
+	antCfg *ant_config.AntConfig,
+	method types.TaskMethod) (provider executor.Provider, err error) {
+	providers.lock.Lock()
+	defer providers.lock.Unlock()
+	byMethod := providers.byConfig[antCfg]
+	if byMethod == nil {
+		byMethod = make(map[types.TaskMethod]executor.Provider)
+		providers.byConfig[antCfg] = byMethod
+	}
+	if provider = byMethod[method]; provider != nil {
+		return provider, nil
+	}
+	switch method {
+	case types.Podman:
+		provider, err = podman.NewExecutorProvider(antCfg)
+	case types.Containerd:
+		provider, err = containerd.NewExecutorProvider(antCfg)
+	case types.SSH:
+		provider, err = ssh.NewExecutorProvider(antCfg)
+	default:
+		return nil, fmt.Errorf("unsupported shared method %s", method)
+	}
+	if err != nil {
+		return nil, err
+	}
+	byMethod[method] = provider
+	return provider, nil
+}
+
+func supportsMethod(antCfg *ant_config.AntConfig, method types.TaskMethod) bool {
+	for _, m := range antCfg.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
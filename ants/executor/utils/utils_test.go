@@ -1,6 +1,32 @@
 package utils
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/internal/ant_config"
+	"plexobject.com/formicary/internal/types"
+)
 
 func Test_Should(t *testing.T) {
 }
+
+func Test_ShouldReuseProvidersOfAntConfig(t *testing.T) {
+	// GIVEN ant config that supports containerd and ssh
+	antCfg := &ant_config.AntConfig{Methods: []types.TaskMethod{types.Containerd, types.SSH}}
+	opts := types.NewExecutorOptions("frm-1-build", types.Containerd)
+
+	// WHEN providers are built for multiple requests
+	first, err := BuildProvider(context.Background(), antCfg, nil, opts)
+	require.NoError(t, err)
+	second, err := BuildProvider(context.Background(), antCfg, nil, opts)
+	require.NoError(t, err)
+
+	// THEN same provider should be reused
+	require.Same(t, first, second)
+	// AND other ant configs should get their own providers
+	other, err := BuildProvider(context.Background(), &ant_config.AntConfig{}, nil, opts)
+	require.NoError(t, err)
+	require.NotSame(t, first, other)
+}
@@ -112,6 +112,7 @@ func (re *RequestExecutorImpl) Execute(
 		return
 	}
 	taskResp.Timings.PodStartedAt = time.Now()
+	stopProfiling := re.startProfiling(ctx, container, taskReq, taskResp)
 	cmdExecutor := func(
 		ctx context.Context,
 		cmd string,
@@ -176,6 +177,14 @@ func (re *RequestExecutorImpl) Execute(
 		// Execute all commands in script
 		taskResp.Status = types.FAILED
 		taskResp.ErrorMessage = taskReq.Mask(err.Error())
+	} else if err := re.buildImage(
+		scriptCtx,
+		container,
+		taskReq,
+		taskResp); err != nil {
+		// Build image from the working directory after script succeeds
+		taskResp.Status = types.FAILED
+		taskResp.ErrorMessage = taskReq.Mask(err.Error())
 	} else {
 		taskResp.Status = types.COMPLETED
 	}
@@ -215,6 +224,7 @@ func (re *RequestExecutorImpl) Execute(
 		taskResp.AdditionalError(err.Error(), false)
 	}
 	postSpan.End()
+	stopProfiling()
 	taskResp.Timings.PostScriptFinishedAt = time.Now()
 	// copy applied limits
 	taskResp.CostFactor = taskReq.ExecutorOpts.CostFactor
@@ -242,7 +252,20 @@ func (re *RequestExecutorImpl) execute(
 	}
 	defer cancel()
 
-	doExecute := func(cmd string) ([]byte, error) {
+	doExecute := func(cmd string) (stdout []byte, err error) {
+		ctx, span := tracing.Tracer("formicary.ant").Start(ctx, "script.command",
+			trace.WithAttributes(
+				attribute.String("task.type", taskReq.TaskType),
+				attribute.String("script.command", taskReq.Mask(cmd)),
+			),
+		)
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, taskReq.Mask(err.Error()))
+			}
+			span.End()
+		}()
 		taskReq.ExecutorOpts.ExecuteCommandWithoutShell = checkCommandCanExecuteWithoutShell(cmd)
 		if ctx.Value(types.HelperContainerKey) == nil && taskReq.ExecutorOpts.Debug && taskReq.ExecutorOpts.Privileged {
 			_ = container.WriteTraceInfo(
@@ -251,12 +274,16 @@ func (re *RequestExecutorImpl) execute(
 					cmd, taskReq.TaskType, taskReq.JobType, taskReq.JobRequestID, taskReq.ContainerName()))
 		}
 		// executing...
-		stdout, stderr, exitCode, exitMessage, err := re.asyncExecuteCommand(
+		var stderr []byte
+		var exitCode int
+		var exitMessage string
+		stdout, stderr, exitCode, exitMessage, err = re.asyncExecuteCommand(
 			ctx,
 			container,
 			cmd,
 			taskReq.Variables,
 			false)
+		span.SetAttributes(attribute.Int("script.exit_code", exitCode))
 		// debugging...
 		if ctx.Value(types.HelperContainerKey) == nil {
 			if err == nil {
@@ -349,6 +376,106 @@ func (re *RequestExecutorImpl) execute(
 	return ctx.Err()
 }
 
+// buildImage builds image defined by image_build of the task and adds its digest to the task context
+func (re *RequestExecutorImpl) buildImage(
+	ctx context.Context,
+	container executor.Executor,
+	taskReq *types.TaskRequest,
+	taskResp *types.TaskResponse) error {
+	imageBuild := taskReq.ExecutorOpts.ImageBuild
+	if imageBuild == nil {
+		return nil
+	}
+	builder, ok := container.(executor.ImageBuilder)
+	if !ok {
+		return fmt.Errorf("image_build is not supported by method %s", taskReq.ExecutorOpts.Method)
+	}
+	if err := imageBuild.Validate(); err != nil {
+		return err
+	}
+	var cancel context.CancelFunc
+	if taskReq.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, taskReq.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	started := time.Now()
+	res, err := builder.BuildImage(ctx, imageBuild)
+	if err != nil {
+		_ = container.WriteTraceError(ctx, fmt.Sprintf("❌ failed to build image %v: %s", imageBuild.Tags, err))
+		return err
+	}
+	taskResp.AddContext("ImageDigest", res.Digest)
+	taskResp.AddContext("ImageTags", strings.Join(res.Tags, ","))
+	taskResp.AddContext("ImagePushed", res.Pushed)
+	_ = container.WriteTraceSuccess(ctx, fmt.Sprintf("✅ built image %v Digest=%s Pushed=%v Duration=%v",
+		res.Tags, res.Digest, res.Pushed, time.Since(started)))
+	return nil
+}
+
+// startProfiling samples resources used by the container periodically if the executor supports it, the returned
+// function stops sampling and adds profile of the task to the response
+func (re *RequestExecutorImpl) startProfiling(
+	ctx context.Context,
+	container executor.Executor,
+	taskReq *types.TaskRequest,
+	taskResp *types.TaskResponse) func() {
+	sampler, ok := container.(executor.ResourceSampler)
+	interval := re.antCfg.ResourceProfileInterval
+	if !ok || interval <= 0 {
+		return func() {}
+	}
+	profile := &types.TaskProfile{}
+	sample := func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, interval)
+		defer cancel()
+		stats, err := sampler.SampleResources(ctx)
+		if err != nil {
+			if logrus.IsLevelEnabled(logrus.DebugLevel) {
+				logrus.WithFields(logrus.Fields{
+					"Component": "RequestExecutorImpl",
+					"AntID":     re.antCfg.Common.ID,
+					"Task":      taskReq.String(),
+					"Error":     err,
+				}).Debugf("failed to sample resources")
+			}
+			return
+		}
+		profile.Add(time.Now(), stats)
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		sample(ctx)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sample(ctx)
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+		// let the last sample complete with fresh context in case the task was cancelled
+		sample(context.Background())
+		if profile.Samples == 0 {
+			return
+		}
+		taskResp.Profile = profile
+		_ = container.WriteTraceInfo(ctx, fmt.Sprintf("📊 resources used by task '%s': %s",
+			taskReq.TaskType, profile))
+	}
+}
+
 func addArtifactToPath(taskReq *types.TaskRequest, i int, cmd string, stdout []byte) (string, error) {
 	if len(stdout) == 0 {
 		return "", nil
@@ -467,8 +594,17 @@ func (re *RequestExecutorImpl) preProcess(
 			s.AddEmptyKubernetesVolume(
 				"cache", taskReq.ExecutorOpts.CacheDirectory)
 		}
-	} else if taskReq.ExecutorOpts.Method == types.Docker {
-		taskReq.ExecutorOpts.HelperContainer.Image = re.antCfg.Docker.HelperImage
+	} else if taskReq.ExecutorOpts.Method == types.Docker ||
+		taskReq.ExecutorOpts.Method == types.Podman ||
+		taskReq.ExecutorOpts.Method == types.Containerd {
+		switch taskReq.ExecutorOpts.Method {
+		case types.Podman:
+			taskReq.ExecutorOpts.HelperContainer.Image = re.antCfg.Podman.HelperImage
+		case types.Containerd:
+			taskReq.ExecutorOpts.HelperContainer.Image = re.antCfg.Containerd.HelperImage
+		default:
+			taskReq.ExecutorOpts.HelperContainer.Image = re.antCfg.Docker.HelperImage
+		}
 		//artDir, err := ioutil.TempDir(os.TempDir(), tmpArtifacts)
 		//if err != nil {
 		//	return nil, fmt.Errorf("failed to create artDir directory due to %w", err)
@@ -483,12 +619,26 @@ func (re *RequestExecutorImpl) preProcess(
 		//	taskReq.ExecutorOpts.ArtifactsDirectory,
 		//}
 	}
-	if container, err = utils.BuildExecutor(
-		ctx,
+	// building executor pulls image and creates container for container based methods
+	createCtx, createSpan := tracing.Tracer("formicary.ant").Start(ctx, "container.create",
+		trace.WithAttributes(
+			attribute.String("executor.method", string(taskReq.ExecutorOpts.Method)),
+			attribute.String("container.image", taskReq.ExecutorOpts.MainContainer.Image),
+			attribute.String("container.name", taskReq.ExecutorOpts.Name),
+		),
+	)
+	container, err = utils.BuildExecutor(
+		createCtx,
 		re.antCfg,
 		logStreamer,
 		re.webClient,
-		taskReq.ExecutorOpts); err != nil {
+		taskReq.ExecutorOpts)
+	if err != nil {
+		createSpan.RecordError(err)
+		createSpan.SetStatus(codes.Error, err.Error())
+	}
+	createSpan.End()
+	if err != nil {
 		return
 	}
 
@@ -668,7 +818,9 @@ func (re *RequestExecutorImpl) postProcess(
 
 // remove any temporary files created if using SHELL or HTTP
 func removeTemporaryFiles(taskReq *types.TaskRequest) {
-	if taskReq.ExecutorOpts.Method == types.Docker {
+	if taskReq.ExecutorOpts.Method == types.Docker ||
+		taskReq.ExecutorOpts.Method == types.Podman ||
+		taskReq.ExecutorOpts.Method == types.Containerd {
 		for _, m := range taskReq.ExecutorOpts.MainContainer.GetDockerMounts() {
 			if strings.Contains(m.Source, tmpArtifacts) {
 				err := os.RemoveAll(m.Source)
@@ -720,6 +872,15 @@ func (re *RequestExecutorImpl) updateResponseContext(
 	} else if taskReq.ExecutorOpts.Method == types.Docker {
 		taskResp.AddContext("DockerHost", re.antCfg.Docker.Host)
 		taskResp.AddContext("DockerServer", re.antCfg.Docker.Server)
+	} else if taskReq.ExecutorOpts.Method == types.Podman {
+		taskResp.AddContext("PodmanHost", re.antCfg.Podman.Host)
+		taskResp.AddContext("PodmanServer", re.antCfg.Podman.Server)
+	} else if taskReq.ExecutorOpts.Method == types.Containerd {
+		taskResp.AddContext("ContainerdNamespace", re.antCfg.Containerd.Namespace)
+		taskResp.AddContext("ContainerdServer", re.antCfg.Containerd.Server)
+	} else if taskReq.ExecutorOpts.Method == types.SSH && taskReq.ExecutorOpts.SSH != nil {
+		taskResp.AddContext("SSHHost", taskReq.ExecutorOpts.SSH.Address())
+		taskResp.AddContext("SSHUser", taskReq.ExecutorOpts.SSH.User)
 	}
 
 	taskResp.AddJobContext(fmt.Sprintf("%s-status", taskReq.TaskType), taskResp.Status)
@@ -83,6 +83,9 @@ func UploadCacheAndArtifacts(
 	}
 
 	paths, expiration := taskReq.ExecutorOpts.Artifacts.GetPathsAndExpiration(taskResp.Status.Completed())
+	if resolver, ok := jobWriter.(executor.PathResolver); ok {
+		paths = resolver.ResolvePaths(paths)
+	}
 	artifacts = make([]*types.Artifact, 0)
 	if len(paths) > 0 { // taskReq.ExecutorOpts.Method.SupportsDependentArtifacts()
 		artifact, err := uploadArtifacts(
@@ -457,8 +460,25 @@ func buildArtifactTransferService(
 			execute,
 			taskReq,
 			taskResp), nil
+	case types.SSH:
+		// remote hosts don't share filesystem with the ant so files are copied by the executor
+		files, ok := jobWriter.(executor.FileTransfer)
+		if !ok {
+			return nil, fmt.Errorf("executor for %s does not support file transfer",
+				taskReq.ExecutorOpts.Method)
+		}
+		return NewArtifactTransferRemote(
+			artifactService,
+			execute,
+			files,
+			taskReq,
+			taskResp), nil
 	case types.Kubernetes:
 		fallthrough
+	case types.Podman:
+		fallthrough
+	case types.Containerd:
+		fallthrough
 	case types.Docker:
 		return NewArtifactTransferHelperContainer(
 			antCfg,
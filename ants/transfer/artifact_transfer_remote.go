@@ -0,0 +1,147 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"plexobject.com/formicary/ants/executor"
+	"plexobject.com/formicary/internal/artifacts"
+	"plexobject.com/formicary/internal/types"
+)
+
+// ArtifactTransferRemote structure transfers artifacts of executors that run on remote hosts
+// such as SSH by staging files in a temporary directory of the ant
+type ArtifactTransferRemote struct {
+	*ArtifactTransferService
+	files executor.FileTransfer
+}
+
+// NewArtifactTransferRemote constructor
+func NewArtifactTransferRemote(
+	artifactService artifacts.Service,
+	execute AsyncCommandExecutor,
+	files executor.FileTransfer,
+	taskReq *types.TaskRequest,
+	taskResp *types.TaskResponse) ArtifactTransfer {
+	return &ArtifactTransferRemote{
+		ArtifactTransferService: &ArtifactTransferService{
+			artifactService: artifactService,
+			execute:         execute,
+			taskReq:         taskReq,
+			taskResp:        taskResp,
+		},
+		files: files,
+	}
+}
+
+// UploadCache uploads cache from the remote host
+func (t *ArtifactTransferRemote) UploadCache(
+	ctx context.Context,
+	id string,
+	paths []string,
+	expiration time.Time) (artifact *types.Artifact, err error) {
+	return t.uploadRemoteArtifacts(
+		ctx,
+		"",
+		id,
+		fmt.Sprintf("%s_cache.zip", t.taskReq.TaskType),
+		paths,
+		expiration)
+}
+
+// UploadArtifacts uploads artifacts from the remote host
+func (t *ArtifactTransferRemote) UploadArtifacts(
+	ctx context.Context,
+	paths []string,
+	expiration time.Time) (artifact *types.Artifact, err error) {
+	return t.uploadRemoteArtifacts(
+		ctx,
+		t.taskReq.KeyPath(),
+		"",
+		fmt.Sprintf("%s.zip", t.taskReq.TaskType),
+		paths,
+		expiration)
+}
+
+// CalculateDigest calculates digest of key paths on the remote host
+func (t *ArtifactTransferRemote) CalculateDigest(ctx context.Context, paths []string) (digest string, err error) {
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no paths specified for digest")
+	}
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "remote-digest")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	files, err := t.files.DownloadFiles(ctx, paths, tmpDir)
+	if err != nil {
+		return "", err
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("failed to find key paths %v for digest", paths)
+	}
+	for i, f := range files {
+		files[i] = filepath.Join(tmpDir, f)
+	}
+	return t.ArtifactTransferService.CalculateDigest(ctx, files)
+}
+
+// DownloadArtifact downloads artifact on the ant and copies extracted files to the remote host
+func (t *ArtifactTransferRemote) DownloadArtifact(
+	ctx context.Context,
+	extractedDir string,
+	id string) (err error) {
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "remote-artifact")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	if err = t.ArtifactTransferService.DownloadArtifact(ctx, tmpDir, id); err != nil {
+		return err
+	}
+	if err = t.files.UploadDirectory(ctx, tmpDir, extractedDir); err != nil {
+		return fmt.Errorf("failed to copy artifact %s to %s due to %w", id, extractedDir, err)
+	}
+	return nil
+}
+
+func (t *ArtifactTransferRemote) uploadRemoteArtifacts(
+	ctx context.Context,
+	prefix string,
+	id string,
+	name string,
+	paths []string,
+	expiration time.Time) (artifact *types.Artifact, err error) {
+	tmpDir, err := ioutil.TempDir(os.TempDir(), "remote-artifacts")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	files, err := t.files.DownloadFiles(ctx, paths, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "artifacts.zip")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(tmpFile.Name())
+	}()
+	if err = artifacts.ZipFilesRelative(tmpFile, tmpDir, files); err != nil {
+		return nil, err
+	}
+	_ = tmpFile.Close()
+	return t.saveZipFile(ctx, prefix, id, name, tmpFile.Name(), expiration)
+}
@@ -85,6 +85,17 @@ func (t *ArtifactTransferService) uploadArtifacts(
 		return nil, err
 	}
 	_ = tmpFile.Close()
+	return t.saveZipFile(ctx, prefix, id, name, tmpFile.Name(), expiration)
+}
+
+// saveZipFile saves zip file as artifact
+func (t *ArtifactTransferService) saveZipFile(
+	ctx context.Context,
+	prefix string,
+	id string,
+	name string,
+	zipFile string,
+	expiration time.Time) (artifact *types.Artifact, err error) {
 	artifact = &types.Artifact{
 		ID:          id,
 		Name:        name,
@@ -98,7 +109,7 @@ func (t *ArtifactTransferService) uploadArtifacts(
 		ctx,
 		prefix,
 		artifact,
-		tmpFile.Name()); err != nil {
+		zipFile); err != nil {
 		return nil, err
 	}
 
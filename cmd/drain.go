@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var drainTimeout string
+
+// drainCmd represents the drain command
+var drainCmd = &cobra.Command{
+	Use:   "drain <ant-id>",
+	Short: "drains a formicary ant",
+	Long: "drains a formicary ant so that it stops accepting new tasks and exits after its " +
+		"running tasks finish or the timeout elapses",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "/api/ants/" + url.PathEscape(args[0]) + "/drain"
+		if drainTimeout != "" {
+			path += "?timeout=" + url.QueryEscape(drainTimeout)
+		}
+		body, err := callServer(http.MethodPost, path, nil)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"AntID": args[0],
+				"Error": err}).
+				Errorf("failed to drain ant...")
+			os.Exit(1)
+		}
+		fmt.Println(string(body))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(drainCmd)
+
+	addServerFlags(drainCmd)
+	drainCmd.Flags().StringVar(&drainTimeout, "timeout", "",
+		"how long to wait for running tasks such as 30m (default is ant_drain_timeout of server)")
+}
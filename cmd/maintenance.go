@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var maintenanceReason string
+
+// maintenanceCmd represents the maintenance command
+var maintenanceCmd = &cobra.Command{
+	Use:       "maintenance <on|off|status>",
+	Short:     "updates maintenance mode of formicary",
+	Long:      "enables or disables maintenance mode that pauses scheduling of new jobs while running jobs complete",
+	Args:      cobra.ExactValidArgs(1),
+	ValidArgs: []string{"on", "off", "status"},
+	Run: func(cmd *cobra.Command, args []string) {
+		var body []byte
+		var err error
+		if args[0] == "status" {
+			body, err = callServer(http.MethodGet, "/api/maintenance", nil)
+		} else {
+			req, _ := json.Marshal(map[string]any{
+				"enabled": args[0] == "on",
+				"reason":  maintenanceReason,
+			})
+			body, err = callServer(http.MethodPut, "/api/maintenance", req)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Error": err}).
+				Errorf("failed to update maintenance mode...")
+			os.Exit(1)
+		}
+		fmt.Println(string(body))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(maintenanceCmd)
+
+	addServerFlags(maintenanceCmd)
+	maintenanceCmd.Flags().StringVar(&maintenanceReason, "reason", "", "reason of maintenance shown to users")
+}
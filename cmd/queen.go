@@ -104,6 +104,10 @@ func configureEmbeddedAnts(serverConfig *config.ServerConfig) error {
 				methods = append(methods, types.Docker)
 			case "KUBERNETES", "K8S":
 				methods = append(methods, types.Kubernetes)
+			case "PODMAN":
+				methods = append(methods, types.Podman)
+			case "CONTAINERD", "NERDCTL":
+				methods = append(methods, types.Containerd)
 			case "HTTP_GET":
 				methods = append(methods, types.HTTPPostJSON)
 			case "HTTP_POST":
@@ -114,6 +118,8 @@ func configureEmbeddedAnts(serverConfig *config.ServerConfig) error {
 				methods = append(methods, types.HTTPDelete)
 			case "SHELL":
 				methods = append(methods, types.Shell)
+			case "SSH":
+				methods = append(methods, types.SSH)
 			case "WEBSOCKET":
 				methods = append(methods, types.WebSocket)
 			default:
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var serverURL string
+var apiToken string
+
+// addServerFlags adds flags of commands that call APIs of the queen server
+func addServerFlags(cmd *cobra.Command) {
+	defaultServer := os.Getenv("FORMICARY_SERVER")
+	if defaultServer == "" {
+		defaultServer = "http://localhost:7777"
+	}
+	cmd.Flags().StringVar(&serverURL, "server", defaultServer,
+		"URL of formicary server (default is $FORMICARY_SERVER)")
+	cmd.Flags().StringVar(&apiToken, "token", os.Getenv("FORMICARY_TOKEN"),
+		"API token of admin user (default is $FORMICARY_TOKEN)")
+}
+
+// callServer invokes API of the queen server and returns the response body
+func callServer(method string, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, strings.TrimSuffix(serverURL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiToken != "" {
+		req.Header.Set("Authorization", "Bearer "+apiToken)
+	}
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
@@ -48,8 +48,12 @@ type ApprovalPolicy struct {
 	EscalationMessage    string                 `protobuf:"bytes,10,opt,name=escalation_message,json=escalationMessage,proto3" json:"escalation_message,omitempty"`
 	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// escalation_voting: escalation recipients may vote on the current stage after SLA breach.
+	EscalationVoting bool `protobuf:"varint,13,opt,name=escalation_voting,json=escalationVoting,proto3" json:"escalation_voting,omitempty"`
+	// stages: ordered approval stages that must reach quorum one after another.
+	Stages        []*ApprovalStage `protobuf:"bytes,14,rep,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApprovalPolicy) Reset() {
@@ -166,6 +170,97 @@ func (x *ApprovalPolicy) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ApprovalPolicy) GetEscalationVoting() bool {
+	if x != nil {
+		return x.EscalationVoting
+	}
+	return false
+}
+
+func (x *ApprovalPolicy) GetStages() []*ApprovalStage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// ApprovalStage defines quorum rules of one step in an ordered multi-stage approval.
+type ApprovalStage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MinApprovals     int32                  `protobuf:"varint,2,opt,name=min_approvals,json=minApprovals,proto3" json:"min_approvals,omitempty"`
+	AllowedRoles     string                 `protobuf:"bytes,3,opt,name=allowed_roles,json=allowedRoles,proto3" json:"allowed_roles,omitempty"`
+	AllowedUsers     string                 `protobuf:"bytes,4,opt,name=allowed_users,json=allowedUsers,proto3" json:"allowed_users,omitempty"`
+	RequireUnanimous bool                   `protobuf:"varint,5,opt,name=require_unanimous,json=requireUnanimous,proto3" json:"require_unanimous,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApprovalStage) Reset() {
+	*x = ApprovalStage{}
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStage) ProtoMessage() {}
+
+func (x *ApprovalStage) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStage.ProtoReflect.Descriptor instead.
+func (*ApprovalStage) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApprovalStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApprovalStage) GetMinApprovals() int32 {
+	if x != nil {
+		return x.MinApprovals
+	}
+	return 0
+}
+
+func (x *ApprovalStage) GetAllowedRoles() string {
+	if x != nil {
+		return x.AllowedRoles
+	}
+	return ""
+}
+
+func (x *ApprovalStage) GetAllowedUsers() string {
+	if x != nil {
+		return x.AllowedUsers
+	}
+	return ""
+}
+
+func (x *ApprovalStage) GetRequireUnanimous() bool {
+	if x != nil {
+		return x.RequireUnanimous
+	}
+	return false
+}
+
 // ApprovalVote records a single approver's decision on a task execution.
 type ApprovalVote struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -175,16 +270,20 @@ type ApprovalVote struct {
 	VoterId         string                 `protobuf:"bytes,4,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
 	VoterName       string                 `protobuf:"bytes,5,opt,name=voter_name,json=voterName,proto3" json:"voter_name,omitempty"`
 	// decision: "APPROVED" or "REJECTED"
-	Decision      string                 `protobuf:"bytes,6,opt,name=decision,proto3" json:"decision,omitempty"`
-	Comments      string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
-	VotedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	Decision string                 `protobuf:"bytes,6,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comments string                 `protobuf:"bytes,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	VotedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	// on_behalf_of: user-id of the away approver when the vote was cast by their delegate.
+	OnBehalfOf string `protobuf:"bytes,9,opt,name=on_behalf_of,json=onBehalfOf,proto3" json:"on_behalf_of,omitempty"`
+	// stage: name of the approval stage the vote counts toward.
+	Stage         string `protobuf:"bytes,10,opt,name=stage,proto3" json:"stage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApprovalVote) Reset() {
 	*x = ApprovalVote{}
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[1]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -196,7 +295,7 @@ func (x *ApprovalVote) String() string {
 func (*ApprovalVote) ProtoMessage() {}
 
 func (x *ApprovalVote) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[1]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +308,7 @@ func (x *ApprovalVote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalVote.ProtoReflect.Descriptor instead.
 func (*ApprovalVote) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{1}
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ApprovalVote) GetId() string {
@@ -268,6 +367,20 @@ func (x *ApprovalVote) GetVotedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ApprovalVote) GetOnBehalfOf() string {
+	if x != nil {
+		return x.OnBehalfOf
+	}
+	return ""
+}
+
+func (x *ApprovalVote) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
 // ApprovalVoteRequest is the input for casting a vote.
 type ApprovalVoteRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -276,15 +389,17 @@ type ApprovalVoteRequest struct {
 	VoterId   string                 `protobuf:"bytes,3,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"`
 	VoterName string                 `protobuf:"bytes,4,opt,name=voter_name,json=voterName,proto3" json:"voter_name,omitempty"`
 	// decision: "APPROVED" or "REJECTED"
-	Decision      string `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
-	Comments      string `protobuf:"bytes,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	Decision string `protobuf:"bytes,5,opt,name=decision,proto3" json:"decision,omitempty"`
+	Comments string `protobuf:"bytes,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	// on_behalf_of: user-id of an away approver who delegated their vote to the voter.
+	OnBehalfOf    string `protobuf:"bytes,7,opt,name=on_behalf_of,json=onBehalfOf,proto3" json:"on_behalf_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApprovalVoteRequest) Reset() {
 	*x = ApprovalVoteRequest{}
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[2]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +411,7 @@ func (x *ApprovalVoteRequest) String() string {
 func (*ApprovalVoteRequest) ProtoMessage() {}
 
 func (x *ApprovalVoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[2]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +424,7 @@ func (x *ApprovalVoteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalVoteRequest.ProtoReflect.Descriptor instead.
 func (*ApprovalVoteRequest) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{2}
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ApprovalVoteRequest) GetRequestId() string {
@@ -354,6 +469,13 @@ func (x *ApprovalVoteRequest) GetComments() string {
 	return ""
 }
 
+func (x *ApprovalVoteRequest) GetOnBehalfOf() string {
+	if x != nil {
+		return x.OnBehalfOf
+	}
+	return ""
+}
+
 // ApprovalStatus is the aggregate state for a task execution's approval.
 type ApprovalStatus struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -368,13 +490,16 @@ type ApprovalStatus struct {
 	Deadline             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Votes                []*ApprovalVote        `protobuf:"bytes,10,rep,name=votes,proto3" json:"votes,omitempty"`
 	Policy               *ApprovalPolicy        `protobuf:"bytes,11,opt,name=policy,proto3" json:"policy,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// current_stage: stage awaiting votes, or the last stage once resolved.
+	CurrentStage  string                 `protobuf:"bytes,12,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	Stages        []*ApprovalStageStatus `protobuf:"bytes,13,rep,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApprovalStatus) Reset() {
 	*x = ApprovalStatus{}
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[3]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +511,7 @@ func (x *ApprovalStatus) String() string {
 func (*ApprovalStatus) ProtoMessage() {}
 
 func (x *ApprovalStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_queen_approval_proto_msgTypes[3]
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,7 +524,7 @@ func (x *ApprovalStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovalStatus.ProtoReflect.Descriptor instead.
 func (*ApprovalStatus) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{3}
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ApprovalStatus) GetTaskExecutionId() string {
@@ -479,6 +604,206 @@ func (x *ApprovalStatus) GetPolicy() *ApprovalPolicy {
 	return nil
 }
 
+func (x *ApprovalStatus) GetCurrentStage() string {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return ""
+}
+
+func (x *ApprovalStatus) GetStages() []*ApprovalStageStatus {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+// ApprovalStageStatus is the vote tally of a single approval stage.
+type ApprovalStageStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ApprovalsReceived    int32                  `protobuf:"varint,2,opt,name=approvals_received,json=approvalsReceived,proto3" json:"approvals_received,omitempty"`
+	RejectionsReceived   int32                  `protobuf:"varint,3,opt,name=rejections_received,json=rejectionsReceived,proto3" json:"rejections_received,omitempty"`
+	MinApprovalsRequired int32                  `protobuf:"varint,4,opt,name=min_approvals_required,json=minApprovalsRequired,proto3" json:"min_approvals_required,omitempty"`
+	Completed            bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ApprovalStageStatus) Reset() {
+	*x = ApprovalStageStatus{}
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalStageStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalStageStatus) ProtoMessage() {}
+
+func (x *ApprovalStageStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalStageStatus.ProtoReflect.Descriptor instead.
+func (*ApprovalStageStatus) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApprovalStageStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApprovalStageStatus) GetApprovalsReceived() int32 {
+	if x != nil {
+		return x.ApprovalsReceived
+	}
+	return 0
+}
+
+func (x *ApprovalStageStatus) GetRejectionsReceived() int32 {
+	if x != nil {
+		return x.RejectionsReceived
+	}
+	return 0
+}
+
+func (x *ApprovalStageStatus) GetMinApprovalsRequired() int32 {
+	if x != nil {
+		return x.MinApprovalsRequired
+	}
+	return 0
+}
+
+func (x *ApprovalStageStatus) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// ApprovalDelegation lets a delegate vote on behalf of an approver who is out of office.
+type ApprovalDelegation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DelegatorId    string                 `protobuf:"bytes,2,opt,name=delegator_id,json=delegatorId,proto3" json:"delegator_id,omitempty"`
+	DelegatorName  string                 `protobuf:"bytes,3,opt,name=delegator_name,json=delegatorName,proto3" json:"delegator_name,omitempty"`
+	DelegateId     string                 `protobuf:"bytes,4,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApprovalDelegation) Reset() {
+	*x = ApprovalDelegation{}
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDelegation) ProtoMessage() {}
+
+func (x *ApprovalDelegation) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_queen_approval_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDelegation.ProtoReflect.Descriptor instead.
+func (*ApprovalDelegation) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_queen_approval_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApprovalDelegation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetDelegatorId() string {
+	if x != nil {
+		return x.DelegatorId
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetDelegatorName() string {
+	if x != nil {
+		return x.DelegatorName
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ApprovalDelegation) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ApprovalDelegation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ApprovalDelegation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 var File_formicary_v1_queen_approval_proto protoreflect.FileDescriptor
 
 var file_formicary_v1_queen_approval_proto_rawDesc = string([]byte{
@@ -491,7 +816,7 @@ var file_formicary_v1_queen_approval_proto_rawDesc = string([]byte{
 	0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x08, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x0a, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
 	0x61, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
 	0x69, 0x64, 0x22, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
@@ -561,65 +886,118 @@ var file_formicary_v1_queen_approval_proto_rawDesc = string([]byte{
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0x8a, 0xb5, 0x18,
 	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdf, 0x03,
-	0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x30,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x48, 0x0a, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18,
-	0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x6a, 0x6f,
-	0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x6f,
-	0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0c, 0x6a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x8a,
-	0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x22, 0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x15, 0x8a, 0xb5, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0xb9, 0x03, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xba, 0x48, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x8a, 0xb5, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x09, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xba, 0x48, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x8a, 0xb5, 0x18, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x74, 0x61, 0x73,
-	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3e, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x8a, 0xb5, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x66, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x09,
-	0x76, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x65, 0x63,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5, 0x18,
-	0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5,
-	0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbc, 0x06, 0x0a, 0x0e,
+	0x74, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x6c, 0x0a,
+	0x11, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0x8a, 0xb5, 0x18, 0x3b, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x10, 0x65, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x6d, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6f,
+	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x42, 0x32,
+	0x8a, 0xb5, 0x18, 0x2e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x2d, 0x22, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x0d, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x52, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x2d, 0x8a, 0xb5, 0x18, 0x29, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x20, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x22, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x12, 0x5c, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x8a, 0xb5, 0x18, 0x33, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x22, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12,
+	0x5c, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x8a, 0xb5, 0x18, 0x33, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x6c, 0x0a,
+	0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x61, 0x6e, 0x69, 0x6d, 0x6f,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3f, 0x8a, 0xb5, 0x18, 0x3b, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x6e, 0x61, 0x6e,
+	0x69, 0x6d, 0x6f, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75,
+	0x6e, 0x61, 0x6e, 0x69, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x55, 0x6e, 0x61, 0x6e, 0x69, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0xc2, 0x04, 0x0a, 0x0c,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x48,
+	0x0a, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0f, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x6f, 0x62, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0c, 0x6a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18,
+	0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x8a,
+	0xb5, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x4a, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x13, 0x8a, 0xb5, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0c, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0x8a, 0xb5, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f,
+	0x6e, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x22, 0x52, 0x0a, 0x6f, 0x6e,
+	0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x8a, 0xb5, 0x18, 0x0c, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x22, 0x88, 0x04, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x8a, 0xb5, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x8a, 0xb5, 0x18, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x07, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x8a, 0xb5, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x66, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52,
+	0x09, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a, 0xb5,
+	0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x8a,
+	0xb5, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x0c,
+	0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0x8a, 0xb5, 0x18, 0x27, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x6e,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d,
+	0x3a, 0x22, 0x6f, 0x6e, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x22, 0x52,
+	0x0a, 0x6f, 0x6e, 0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x22, 0xcf, 0x07, 0x0a, 0x0e,
 	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48,
 	0x0a, 0x11, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x8a, 0xb5, 0x18, 0x18, 0x6a,
@@ -671,11 +1049,81 @@ var file_formicary_v1_queen_approval_proto_rawDesc = string([]byte{
 	0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x50,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x1b, 0x8a, 0xb5, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
 	0x22, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x3a, 0x5a, 0x38, 0x70, 0x6c,
-	0x65, 0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72,
-	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f,
-	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x65, 0x6e,
-	0x3b, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x22, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0x8a, 0xb5, 0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x22, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
+	0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe6, 0x02,
+	0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0x8a, 0xb5, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x12, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1d, 0x8a, 0xb5, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x13, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1e, 0x8a, 0xb5, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x52, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x16, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0x8a, 0xb5, 0x18, 0x1d, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x52, 0x14, 0x6d, 0x69,
+	0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x8a, 0xb5, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xd7, 0x04, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x8a, 0xb5, 0x18, 0x1c, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x20, 0x67, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3a, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x8a, 0xb5, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0b,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0x8a, 0xb5, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a,
+	0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0x8a, 0xb5, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0x8a, 0xb5, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x0e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x14, 0x8a, 0xb5, 0x18, 0x10,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x22,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x22, 0x52, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x8a, 0xb5, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x50,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15,
+	0x8a, 0xb5, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x3a, 0x5a, 0x38, 0x70, 0x6c, 0x65, 0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x3b, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -690,26 +1138,34 @@ func file_formicary_v1_queen_approval_proto_rawDescGZIP() []byte {
 	return file_formicary_v1_queen_approval_proto_rawDescData
 }
 
-var file_formicary_v1_queen_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_formicary_v1_queen_approval_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_formicary_v1_queen_approval_proto_goTypes = []any{
 	(*ApprovalPolicy)(nil),        // 0: formicary.v1.queen.ApprovalPolicy
-	(*ApprovalVote)(nil),          // 1: formicary.v1.queen.ApprovalVote
-	(*ApprovalVoteRequest)(nil),   // 2: formicary.v1.queen.ApprovalVoteRequest
-	(*ApprovalStatus)(nil),        // 3: formicary.v1.queen.ApprovalStatus
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*ApprovalStage)(nil),         // 1: formicary.v1.queen.ApprovalStage
+	(*ApprovalVote)(nil),          // 2: formicary.v1.queen.ApprovalVote
+	(*ApprovalVoteRequest)(nil),   // 3: formicary.v1.queen.ApprovalVoteRequest
+	(*ApprovalStatus)(nil),        // 4: formicary.v1.queen.ApprovalStatus
+	(*ApprovalStageStatus)(nil),   // 5: formicary.v1.queen.ApprovalStageStatus
+	(*ApprovalDelegation)(nil),    // 6: formicary.v1.queen.ApprovalDelegation
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_formicary_v1_queen_approval_proto_depIdxs = []int32{
-	4, // 0: formicary.v1.queen.ApprovalPolicy.created_at:type_name -> google.protobuf.Timestamp
-	4, // 1: formicary.v1.queen.ApprovalPolicy.updated_at:type_name -> google.protobuf.Timestamp
-	4, // 2: formicary.v1.queen.ApprovalVote.voted_at:type_name -> google.protobuf.Timestamp
-	4, // 3: formicary.v1.queen.ApprovalStatus.deadline:type_name -> google.protobuf.Timestamp
-	1, // 4: formicary.v1.queen.ApprovalStatus.votes:type_name -> formicary.v1.queen.ApprovalVote
-	0, // 5: formicary.v1.queen.ApprovalStatus.policy:type_name -> formicary.v1.queen.ApprovalPolicy
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7,  // 0: formicary.v1.queen.ApprovalPolicy.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: formicary.v1.queen.ApprovalPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: formicary.v1.queen.ApprovalPolicy.stages:type_name -> formicary.v1.queen.ApprovalStage
+	7,  // 3: formicary.v1.queen.ApprovalVote.voted_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: formicary.v1.queen.ApprovalStatus.deadline:type_name -> google.protobuf.Timestamp
+	2,  // 5: formicary.v1.queen.ApprovalStatus.votes:type_name -> formicary.v1.queen.ApprovalVote
+	0,  // 6: formicary.v1.queen.ApprovalStatus.policy:type_name -> formicary.v1.queen.ApprovalPolicy
+	5,  // 7: formicary.v1.queen.ApprovalStatus.stages:type_name -> formicary.v1.queen.ApprovalStageStatus
+	7,  // 8: formicary.v1.queen.ApprovalDelegation.starts_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: formicary.v1.queen.ApprovalDelegation.ends_at:type_name -> google.protobuf.Timestamp
+	7,  // 10: formicary.v1.queen.ApprovalDelegation.created_at:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_formicary_v1_queen_approval_proto_init() }
@@ -723,7 +1179,7 @@ func file_formicary_v1_queen_approval_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_formicary_v1_queen_approval_proto_rawDesc), len(file_formicary_v1_queen_approval_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
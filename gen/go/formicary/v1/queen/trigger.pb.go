@@ -479,6 +479,152 @@ func (x *TriggerState) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// TriggerDeadLetter is a queue or S3 notification event whose job request couldn't be submitted,
+// e.g. due to bad template, validation error or rate limit.
+type TriggerDeadLetter struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobDefinitionId string                 `protobuf:"bytes,2,opt,name=job_definition_id,json=jobDefinitionId,proto3" json:"job_definition_id,omitempty"`
+	JobType         string                 `protobuf:"bytes,3,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	TriggerName     string                 `protobuf:"bytes,4,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	TriggerType     string                 `protobuf:"bytes,5,opt,name=trigger_type,json=triggerType,proto3" json:"trigger_type,omitempty"`
+	// payload is the JSON encoded template context of the event such as Message and Properties.
+	Payload      string `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// state is FAILED until the event is replayed or discarded, i.e., REPLAYED or DISCARDED.
+	State string `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	// attempts counts failed deliveries including replays.
+	Attempts int32 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// job_request_id is the request created by replay; empty if replay was filtered or deduped.
+	JobRequestId  string                 `protobuf:"bytes,10,opt,name=job_request_id,json=jobRequestId,proto3" json:"job_request_id,omitempty"`
+	ReplayedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDeadLetter) Reset() {
+	*x = TriggerDeadLetter{}
+	mi := &file_formicary_v1_queen_trigger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDeadLetter) ProtoMessage() {}
+
+func (x *TriggerDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_queen_trigger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDeadLetter.ProtoReflect.Descriptor instead.
+func (*TriggerDeadLetter) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_queen_trigger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TriggerDeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetJobDefinitionId() string {
+	if x != nil {
+		return x.JobDefinitionId
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetTriggerType() string {
+	if x != nil {
+		return x.TriggerType
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *TriggerDeadLetter) GetJobRequestId() string {
+	if x != nil {
+		return x.JobRequestId
+	}
+	return ""
+}
+
+func (x *TriggerDeadLetter) GetReplayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return nil
+}
+
+func (x *TriggerDeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TriggerDeadLetter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_formicary_v1_queen_trigger_proto protoreflect.FileDescriptor
 
 var file_formicary_v1_queen_trigger_proto_rawDesc = string([]byte{
@@ -648,21 +794,53 @@ var file_formicary_v1_queen_trigger_proto_rawDesc = string([]byte{
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0x8a, 0xb5, 0x18,
 	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x96, 0x01,
-	0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x41,
-	0x55, 0x54, 0x48, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x49, 0x47,
-	0x47, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48,
-	0x41, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
-	0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
-	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x48, 0x45,
-	0x41, 0x44, 0x45, 0x52, 0x10, 0x03, 0x42, 0x3a, 0x5a, 0x38, 0x70, 0x6c, 0x65, 0x78, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
-	0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
-	0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x3b, 0x71, 0x75, 0x65,
-	0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x22, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xfa, 0x03,
+	0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6a, 0x6f, 0x62, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6a, 0x6f, 0x62, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x96, 0x01, 0x0a, 0x11, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x23, 0x0a, 0x1f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35,
+	0x36, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45,
+	0x52, 0x10, 0x03, 0x42, 0x3a, 0x5a, 0x38, 0x70, 0x6c, 0x65, 0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x3b, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -678,30 +856,34 @@ func file_formicary_v1_queen_trigger_proto_rawDescGZIP() []byte {
 }
 
 var file_formicary_v1_queen_trigger_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_formicary_v1_queen_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_formicary_v1_queen_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_formicary_v1_queen_trigger_proto_goTypes = []any{
 	(TriggerAuthMethod)(0),        // 0: formicary.v1.queen.TriggerAuthMethod
 	(*TriggerAuth)(nil),           // 1: formicary.v1.queen.TriggerAuth
 	(*TriggerRateLimit)(nil),      // 2: formicary.v1.queen.TriggerRateLimit
 	(*TriggerDefinition)(nil),     // 3: formicary.v1.queen.TriggerDefinition
 	(*TriggerState)(nil),          // 4: formicary.v1.queen.TriggerState
-	nil,                           // 5: formicary.v1.queen.TriggerDefinition.ParamsEntry
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*TriggerDeadLetter)(nil),     // 5: formicary.v1.queen.TriggerDeadLetter
+	nil,                           // 6: formicary.v1.queen.TriggerDefinition.ParamsEntry
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_formicary_v1_queen_trigger_proto_depIdxs = []int32{
-	0, // 0: formicary.v1.queen.TriggerAuth.method:type_name -> formicary.v1.queen.TriggerAuthMethod
-	1, // 1: formicary.v1.queen.TriggerDefinition.auth:type_name -> formicary.v1.queen.TriggerAuth
-	5, // 2: formicary.v1.queen.TriggerDefinition.params:type_name -> formicary.v1.queen.TriggerDefinition.ParamsEntry
-	2, // 3: formicary.v1.queen.TriggerDefinition.rate_limit:type_name -> formicary.v1.queen.TriggerRateLimit
-	6, // 4: formicary.v1.queen.TriggerState.last_seen_time:type_name -> google.protobuf.Timestamp
-	6, // 5: formicary.v1.queen.TriggerState.window_start:type_name -> google.protobuf.Timestamp
-	6, // 6: formicary.v1.queen.TriggerState.created_at:type_name -> google.protobuf.Timestamp
-	6, // 7: formicary.v1.queen.TriggerState.updated_at:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: formicary.v1.queen.TriggerAuth.method:type_name -> formicary.v1.queen.TriggerAuthMethod
+	1,  // 1: formicary.v1.queen.TriggerDefinition.auth:type_name -> formicary.v1.queen.TriggerAuth
+	6,  // 2: formicary.v1.queen.TriggerDefinition.params:type_name -> formicary.v1.queen.TriggerDefinition.ParamsEntry
+	2,  // 3: formicary.v1.queen.TriggerDefinition.rate_limit:type_name -> formicary.v1.queen.TriggerRateLimit
+	7,  // 4: formicary.v1.queen.TriggerState.last_seen_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: formicary.v1.queen.TriggerState.window_start:type_name -> google.protobuf.Timestamp
+	7,  // 6: formicary.v1.queen.TriggerState.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: formicary.v1.queen.TriggerState.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: formicary.v1.queen.TriggerDeadLetter.replayed_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: formicary.v1.queen.TriggerDeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 10: formicary.v1.queen.TriggerDeadLetter.updated_at:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_formicary_v1_queen_trigger_proto_init() }
@@ -715,7 +897,7 @@ func file_formicary_v1_queen_trigger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_formicary_v1_queen_trigger_proto_rawDesc), len(file_formicary_v1_queen_trigger_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -981,6 +981,247 @@ func (x *ListPendingApprovalsResponse) GetPageSize() int32 {
 	return 0
 }
 
+// ListApprovalDelegationsRequest queries unexpired delegations from or to the authenticated user.
+type ListApprovalDelegationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalDelegationsRequest) Reset() {
+	*x = ListApprovalDelegationsRequest{}
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalDelegationsRequest) ProtoMessage() {}
+
+func (x *ListApprovalDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{16}
+}
+
+// ListApprovalDelegationsResponse returns approval delegations of the user.
+type ListApprovalDelegationsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Delegations   []*queen.ApprovalDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalDelegationsResponse) Reset() {
+	*x = ListApprovalDelegationsResponse{}
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalDelegationsResponse) ProtoMessage() {}
+
+func (x *ListApprovalDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApprovalDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListApprovalDelegationsResponse) GetDelegations() []*queen.ApprovalDelegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+// CreateApprovalDelegationRequest delegates votes of the authenticated user while out of office.
+type CreateApprovalDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DelegateId    string                 `protobuf:"bytes,1,opt,name=delegate_id,json=delegateId,proto3" json:"delegate_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalDelegationRequest) Reset() {
+	*x = CreateApprovalDelegationRequest{}
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalDelegationRequest) ProtoMessage() {}
+
+func (x *CreateApprovalDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalDelegationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApprovalDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateApprovalDelegationRequest) GetDelegateId() string {
+	if x != nil {
+		return x.DelegateId
+	}
+	return ""
+}
+
+func (x *CreateApprovalDelegationRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreateApprovalDelegationRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreateApprovalDelegationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CreateApprovalDelegationResponse returns the created delegation.
+type CreateApprovalDelegationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Delegation    *queen.ApprovalDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApprovalDelegationResponse) Reset() {
+	*x = CreateApprovalDelegationResponse{}
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApprovalDelegationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApprovalDelegationResponse) ProtoMessage() {}
+
+func (x *CreateApprovalDelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApprovalDelegationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApprovalDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateApprovalDelegationResponse) GetDelegation() *queen.ApprovalDelegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+// RevokeApprovalDelegationRequest identifies the delegation to revoke.
+type RevokeApprovalDelegationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApprovalDelegationRequest) Reset() {
+	*x = RevokeApprovalDelegationRequest{}
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApprovalDelegationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApprovalDelegationRequest) ProtoMessage() {}
+
+func (x *RevokeApprovalDelegationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApprovalDelegationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApprovalDelegationRequest) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevokeApprovalDelegationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // JobWaitTimeResponse returns estimated wait time information.
 type JobWaitTimeResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -993,7 +1234,7 @@ type JobWaitTimeResponse struct {
 
 func (x *JobWaitTimeResponse) Reset() {
 	*x = JobWaitTimeResponse{}
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[16]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1246,7 @@ func (x *JobWaitTimeResponse) String() string {
 func (*JobWaitTimeResponse) ProtoMessage() {}
 
 func (x *JobWaitTimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[16]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1259,7 @@ func (x *JobWaitTimeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobWaitTimeResponse.ProtoReflect.Descriptor instead.
 func (*JobWaitTimeResponse) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{16}
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *JobWaitTimeResponse) GetEstimatedWaitSecs() int64 {
@@ -1052,7 +1293,7 @@ type JobRequestStatsResponse struct {
 
 func (x *JobRequestStatsResponse) Reset() {
 	*x = JobRequestStatsResponse{}
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[17]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +1305,7 @@ func (x *JobRequestStatsResponse) String() string {
 func (*JobRequestStatsResponse) ProtoMessage() {}
 
 func (x *JobRequestStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[17]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1318,7 @@ func (x *JobRequestStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobRequestStatsResponse.ProtoReflect.Descriptor instead.
 func (*JobRequestStatsResponse) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{17}
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *JobRequestStatsResponse) GetStats() []*JobRequestStat {
@@ -1103,7 +1344,7 @@ type JobRequestStat struct {
 
 func (x *JobRequestStat) Reset() {
 	*x = JobRequestStat{}
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[18]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1115,7 +1356,7 @@ func (x *JobRequestStat) String() string {
 func (*JobRequestStat) ProtoMessage() {}
 
 func (x *JobRequestStat) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[18]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1369,7 @@ func (x *JobRequestStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobRequestStat.ProtoReflect.Descriptor instead.
 func (*JobRequestStat) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{18}
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *JobRequestStat) GetJobType() string {
@@ -1190,7 +1431,7 @@ type GetJobExecutionRequest struct {
 
 func (x *GetJobExecutionRequest) Reset() {
 	*x = GetJobExecutionRequest{}
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[19]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1202,7 +1443,7 @@ func (x *GetJobExecutionRequest) String() string {
 func (*GetJobExecutionRequest) ProtoMessage() {}
 
 func (x *GetJobExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[19]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1456,7 @@ func (x *GetJobExecutionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobExecutionRequest.ProtoReflect.Descriptor instead.
 func (*GetJobExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{19}
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetJobExecutionRequest) GetId() string {
@@ -1237,7 +1478,7 @@ type GetJobExecutionResponse struct {
 
 func (x *GetJobExecutionResponse) Reset() {
 	*x = GetJobExecutionResponse{}
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[20]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1249,7 +1490,7 @@ func (x *GetJobExecutionResponse) String() string {
 func (*GetJobExecutionResponse) ProtoMessage() {}
 
 func (x *GetJobExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[20]
+	mi := &file_formicary_v1_services_job_execution_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +1503,7 @@ func (x *GetJobExecutionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobExecutionResponse.ProtoReflect.Descriptor instead.
 func (*GetJobExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{20}
+	return file_formicary_v1_services_job_execution_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetJobExecutionResponse) GetJobExecution() *queen.JobExecution {
@@ -1466,334 +1707,439 @@ var file_formicary_v1_services_job_execution_service_proto_rawDesc = string([]by
 	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
 	0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
 	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x7d, 0x0a, 0x13, 0x4a, 0x6f, 0x62, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
-	0x57, 0x61, 0x69, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x56, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6f, 0x72,
-	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x0e, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a,
-	0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x22,
-	0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41, 0x1a, 0x32, 0x18, 0x4a, 0x6f, 0x62, 0x20,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x49, 0x44, 0x20, 0x28, 0x55, 0x4c,
-	0x49, 0x44, 0x29, 0x2e, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x6a, 0x6f,
-	0x62, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x32, 0xa0, 0x23, 0x0a, 0x13,
-	0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0xbe, 0x02, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f,
-	0x62, 0x12, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6f,
+	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xcd, 0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x30, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x20, 0x49, 0x44, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x6d, 0x61, 0x79,
+	0x20, 0x76, 0x6f, 0x74, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x66, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0x92, 0x41, 0x46, 0x32, 0x44, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x20, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x20, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x2d, 0x6f, 0x66, 0x2d,
+	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x20, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x6e, 0x6f, 0x77, 0x2e, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x2a, 0x32,
+	0x28, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x2d, 0x6f, 0x66, 0x2d, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x20, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2e, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x6a, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x1f,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7d, 0x0a, 0x13, 0x4a, 0x6f, 0x62, 0x57,
+	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x13, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x57, 0x61, 0x69, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f,
+	0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0xe4, 0x01, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76,
+	0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0x92, 0x41,
+	0x1a, 0x32, 0x18, 0x4a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x49, 0x44, 0x20, 0x28, 0x55, 0x4c, 0x49, 0x44, 0x29, 0x2e, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0d, 0x6a, 0x6f, 0x62, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x4a, 0x6f,
+	0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6a, 0x6f, 0x62, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x72, 0x6d,
+	0x61, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x72, 0x6d, 0x61,
+	0x69, 0x64, 0x32, 0xac, 0x2b, 0x0a, 0x13, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbe, 0x02, 0x0a, 0x09, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x6f, 0x72,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x01, 0x92, 0x41,
+	0xb9, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x20, 0x61, 0x20, 0x6a, 0x6f, 0x62,
+	0x1a, 0x7a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20,
+	0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x74, 0x20, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x20, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x49, 0x44, 0x2e, 0x4a, 0x1d, 0x0a, 0x03,
+	0x32, 0x30, 0x31, 0x12, 0x16, 0x0a, 0x14, 0x4a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0xad, 0x02, 0x0a, 0x10,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xb7, 0x01, 0x92, 0x41, 0x96, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6a,
+	0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x47, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x2e, 0x4a, 0x28, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x0a, 0x1f, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x9c, 0x02, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x66, 0x6f, 0x72,
 	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x01, 0x92, 0x41, 0xb9, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62,
-	0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x20, 0x61, 0x20, 0x6a, 0x6f, 0x62, 0x1a, 0x7a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x73, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x20,
-	0x69, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x74, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2e,
-	0x20, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x20, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
-	0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x20, 0x49, 0x44, 0x2e, 0x4a, 0x1d, 0x0a, 0x03, 0x32, 0x30, 0x31, 0x12, 0x16, 0x0a, 0x14,
-	0x4a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0xad, 0x02, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
-	0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
-	0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x92, 0x41, 0x96,
-	0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x1a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f,
-	0x66, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x20, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x64, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4a, 0x28, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x21, 0x0a, 0x1f, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65,
-	0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x92, 0x41, 0x89, 0x01, 0x0a,
+	0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x0f, 0x47, 0x65, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x4f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x2e, 0x4a, 0x15, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x0e, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x20,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb4, 0x02, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc1, 0x01,
+	0x92, 0x41, 0x99, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x47, 0x65, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x50, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e,
+	0x67, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x2e, 0x4a, 0x22, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x1b, 0x0a, 0x19, 0x4a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62,
+	0x73, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0x9e, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12,
+	0x27, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0xcf, 0x01, 0x92, 0x41, 0xa2, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x20,
+	0x6a, 0x6f, 0x62, 0x1a, 0x62, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x73, 0x20, 0x61, 0x20, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x53,
+	0x65, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x74, 0x20,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19,
+	0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x12, 0xe6, 0x01, 0x0a, 0x08, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x26, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x99, 0x01, 0x92, 0x41, 0x6e, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x09, 0x50, 0x61, 0x75, 0x73, 0x65, 0x20, 0x6a, 0x6f, 0x62,
+	0x1a, 0x32, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x2e, 0x4a, 0x1d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x16, 0x0a, 0x14, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0xc0, 0x02, 0x0a, 0x0a,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xef, 0x01, 0x92,
+	0x41, 0xbe, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6a, 0x6f, 0x62,
+	0x1a, 0x7d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x20, 0x61, 0x20, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x2c, 0x20, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x2c, 0x20,
+	0x6f, 0x72, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x2d,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20,
+	0x48, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x6b, 0x69, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x2e, 0x4a,
+	0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x90,
+	0x02, 0x0a, 0x0a, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0xbf, 0x01, 0x92, 0x41, 0x91, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x20,
+	0x6a, 0x6f, 0x62, 0x1a, 0x50, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x20, 0x61, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x74, 0x68, 0x61,
+	0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x6f, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x12, 0x9c, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xaf, 0x01, 0x92, 0x41, 0x89, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x6a, 0x6f,
-	0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4f, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e,
-	0x67, 0x20, 0x69, 0x74, 0x73, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x4a, 0x15, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x0e, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x8e, 0x03, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x4f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f,
+	0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x9e, 0x02, 0x92, 0x41, 0xd3, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x20, 0x6f,
+	0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x75, 0x43, 0x61, 0x73, 0x74,
+	0x73, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x20, 0x6f, 0x72,
+	0x20, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69,
+	0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62,
+	0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x2e, 0x4a, 0x38, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x31, 0x0a, 0x2f, 0x56, 0x6f, 0x74, 0x65,
+	0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x3b, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x41, 0x3a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x22, 0x39, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
 	0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0xb4, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
-	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc1, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a, 0x0e, 0x6a,
-	0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x11, 0x47,
-	0x65, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x50, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74,
-	0x61, 0x73, 0x6b, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61,
-	0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x73, 0x2e, 0x4a, 0x22, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1b, 0x0a, 0x19, 0x4a, 0x6f, 0x62,
-	0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9e, 0x02, 0x0a, 0x09, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x27, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x76, 0x6f,
+	0x74, 0x65, 0x12, 0xf0, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
 	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xcf, 0x01, 0x92, 0x41, 0xa2, 0x01,
-	0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x20, 0x6a, 0x6f, 0x62, 0x1a, 0x62, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x73, 0x20, 0x61, 0x20, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x20,
-	0x6f, 0x72, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x73, 0x20, 0x61, 0x20,
-	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6e, 0x74, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e,
-	0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x6c, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c,
-	0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xe6, 0x01, 0x0a, 0x08,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x26, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
-	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x99, 0x01, 0x92, 0x41, 0x6e, 0x0a, 0x0e,
-	0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x09,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x1a, 0x32, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x73, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6a,
-	0x6f, 0x62, 0x20, 0x61, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x74,
-	0x61, 0x73, 0x6b, 0x20, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2e, 0x4a, 0x1d, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x16, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
-	0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x12, 0xc0, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xef, 0x01, 0x92, 0x41, 0xbe, 0x01, 0x0a, 0x0e, 0x6a, 0x6f,
-	0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0b, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x1a, 0x7d, 0x52, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x73, 0x20, 0x61, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x2c, 0x20, 0x63, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x20,
-	0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x64, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x48, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x6b,
-	0x69, 0x63, 0x6b, 0x73, 0x20, 0x69, 0x6e, 0x2e, 0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
-	0x19, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62,
-	0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
-	0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x90, 0x02, 0x0a, 0x0a, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xbf, 0x01, 0x92, 0x41, 0x91, 0x01, 0x0a,
-	0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x20, 0x6a, 0x6f, 0x62, 0x1a, 0x50, 0x4d, 0x61,
-	0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x20,
-	0x61, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x69, 0x73, 0x20, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x61,
-	0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4a, 0x20,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x8e, 0x03, 0x0a, 0x0e, 0x56,
-	0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2c, 0x2e,
-	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x66, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
+	0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x92, 0x41,
+	0xae, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x76, 0x6f, 0x74,
+	0x65, 0x20, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x2c, 0x20, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x61, 0x77, 0x61, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x2e, 0x4a, 0x24, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x1d, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0xc0, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x32,
+	0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbe, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a, 0x0e,
+	0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x4d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+	0x61, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0x73, 0x20,
+	0x76, 0x6f, 0x74, 0x65, 0x2e, 0x4a, 0x20, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0xe0, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x66, 0x6f,
 	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x02, 0x92, 0x41, 0xd3,
+	0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xd5, 0x01, 0x92, 0x41, 0xac, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x4c, 0x69, 0x73, 0x74,
+	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x75,
+	0x6e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x77, 0x68, 0x65, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x61, 0x77, 0x61, 0x79, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x20, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x4a, 0x23, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1c, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xff, 0x02, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x01, 0x92, 0x41, 0xc5, 0x01,
+	0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x79, 0x4c, 0x65,
+	0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x20,
+	0x76, 0x6f, 0x74, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x62, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x73, 0x5f, 0x61, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74,
+	0x20, 0x62, 0x79, 0x20, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x6e, 0x5f, 0x62,
+	0x65, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6f, 0x66, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x4a, 0x1c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x15,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa4, 0x02,
+	0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xb7, 0x01, 0x92, 0x41, 0x89,
 	0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x10, 0x56, 0x6f, 0x74, 0x65, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x1a, 0x75, 0x43, 0x61, 0x73, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50,
-	0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x20, 0x6f, 0x72, 0x20, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
-	0x45, 0x44, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x74, 0x61,
-	0x73, 0x6b, 0x20, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x2e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65,
-	0x64, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x20, 0x69,
-	0x73, 0x20, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x2e, 0x4a, 0x38, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x31, 0x0a, 0x2f, 0x56, 0x6f, 0x74, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x65, 0x64, 0x3b, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x04, 0x76, 0x6f, 0x74, 0x65,
-	0x22, 0x39, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x76, 0x6f, 0x74, 0x65, 0x12, 0xf0, 0x02, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf7, 0x01, 0x92, 0x41, 0xae, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62,
-	0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x47, 0x65, 0x74,
-	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x1a, 0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x20, 0x74, 0x61, 0x6c, 0x6c, 0x79,
-	0x2c, 0x20, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
-	0x61, 0x6c, 0x6c, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20,
-	0x74, 0x61, 0x73, 0x6b, 0x20, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x2d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x2e, 0x4a, 0x24, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x1d, 0x0a, 0x1b, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x77, 0x69,
-	0x74, 0x68, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12,
-	0x3d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0xc0,
-	0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x32, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
-	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x6f,
-	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xbe, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x1a,
-	0x4d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x65, 0x64, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x61, 0x73,
-	0x6b, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x77, 0x61,
-	0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0x73, 0x20, 0x76, 0x6f, 0x74, 0x65, 0x2e, 0x4a, 0x20,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x19, 0x0a, 0x17, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0xc3, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x57, 0x61, 0x69, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x57, 0x61, 0x69,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01,
-	0x92, 0x41, 0xa7, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x47, 0x65, 0x74, 0x20, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x5e, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
-	0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x2c, 0x20,
-	0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x20, 0x64, 0x65, 0x70, 0x74, 0x68, 0x2e, 0x4a, 0x1c, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x15, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x20, 0x74, 0x69, 0x6d,
-	0x65, 0x20, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x77, 0x61,
-	0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0xdd, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64,
-	0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe7, 0x01,
-	0x92, 0x41, 0xb9, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69,
-	0x64, 0x20, 0x64, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x53, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61,
-	0x20, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x2e, 0x6a, 0x73, 0x20, 0x66, 0x6c, 0x6f, 0x77,
-	0x63, 0x68, 0x61, 0x72, 0x74, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68,
-	0x65, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x20, 0x69,
-	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x2e, 0x4a, 0x31, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x2a, 0x0a, 0x28, 0x4a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x20,
-	0x64, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62,
-	0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
-	0x6d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x12, 0xb4, 0x02, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
-	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
+	0x73, 0x12, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3b, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x61, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x2e, 0x4a, 0x1e, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x2a, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc3, 0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x57,
+	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
 	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x92, 0x41, 0x9d, 0x01, 0x0a, 0x0e,
-	0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d,
-	0x47, 0x65, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x61, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x64, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x28, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20,
-	0x72, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x61, 0x76, 0x67, 0x20, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2c, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x29, 0x20, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x74, 0x79, 0x70, 0x65, 0x2e,
-	0x4a, 0x19, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x3e,
-	0x92, 0x41, 0x3b, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x42, 0x40,
-	0x5a, 0x3e, 0x70, 0x6c, 0x65, 0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-	0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62,
+	0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xd7, 0x01, 0x92, 0x41, 0xa7, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x47, 0x65, 0x74, 0x20, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65,
+	0x1a, 0x5e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x20, 0x77, 0x61, 0x69, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x77, 0x69, 0x6c,
+	0x6c, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x2c, 0x20, 0x62, 0x61, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x20, 0x64, 0x65, 0x70, 0x74, 0x68, 0x2e,
+	0x4a, 0x1c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x15, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x20, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0xdd, 0x02, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x6d,
+	0x61, 0x69, 0x64, 0x12, 0x2b, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xe7, 0x01, 0x92, 0x41, 0xb9, 0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x4d, 0x65, 0x72,
+	0x6d, 0x61, 0x69, 0x64, 0x20, 0x64, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x53, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x20, 0x61, 0x20, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x2e, 0x6a, 0x73, 0x20, 0x66,
+	0x6c, 0x6f, 0x77, 0x63, 0x68, 0x61, 0x72, 0x74, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x2e, 0x4a, 0x31, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x2a, 0x0a, 0x28, 0x4a, 0x6f, 0x62, 0x20, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x4d, 0x65, 0x72, 0x6d, 0x61,
+	0x69, 0x64, 0x20, 0x64, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x12, 0xb4, 0x02, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc4, 0x01, 0x92, 0x41, 0x9d,
+	0x01, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x0d, 0x47, 0x65, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x1a, 0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x28, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x20, 0x72, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x61, 0x76, 0x67, 0x20, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x29, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4a, 0x19, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x1a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x0e, 0x6a, 0x6f, 0x62, 0x2d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x42, 0x40, 0x5a, 0x3e, 0x70, 0x6c, 0x65, 0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1808,81 +2154,95 @@ func file_formicary_v1_services_job_execution_service_proto_rawDescGZIP() []byte
 	return file_formicary_v1_services_job_execution_service_proto_rawDescData
 }
 
-var file_formicary_v1_services_job_execution_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_formicary_v1_services_job_execution_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_formicary_v1_services_job_execution_service_proto_goTypes = []any{
-	(*SubmitJobRequest)(nil),             // 0: formicary.v1.services.SubmitJobRequest
-	(*SubmitJobResponse)(nil),            // 1: formicary.v1.services.SubmitJobResponse
-	(*QueryJobRequestsRequest)(nil),      // 2: formicary.v1.services.QueryJobRequestsRequest
-	(*QueryJobRequestsResponse)(nil),     // 3: formicary.v1.services.QueryJobRequestsResponse
-	(*GetJobRequestRequest)(nil),         // 4: formicary.v1.services.GetJobRequestRequest
-	(*GetJobRequestResponse)(nil),        // 5: formicary.v1.services.GetJobRequestResponse
-	(*CancelJobRequest)(nil),             // 6: formicary.v1.services.CancelJobRequest
-	(*PauseJobRequest)(nil),              // 7: formicary.v1.services.PauseJobRequest
-	(*RestartJobRequest)(nil),            // 8: formicary.v1.services.RestartJobRequest
-	(*TriggerJobRequest)(nil),            // 9: formicary.v1.services.TriggerJobRequest
-	(*VoteOnApprovalRequest)(nil),        // 10: formicary.v1.services.VoteOnApprovalRequest
-	(*VoteOnApprovalResponse)(nil),       // 11: formicary.v1.services.VoteOnApprovalResponse
-	(*GetApprovalStatusRequest)(nil),     // 12: formicary.v1.services.GetApprovalStatusRequest
-	(*GetApprovalStatusResponse)(nil),    // 13: formicary.v1.services.GetApprovalStatusResponse
-	(*ListPendingApprovalsRequest)(nil),  // 14: formicary.v1.services.ListPendingApprovalsRequest
-	(*ListPendingApprovalsResponse)(nil), // 15: formicary.v1.services.ListPendingApprovalsResponse
-	(*JobWaitTimeResponse)(nil),          // 16: formicary.v1.services.JobWaitTimeResponse
-	(*JobRequestStatsResponse)(nil),      // 17: formicary.v1.services.JobRequestStatsResponse
-	(*JobRequestStat)(nil),               // 18: formicary.v1.services.JobRequestStat
-	(*GetJobExecutionRequest)(nil),       // 19: formicary.v1.services.GetJobExecutionRequest
-	(*GetJobExecutionResponse)(nil),      // 20: formicary.v1.services.GetJobExecutionResponse
-	nil,                                  // 21: formicary.v1.services.SubmitJobRequest.ParamsEntry
-	(*queen.JobRequest)(nil),             // 22: formicary.v1.queen.JobRequest
-	(*queen.ApprovalVoteRequest)(nil),    // 23: formicary.v1.queen.ApprovalVoteRequest
-	(*queen.ApprovalStatus)(nil),         // 24: formicary.v1.queen.ApprovalStatus
-	(*queen.PendingApproval)(nil),        // 25: formicary.v1.queen.PendingApproval
-	(*queen.JobExecution)(nil),           // 26: formicary.v1.queen.JobExecution
-	(*emptypb.Empty)(nil),                // 27: google.protobuf.Empty
+	(*SubmitJobRequest)(nil),                 // 0: formicary.v1.services.SubmitJobRequest
+	(*SubmitJobResponse)(nil),                // 1: formicary.v1.services.SubmitJobResponse
+	(*QueryJobRequestsRequest)(nil),          // 2: formicary.v1.services.QueryJobRequestsRequest
+	(*QueryJobRequestsResponse)(nil),         // 3: formicary.v1.services.QueryJobRequestsResponse
+	(*GetJobRequestRequest)(nil),             // 4: formicary.v1.services.GetJobRequestRequest
+	(*GetJobRequestResponse)(nil),            // 5: formicary.v1.services.GetJobRequestResponse
+	(*CancelJobRequest)(nil),                 // 6: formicary.v1.services.CancelJobRequest
+	(*PauseJobRequest)(nil),                  // 7: formicary.v1.services.PauseJobRequest
+	(*RestartJobRequest)(nil),                // 8: formicary.v1.services.RestartJobRequest
+	(*TriggerJobRequest)(nil),                // 9: formicary.v1.services.TriggerJobRequest
+	(*VoteOnApprovalRequest)(nil),            // 10: formicary.v1.services.VoteOnApprovalRequest
+	(*VoteOnApprovalResponse)(nil),           // 11: formicary.v1.services.VoteOnApprovalResponse
+	(*GetApprovalStatusRequest)(nil),         // 12: formicary.v1.services.GetApprovalStatusRequest
+	(*GetApprovalStatusResponse)(nil),        // 13: formicary.v1.services.GetApprovalStatusResponse
+	(*ListPendingApprovalsRequest)(nil),      // 14: formicary.v1.services.ListPendingApprovalsRequest
+	(*ListPendingApprovalsResponse)(nil),     // 15: formicary.v1.services.ListPendingApprovalsResponse
+	(*ListApprovalDelegationsRequest)(nil),   // 16: formicary.v1.services.ListApprovalDelegationsRequest
+	(*ListApprovalDelegationsResponse)(nil),  // 17: formicary.v1.services.ListApprovalDelegationsResponse
+	(*CreateApprovalDelegationRequest)(nil),  // 18: formicary.v1.services.CreateApprovalDelegationRequest
+	(*CreateApprovalDelegationResponse)(nil), // 19: formicary.v1.services.CreateApprovalDelegationResponse
+	(*RevokeApprovalDelegationRequest)(nil),  // 20: formicary.v1.services.RevokeApprovalDelegationRequest
+	(*JobWaitTimeResponse)(nil),              // 21: formicary.v1.services.JobWaitTimeResponse
+	(*JobRequestStatsResponse)(nil),          // 22: formicary.v1.services.JobRequestStatsResponse
+	(*JobRequestStat)(nil),                   // 23: formicary.v1.services.JobRequestStat
+	(*GetJobExecutionRequest)(nil),           // 24: formicary.v1.services.GetJobExecutionRequest
+	(*GetJobExecutionResponse)(nil),          // 25: formicary.v1.services.GetJobExecutionResponse
+	nil,                                      // 26: formicary.v1.services.SubmitJobRequest.ParamsEntry
+	(*queen.JobRequest)(nil),                 // 27: formicary.v1.queen.JobRequest
+	(*queen.ApprovalVoteRequest)(nil),        // 28: formicary.v1.queen.ApprovalVoteRequest
+	(*queen.ApprovalStatus)(nil),             // 29: formicary.v1.queen.ApprovalStatus
+	(*queen.PendingApproval)(nil),            // 30: formicary.v1.queen.PendingApproval
+	(*queen.ApprovalDelegation)(nil),         // 31: formicary.v1.queen.ApprovalDelegation
+	(*queen.JobExecution)(nil),               // 32: formicary.v1.queen.JobExecution
+	(*emptypb.Empty)(nil),                    // 33: google.protobuf.Empty
 }
 var file_formicary_v1_services_job_execution_service_proto_depIdxs = []int32{
-	21, // 0: formicary.v1.services.SubmitJobRequest.params:type_name -> formicary.v1.services.SubmitJobRequest.ParamsEntry
-	22, // 1: formicary.v1.services.SubmitJobResponse.job_request:type_name -> formicary.v1.queen.JobRequest
-	22, // 2: formicary.v1.services.QueryJobRequestsResponse.records:type_name -> formicary.v1.queen.JobRequest
-	22, // 3: formicary.v1.services.GetJobRequestResponse.job_request:type_name -> formicary.v1.queen.JobRequest
-	23, // 4: formicary.v1.services.VoteOnApprovalRequest.vote:type_name -> formicary.v1.queen.ApprovalVoteRequest
-	24, // 5: formicary.v1.services.VoteOnApprovalResponse.status:type_name -> formicary.v1.queen.ApprovalStatus
-	24, // 6: formicary.v1.services.GetApprovalStatusResponse.status:type_name -> formicary.v1.queen.ApprovalStatus
-	25, // 7: formicary.v1.services.ListPendingApprovalsResponse.approvals:type_name -> formicary.v1.queen.PendingApproval
-	18, // 8: formicary.v1.services.JobRequestStatsResponse.stats:type_name -> formicary.v1.services.JobRequestStat
-	26, // 9: formicary.v1.services.GetJobExecutionResponse.job_execution:type_name -> formicary.v1.queen.JobExecution
-	0,  // 10: formicary.v1.services.JobExecutionService.SubmitJob:input_type -> formicary.v1.services.SubmitJobRequest
-	2,  // 11: formicary.v1.services.JobExecutionService.QueryJobRequests:input_type -> formicary.v1.services.QueryJobRequestsRequest
-	4,  // 12: formicary.v1.services.JobExecutionService.GetJobRequest:input_type -> formicary.v1.services.GetJobRequestRequest
-	19, // 13: formicary.v1.services.JobExecutionService.GetJobExecution:input_type -> formicary.v1.services.GetJobExecutionRequest
-	6,  // 14: formicary.v1.services.JobExecutionService.CancelJob:input_type -> formicary.v1.services.CancelJobRequest
-	7,  // 15: formicary.v1.services.JobExecutionService.PauseJob:input_type -> formicary.v1.services.PauseJobRequest
-	8,  // 16: formicary.v1.services.JobExecutionService.RestartJob:input_type -> formicary.v1.services.RestartJobRequest
-	9,  // 17: formicary.v1.services.JobExecutionService.TriggerJob:input_type -> formicary.v1.services.TriggerJobRequest
-	10, // 18: formicary.v1.services.JobExecutionService.VoteOnApproval:input_type -> formicary.v1.services.VoteOnApprovalRequest
-	12, // 19: formicary.v1.services.JobExecutionService.GetApprovalStatus:input_type -> formicary.v1.services.GetApprovalStatusRequest
-	14, // 20: formicary.v1.services.JobExecutionService.ListPendingApprovals:input_type -> formicary.v1.services.ListPendingApprovalsRequest
-	4,  // 21: formicary.v1.services.JobExecutionService.GetJobWaitTime:input_type -> formicary.v1.services.GetJobRequestRequest
-	4,  // 22: formicary.v1.services.JobExecutionService.GetJobRequestMermaid:input_type -> formicary.v1.services.GetJobRequestRequest
-	2,  // 23: formicary.v1.services.JobExecutionService.GetJobStats:input_type -> formicary.v1.services.QueryJobRequestsRequest
-	1,  // 24: formicary.v1.services.JobExecutionService.SubmitJob:output_type -> formicary.v1.services.SubmitJobResponse
-	3,  // 25: formicary.v1.services.JobExecutionService.QueryJobRequests:output_type -> formicary.v1.services.QueryJobRequestsResponse
-	5,  // 26: formicary.v1.services.JobExecutionService.GetJobRequest:output_type -> formicary.v1.services.GetJobRequestResponse
-	20, // 27: formicary.v1.services.JobExecutionService.GetJobExecution:output_type -> formicary.v1.services.GetJobExecutionResponse
-	27, // 28: formicary.v1.services.JobExecutionService.CancelJob:output_type -> google.protobuf.Empty
-	27, // 29: formicary.v1.services.JobExecutionService.PauseJob:output_type -> google.protobuf.Empty
-	27, // 30: formicary.v1.services.JobExecutionService.RestartJob:output_type -> google.protobuf.Empty
-	27, // 31: formicary.v1.services.JobExecutionService.TriggerJob:output_type -> google.protobuf.Empty
-	11, // 32: formicary.v1.services.JobExecutionService.VoteOnApproval:output_type -> formicary.v1.services.VoteOnApprovalResponse
-	13, // 33: formicary.v1.services.JobExecutionService.GetApprovalStatus:output_type -> formicary.v1.services.GetApprovalStatusResponse
-	15, // 34: formicary.v1.services.JobExecutionService.ListPendingApprovals:output_type -> formicary.v1.services.ListPendingApprovalsResponse
-	16, // 35: formicary.v1.services.JobExecutionService.GetJobWaitTime:output_type -> formicary.v1.services.JobWaitTimeResponse
-	20, // 36: formicary.v1.services.JobExecutionService.GetJobRequestMermaid:output_type -> formicary.v1.services.GetJobExecutionResponse
-	17, // 37: formicary.v1.services.JobExecutionService.GetJobStats:output_type -> formicary.v1.services.JobRequestStatsResponse
-	24, // [24:38] is the sub-list for method output_type
-	10, // [10:24] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	26, // 0: formicary.v1.services.SubmitJobRequest.params:type_name -> formicary.v1.services.SubmitJobRequest.ParamsEntry
+	27, // 1: formicary.v1.services.SubmitJobResponse.job_request:type_name -> formicary.v1.queen.JobRequest
+	27, // 2: formicary.v1.services.QueryJobRequestsResponse.records:type_name -> formicary.v1.queen.JobRequest
+	27, // 3: formicary.v1.services.GetJobRequestResponse.job_request:type_name -> formicary.v1.queen.JobRequest
+	28, // 4: formicary.v1.services.VoteOnApprovalRequest.vote:type_name -> formicary.v1.queen.ApprovalVoteRequest
+	29, // 5: formicary.v1.services.VoteOnApprovalResponse.status:type_name -> formicary.v1.queen.ApprovalStatus
+	29, // 6: formicary.v1.services.GetApprovalStatusResponse.status:type_name -> formicary.v1.queen.ApprovalStatus
+	30, // 7: formicary.v1.services.ListPendingApprovalsResponse.approvals:type_name -> formicary.v1.queen.PendingApproval
+	31, // 8: formicary.v1.services.ListApprovalDelegationsResponse.delegations:type_name -> formicary.v1.queen.ApprovalDelegation
+	31, // 9: formicary.v1.services.CreateApprovalDelegationResponse.delegation:type_name -> formicary.v1.queen.ApprovalDelegation
+	23, // 10: formicary.v1.services.JobRequestStatsResponse.stats:type_name -> formicary.v1.services.JobRequestStat
+	32, // 11: formicary.v1.services.GetJobExecutionResponse.job_execution:type_name -> formicary.v1.queen.JobExecution
+	0,  // 12: formicary.v1.services.JobExecutionService.SubmitJob:input_type -> formicary.v1.services.SubmitJobRequest
+	2,  // 13: formicary.v1.services.JobExecutionService.QueryJobRequests:input_type -> formicary.v1.services.QueryJobRequestsRequest
+	4,  // 14: formicary.v1.services.JobExecutionService.GetJobRequest:input_type -> formicary.v1.services.GetJobRequestRequest
+	24, // 15: formicary.v1.services.JobExecutionService.GetJobExecution:input_type -> formicary.v1.services.GetJobExecutionRequest
+	6,  // 16: formicary.v1.services.JobExecutionService.CancelJob:input_type -> formicary.v1.services.CancelJobRequest
+	7,  // 17: formicary.v1.services.JobExecutionService.PauseJob:input_type -> formicary.v1.services.PauseJobRequest
+	8,  // 18: formicary.v1.services.JobExecutionService.RestartJob:input_type -> formicary.v1.services.RestartJobRequest
+	9,  // 19: formicary.v1.services.JobExecutionService.TriggerJob:input_type -> formicary.v1.services.TriggerJobRequest
+	10, // 20: formicary.v1.services.JobExecutionService.VoteOnApproval:input_type -> formicary.v1.services.VoteOnApprovalRequest
+	12, // 21: formicary.v1.services.JobExecutionService.GetApprovalStatus:input_type -> formicary.v1.services.GetApprovalStatusRequest
+	14, // 22: formicary.v1.services.JobExecutionService.ListPendingApprovals:input_type -> formicary.v1.services.ListPendingApprovalsRequest
+	16, // 23: formicary.v1.services.JobExecutionService.ListApprovalDelegations:input_type -> formicary.v1.services.ListApprovalDelegationsRequest
+	18, // 24: formicary.v1.services.JobExecutionService.CreateApprovalDelegation:input_type -> formicary.v1.services.CreateApprovalDelegationRequest
+	20, // 25: formicary.v1.services.JobExecutionService.RevokeApprovalDelegation:input_type -> formicary.v1.services.RevokeApprovalDelegationRequest
+	4,  // 26: formicary.v1.services.JobExecutionService.GetJobWaitTime:input_type -> formicary.v1.services.GetJobRequestRequest
+	4,  // 27: formicary.v1.services.JobExecutionService.GetJobRequestMermaid:input_type -> formicary.v1.services.GetJobRequestRequest
+	2,  // 28: formicary.v1.services.JobExecutionService.GetJobStats:input_type -> formicary.v1.services.QueryJobRequestsRequest
+	1,  // 29: formicary.v1.services.JobExecutionService.SubmitJob:output_type -> formicary.v1.services.SubmitJobResponse
+	3,  // 30: formicary.v1.services.JobExecutionService.QueryJobRequests:output_type -> formicary.v1.services.QueryJobRequestsResponse
+	5,  // 31: formicary.v1.services.JobExecutionService.GetJobRequest:output_type -> formicary.v1.services.GetJobRequestResponse
+	25, // 32: formicary.v1.services.JobExecutionService.GetJobExecution:output_type -> formicary.v1.services.GetJobExecutionResponse
+	33, // 33: formicary.v1.services.JobExecutionService.CancelJob:output_type -> google.protobuf.Empty
+	33, // 34: formicary.v1.services.JobExecutionService.PauseJob:output_type -> google.protobuf.Empty
+	33, // 35: formicary.v1.services.JobExecutionService.RestartJob:output_type -> google.protobuf.Empty
+	33, // 36: formicary.v1.services.JobExecutionService.TriggerJob:output_type -> google.protobuf.Empty
+	11, // 37: formicary.v1.services.JobExecutionService.VoteOnApproval:output_type -> formicary.v1.services.VoteOnApprovalResponse
+	13, // 38: formicary.v1.services.JobExecutionService.GetApprovalStatus:output_type -> formicary.v1.services.GetApprovalStatusResponse
+	15, // 39: formicary.v1.services.JobExecutionService.ListPendingApprovals:output_type -> formicary.v1.services.ListPendingApprovalsResponse
+	17, // 40: formicary.v1.services.JobExecutionService.ListApprovalDelegations:output_type -> formicary.v1.services.ListApprovalDelegationsResponse
+	19, // 41: formicary.v1.services.JobExecutionService.CreateApprovalDelegation:output_type -> formicary.v1.services.CreateApprovalDelegationResponse
+	33, // 42: formicary.v1.services.JobExecutionService.RevokeApprovalDelegation:output_type -> google.protobuf.Empty
+	21, // 43: formicary.v1.services.JobExecutionService.GetJobWaitTime:output_type -> formicary.v1.services.JobWaitTimeResponse
+	25, // 44: formicary.v1.services.JobExecutionService.GetJobRequestMermaid:output_type -> formicary.v1.services.GetJobExecutionResponse
+	22, // 45: formicary.v1.services.JobExecutionService.GetJobStats:output_type -> formicary.v1.services.JobRequestStatsResponse
+	29, // [29:46] is the sub-list for method output_type
+	12, // [12:29] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_formicary_v1_services_job_execution_service_proto_init() }
@@ -1896,7 +2256,7 @@ func file_formicary_v1_services_job_execution_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_formicary_v1_services_job_execution_service_proto_rawDesc), len(file_formicary_v1_services_job_execution_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -463,6 +463,86 @@ func local_request_JobExecutionService_ListPendingApprovals_0(ctx context.Contex
 	return msg, metadata, err
 }
 
+func request_JobExecutionService_ListApprovalDelegations_0(ctx context.Context, marshaler runtime.Marshaler, client JobExecutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListApprovalDelegationsRequest
+		metadata runtime.ServerMetadata
+	)
+	io.Copy(io.Discard, req.Body)
+	msg, err := client.ListApprovalDelegations(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_JobExecutionService_ListApprovalDelegations_0(ctx context.Context, marshaler runtime.Marshaler, server JobExecutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq ListApprovalDelegationsRequest
+		metadata runtime.ServerMetadata
+	)
+	msg, err := server.ListApprovalDelegations(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_JobExecutionService_CreateApprovalDelegation_0(ctx context.Context, marshaler runtime.Marshaler, client JobExecutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CreateApprovalDelegationRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := client.CreateApprovalDelegation(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_JobExecutionService_CreateApprovalDelegation_0(ctx context.Context, marshaler runtime.Marshaler, server JobExecutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq CreateApprovalDelegationRequest
+		metadata runtime.ServerMetadata
+	)
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && !errors.Is(err, io.EOF) {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := server.CreateApprovalDelegation(ctx, &protoReq)
+	return msg, metadata, err
+}
+
+func request_JobExecutionService_RevokeApprovalDelegation_0(ctx context.Context, marshaler runtime.Marshaler, client JobExecutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq RevokeApprovalDelegationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	io.Copy(io.Discard, req.Body)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := client.RevokeApprovalDelegation(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_JobExecutionService_RevokeApprovalDelegation_0(ctx context.Context, marshaler runtime.Marshaler, server JobExecutionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var (
+		protoReq RevokeApprovalDelegationRequest
+		metadata runtime.ServerMetadata
+		err      error
+	)
+	val, ok := pathParams["id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "id")
+	}
+	protoReq.Id, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "id", err)
+	}
+	msg, err := server.RevokeApprovalDelegation(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 func request_JobExecutionService_GetJobWaitTime_0(ctx context.Context, marshaler runtime.Marshaler, client JobExecutionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var (
 		protoReq GetJobRequestRequest
@@ -796,6 +876,66 @@ func RegisterJobExecutionServiceHandlerServer(ctx context.Context, mux *runtime.
 		}
 		forward_JobExecutionService_ListPendingApprovals_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_JobExecutionService_ListApprovalDelegations_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/ListApprovalDelegations", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_JobExecutionService_ListApprovalDelegations_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_ListApprovalDelegations_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_JobExecutionService_CreateApprovalDelegation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/CreateApprovalDelegation", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_JobExecutionService_CreateApprovalDelegation_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_CreateApprovalDelegation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_JobExecutionService_RevokeApprovalDelegation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/RevokeApprovalDelegation", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_JobExecutionService_RevokeApprovalDelegation_0(annotatedContext, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_RevokeApprovalDelegation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_JobExecutionService_GetJobWaitTime_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1083,6 +1223,57 @@ func RegisterJobExecutionServiceHandlerClient(ctx context.Context, mux *runtime.
 		}
 		forward_JobExecutionService_ListPendingApprovals_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
+	mux.Handle(http.MethodGet, pattern_JobExecutionService_ListApprovalDelegations_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/ListApprovalDelegations", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_JobExecutionService_ListApprovalDelegations_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_ListApprovalDelegations_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodPost, pattern_JobExecutionService_CreateApprovalDelegation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/CreateApprovalDelegation", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_JobExecutionService_CreateApprovalDelegation_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_CreateApprovalDelegation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+	mux.Handle(http.MethodDelete, pattern_JobExecutionService_RevokeApprovalDelegation_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/formicary.v1.services.JobExecutionService/RevokeApprovalDelegation", runtime.WithHTTPPathPattern("/api/v1/approvals/delegations/{id}"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_JobExecutionService_RevokeApprovalDelegation_0(annotatedContext, inboundMarshaler, client, req, pathParams)
+		annotatedContext = runtime.NewServerMetadataContext(annotatedContext, md)
+		if err != nil {
+			runtime.HTTPError(annotatedContext, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		forward_JobExecutionService_RevokeApprovalDelegation_0(annotatedContext, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
 	mux.Handle(http.MethodGet, pattern_JobExecutionService_GetJobWaitTime_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1138,35 +1329,41 @@ func RegisterJobExecutionServiceHandlerClient(ctx context.Context, mux *runtime.
 }
 
 var (
-	pattern_JobExecutionService_SubmitJob_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "jobs", "requests"}, ""))
-	pattern_JobExecutionService_QueryJobRequests_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "jobs", "requests"}, ""))
-	pattern_JobExecutionService_GetJobRequest_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "jobs", "requests", "id"}, ""))
-	pattern_JobExecutionService_GetJobExecution_0      = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "jobs", "executions", "id"}, ""))
-	pattern_JobExecutionService_CancelJob_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "cancel"}, ""))
-	pattern_JobExecutionService_PauseJob_0             = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "pause"}, ""))
-	pattern_JobExecutionService_RestartJob_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "restart"}, ""))
-	pattern_JobExecutionService_TriggerJob_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "trigger"}, ""))
-	pattern_JobExecutionService_VoteOnApproval_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"api", "v1", "jobs", "requests", "request_id", "tasks", "task_type", "vote"}, ""))
-	pattern_JobExecutionService_GetApprovalStatus_0    = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"api", "v1", "jobs", "requests", "request_id", "tasks", "task_type", "approval"}, ""))
-	pattern_JobExecutionService_ListPendingApprovals_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "approvals", "pending"}, ""))
-	pattern_JobExecutionService_GetJobWaitTime_0       = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "wait_time"}, ""))
-	pattern_JobExecutionService_GetJobRequestMermaid_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "mermaid"}, ""))
-	pattern_JobExecutionService_GetJobStats_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "jobs", "requests", "stats"}, ""))
+	pattern_JobExecutionService_SubmitJob_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "jobs", "requests"}, ""))
+	pattern_JobExecutionService_QueryJobRequests_0         = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "jobs", "requests"}, ""))
+	pattern_JobExecutionService_GetJobRequest_0            = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "jobs", "requests", "id"}, ""))
+	pattern_JobExecutionService_GetJobExecution_0          = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "jobs", "executions", "id"}, ""))
+	pattern_JobExecutionService_CancelJob_0                = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "cancel"}, ""))
+	pattern_JobExecutionService_PauseJob_0                 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "pause"}, ""))
+	pattern_JobExecutionService_RestartJob_0               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "restart"}, ""))
+	pattern_JobExecutionService_TriggerJob_0               = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "trigger"}, ""))
+	pattern_JobExecutionService_VoteOnApproval_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"api", "v1", "jobs", "requests", "request_id", "tasks", "task_type", "vote"}, ""))
+	pattern_JobExecutionService_GetApprovalStatus_0        = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7}, []string{"api", "v1", "jobs", "requests", "request_id", "tasks", "task_type", "approval"}, ""))
+	pattern_JobExecutionService_ListPendingApprovals_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "approvals", "pending"}, ""))
+	pattern_JobExecutionService_ListApprovalDelegations_0  = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "approvals", "delegations"}, ""))
+	pattern_JobExecutionService_CreateApprovalDelegation_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"api", "v1", "approvals", "delegations"}, ""))
+	pattern_JobExecutionService_RevokeApprovalDelegation_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"api", "v1", "approvals", "delegations", "id"}, ""))
+	pattern_JobExecutionService_GetJobWaitTime_0           = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "wait_time"}, ""))
+	pattern_JobExecutionService_GetJobRequestMermaid_0     = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"api", "v1", "jobs", "requests", "id", "mermaid"}, ""))
+	pattern_JobExecutionService_GetJobStats_0              = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 2, 4}, []string{"api", "v1", "jobs", "requests", "stats"}, ""))
 )
 
 var (
-	forward_JobExecutionService_SubmitJob_0            = runtime.ForwardResponseMessage
-	forward_JobExecutionService_QueryJobRequests_0     = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetJobRequest_0        = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetJobExecution_0      = runtime.ForwardResponseMessage
-	forward_JobExecutionService_CancelJob_0            = runtime.ForwardResponseMessage
-	forward_JobExecutionService_PauseJob_0             = runtime.ForwardResponseMessage
-	forward_JobExecutionService_RestartJob_0           = runtime.ForwardResponseMessage
-	forward_JobExecutionService_TriggerJob_0           = runtime.ForwardResponseMessage
-	forward_JobExecutionService_VoteOnApproval_0       = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetApprovalStatus_0    = runtime.ForwardResponseMessage
-	forward_JobExecutionService_ListPendingApprovals_0 = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetJobWaitTime_0       = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetJobRequestMermaid_0 = runtime.ForwardResponseMessage
-	forward_JobExecutionService_GetJobStats_0          = runtime.ForwardResponseMessage
+	forward_JobExecutionService_SubmitJob_0                = runtime.ForwardResponseMessage
+	forward_JobExecutionService_QueryJobRequests_0         = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetJobRequest_0            = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetJobExecution_0          = runtime.ForwardResponseMessage
+	forward_JobExecutionService_CancelJob_0                = runtime.ForwardResponseMessage
+	forward_JobExecutionService_PauseJob_0                 = runtime.ForwardResponseMessage
+	forward_JobExecutionService_RestartJob_0               = runtime.ForwardResponseMessage
+	forward_JobExecutionService_TriggerJob_0               = runtime.ForwardResponseMessage
+	forward_JobExecutionService_VoteOnApproval_0           = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetApprovalStatus_0        = runtime.ForwardResponseMessage
+	forward_JobExecutionService_ListPendingApprovals_0     = runtime.ForwardResponseMessage
+	forward_JobExecutionService_ListApprovalDelegations_0  = runtime.ForwardResponseMessage
+	forward_JobExecutionService_CreateApprovalDelegation_0 = runtime.ForwardResponseMessage
+	forward_JobExecutionService_RevokeApprovalDelegation_0 = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetJobWaitTime_0           = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetJobRequestMermaid_0     = runtime.ForwardResponseMessage
+	forward_JobExecutionService_GetJobStats_0              = runtime.ForwardResponseMessage
 )
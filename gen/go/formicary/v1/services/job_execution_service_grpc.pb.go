@@ -22,20 +22,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JobExecutionService_SubmitJob_FullMethodName            = "/formicary.v1.services.JobExecutionService/SubmitJob"
-	JobExecutionService_QueryJobRequests_FullMethodName     = "/formicary.v1.services.JobExecutionService/QueryJobRequests"
-	JobExecutionService_GetJobRequest_FullMethodName        = "/formicary.v1.services.JobExecutionService/GetJobRequest"
-	JobExecutionService_GetJobExecution_FullMethodName      = "/formicary.v1.services.JobExecutionService/GetJobExecution"
-	JobExecutionService_CancelJob_FullMethodName            = "/formicary.v1.services.JobExecutionService/CancelJob"
-	JobExecutionService_PauseJob_FullMethodName             = "/formicary.v1.services.JobExecutionService/PauseJob"
-	JobExecutionService_RestartJob_FullMethodName           = "/formicary.v1.services.JobExecutionService/RestartJob"
-	JobExecutionService_TriggerJob_FullMethodName           = "/formicary.v1.services.JobExecutionService/TriggerJob"
-	JobExecutionService_VoteOnApproval_FullMethodName       = "/formicary.v1.services.JobExecutionService/VoteOnApproval"
-	JobExecutionService_GetApprovalStatus_FullMethodName    = "/formicary.v1.services.JobExecutionService/GetApprovalStatus"
-	JobExecutionService_ListPendingApprovals_FullMethodName = "/formicary.v1.services.JobExecutionService/ListPendingApprovals"
-	JobExecutionService_GetJobWaitTime_FullMethodName       = "/formicary.v1.services.JobExecutionService/GetJobWaitTime"
-	JobExecutionService_GetJobRequestMermaid_FullMethodName = "/formicary.v1.services.JobExecutionService/GetJobRequestMermaid"
-	JobExecutionService_GetJobStats_FullMethodName          = "/formicary.v1.services.JobExecutionService/GetJobStats"
+	JobExecutionService_SubmitJob_FullMethodName                = "/formicary.v1.services.JobExecutionService/SubmitJob"
+	JobExecutionService_QueryJobRequests_FullMethodName         = "/formicary.v1.services.JobExecutionService/QueryJobRequests"
+	JobExecutionService_GetJobRequest_FullMethodName            = "/formicary.v1.services.JobExecutionService/GetJobRequest"
+	JobExecutionService_GetJobExecution_FullMethodName          = "/formicary.v1.services.JobExecutionService/GetJobExecution"
+	JobExecutionService_CancelJob_FullMethodName                = "/formicary.v1.services.JobExecutionService/CancelJob"
+	JobExecutionService_PauseJob_FullMethodName                 = "/formicary.v1.services.JobExecutionService/PauseJob"
+	JobExecutionService_RestartJob_FullMethodName               = "/formicary.v1.services.JobExecutionService/RestartJob"
+	JobExecutionService_TriggerJob_FullMethodName               = "/formicary.v1.services.JobExecutionService/TriggerJob"
+	JobExecutionService_VoteOnApproval_FullMethodName           = "/formicary.v1.services.JobExecutionService/VoteOnApproval"
+	JobExecutionService_GetApprovalStatus_FullMethodName        = "/formicary.v1.services.JobExecutionService/GetApprovalStatus"
+	JobExecutionService_ListPendingApprovals_FullMethodName     = "/formicary.v1.services.JobExecutionService/ListPendingApprovals"
+	JobExecutionService_ListApprovalDelegations_FullMethodName  = "/formicary.v1.services.JobExecutionService/ListApprovalDelegations"
+	JobExecutionService_CreateApprovalDelegation_FullMethodName = "/formicary.v1.services.JobExecutionService/CreateApprovalDelegation"
+	JobExecutionService_RevokeApprovalDelegation_FullMethodName = "/formicary.v1.services.JobExecutionService/RevokeApprovalDelegation"
+	JobExecutionService_GetJobWaitTime_FullMethodName           = "/formicary.v1.services.JobExecutionService/GetJobWaitTime"
+	JobExecutionService_GetJobRequestMermaid_FullMethodName     = "/formicary.v1.services.JobExecutionService/GetJobRequestMermaid"
+	JobExecutionService_GetJobStats_FullMethodName              = "/formicary.v1.services.JobExecutionService/GetJobStats"
 )
 
 // JobExecutionServiceClient is the client API for JobExecutionService service.
@@ -66,6 +69,12 @@ type JobExecutionServiceClient interface {
 	GetApprovalStatus(ctx context.Context, in *GetApprovalStatusRequest, opts ...grpc.CallOption) (*GetApprovalStatusResponse, error)
 	// ListPendingApprovals returns tasks awaiting the authenticated user's vote.
 	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	// ListApprovalDelegations returns out-of-office delegations from or to the authenticated user.
+	ListApprovalDelegations(ctx context.Context, in *ListApprovalDelegationsRequest, opts ...grpc.CallOption) (*ListApprovalDelegationsResponse, error)
+	// CreateApprovalDelegation delegates approval votes of the authenticated user while out of office.
+	CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error)
+	// RevokeApprovalDelegation removes an approval delegation of the authenticated user.
+	RevokeApprovalDelegation(ctx context.Context, in *RevokeApprovalDelegationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetJobWaitTime returns the estimated wait time for a job request.
 	GetJobWaitTime(ctx context.Context, in *GetJobRequestRequest, opts ...grpc.CallOption) (*JobWaitTimeResponse, error)
 	// GetJobRequestMermaid returns a Mermaid.js diagram for a job request's execution graph.
@@ -192,6 +201,36 @@ func (c *jobExecutionServiceClient) ListPendingApprovals(ctx context.Context, in
 	return out, nil
 }
 
+func (c *jobExecutionServiceClient) ListApprovalDelegations(ctx context.Context, in *ListApprovalDelegationsRequest, opts ...grpc.CallOption) (*ListApprovalDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalDelegationsResponse)
+	err := c.cc.Invoke(ctx, JobExecutionService_ListApprovalDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobExecutionServiceClient) CreateApprovalDelegation(ctx context.Context, in *CreateApprovalDelegationRequest, opts ...grpc.CallOption) (*CreateApprovalDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApprovalDelegationResponse)
+	err := c.cc.Invoke(ctx, JobExecutionService_CreateApprovalDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobExecutionServiceClient) RevokeApprovalDelegation(ctx context.Context, in *RevokeApprovalDelegationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, JobExecutionService_RevokeApprovalDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobExecutionServiceClient) GetJobWaitTime(ctx context.Context, in *GetJobRequestRequest, opts ...grpc.CallOption) (*JobWaitTimeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(JobWaitTimeResponse)
@@ -250,6 +289,12 @@ type JobExecutionServiceServer interface {
 	GetApprovalStatus(context.Context, *GetApprovalStatusRequest) (*GetApprovalStatusResponse, error)
 	// ListPendingApprovals returns tasks awaiting the authenticated user's vote.
 	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	// ListApprovalDelegations returns out-of-office delegations from or to the authenticated user.
+	ListApprovalDelegations(context.Context, *ListApprovalDelegationsRequest) (*ListApprovalDelegationsResponse, error)
+	// CreateApprovalDelegation delegates approval votes of the authenticated user while out of office.
+	CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error)
+	// RevokeApprovalDelegation removes an approval delegation of the authenticated user.
+	RevokeApprovalDelegation(context.Context, *RevokeApprovalDelegationRequest) (*emptypb.Empty, error)
 	// GetJobWaitTime returns the estimated wait time for a job request.
 	GetJobWaitTime(context.Context, *GetJobRequestRequest) (*JobWaitTimeResponse, error)
 	// GetJobRequestMermaid returns a Mermaid.js diagram for a job request's execution graph.
@@ -298,6 +343,15 @@ func (UnimplementedJobExecutionServiceServer) GetApprovalStatus(context.Context,
 func (UnimplementedJobExecutionServiceServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
 }
+func (UnimplementedJobExecutionServiceServer) ListApprovalDelegations(context.Context, *ListApprovalDelegationsRequest) (*ListApprovalDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovalDelegations not implemented")
+}
+func (UnimplementedJobExecutionServiceServer) CreateApprovalDelegation(context.Context, *CreateApprovalDelegationRequest) (*CreateApprovalDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApprovalDelegation not implemented")
+}
+func (UnimplementedJobExecutionServiceServer) RevokeApprovalDelegation(context.Context, *RevokeApprovalDelegationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApprovalDelegation not implemented")
+}
 func (UnimplementedJobExecutionServiceServer) GetJobWaitTime(context.Context, *GetJobRequestRequest) (*JobWaitTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobWaitTime not implemented")
 }
@@ -525,6 +579,60 @@ func _JobExecutionService_ListPendingApprovals_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobExecutionService_ListApprovalDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobExecutionServiceServer).ListApprovalDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobExecutionService_ListApprovalDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobExecutionServiceServer).ListApprovalDelegations(ctx, req.(*ListApprovalDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobExecutionService_CreateApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobExecutionServiceServer).CreateApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobExecutionService_CreateApprovalDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobExecutionServiceServer).CreateApprovalDelegation(ctx, req.(*CreateApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobExecutionService_RevokeApprovalDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApprovalDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobExecutionServiceServer).RevokeApprovalDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobExecutionService_RevokeApprovalDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobExecutionServiceServer).RevokeApprovalDelegation(ctx, req.(*RevokeApprovalDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobExecutionService_GetJobWaitTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetJobRequestRequest)
 	if err := dec(in); err != nil {
@@ -630,6 +738,18 @@ var JobExecutionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPendingApprovals",
 			Handler:    _JobExecutionService_ListPendingApprovals_Handler,
 		},
+		{
+			MethodName: "ListApprovalDelegations",
+			Handler:    _JobExecutionService_ListApprovalDelegations_Handler,
+		},
+		{
+			MethodName: "CreateApprovalDelegation",
+			Handler:    _JobExecutionService_CreateApprovalDelegation_Handler,
+		},
+		{
+			MethodName: "RevokeApprovalDelegation",
+			Handler:    _JobExecutionService_RevokeApprovalDelegation_Handler,
+		},
 		{
 			MethodName: "GetJobWaitTime",
 			Handler:    _JobExecutionService_GetJobWaitTime_Handler,
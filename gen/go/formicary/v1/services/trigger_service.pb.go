@@ -282,6 +282,292 @@ func (x *FireWebhookTriggerResponse) GetRequestId() string {
 	return ""
 }
 
+type ListTriggerDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobType       string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	TriggerName   string                 `protobuf:"bytes,2,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTriggerDeadLettersRequest) Reset() {
+	*x = ListTriggerDeadLettersRequest{}
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTriggerDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListTriggerDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListTriggerDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_trigger_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTriggerDeadLettersRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *ListTriggerDeadLettersRequest) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *ListTriggerDeadLettersRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListTriggerDeadLettersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTriggerDeadLettersResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	DeadLetters   []*queen.TriggerDeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTriggerDeadLettersResponse) Reset() {
+	*x = ListTriggerDeadLettersResponse{}
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTriggerDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListTriggerDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListTriggerDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_trigger_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTriggerDeadLettersResponse) GetDeadLetters() []*queen.TriggerDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type TriggerDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobType       string                 `protobuf:"bytes,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	TriggerName   string                 `protobuf:"bytes,3,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	All           bool                   `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerDeadLettersRequest) Reset() {
+	*x = TriggerDeadLettersRequest{}
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDeadLettersRequest) ProtoMessage() {}
+
+func (x *TriggerDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*TriggerDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_trigger_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TriggerDeadLettersRequest) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *TriggerDeadLettersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *TriggerDeadLettersRequest) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *TriggerDeadLettersRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type ReplayTriggerDeadLettersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// request_ids are the created JobRequest IDs; filtered or deduped replays are not included.
+	RequestIds []string `protobuf:"bytes,1,rep,name=request_ids,json=requestIds,proto3" json:"request_ids,omitempty"`
+	Replayed   int32    `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	// failed maps dead-letter id to the error of its replay.
+	Failed        map[string]string `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayTriggerDeadLettersResponse) Reset() {
+	*x = ReplayTriggerDeadLettersResponse{}
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayTriggerDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayTriggerDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayTriggerDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayTriggerDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayTriggerDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_trigger_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplayTriggerDeadLettersResponse) GetRequestIds() []string {
+	if x != nil {
+		return x.RequestIds
+	}
+	return nil
+}
+
+func (x *ReplayTriggerDeadLettersResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+func (x *ReplayTriggerDeadLettersResponse) GetFailed() map[string]string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type DiscardTriggerDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discarded     int32                  `protobuf:"varint,1,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardTriggerDeadLettersResponse) Reset() {
+	*x = DiscardTriggerDeadLettersResponse{}
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardTriggerDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardTriggerDeadLettersResponse) ProtoMessage() {}
+
+func (x *DiscardTriggerDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_formicary_v1_services_trigger_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardTriggerDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*DiscardTriggerDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_formicary_v1_services_trigger_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiscardTriggerDeadLettersResponse) GetDiscarded() int32 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
 var File_formicary_v1_services_trigger_service_proto protoreflect.FileDescriptor
 
 var file_formicary_v1_services_trigger_service_proto_rawDesc = string([]byte{
@@ -387,52 +673,200 @@ var file_formicary_v1_services_trigger_service_proto_rawDesc = string([]byte{
 	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x20, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69,
 	0x66, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x65,
 	0x64, 0x75, 0x70, 0x65, 0x64, 0x2e, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x32, 0x85, 0x05, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x66, 0x6f, 0x72,
+	0x64, 0x22, 0x96, 0x04, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x2e, 0x32, 0x2c, 0x54, 0x68, 0x65, 0x20,
+	0x6a, 0x6f, 0x62, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x73, 0x65, 0x20, 0x64,
+	0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x2e, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
+	0x92, 0x41, 0x2f, 0x32, 0x2d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x6d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57,
+	0x92, 0x41, 0x30, 0x32, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x3a, 0x20, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x2c, 0x20, 0x52, 0x45, 0x50,
+	0x4c, 0x41, 0x59, 0x45, 0x44, 0x20, 0x6f, 0x72, 0x20, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44,
+	0x45, 0x44, 0x2e, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x52, 0x00, 0x52, 0x06, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x52, 0x08, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x52, 0x09, 0x44, 0x49,
+	0x53, 0x43, 0x41, 0x52, 0x44, 0x45, 0x44, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x64,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x4e, 0x92,
+	0x41, 0x41, 0x32, 0x3f, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x20, 0x64, 0x65, 0x61,
+	0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x20, 0x28, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x20, 0x31, 0x30, 0x30,
+	0x30, 0x29, 0x2e, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x3a, 0x74, 0x92, 0x41, 0x71, 0x0a, 0x6f, 0x2a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x43, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x20, 0x61, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0xd2,
+	0x01, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6a, 0x0a, 0x1e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c,
+	0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x71, 0x75, 0x65, 0x65, 0x6e, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0xbf, 0x04, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x23, 0x32, 0x21, 0x54, 0x68, 0x65,
+	0x20, 0x6a, 0x6f, 0x62, 0x20, 0x74, 0x79, 0x70, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x2e, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x70,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x5e, 0x92, 0x41, 0x52,
+	0x32, 0x50, 0x44, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x20, 0x69, 0x64,
+	0x73, 0x3b, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x64, 0x65,
+	0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x69, 0x73, 0x20, 0x73, 0x65,
+	0x74, 0x2e, 0xba, 0x48, 0x06, 0x92, 0x01, 0x03, 0x10, 0xe8, 0x07, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x68, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x40, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20,
+	0x61, 0x72, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x2e, 0x52, 0x0b, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x69, 0x0a, 0x03, 0x61, 0x6c,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x57, 0x92, 0x41, 0x54, 0x32, 0x52, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20,
+	0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x3b, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x69, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x52, 0x03, 0x61, 0x6c, 0x6c, 0x3a, 0x90, 0x01, 0x92, 0x41, 0x8c, 0x01, 0x0a, 0x89, 0x01, 0x2a,
+	0x19, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x20, 0x6f, 0x72,
+	0x20, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x73, 0x2c, 0x20, 0x6f, 0x72,
+	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x64, 0x65, 0x61, 0x64,
+	0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x6a, 0x6f,
+	0x62, 0x20, 0x6f, 0x72, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0xd2, 0x01, 0x08,
+	0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x20, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x49, 0x44, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20,
+	0x62, 0x79, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x20, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x98, 0x01, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x3b, 0x92, 0x41,
+	0x38, 0x32, 0x36, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x61,
+	0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x2c, 0x20, 0x6b, 0x65, 0x79,
+	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x2e, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x69, 0x0a, 0x21,
+	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x20, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x2e, 0x52, 0x09, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x32, 0xfd, 0x09, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d,
+	0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43,
+	0x2a, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x66, 0x6f, 0x72,
 	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x6f,
-	0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a,
-	0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x66, 0x6f, 0x72, 0x6d,
-	0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x2a, 0x41, 0x2f, 0x61, 0x70, 0x69,
+	0x65, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66,
+	0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f,
 	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0xc6, 0x01,
-	0x0a, 0x12, 0x46, 0x69, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x72,
-	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46,
-	0x69, 0x72, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f,
-	0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x1a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x08, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x42, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x20, 0x61,
-	0x6e, 0x64, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x20, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x42, 0x40, 0x5a, 0x3e, 0x70, 0x6c, 0x65,
-	0x78, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d,
-	0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72,
-	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x72, 0x65, 0x12, 0xc8, 0x01, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0xd5, 0x01, 0x0a,
+	0x19, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x66, 0x6f, 0x72,
+	0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x66,
+	0x6f, 0x72, 0x6d, 0x69, 0x63, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x3a, 0x01,
+	0x2a, 0x22, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x2f,
+	0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x1a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x73, 0x12, 0x42, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2d, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x42, 0x40, 0x5a, 0x3e, 0x70, 0x6c, 0x65, 0x78, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69, 0x63,
+	0x61, 0x72, 0x79, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x69,
+	0x63, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -447,31 +881,46 @@ func file_formicary_v1_services_trigger_service_proto_rawDescGZIP() []byte {
 	return file_formicary_v1_services_trigger_service_proto_rawDescData
 }
 
-var file_formicary_v1_services_trigger_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_formicary_v1_services_trigger_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_formicary_v1_services_trigger_service_proto_goTypes = []any{
-	(*ListTriggerStatesRequest)(nil),   // 0: formicary.v1.services.ListTriggerStatesRequest
-	(*ListTriggerStatesResponse)(nil),  // 1: formicary.v1.services.ListTriggerStatesResponse
-	(*ResetTriggerStateRequest)(nil),   // 2: formicary.v1.services.ResetTriggerStateRequest
-	(*FireWebhookTriggerRequest)(nil),  // 3: formicary.v1.services.FireWebhookTriggerRequest
-	(*FireWebhookTriggerResponse)(nil), // 4: formicary.v1.services.FireWebhookTriggerResponse
-	nil,                                // 5: formicary.v1.services.FireWebhookTriggerRequest.HeadersEntry
-	(*queen.TriggerState)(nil),         // 6: formicary.v1.queen.TriggerState
-	(*emptypb.Empty)(nil),              // 7: google.protobuf.Empty
+	(*ListTriggerStatesRequest)(nil),          // 0: formicary.v1.services.ListTriggerStatesRequest
+	(*ListTriggerStatesResponse)(nil),         // 1: formicary.v1.services.ListTriggerStatesResponse
+	(*ResetTriggerStateRequest)(nil),          // 2: formicary.v1.services.ResetTriggerStateRequest
+	(*FireWebhookTriggerRequest)(nil),         // 3: formicary.v1.services.FireWebhookTriggerRequest
+	(*FireWebhookTriggerResponse)(nil),        // 4: formicary.v1.services.FireWebhookTriggerResponse
+	(*ListTriggerDeadLettersRequest)(nil),     // 5: formicary.v1.services.ListTriggerDeadLettersRequest
+	(*ListTriggerDeadLettersResponse)(nil),    // 6: formicary.v1.services.ListTriggerDeadLettersResponse
+	(*TriggerDeadLettersRequest)(nil),         // 7: formicary.v1.services.TriggerDeadLettersRequest
+	(*ReplayTriggerDeadLettersResponse)(nil),  // 8: formicary.v1.services.ReplayTriggerDeadLettersResponse
+	(*DiscardTriggerDeadLettersResponse)(nil), // 9: formicary.v1.services.DiscardTriggerDeadLettersResponse
+	nil,                             // 10: formicary.v1.services.FireWebhookTriggerRequest.HeadersEntry
+	nil,                             // 11: formicary.v1.services.ReplayTriggerDeadLettersResponse.FailedEntry
+	(*queen.TriggerState)(nil),      // 12: formicary.v1.queen.TriggerState
+	(*queen.TriggerDeadLetter)(nil), // 13: formicary.v1.queen.TriggerDeadLetter
+	(*emptypb.Empty)(nil),           // 14: google.protobuf.Empty
 }
 var file_formicary_v1_services_trigger_service_proto_depIdxs = []int32{
-	6, // 0: formicary.v1.services.ListTriggerStatesResponse.states:type_name -> formicary.v1.queen.TriggerState
-	5, // 1: formicary.v1.services.FireWebhookTriggerRequest.headers:type_name -> formicary.v1.services.FireWebhookTriggerRequest.HeadersEntry
-	0, // 2: formicary.v1.services.TriggerService.ListTriggerStates:input_type -> formicary.v1.services.ListTriggerStatesRequest
-	2, // 3: formicary.v1.services.TriggerService.ResetTriggerState:input_type -> formicary.v1.services.ResetTriggerStateRequest
-	3, // 4: formicary.v1.services.TriggerService.FireWebhookTrigger:input_type -> formicary.v1.services.FireWebhookTriggerRequest
-	1, // 5: formicary.v1.services.TriggerService.ListTriggerStates:output_type -> formicary.v1.services.ListTriggerStatesResponse
-	7, // 6: formicary.v1.services.TriggerService.ResetTriggerState:output_type -> google.protobuf.Empty
-	4, // 7: formicary.v1.services.TriggerService.FireWebhookTrigger:output_type -> formicary.v1.services.FireWebhookTriggerResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: formicary.v1.services.ListTriggerStatesResponse.states:type_name -> formicary.v1.queen.TriggerState
+	10, // 1: formicary.v1.services.FireWebhookTriggerRequest.headers:type_name -> formicary.v1.services.FireWebhookTriggerRequest.HeadersEntry
+	13, // 2: formicary.v1.services.ListTriggerDeadLettersResponse.dead_letters:type_name -> formicary.v1.queen.TriggerDeadLetter
+	11, // 3: formicary.v1.services.ReplayTriggerDeadLettersResponse.failed:type_name -> formicary.v1.services.ReplayTriggerDeadLettersResponse.FailedEntry
+	0,  // 4: formicary.v1.services.TriggerService.ListTriggerStates:input_type -> formicary.v1.services.ListTriggerStatesRequest
+	2,  // 5: formicary.v1.services.TriggerService.ResetTriggerState:input_type -> formicary.v1.services.ResetTriggerStateRequest
+	3,  // 6: formicary.v1.services.TriggerService.FireWebhookTrigger:input_type -> formicary.v1.services.FireWebhookTriggerRequest
+	5,  // 7: formicary.v1.services.TriggerService.ListTriggerDeadLetters:input_type -> formicary.v1.services.ListTriggerDeadLettersRequest
+	7,  // 8: formicary.v1.services.TriggerService.ReplayTriggerDeadLetters:input_type -> formicary.v1.services.TriggerDeadLettersRequest
+	7,  // 9: formicary.v1.services.TriggerService.DiscardTriggerDeadLetters:input_type -> formicary.v1.services.TriggerDeadLettersRequest
+	1,  // 10: formicary.v1.services.TriggerService.ListTriggerStates:output_type -> formicary.v1.services.ListTriggerStatesResponse
+	14, // 11: formicary.v1.services.TriggerService.ResetTriggerState:output_type -> google.protobuf.Empty
+	4,  // 12: formicary.v1.services.TriggerService.FireWebhookTrigger:output_type -> formicary.v1.services.FireWebhookTriggerResponse
+	6,  // 13: formicary.v1.services.TriggerService.ListTriggerDeadLetters:output_type -> formicary.v1.services.ListTriggerDeadLettersResponse
+	8,  // 14: formicary.v1.services.TriggerService.ReplayTriggerDeadLetters:output_type -> formicary.v1.services.ReplayTriggerDeadLettersResponse
+	9,  // 15: formicary.v1.services.TriggerService.DiscardTriggerDeadLetters:output_type -> formicary.v1.services.DiscardTriggerDeadLettersResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_formicary_v1_services_trigger_service_proto_init() }
@@ -485,7 +934,7 @@ func file_formicary_v1_services_trigger_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_formicary_v1_services_trigger_service_proto_rawDesc), len(file_formicary_v1_services_trigger_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,9 +22,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TriggerService_ListTriggerStates_FullMethodName  = "/formicary.v1.services.TriggerService/ListTriggerStates"
-	TriggerService_ResetTriggerState_FullMethodName  = "/formicary.v1.services.TriggerService/ResetTriggerState"
-	TriggerService_FireWebhookTrigger_FullMethodName = "/formicary.v1.services.TriggerService/FireWebhookTrigger"
+	TriggerService_ListTriggerStates_FullMethodName         = "/formicary.v1.services.TriggerService/ListTriggerStates"
+	TriggerService_ResetTriggerState_FullMethodName         = "/formicary.v1.services.TriggerService/ResetTriggerState"
+	TriggerService_FireWebhookTrigger_FullMethodName        = "/formicary.v1.services.TriggerService/FireWebhookTrigger"
+	TriggerService_ListTriggerDeadLetters_FullMethodName    = "/formicary.v1.services.TriggerService/ListTriggerDeadLetters"
+	TriggerService_ReplayTriggerDeadLetters_FullMethodName  = "/formicary.v1.services.TriggerService/ReplayTriggerDeadLetters"
+	TriggerService_DiscardTriggerDeadLetters_FullMethodName = "/formicary.v1.services.TriggerService/DiscardTriggerDeadLetters"
 )
 
 // TriggerServiceClient is the client API for TriggerService service.
@@ -44,6 +47,14 @@ type TriggerServiceClient interface {
 	// be authenticated via gRPC/JWT; trigger-level HTTP auth (HMAC, bearer) is
 	// bypassed. Useful for testing pipelines and CI integrations.
 	FireWebhookTrigger(ctx context.Context, in *FireWebhookTriggerRequest, opts ...grpc.CallOption) (*FireWebhookTriggerResponse, error)
+	// ListTriggerDeadLetters returns newest queue and S3 notification events of a job
+	// definition that failed to submit a job request.
+	ListTriggerDeadLetters(ctx context.Context, in *ListTriggerDeadLettersRequest, opts ...grpc.CallOption) (*ListTriggerDeadLettersResponse, error)
+	// ReplayTriggerDeadLetters evaluates failed events again with the current trigger
+	// definition and submits their job requests. Events that fail again stay in dead-letters.
+	ReplayTriggerDeadLetters(ctx context.Context, in *TriggerDeadLettersRequest, opts ...grpc.CallOption) (*ReplayTriggerDeadLettersResponse, error)
+	// DiscardTriggerDeadLetters marks failed events as discarded so that they are no longer replayed.
+	DiscardTriggerDeadLetters(ctx context.Context, in *TriggerDeadLettersRequest, opts ...grpc.CallOption) (*DiscardTriggerDeadLettersResponse, error)
 }
 
 type triggerServiceClient struct {
@@ -84,6 +95,36 @@ func (c *triggerServiceClient) FireWebhookTrigger(ctx context.Context, in *FireW
 	return out, nil
 }
 
+func (c *triggerServiceClient) ListTriggerDeadLetters(ctx context.Context, in *ListTriggerDeadLettersRequest, opts ...grpc.CallOption) (*ListTriggerDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTriggerDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TriggerService_ListTriggerDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerServiceClient) ReplayTriggerDeadLetters(ctx context.Context, in *TriggerDeadLettersRequest, opts ...grpc.CallOption) (*ReplayTriggerDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayTriggerDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TriggerService_ReplayTriggerDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerServiceClient) DiscardTriggerDeadLetters(ctx context.Context, in *TriggerDeadLettersRequest, opts ...grpc.CallOption) (*DiscardTriggerDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardTriggerDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TriggerService_DiscardTriggerDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TriggerServiceServer is the server API for TriggerService service.
 // All implementations should embed UnimplementedTriggerServiceServer
 // for forward compatibility.
@@ -101,6 +142,14 @@ type TriggerServiceServer interface {
 	// be authenticated via gRPC/JWT; trigger-level HTTP auth (HMAC, bearer) is
 	// bypassed. Useful for testing pipelines and CI integrations.
 	FireWebhookTrigger(context.Context, *FireWebhookTriggerRequest) (*FireWebhookTriggerResponse, error)
+	// ListTriggerDeadLetters returns newest queue and S3 notification events of a job
+	// definition that failed to submit a job request.
+	ListTriggerDeadLetters(context.Context, *ListTriggerDeadLettersRequest) (*ListTriggerDeadLettersResponse, error)
+	// ReplayTriggerDeadLetters evaluates failed events again with the current trigger
+	// definition and submits their job requests. Events that fail again stay in dead-letters.
+	ReplayTriggerDeadLetters(context.Context, *TriggerDeadLettersRequest) (*ReplayTriggerDeadLettersResponse, error)
+	// DiscardTriggerDeadLetters marks failed events as discarded so that they are no longer replayed.
+	DiscardTriggerDeadLetters(context.Context, *TriggerDeadLettersRequest) (*DiscardTriggerDeadLettersResponse, error)
 }
 
 // UnimplementedTriggerServiceServer should be embedded to have
@@ -119,6 +168,15 @@ func (UnimplementedTriggerServiceServer) ResetTriggerState(context.Context, *Res
 func (UnimplementedTriggerServiceServer) FireWebhookTrigger(context.Context, *FireWebhookTriggerRequest) (*FireWebhookTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FireWebhookTrigger not implemented")
 }
+func (UnimplementedTriggerServiceServer) ListTriggerDeadLetters(context.Context, *ListTriggerDeadLettersRequest) (*ListTriggerDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggerDeadLetters not implemented")
+}
+func (UnimplementedTriggerServiceServer) ReplayTriggerDeadLetters(context.Context, *TriggerDeadLettersRequest) (*ReplayTriggerDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayTriggerDeadLetters not implemented")
+}
+func (UnimplementedTriggerServiceServer) DiscardTriggerDeadLetters(context.Context, *TriggerDeadLettersRequest) (*DiscardTriggerDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardTriggerDeadLetters not implemented")
+}
 func (UnimplementedTriggerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTriggerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +251,60 @@ func _TriggerService_FireWebhookTrigger_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TriggerService_ListTriggerDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTriggerDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).ListTriggerDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_ListTriggerDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).ListTriggerDeadLetters(ctx, req.(*ListTriggerDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerService_ReplayTriggerDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).ReplayTriggerDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_ReplayTriggerDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).ReplayTriggerDeadLetters(ctx, req.(*TriggerDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerService_DiscardTriggerDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerServiceServer).DiscardTriggerDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerService_DiscardTriggerDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerServiceServer).DiscardTriggerDeadLetters(ctx, req.(*TriggerDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TriggerService_ServiceDesc is the grpc.ServiceDesc for TriggerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -212,6 +324,18 @@ var TriggerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FireWebhookTrigger",
 			Handler:    _TriggerService_FireWebhookTrigger_Handler,
 		},
+		{
+			MethodName: "ListTriggerDeadLetters",
+			Handler:    _TriggerService_ListTriggerDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayTriggerDeadLetters",
+			Handler:    _TriggerService_ReplayTriggerDeadLetters_Handler,
+		},
+		{
+			MethodName: "DiscardTriggerDeadLetters",
+			Handler:    _TriggerService_DiscardTriggerDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "formicary/v1/services/trigger_service.proto",
@@ -35,8 +35,13 @@ type AntConfig struct {
 	Common                 types.CommonConfig `yaml:"common" mapstructure:"common"`
 	Tags                   []string           `yaml:"tags" mapstructure:"tags"`
 	Methods                []types.TaskMethod `yaml:"methods" mapstructure:"methods"`
+	Zone                   string             `yaml:"zone" mapstructure:"zone"`
 	Docker                 DockerConfig       `yaml:"docker" mapstructure:"docker"`
 	Kubernetes             KubernetesConfig   `yaml:"kubernetes" mapstructure:"kubernetes"`
+	Podman                 PodmanConfig       `yaml:"podman" mapstructure:"podman"`
+	Containerd             ContainerdConfig   `yaml:"containerd" mapstructure:"containerd"`
+	Shell                  ShellConfig        `yaml:"shell" mapstructure:"shell"`
+	SSH                    SSHConfig          `yaml:"ssh" mapstructure:"ssh"`
 	DefaultShell           []string           `yaml:"default_shell" mapstructure:"default_shell"`
 	OutputLimit            int                `yaml:"output_limit" mapstructure:"output_limit"`
 	MaxCapacity            int                `yaml:"max_capacity" mapstructure:"max_capacity"`
@@ -44,6 +49,8 @@ type AntConfig struct {
 	AwaitRunningPeriod     time.Duration      `yaml:"await_running_period" json:"await_running_period" mapstructure:"await_running_period"`
 	PollInterval           int64              `yaml:"poll_interval" json:"poll_interval" mapstructure:"poll_interval"`
 	PollTimeout            int64              `yaml:"poll_timeout" json:"poll_timeout" mapstructure:"poll_timeout"`
+	// ResourceProfileInterval is interval of sampling resources used by tasks, negative value disables profiling
+	ResourceProfileInterval time.Duration `yaml:"resource_profile_interval" json:"resource_profile_interval" mapstructure:"resource_profile_interval"`
 
 	PollIntervalBeforeShutdown time.Duration `yaml:"poll_interval_before_shutdown" json:"poll_interval_before_shutdown" mapstructure:"poll_interval_before_shutdown"`
 	PollAttemptsBeforeShutdown int           `yaml:"poll_attempts_before_shutdown" json:"poll_attempts_before_shutdown" mapstructure:"poll_attempts_before_shutdown"`
@@ -105,6 +112,19 @@ func NewAntConfig(id string) (*AntConfig, error) {
 	viper.SetDefault("kubernetes.registry.username", "")
 	viper.SetDefault("kubernetes.registry.password", "")
 
+	// Podman Defaults
+	viper.SetDefault("podman.host", "")
+	viper.SetDefault("podman.registry.server", "index.docker.io")
+	viper.SetDefault("podman.registry.username", "")
+	viper.SetDefault("podman.registry.password", "")
+
+	// Containerd Defaults
+	viper.SetDefault("containerd.address", "")
+	viper.SetDefault("containerd.namespace", "formicary")
+	viper.SetDefault("containerd.registry.server", "index.docker.io")
+	viper.SetDefault("containerd.registry.username", "")
+	viper.SetDefault("containerd.registry.password", "")
+
 	viper.SetEnvPrefix("")
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
@@ -153,6 +173,18 @@ func (c *AntConfig) Validate() error {
 	if err := c.Kubernetes.Validate(); err != nil {
 		return err
 	}
+	if err := c.Podman.Validate(); err != nil {
+		return err
+	}
+	if err := c.Containerd.Validate(); err != nil {
+		return err
+	}
+	if err := c.Shell.Validate(); err != nil {
+		return err
+	}
+	if err := c.SSH.Validate(); err != nil {
+		return err
+	}
 	if c.MaxCapacity <= 0 {
 		c.MaxCapacity = 10
 	}
@@ -165,6 +197,9 @@ func (c *AntConfig) Validate() error {
 	if c.PollInterval <= 0 {
 		c.PollInterval = 3
 	}
+	if c.ResourceProfileInterval == 0 {
+		c.ResourceProfileInterval = 5 * time.Second
+	}
 	if c.Common.EncryptionKey == "" {
 		if b, err := crypto.GenerateKey(32); err == nil {
 			c.Common.EncryptionKey = string(b)
@@ -189,6 +224,7 @@ func (c *AntConfig) NewAntRegistration() *types.AntRegistration {
 		Tags:         c.Tags,
 		AutoRefresh:  true,
 		Methods:      c.Methods,
+		Zone:         c.Zone,
 		CreatedAt:    time.Now(),
 		AntStartedAt: c.antStartedAt,
 	}
@@ -0,0 +1,45 @@
+package ant_config
+
+import "plexobject.com/formicary/internal/types"
+
+// ContainerdConfig -- Default Containerd Config, containers are managed using nerdctl CLI
+type ContainerdConfig struct {
+	Registry    `yaml:"registry"`
+	Address     string               `yaml:"address" json:"address" mapstructure:"address" env:"CONTAINERD_ADDRESS"`
+	Namespace   string               `yaml:"namespace" json:"namespace" mapstructure:"namespace"`
+	Nerdctl     string               `yaml:"nerdctl" json:"nerdctl" mapstructure:"nerdctl"`
+	Snapshotter string               `yaml:"snapshotter" json:"snapshotter" mapstructure:"snapshotter"`
+	Network     string               `yaml:"network" json:"network" mapstructure:"network"`
+	Labels      map[string]string    `yaml:"labels" json:"labels" mapstructure:"labels"`
+	Environment types.EnvironmentMap `yaml:"environment" json:"environment" mapstructure:"environment"`
+	HelperImage string               `yaml:"helper_image" json:"helper_image" mapstructure:"helper_image"`
+}
+
+// Validate config
+func (cc *ContainerdConfig) Validate() error {
+	if cc.Registry.PullPolicy == "" {
+		cc.Registry.PullPolicy = types.PullPolicyIfNotPresent
+	}
+	if cc.HelperImage == "" {
+		cc.HelperImage = "amazon/aws-cli"
+	}
+	if cc.Namespace == "" {
+		cc.Namespace = "formicary"
+	}
+	if cc.Nerdctl == "" {
+		cc.Nerdctl = "nerdctl"
+	}
+	return nil
+}
+
+// GlobalArgs returns arguments passed to every nerdctl invocation
+func (cc *ContainerdConfig) GlobalArgs() []string {
+	args := []string{"--namespace", cc.Namespace}
+	if cc.Address != "" {
+		args = append(args, "--address", cc.Address)
+	}
+	if cc.Snapshotter != "" {
+		args = append(args, "--snapshotter", cc.Snapshotter)
+	}
+	return args
+}
@@ -73,6 +73,20 @@ func (c ResourceConfig) BuildResourceRequirements() api.ResourceRequirements {
 	return resources
 }
 
+// KubernetesImageBuilderConfig defines rootless builder container used by image_build tasks
+type KubernetesImageBuilderConfig struct {
+	// Type is kaniko or buildkit
+	Type  string `yaml:"type" json:"type" mapstructure:"type"`
+	Image string `yaml:"image" json:"image" mapstructure:"image"`
+	// PushSecret is dockerconfigjson secret used for pushing, defaults to first image pull secret
+	PushSecret string `yaml:"push_secret" json:"push_secret" mapstructure:"push_secret"`
+}
+
+// IsBuildkit returns true if rootless buildkit is used instead of kaniko
+func (c *KubernetesImageBuilderConfig) IsBuildkit() bool {
+	return c.Type == "buildkit"
+}
+
 // ClientHolder holds kubernetes clients with thread-safe access
 type ClientHolder struct {
 	clientMutex   sync.RWMutex
@@ -116,6 +130,13 @@ type KubernetesConfig struct {
 	MaxLimits          api.ResourceList `yaml:"max_limits" json:"max_limits"`
 	MaxServicesPerPod  int              `yaml:"max_services_per_pod" json:"max_services_per_pod"`
 	AwaitShutdownPod   bool             `yaml:"await_shutdown_pod" json:"await_shutdown_pod" mapstructure:"await_shutdown_pod"`
+
+	// UseJobs submits all tasks as batch/v1 Jobs, otherwise only tasks with kubernetes_job block
+	UseJobs bool                `yaml:"use_jobs" json:"use_jobs" mapstructure:"use_jobs"`
+	Job     types.KubernetesJob `yaml:"job" json:"job" mapstructure:"job"`
+
+	ImageBuilder KubernetesImageBuilderConfig `yaml:"image_builder" json:"image_builder" mapstructure:"image_builder"`
+
 	QPS                float32          `yaml:"qps" env:"K8S_QPS"` // Performance tuning
 	Burst              int              `yaml:"burst" env:"K8S_BURST"`
 	SelectedKubeconfig *rest.Config     `yaml:"-" json:"-"` // Internal fields
@@ -730,6 +751,26 @@ func (kc *KubernetesConfig) Validate() error {
 	if kc.MaxServicesPerPod <= 0 {
 		kc.MaxServicesPerPod = 100
 	}
+	if kc.Job.TTLSecondsAfterFinished == nil {
+		ttl := int32(300)
+		kc.Job.TTLSecondsAfterFinished = &ttl
+	}
+	if err := kc.Job.Validate(); err != nil {
+		return fmt.Errorf("invalid kubernetes job config due to %w", err)
+	}
+	if kc.ImageBuilder.Type == "" {
+		kc.ImageBuilder.Type = "kaniko"
+	}
+	if kc.ImageBuilder.Type != "kaniko" && kc.ImageBuilder.Type != "buildkit" {
+		return fmt.Errorf("unsupported image builder %s, use kaniko or buildkit", kc.ImageBuilder.Type)
+	}
+	if kc.ImageBuilder.Image == "" {
+		if kc.ImageBuilder.IsBuildkit() {
+			kc.ImageBuilder.Image = "moby/buildkit:rootless"
+		} else {
+			kc.ImageBuilder.Image = "gcr.io/kaniko-project/executor:debug"
+		}
+	}
 	if len(kc.HostAliases) == 0 {
 		kc.HostAliases = []types.KubernetesHostAliases{
 			{Hostnames: []string{"dns1", "dns2"}, IP: "8.8.8.8"},
@@ -0,0 +1,53 @@
+package ant_config
+
+import (
+	"fmt"
+	"os"
+	"plexobject.com/formicary/internal/types"
+)
+
+// PodmanConfig -- Default Podman Config, podman exposes a docker-compatible API over its socket
+type PodmanConfig struct {
+	Registry    `yaml:"registry"`
+	Host        string               `yaml:"host" json:"host" mapstructure:"host" env:"HOST"`
+	APIVersion  string               `yaml:"api_version" json:"api_version" mapstructure:"api_version"`
+	Labels      map[string]string    `yaml:"labels" json:"labels" mapstructure:"labels"`
+	Environment types.EnvironmentMap `yaml:"environment" json:"environment" mapstructure:"environment"`
+	HelperImage string               `yaml:"helper_image" json:"helper_image" mapstructure:"helper_image"`
+}
+
+// Validate config
+func (pc *PodmanConfig) Validate() error {
+	if pc.Registry.PullPolicy == "" {
+		pc.Registry.PullPolicy = types.PullPolicyIfNotPresent
+	}
+	if pc.HelperImage == "" {
+		pc.HelperImage = "amazon/aws-cli"
+	}
+	if pc.Host == "" {
+		pc.Host = DefaultPodmanHost()
+	}
+	if pc.APIVersion == "" {
+		pc.APIVersion = "1.40"
+	}
+	return nil
+}
+
+// ToDockerConfig returns docker config so that docker adapter can be reused against podman socket
+func (pc *PodmanConfig) ToDockerConfig() *DockerConfig {
+	return &DockerConfig{
+		Registry:    pc.Registry,
+		Host:        pc.Host,
+		Labels:      pc.Labels,
+		Environment: pc.Environment,
+		HelperImage: pc.HelperImage,
+	}
+}
+
+// DefaultPodmanHost returns socket of rootless podman if XDG_RUNTIME_DIR is set, otherwise rootful socket
+func DefaultPodmanHost() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" && os.Getuid() != 0 {
+		return fmt.Sprintf("unix://%s/podman/podman.sock", dir)
+	}
+	return "unix:///run/podman/podman.sock"
+}
@@ -0,0 +1,30 @@
+package ant_config
+
+// ShellConfig -- Default Shell Config
+type ShellConfig struct {
+	Sandbox ShellSandboxConfig `yaml:"sandbox" json:"sandbox" mapstructure:"sandbox"`
+}
+
+// ShellSandboxConfig defines isolation of shell tasks using cgroup v2 and namespaces (linux only)
+type ShellSandboxConfig struct {
+	// Enabled runs all shell tasks in sandbox, otherwise only tasks that set `sandbox: true` are isolated
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	// CgroupRoot is the cgroup v2 directory under which a slice is created for each task
+	CgroupRoot string `yaml:"cgroup_root" json:"cgroup_root" mapstructure:"cgroup_root"`
+	// TempDir is the parent of private working directories created for each task
+	TempDir string `yaml:"temp_dir" json:"temp_dir" mapstructure:"temp_dir"`
+	// IsolateNetwork runs task in its own network namespace without any interfaces besides loopback
+	IsolateNetwork bool `yaml:"isolate_network" json:"isolate_network" mapstructure:"isolate_network"`
+	// DefaultCPULimit is used when task doesn't define cpu_limit, e.g. 500m or 2
+	DefaultCPULimit string `yaml:"default_cpu_limit" json:"default_cpu_limit" mapstructure:"default_cpu_limit"`
+	// DefaultMemoryLimit is used when task doesn't define memory_limit, e.g. 512Mi
+	DefaultMemoryLimit string `yaml:"default_memory_limit" json:"default_memory_limit" mapstructure:"default_memory_limit"`
+}
+
+// Validate config
+func (sc *ShellConfig) Validate() error {
+	if sc.Sandbox.CgroupRoot == "" {
+		sc.Sandbox.CgroupRoot = "/sys/fs/cgroup/formicary.slice"
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package ant_config
+
+import "time"
+
+// SSHConfig -- Defines defaults for SSH executor that runs tasks on remote servers
+type SSHConfig struct {
+	// KnownHostsFile is used to verify servers when task doesn't specify host_key
+	KnownHostsFile    string        `yaml:"known_hosts_file" json:"known_hosts_file" mapstructure:"known_hosts_file"`
+	ConnectTimeout    time.Duration `yaml:"connect_timeout" json:"connect_timeout" mapstructure:"connect_timeout"`
+	KeepAliveInterval time.Duration `yaml:"keep_alive_interval" json:"keep_alive_interval" mapstructure:"keep_alive_interval"`
+	// TempDir on remote server for runtime files such as pid of running commands and working directories of
+	// tasks that don't define working directory
+	TempDir string `yaml:"temp_dir" json:"temp_dir" mapstructure:"temp_dir"`
+}
+
+// Validate config
+func (sc *SSHConfig) Validate() error {
+	if sc.ConnectTimeout <= 0 {
+		sc.ConnectTimeout = 30 * time.Second
+	}
+	if sc.KeepAliveInterval <= 0 {
+		sc.KeepAliveInterval = 30 * time.Second
+	}
+	if sc.TempDir == "" {
+		sc.TempDir = "/tmp"
+	}
+	return nil
+}
@@ -24,12 +24,12 @@ func ZipFiles(newZipFile *os.File, files []string) (err error) {
 				return fmt.Errorf("invalid glob pattern %q: %w", pattern, globErr)
 			}
 			for _, file := range matches {
-				if err = addFileToZip(zipWriter, file); err != nil {
+				if err = addFileToZip(zipWriter, file, file); err != nil {
 					return err
 				}
 			}
 		} else {
-			if err = addFileToZip(zipWriter, pattern); err != nil {
+			if err = addFileToZip(zipWriter, pattern, pattern); err != nil {
 				return err
 			}
 		}
@@ -37,6 +37,21 @@ func ZipFiles(newZipFile *os.File, files []string) (err error) {
 	return nil
 }
 
+// ZipFilesRelative zips files under base directory using their relative paths as entry names
+func ZipFilesRelative(newZipFile *os.File, baseDir string, files []string) (err error) {
+	zipWriter := zip.NewWriter(newZipFile)
+	defer func() {
+		_ = zipWriter.Close()
+	}()
+
+	for _, file := range files {
+		if err = addFileToZip(zipWriter, filepath.Join(baseDir, file), filepath.ToSlash(file)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isGlob(pattern string) bool {
 	for _, ch := range pattern {
 		if ch == '*' || ch == '?' || ch == '[' {
@@ -94,7 +109,7 @@ func extractFile(f *zip.File, destDir string) error {
 	return nil
 }
 
-func addFileToZip(zipWriter *zip.Writer, filename string) error {
+func addFileToZip(zipWriter *zip.Writer, filename string, name string) error {
 	fileToZip, err := os.Open(filename)
 	if err != nil {
 		return err
@@ -111,7 +126,7 @@ func addFileToZip(zipWriter *zip.Writer, filename string) error {
 		return err
 	}
 
-	header.Name = filename
+	header.Name = name
 	header.Method = zip.Deflate
 	writer, err := zipWriter.CreateHeader(header)
 	if err != nil {
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"plexobject.com/formicary/internal/types"
+)
+
+// SLABreachEvent is sent when a job request or task breaches the SLA of its job definition
+type SLABreachEvent struct {
+	BaseEvent
+	UserID         string `json:"user_id"`
+	OrganizationID string `json:"organization_id"`
+	// JobRequestID defines key for job request
+	JobRequestID string `json:"job_request_id"`
+	// JobType defines type of job
+	JobType string `json:"job_type"`
+	// TaskType is empty for SLA of the job
+	TaskType string `json:"task_type"`
+	// Kind is QUEUE_WAIT, RUN_TIME or FINISH_BY
+	Kind string `json:"kind"`
+	// Expected is max duration or finish_by time of the SLA
+	Expected string `json:"expected"`
+	// Deadline is when the SLA was breached
+	Deadline time.Time `json:"deadline"`
+	// JobState defines state of job when the breach was detected
+	JobState types.RequestState `json:"job_state"`
+}
+
+// NewSLABreachEvent constructor
+func NewSLABreachEvent(
+	source string,
+	userID string,
+	organizationID string,
+	requestID string,
+	jobType string,
+	taskType string,
+	kind string,
+	expected string,
+	deadline time.Time,
+	jobState types.RequestState) *SLABreachEvent {
+	return &SLABreachEvent{
+		BaseEvent: BaseEvent{
+			ID:        ulid.Make().String(),
+			Source:    source,
+			EventType: "SLABreachEvent",
+			CreatedAt: time.Now(),
+		},
+		UserID:         userID,
+		OrganizationID: organizationID,
+		JobRequestID:   requestID,
+		JobType:        jobType,
+		TaskType:       taskType,
+		Kind:           kind,
+		Expected:       expected,
+		Deadline:       deadline,
+		JobState:       jobState,
+	}
+}
+
+// String format
+func (e *SLABreachEvent) String() string {
+	return fmt.Sprintf("JobRequestID=%s JobType=%s TaskType=%s Kind=%s Expected=%s",
+		e.JobRequestID, e.JobType, e.TaskType, e.Kind, e.Expected)
+}
+
+// UnmarshalSLABreachEvent unmarshal
+func UnmarshalSLABreachEvent(b []byte) (*SLABreachEvent, error) {
+	var event SLABreachEvent
+	if err := json.Unmarshal(b, &event); err != nil {
+		return nil, err
+	}
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Validate validates event for SLA breach
+func (e *SLABreachEvent) Validate() error {
+	if e.JobRequestID == "" {
+		return fmt.Errorf("requestID is not specified")
+	}
+	if e.JobType == "" {
+		return fmt.Errorf("jobType is not specified")
+	}
+	if e.Kind == "" {
+		return fmt.Errorf("kind is not specified")
+	}
+	return nil
+}
+
+// Marshal serializes event
+func (e *SLABreachEvent) Marshal() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(e)
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/internal/types"
+)
+
+func newTestSLABreachEvent() *SLABreachEvent {
+	return NewSLABreachEvent(
+		"source",
+		"userID",
+		"orgID",
+		ulid.Make().String(),
+		"jobType",
+		"",
+		"FINISH_BY",
+		"06:00",
+		time.Now(),
+		types.EXECUTING,
+	)
+}
+
+func Test_ShouldCreateSLABreachEvent(t *testing.T) {
+	// Given sla breach event
+	e := newTestSLABreachEvent()
+
+	// WHEN accessing properties
+	// THEN it should return saved value
+	require.NotEqual(t, "", e.String())
+	require.NoError(t, e.Validate())
+}
+
+func Test_ShouldMarshalSLABreachEvent(t *testing.T) {
+	// Given sla breach event
+	e := newTestSLABreachEvent()
+
+	// WHEN marshaling event
+	// THEN it should return serialized bytes
+	b, err := e.Marshal()
+	require.NoError(t, err)
+	copy, err := UnmarshalSLABreachEvent(b)
+	require.NoError(t, err)
+	require.Equal(t, e.String(), copy.String())
+}
@@ -3,21 +3,31 @@ package metrics
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"reflect"
 	"sync"
 )
 
+var (
+	// DurationBuckets are histogram buckets in seconds from 100ms to about 7 hours
+	DurationBuckets = prometheus.ExponentialBuckets(0.1, 3, 12)
+	// SizeBuckets are histogram buckets in bytes from 1KiB to 16GiB
+	SizeBuckets = prometheus.ExponentialBuckets(1024, 4, 13)
+)
+
 // Registry keeps track of metrics
 type Registry struct {
-	counters map[string]*prometheus.CounterVec
-	gauges   map[string]*prometheus.GaugeVec
-	lock     sync.RWMutex
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+	lock       sync.RWMutex
 }
 
 // New metrics constructor
 func New() *Registry {
 	return &Registry{
-		counters: make(map[string]*prometheus.CounterVec),
-		gauges:   make(map[string]*prometheus.GaugeVec),
+		counters:   make(map[string]*prometheus.CounterVec),
+		gauges:     make(map[string]*prometheus.GaugeVec),
+		histograms: make(map[string]*prometheus.HistogramVec),
 	}
 }
 
@@ -27,37 +37,22 @@ func (r *Registry) Incr(id string, opts map[string]string) {
 	defer r.lock.Unlock()
 	counter := r.counters[id]
 	if counter == nil {
-		keys := make([]string, len(opts))
-		i := 0
-		for k := range opts {
-			keys[i] = k
-			i++
-		}
 		counter = prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: id,
 			},
-			keys,
+			labelNames(opts),
 		)
-		if err := prometheus.Register(counter); err != nil {
-			logrus.WithFields(logrus.Fields{
-				"Component": "MetricsRegistry",
-				"ID":        id,
-				"Opts":      opts,
-				"Error":     err,
-			}).
-				Error("failed to register counter")
+		registered := register(id, counter, opts)
+		if registered == nil {
 			return
 		}
+		counter = registered.(*prometheus.CounterVec)
 		r.counters[id] = counter
 	}
-	labels := make([]string, len(opts))
-	i := 0
-	for _, v := range opts {
-		labels[i] = v
-		i++
+	if c, err := counter.GetMetricWith(opts); err == nil {
+		c.Inc()
 	}
-	counter.WithLabelValues(labels...).Inc()
 }
 
 // Set gauge
@@ -66,26 +61,98 @@ func (r *Registry) Set(id string, val float64, opts map[string]string) {
 	defer r.lock.Unlock()
 	gauge := r.gauges[id]
 	if gauge == nil {
-		keys := make([]string, len(opts))
-		i := 0
-		for k := range opts {
-			keys[i] = k
-			i++
-		}
 		gauge = prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: id,
 			},
-			keys,
+			labelNames(opts),
 		)
-		prometheus.MustRegister(gauge)
+		gauge = mustRegister(gauge).(*prometheus.GaugeVec)
 		r.gauges[id] = gauge
 	}
-	labels := make([]string, len(opts))
-	i := 0
-	for _, v := range opts {
-		labels[i] = v
-		i++
+	if g, err := gauge.GetMetricWith(opts); err == nil {
+		g.Set(val)
+	}
+}
+
+// Observe adds value to histogram, buckets are only used when the histogram is first observed and
+// default to prometheus.DefBuckets
+func (r *Registry) Observe(id string, val float64, buckets []float64, opts map[string]string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	histogram := r.histograms[id]
+	if histogram == nil {
+		histogram = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    id,
+				Buckets: buckets,
+			},
+			labelNames(opts),
+		)
+		registered := register(id, histogram, opts)
+		if registered == nil {
+			return
+		}
+		histogram = registered.(*prometheus.HistogramVec)
+		r.histograms[id] = histogram
+	}
+	if h, err := histogram.GetMetricWith(opts); err == nil {
+		h.Observe(val)
+	}
+}
+
+// Delete removes gauge with given labels, e.g. when the ant it tracks is gone
+func (r *Registry) Delete(id string, opts map[string]string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if gauge := r.gauges[id]; gauge != nil {
+		return gauge.Delete(opts)
+	}
+	return false
+}
+
+// register registers the collector or returns the collector registered under same name by
+// another registry, and nil if it cannot be registered.
+func register(id string, collector prometheus.Collector, opts map[string]string) prometheus.Collector {
+	registered, err := registerOrShare(collector)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "MetricsRegistry",
+			"ID":        id,
+			"Opts":      opts,
+			"Error":     err,
+		}).
+			Error("failed to register metrics")
+	}
+	return registered
+}
+
+// mustRegister registers the collector or returns the collector registered under same name by
+// another registry, and panics like prometheus.MustRegister if it cannot be registered.
+func mustRegister(collector prometheus.Collector) prometheus.Collector {
+	registered, err := registerOrShare(collector)
+	if err != nil {
+		panic(err)
+	}
+	return registered
+}
+
+func registerOrShare(collector prometheus.Collector) (prometheus.Collector, error) {
+	err := prometheus.Register(collector)
+	if err == nil {
+		return collector, nil
+	}
+	if are, ok := err.(prometheus.AlreadyRegisteredError); ok &&
+		reflect.TypeOf(are.ExistingCollector) == reflect.TypeOf(collector) {
+		return are.ExistingCollector, nil
+	}
+	return nil, err
+}
+
+func labelNames(opts map[string]string) []string {
+	keys := make([]string, 0, len(opts))
+	for k := range opts {
+		keys = append(keys, k)
 	}
-	gauge.WithLabelValues(labels...).Set(val)
+	return keys
 }
@@ -1,7 +1,55 @@
 package metrics
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
 
 func Test_ShouldCreateMetricsRegistry(t *testing.T) {
 
 }
+
+func Test_ShouldObserveHistogram(t *testing.T) {
+	// GIVEN metrics registry
+	registry := New()
+	// WHEN observing values with labels
+	registry.Observe("test_histogram_secs", 0.5, DurationBuckets, map[string]string{"Job": "a", "Task": "t1"})
+	registry.Observe("test_histogram_secs", 30, DurationBuckets, map[string]string{"Task": "t1", "Job": "a"})
+	registry.Observe("test_histogram_secs", 3, DurationBuckets, map[string]string{"Job": "b", "Task": "t1"})
+	// THEN histogram should be created per labels
+	require.Equal(t, 2, testutil.CollectAndCount(registry.histograms["test_histogram_secs"]))
+
+	// WHEN another registry observes the same histogram
+	other := New()
+	other.Observe("test_histogram_secs", 1, DurationBuckets, map[string]string{"Job": "c", "Task": "t1"})
+	// THEN it should share the registered histogram
+	require.Equal(t, 3, testutil.CollectAndCount(registry.histograms["test_histogram_secs"]))
+}
+
+func Test_ShouldSetAndDeleteGauges(t *testing.T) {
+	// GIVEN metrics registry
+	registry := New()
+	// WHEN adding gauges
+	registry.Set("test_gauge", 2, map[string]string{"Ant": "a"})
+	registry.Set("test_gauge", 3, map[string]string{"Ant": "b"})
+	// THEN values should be recorded
+	require.Equal(t, float64(3), testutil.ToFloat64(registry.gauges["test_gauge"].WithLabelValues("b")))
+
+	// WHEN deleting gauge of an ant
+	require.True(t, registry.Delete("test_gauge", map[string]string{"Ant": "a"}))
+	// THEN other gauges should be kept
+	require.Equal(t, 1, testutil.CollectAndCount(registry.gauges["test_gauge"]))
+}
+
+func Test_ShouldPanicWhenGaugeIsRegisteredWithOtherLabels(t *testing.T) {
+	// GIVEN metrics registry with a gauge
+	registry := New()
+	registry.Set("test_conflicting_gauge", 1, map[string]string{"Ant": "a"})
+	// WHEN another registry sets the gauge with other labels
+	// THEN it should panic instead of hiding the duplicate registration
+	require.Panics(t, func() {
+		New().Set("test_conflicting_gauge", 1, map[string]string{"Job": "a"})
+	})
+}
@@ -7,9 +7,13 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm/utils"
 	"os"
 	"path"
+	"plexobject.com/formicary/internal/tracing"
 	"plexobject.com/formicary/internal/types"
 	"runtime"
 	"strconv"
@@ -128,6 +132,53 @@ func TestBasicPublishSubscribe(t *testing.T) {
 	}
 }
 
+// TestTraceContextPropagation tests that span context of sender is propagated through message headers
+func TestTraceContextPropagation(t *testing.T) {
+	for _, provider := range getTestProviders() {
+		t.Run(string(provider), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+			client, _, err := createTestClient(ctx, provider)
+			require.NoError(t, err)
+			defer client.Close()
+
+			topic := createTestTopic(t, client, "trace")
+
+			var received trace.SpanContext
+			var wg sync.WaitGroup
+			wg.Add(1)
+
+			callback := func(ctx context.Context, event *MessageEvent, ack, nack AckHandler) error {
+				received = trace.SpanContextFromContext(
+					tracing.ExtractContext(ctx, map[string]string(event.Properties)))
+				ack()
+				wg.Done()
+				return nil
+			}
+
+			_, cleanup := createSubscription(t, ctx, client, SubscribeOptions{
+				Topic:    topic,
+				Callback: callback,
+			})
+			defer cleanup()
+
+			// GIVEN headers with span context of a job execution
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+			sent := tracing.ExecutionSpanContext(ulid.Make().String())
+			props := make(MessageHeaders)
+			tracing.InjectContext(trace.ContextWithSpanContext(context.Background(), sent), props)
+
+			// WHEN publishing message
+			publishTestMessage(t, client, topic, TestMessage{ID: ulid.Make().String()}, props)
+
+			// THEN subscriber should receive span context of the job execution
+			waitWithTimeout(t, &wg, ctx, "waiting for message")
+			require.Equal(t, sent.TraceID(), received.TraceID())
+			require.Equal(t, sent.SpanID(), received.SpanID())
+		})
+	}
+}
+
 // TestSendReceive tests request-response pattern
 func TestSendReceive(t *testing.T) {
 	for _, provider := range getTestProviders() {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"plexobject.com/formicary/internal/tracing"
 	cutils "plexobject.com/formicary/internal/utils"
+	"sync"
 	"time"
 
 	evbus "github.com/asaskevich/EventBus"
@@ -50,6 +51,10 @@ type BaseTasklet struct {
 	reqSubscriptionID           string
 	jobLifecycleSubscriptionID  string
 	taskLifecycleSubscriptionID string
+	// OnDrained is invoked after the tasklet is drained so that the ant can exit
+	OnDrained func()
+	draining  bool
+	lock      sync.Mutex
 }
 
 // NewBaseTasklet constructor
@@ -128,6 +133,60 @@ func (t *BaseTasklet) Stop(
 	return cutils.ErrorsAny(err1, err2, err3)
 }
 
+// Drain marks registration as draining so that servers stop sending new work to the tasklet and then
+// waits for current requests to finish before invoking OnDrained. The requests still running after the
+// timeout are cancelled.
+func (t *BaseTasklet) Drain(timeout time.Duration) {
+	t.lock.Lock()
+	if t.draining {
+		t.lock.Unlock()
+		return
+	}
+	t.draining = true
+	t.registration.Draining = true
+	t.lock.Unlock()
+
+	// let servers know right away instead of waiting for the next heartbeat
+	if err := t.sendRegisterAntRequest(context.Background()); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "BaseTasklet",
+			"Tasklet":   t.ID,
+			"Error":     err,
+		}).Warn("failed to send registration for draining")
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component":          "BaseTasklet",
+		"Tasklet":            t.ID,
+		"Timeout":            timeout,
+		"InProgressRequests": t.RequestRegistry.Count(),
+	}).Warn("draining, waiting for requests to finish...")
+
+	go func() {
+		deadline := time.Now().Add(timeout)
+		for t.RequestRegistry.Count() > 0 && time.Now().Before(deadline) {
+			time.Sleep(time.Second)
+		}
+		// cancel requests that didn't finish in time, a job may have multiple tasks on the same ant
+		for remaining := t.RequestRegistry.Count(); remaining > 0; {
+			for requestID := range t.RequestRegistry.GetAllocations() {
+				_ = t.RequestRegistry.CancelJob(requestID)
+			}
+			next := t.RequestRegistry.Count()
+			if next >= remaining {
+				break
+			}
+			remaining = next
+		}
+		logrus.WithFields(logrus.Fields{
+			"Component": "BaseTasklet",
+			"Tasklet":   t.ID,
+		}).Warn("drained, finished waiting for requests")
+		if t.OnDrained != nil {
+			t.OnDrained()
+		}
+	}()
+}
+
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
 func (t *BaseTasklet) handleRequest(
 	ctx context.Context,
@@ -283,6 +342,11 @@ func (t *BaseTasklet) handleRequest(
 			}
 			err = t.sendResponse(ctx, taskRequest, taskResp, replyTopic, started)
 		}
+	} else if taskRequest.Action == types.DRAIN {
+		t.Drain(taskRequest.Timeout)
+		taskResp := types.NewTaskResponse(taskRequest)
+		taskResp.Status = types.COMPLETED
+		err = t.sendResponse(ctx, taskRequest, taskResp, replyTopic, started)
 	} else if taskRequest.Action == types.PING {
 		taskResp := types.NewTaskResponse(taskRequest)
 		taskResp.Status = types.COMPLETED
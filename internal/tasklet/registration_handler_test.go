@@ -52,6 +52,64 @@ func Test_ShouldStartAndStopRegistration(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldDrainTaskletAfterRequestsFinishOrTimeout(t *testing.T) {
+	cfg := newTestCommonConfig()
+	err := cfg.Validate()
+	require.NoError(t, err)
+
+	// GIVEN a tasklet with two in-progress requests
+	queueClient, err := queue.NewClientManager().GetClient(context.Background(), cfg)
+	require.NoError(t, err)
+	requestRegistry := NewRequestRegistry(cfg, metrics.New())
+	registration := types.AntRegistration{
+		AntID:       "ant-id",
+		MaxCapacity: 100,
+		Methods:     []types.TaskMethod{types.Shell},
+		Allocations: make(map[string]*types.AntAllocation),
+	}
+	tasklet := NewBaseTasklet(
+		"suffix",
+		cfg,
+		queueClient,
+		nil,
+		requestRegistry,
+		"requestTopic",
+		"registrationTopic",
+		&registration,
+		&MockExecutorImpl{})
+	drained := make(chan bool, 1)
+	tasklet.OnDrained = func() { drained <- true }
+	finished := &types.TaskRequest{JobRequestID: "1", TaskType: "a"}
+	stuck := &types.TaskRequest{JobRequestID: "2", TaskType: "b"}
+	cancelled := false
+	stuck.Cancel = func() { cancelled = true }
+	require.NoError(t, requestRegistry.Add(finished))
+	require.NoError(t, requestRegistry.Add(stuck))
+
+	// WHEN draining the tasklet
+	tasklet.Drain(2 * time.Second)
+	tasklet.Drain(2 * time.Second)
+
+	// THEN registration should be draining
+	require.True(t, registration.Draining)
+	// AND it should not be drained while requests are running
+	require.NoError(t, requestRegistry.Remove(finished))
+	select {
+	case <-drained:
+		require.Fail(t, "tasklet drained before timeout")
+	case <-time.After(time.Second):
+	}
+
+	// AND requests that are still running after the timeout should be cancelled
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "tasklet was not drained")
+	}
+	require.True(t, cancelled)
+	require.Equal(t, 0, requestRegistry.Count())
+}
+
 func newTestCommonConfig() *types.CommonConfig {
 	cfg := &types.CommonConfig{}
 	_ = cfg.Validate()
@@ -208,6 +208,7 @@ func (t *BaseTasklet) startTickerForRegistration(
 // of the ant so that it can perform back-pressure if needed.
 func (t *BaseTasklet) sendRegisterAntRequest(
 	ctx context.Context) (err error) {
+	t.lock.Lock()
 	t.registration.AntTopic = t.RequestTopic
 	t.registration.CurrentLoad = t.RequestRegistry.Count()
 	t.registration.TotalExecuted = t.totalExecuted
@@ -215,7 +216,9 @@ func (t *BaseTasklet) sendRegisterAntRequest(
 
 	var b []byte
 	// validate and marshal registration to be sent to server so that it can keep track of active ants
-	if b, err = t.registration.Marshal(); err != nil {
+	b, err = t.registration.Marshal()
+	t.lock.Unlock()
+	if err != nil {
 		return err
 	}
 	if _, err = t.QueueClient.Publish(
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ExecutionSpanName is the name of the root span of a job execution
+const ExecutionSpanName = "job.execution"
+
+// sampleRatio is the configured ratio used to decide sampling of job executions
+var sampleRatio = 1.0
+
+// executionIDsKey is the context key for IDs of the root span started by StartExecutionSpan
+type executionIDsKey struct{}
+
+type executionIDs struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// newExecutionIDs derives trace and span ids from the job execution id so that the scheduler, job
+// supervisor and ants, which may run on different servers, attach their spans to the same root span.
+func newExecutionIDs(executionID string) executionIDs {
+	sum := sha256.Sum256([]byte(executionID))
+	var ids executionIDs
+	copy(ids.traceID[:], sum[:16])
+	copy(ids.spanID[:], sum[16:24])
+	return ids
+}
+
+// ExecutionSpanContext returns span context of the root span of the job execution
+func ExecutionSpanContext(executionID string) trace.SpanContext {
+	ids := newExecutionIDs(executionID)
+	flags := trace.TraceFlags(0)
+	res := sdktrace.TraceIDRatioBased(sampleRatio).ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: context.Background(),
+		TraceID:       ids.traceID,
+	})
+	if res.Decision == sdktrace.RecordAndSample {
+		flags = trace.FlagsSampled
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    ids.traceID,
+		SpanID:     ids.spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+}
+
+// ExecutionContext returns a context whose spans are children of the root span of the job execution.
+// The context is returned as is if it already carries a span of the job execution, e.g. propagated
+// through message headers.
+func ExecutionContext(ctx context.Context, executionID string) context.Context {
+	sc := ExecutionSpanContext(executionID)
+	if trace.SpanContextFromContext(ctx).TraceID() == sc.TraceID() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// StartExecutionSpan starts the root span of the job execution with the ids of ExecutionSpanContext.
+// The root span is started when the job execution finishes so that it is exported once even though the
+// job may be paused and resumed by other servers; startedAt defines the start of the job execution.
+func StartExecutionSpan(
+	ctx context.Context,
+	tracerName string,
+	executionID string,
+	startedAt time.Time,
+	opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx = context.WithValue(ctx, executionIDsKey{}, newExecutionIDs(executionID))
+	opts = append(opts, trace.WithNewRoot(), trace.WithTimestamp(startedAt))
+	return Tracer(tracerName).Start(ctx, ExecutionSpanName, opts...)
+}
+
+// TraceParent returns W3C traceparent of the span in the context or empty string if there is no span
+func TraceParent(ctx context.Context) string {
+	headers := make(map[string]string)
+	propagation.TraceContext{}.Inject(ctx, MessageHeadersCarrier(headers))
+	return headers["traceparent"]
+}
+
+// LinkFromTraceParent returns a span link to the W3C traceparent, e.g. to link a forked job to its parent
+func LinkFromTraceParent(traceParent string) (trace.Link, bool) {
+	if traceParent == "" {
+		return trace.Link{}, false
+	}
+	ctx := propagation.TraceContext{}.Extract(
+		context.Background(),
+		MessageHeadersCarrier(map[string]string{"traceparent": traceParent}))
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return trace.Link{}, false
+	}
+	return trace.Link{SpanContext: sc}, true
+}
+
+// executionIDGenerator generates random ids except for the root span of a job execution
+type executionIDGenerator struct {
+}
+
+// NewIDs returns ids of the job execution for its root span, otherwise random ids
+func (g *executionIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if ids, ok := ctx.Value(executionIDsKey{}).(executionIDs); ok {
+		return ids.traceID, ids.spanID
+	}
+	var tid trace.TraceID
+	_, _ = rand.Read(tid[:])
+	return tid, g.NewSpanID(ctx, tid)
+}
+
+// NewSpanID returns a random span id
+func (g *executionIDGenerator) NewSpanID(_ context.Context, _ trace.TraceID) trace.SpanID {
+	var sid trace.SpanID
+	_, _ = rand.Read(sid[:])
+	return sid
+}
@@ -0,0 +1,160 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// localCollector is a minimal OTLP/HTTP collector that keeps exported spans by name
+type localCollector struct {
+	lock  sync.Mutex
+	spans map[string]*tracepb.Span
+}
+
+func newLocalCollector() (*localCollector, *httptest.Server) {
+	c := &localCollector{spans: make(map[string]*tracepb.Span)}
+	return c, httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		req := &coltracepb.ExportTraceServiceRequest{}
+		if err == nil {
+			err = proto.Unmarshal(body, req)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				for _, span := range ss.Spans {
+					c.spans[span.Name] = span
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write([]byte{})
+	}))
+}
+
+func (c *localCollector) get(name string) *tracepb.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.spans[name]
+}
+
+func TestExecutionSpanContextIsDeterministic(t *testing.T) {
+	sc1 := ExecutionSpanContext("exec-1")
+	sc2 := ExecutionSpanContext("exec-1")
+	if !sc1.IsValid() || !sc1.IsSampled() {
+		t.Fatal("expected valid and sampled span context")
+	}
+	if sc1.TraceID() != sc2.TraceID() || sc1.SpanID() != sc2.SpanID() {
+		t.Fatal("expected same ids for same job execution")
+	}
+	if ExecutionSpanContext("exec-2").TraceID() == sc1.TraceID() {
+		t.Fatal("expected different trace ids for different job executions")
+	}
+}
+
+func TestExecutionContextKeepsSpanOfExecution(t *testing.T) {
+	// context without span is parented by root span of execution
+	ctx := ExecutionContext(context.Background(), "exec-1")
+	if trace.SpanContextFromContext(ctx).SpanID() != ExecutionSpanContext("exec-1").SpanID() {
+		t.Fatal("expected root span of execution as parent")
+	}
+
+	// context with a span of the same execution is not changed
+	child := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: ExecutionSpanContext("exec-1").TraceID(),
+		SpanID:  trace.SpanID{1},
+	}))
+	if ExecutionContext(child, "exec-1") != child {
+		t.Fatal("expected context with span of execution to be kept")
+	}
+}
+
+func TestLinkFromTraceParent(t *testing.T) {
+	ctx := ExecutionContext(context.Background(), "exec-1")
+	link, ok := LinkFromTraceParent(TraceParent(ctx))
+	if !ok {
+		t.Fatal("expected link from traceparent")
+	}
+	if link.SpanContext.SpanID() != ExecutionSpanContext("exec-1").SpanID() {
+		t.Fatal("expected link to root span of execution")
+	}
+	if _, ok = LinkFromTraceParent("bad"); ok {
+		t.Fatal("expected no link for invalid traceparent")
+	}
+}
+
+func TestExportExecutionTraceToLocalCollector(t *testing.T) {
+	collector, server := newLocalCollector()
+	defer server.Close()
+	shutdown, err := Init(context.Background(), &Config{
+		Enabled:      true,
+		Endpoint:     server.URL,
+		ServiceName:  "test-service",
+		SampleRatio:  1.0,
+		BatchTimeout: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	started := time.Now()
+
+	// scheduler starts a span for the execution and sends it through message headers
+	ctx := ExecutionContext(context.Background(), "exec-1")
+	ctx, scheduleSpan := Tracer("formicary.queen").Start(ctx, "job.schedule")
+	headers := map[string]string{}
+	InjectContext(ctx, headers)
+	scheduleSpan.End()
+
+	// ant receives the message and executes the task
+	_, executeSpan := Tracer("formicary.ant").Start(ExtractContext(context.Background(), headers), "task.execute")
+	executeSpan.End()
+
+	// job supervisor emits root span when the execution finishes
+	_, rootSpan := StartExecutionSpan(context.Background(), "formicary.queen", "exec-1", started)
+	rootSpan.End()
+
+	if err = shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown error: %v", err)
+	}
+
+	root := ExecutionSpanContext("exec-1")
+	rootTraceID, rootSpanID := root.TraceID(), root.SpanID()
+	exported := collector.get(ExecutionSpanName)
+	if exported == nil {
+		t.Fatal("expected root span to be exported")
+	}
+	if string(exported.TraceId) != string(rootTraceID[:]) || string(exported.SpanId) != string(rootSpanID[:]) {
+		t.Fatal("expected root span with ids of the execution")
+	}
+	if len(exported.ParentSpanId) != 0 {
+		t.Fatal("expected root span without parent")
+	}
+	if exported.StartTimeUnixNano != uint64(started.UnixNano()) {
+		t.Fatal("expected root span to start when execution started")
+	}
+	schedule := collector.get("job.schedule")
+	if schedule == nil || string(schedule.ParentSpanId) != string(rootSpanID[:]) {
+		t.Fatal("expected schedule span to be child of root span")
+	}
+	execute := collector.get("task.execute")
+	if execute == nil || string(execute.TraceId) != string(rootTraceID[:]) ||
+		string(execute.ParentSpanId) != string(schedule.SpanId) {
+		t.Fatal("expected task span to be propagated through message headers")
+	}
+}
@@ -39,12 +39,14 @@ func Init(ctx context.Context, cfg *Config) (shutdown func(context.Context) erro
 		return nil, fmt.Errorf("failed to create OTel resource: %w", err)
 	}
 
+	sampleRatio = cfg.SampleRatio
 	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))
 
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(cfg.BatchTimeout)),
 		sdktrace.WithResource(res),
 		sdktrace.WithSampler(sampler),
+		sdktrace.WithIDGenerator(&executionIDGenerator{}),
 	)
 
 	otel.SetTracerProvider(tp)
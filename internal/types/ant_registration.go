@@ -33,6 +33,10 @@ type AntRegistration struct {
 	AntStartedAt  time.Time                 `json:"ant_started_at" mapstructure:"ant_started_at"`
 	AutoRefresh   bool                      `json:"auto_refresh" mapstructure:"auto_refresh"`
 	ConfigInfo    map[string]any            `json:"config_info" mapstructure:"config_info"`
+	// Zone defines availability zone of the ant that is used for spreading tasks
+	Zone string `json:"zone" mapstructure:"zone"`
+	// Draining is set when the ant is asked to stop accepting new work and exit once its current requests finish
+	Draining bool `json:"draining" mapstructure:"draining"`
 	// Transient property
 	ReceivedAt        time.Time         `json:"-" mapstructure:"-"`
 	ValidRegistration ValidRegistration `json:"-" mapstructure:"-"`
@@ -133,8 +137,8 @@ func (r *AntRegistration) Marshal() ([]byte, error) {
 
 // String defines description of registration
 func (r *AntRegistration) String() string {
-	return fmt.Sprintf("ID=%s Tags=%s Methods=%v Max=%d Load=%d Executed=%d\n",
-		r.AntID, r.Tags, r.Methods, r.MaxCapacity, r.CurrentLoad, r.TotalExecuted)
+	return fmt.Sprintf("ID=%s Tags=%s Methods=%v Zone=%s Max=%d Load=%d Executed=%d Draining=%v\n",
+		r.AntID, r.Tags, r.Methods, r.Zone, r.MaxCapacity, r.CurrentLoad, r.TotalExecuted, r.Draining)
 }
 
 // UpdatedAtString defines formatted date
@@ -155,11 +159,14 @@ func (r *AntRegistration) IsAlive(timeout time.Duration) bool {
 	return true
 }
 
-// Supports check supported method and tags
+// Supports check supported method and tags, a draining ant doesn't support any new work
 func (r *AntRegistration) Supports(
 	method TaskMethod,
 	tags []string,
 	timeout time.Duration) bool {
+	if r.Draining {
+		return false
+	}
 	if time.Duration(time.Now().Unix()-r.ReceivedAt.Unix())*time.Second > timeout {
 		return false
 	}
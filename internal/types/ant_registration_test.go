@@ -51,3 +51,27 @@ func Test_ShouldMarshalAntRegistration(t *testing.T) {
 	require.Equal(t, reg.String(), unmarshalAntRegistration.String())
 	require.NotEqual(t, "", unmarshalAntRegistration.UpdatedAtString())
 }
+
+func Test_ShouldNotSupportWorkWhenAntIsDraining(t *testing.T) {
+	// Given ant registration that is draining
+	reg := AntRegistration{
+		AntID:       "ant",
+		AntTopic:    "topic",
+		MaxCapacity: 10,
+		Methods:     []TaskMethod{Kubernetes},
+		ReceivedAt:  time.Now(),
+		Draining:    true,
+	}
+
+	// WHEN checking supported method
+	// THEN it should not support any work
+	require.False(t, reg.Supports(Kubernetes, nil, time.Hour))
+	require.True(t, reg.IsAlive(time.Hour))
+
+	// AND draining should be marshaled for other servers
+	b, err := reg.Marshal()
+	require.NoError(t, err)
+	unmarshalAntRegistration, err := UnmarshalAntRegistration(b)
+	require.NoError(t, err)
+	require.True(t, unmarshalAntRegistration.Draining)
+}
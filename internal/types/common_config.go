@@ -299,6 +299,15 @@ func (c *CommonConfig) GetJobExecutionLifecycleTopic() string {
 		"job-execution-lifecycle")
 }
 
+// GetSLABreachTopic topic
+func (c *CommonConfig) GetSLABreachTopic() string {
+	return PersistentTopic(
+		c.Queue.Provider,
+		c.Queue.TopicTenant,
+		c.Queue.TopicNamespace,
+		"sla-breach")
+}
+
 // GetJobWebhookTopic topic
 func (c *CommonConfig) GetJobWebhookTopic() string {
 	return PersistentTopic(
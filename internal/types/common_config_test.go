@@ -64,6 +64,7 @@ func Test_ShouldValidateTopics(t *testing.T) {
 	require.Equal(t, "formicary-topic-ant-registration", c.GetRegistrationTopic())
 	require.Equal(t, "formicary-queue-job-execution-lifecycle", c.GetJobExecutionLifecycleTopic())
 	require.Equal(t, "formicary-queue-task-execution-lifecycle", c.GetTaskExecutionLifecycleTopic())
+	require.Equal(t, "formicary-queue-sla-breach", c.GetSLABreachTopic())
 	require.Equal(t, "formicary-queue-fork-job-tasklet", c.GetForkJobTaskletTopic())
 	require.Equal(t, "formicary-queue-wait-fork-job-tasklet", c.GetWaitForkJobTaskletTopic())
 	require.Equal(t, "formicary-topic-job-scheduler-leader", c.GetJobSchedulerLeaderTopic())
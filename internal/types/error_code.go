@@ -63,6 +63,8 @@ const (
 	ErrorRestartTask = "ERR_RESTART_TASK"
 	// ErrorTaskTimedOut  error code
 	ErrorTaskTimedOut = "ERR_TASK_TIMED_OUT"
+	// ErrorTaskCancelled error code
+	ErrorTaskCancelled = "ERR_TASK_CANCELLED"
 )
 
 // ErrorCode defines codes for tracking different types of errors.
@@ -107,6 +107,10 @@ type ExecutorOptions struct {
 	Affinity                   *KubernetesNodeAffinity `json:"affinity,omitempty" yaml:"affinity,omitempty"`
 	NodeSelector               map[string]string       `json:"node_selector,omitempty" yaml:"node_selector,omitempty"`
 	NodeTolerations            NodeTolerations         `json:"node_tolerations,omitempty" yaml:"node_tolerations,omitempty"`
+	// ImageBuild builds container image from the task working directory after script completes
+	ImageBuild                 *ImageBuild             `json:"image_build,omitempty" yaml:"image_build,omitempty"`
+	// KubernetesJob submits KUBERNETES task as batch/v1 Job instead of bare pod
+	KubernetesJob              *KubernetesJob          `json:"kubernetes_job,omitempty" yaml:"kubernetes_job,omitempty"`
 	PodLabels                  map[string]string       `json:"pod_labels,omitempty" yaml:"pod_labels,omitempty"`
 	PodAnnotations             map[string]string       `json:"pod_annotations,omitempty" yaml:"pod_annotations,omitempty"`
 	NetworkMode                string                  `json:"network_mode,omitempty" yaml:"network_mode,omitempty"`
@@ -125,6 +129,10 @@ type ExecutorOptions struct {
 	// FanOut configures dynamic fan-out when method is FAN_OUT_JOB.
 	FanOut                     *FanOutConfig           `json:"fan_out,omitempty" yaml:"fan_out,omitempty"`
 	CostFactor                 float64                 `json:"cost_factor,omitempty" yaml:"cost_factor,omitempty"`
+	// SSH defines remote host for SSH task method
+	SSH                        *SSHOptions             `json:"ssh,omitempty" yaml:"ssh,omitempty"`
+	// Sandbox runs SHELL task in isolated cgroup with private working directory
+	Sandbox                    bool                    `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
 	ExecuteCommandWithoutShell bool                    `json:"execute_command_without_shell,omitempty" yaml:"execute_command_without_shell,omitempty"`
 	Debug                      bool                    `json:"debug,omitempty" yaml:"debug,omitempty"`
 }
@@ -196,6 +204,30 @@ func (opt *ExecutorOptions) Validate() error {
 	if opt.PodAnnotations == nil {
 		opt.PodAnnotations = make(map[string]string)
 	}
+	if opt.Method == SSH {
+		if opt.SSH == nil {
+			return fmt.Errorf("ssh is not specified for method %s", opt.Method)
+		}
+		if err := opt.SSH.Validate(); err != nil {
+			return err
+		}
+	}
+	if opt.ImageBuild != nil {
+		if !opt.Method.SupportsImageBuild() {
+			return fmt.Errorf("image_build is not supported by method %s", opt.Method)
+		}
+		if err := opt.ImageBuild.Validate(); err != nil {
+			return err
+		}
+		if opt.KubernetesJob.IsIndexed() {
+			return fmt.Errorf("image_build cannot be used with indexed kubernetes_job")
+		}
+	}
+	if opt.KubernetesJob != nil {
+		if err := opt.KubernetesJob.Validate(); err != nil {
+			return fmt.Errorf("invalid kubernetes_job due to %w", err)
+		}
+	}
 	return nil
 }
 
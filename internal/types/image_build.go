@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+// ImageBuild defines container image that is built from the working directory of the task after its script
+// completes, using BuildKit on docker ants and a rootless builder container on kubernetes ants.
+type ImageBuild struct {
+	Context    string            `json:"context,omitempty" yaml:"context,omitempty"`
+	Dockerfile string            `json:"dockerfile,omitempty" yaml:"dockerfile,omitempty"`
+	Target     string            `json:"target,omitempty" yaml:"target,omitempty"`
+	Platform   string            `json:"platform,omitempty" yaml:"platform,omitempty"`
+	BuildArgs  map[string]string `json:"build_args,omitempty" yaml:"build_args,omitempty"`
+	Tags       []string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Push       bool              `json:"push,omitempty" yaml:"push,omitempty"`
+	CacheFrom  []string          `json:"cache_from,omitempty" yaml:"cache_from,omitempty"`
+}
+
+// ImageBuildResult defines output of image build
+type ImageBuildResult struct {
+	Digest string
+	Tags   []string
+	Pushed bool
+}
+
+// Validate validates image build and sets defaults
+func (b *ImageBuild) Validate() error {
+	if len(b.Tags) == 0 {
+		return fmt.Errorf("tags are not specified for image_build")
+	}
+	if b.Context == "" {
+		b.Context = "."
+	}
+	if b.Dockerfile == "" {
+		b.Dockerfile = "Dockerfile"
+	}
+	if path.IsAbs(b.Dockerfile) {
+		return fmt.Errorf("dockerfile %s must be relative to build context", b.Dockerfile)
+	}
+	if b.BuildArgs == nil {
+		b.BuildArgs = make(map[string]string)
+	}
+	return nil
+}
+
+// ContextDir returns absolute path of build context inside the container
+func (b *ImageBuild) ContextDir(workingDir string) string {
+	if path.IsAbs(b.Context) {
+		return path.Clean(b.Context)
+	}
+	if workingDir == "" {
+		workingDir = "/"
+	}
+	return path.Join(workingDir, b.Context)
+}
+
+// SortedBuildArgs returns build arguments as sorted name=value pairs
+func (b *ImageBuild) SortedBuildArgs() []string {
+	args := make([]string, 0, len(b.BuildArgs))
+	for k, v := range b.BuildArgs {
+		args = append(args, k+"="+v)
+	}
+	sort.Strings(args)
+	return args
+}
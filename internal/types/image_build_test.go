@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_ShouldParseImageBuild(t *testing.T) {
+	// GIVEN task definition with image_build
+	serData := `
+method: KUBERNETES
+image_build:
+  context: app
+  build_args:
+    VERSION: "1.0"
+  tags:
+    - registry/app:1.0
+  push: true
+  cache_from:
+    - registry/app:cache
+`
+	opts := NewExecutorOptions("", "")
+	// WHEN unmarshalling and validating
+	err := yaml.Unmarshal([]byte(serData), opts)
+	require.NoError(t, err)
+	require.NoError(t, opts.Validate())
+
+	// THEN defaults should be set
+	require.NotNil(t, opts.ImageBuild)
+	require.Equal(t, "Dockerfile", opts.ImageBuild.Dockerfile)
+	require.Equal(t, []string{"VERSION=1.0"}, opts.ImageBuild.SortedBuildArgs())
+	require.Equal(t, "/workspace/app", opts.ImageBuild.ContextDir("/workspace"))
+	require.True(t, opts.ImageBuild.Push)
+}
+
+func Test_ShouldValidateImageBuild(t *testing.T) {
+	// GIVEN image build without tags
+	build := &ImageBuild{}
+	// WHEN validating THEN it should fail
+	require.Error(t, build.Validate())
+
+	// GIVEN image build with absolute dockerfile
+	build = &ImageBuild{Tags: []string{"app"}, Dockerfile: "/tmp/Dockerfile"}
+	require.Error(t, build.Validate())
+
+	// GIVEN image build for method that cannot build images
+	opts := NewExecutorOptions("", Shell)
+	opts.ImageBuild = &ImageBuild{Tags: []string{"app"}}
+	require.Error(t, opts.Validate())
+}
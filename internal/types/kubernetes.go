@@ -117,6 +117,59 @@ type KubernetesPodSecurityContext struct {
 	SupplementalGroups []int64 `yaml:"supplemental_groups,omitempty" json:"supplemental_groups,omitempty" mapstructure:"supplemental_groups,omitempty"`
 }
 
+// KubernetesJob runs the task pod as a batch/v1 Job so that retries, deadlines and cleanup are owned by the cluster
+type KubernetesJob struct {
+	BackoffLimit            *int32 `yaml:"backoff_limit,omitempty" json:"backoff_limit,omitempty" mapstructure:"backoff_limit"`
+	ActiveDeadlineSeconds   *int64 `yaml:"active_deadline_seconds,omitempty" json:"active_deadline_seconds,omitempty" mapstructure:"active_deadline_seconds"`
+	TTLSecondsAfterFinished *int32 `yaml:"ttl_seconds_after_finished,omitempty" json:"ttl_seconds_after_finished,omitempty" mapstructure:"ttl_seconds_after_finished"`
+	// Completions > 1 uses indexed completion where each pod receives JOB_COMPLETION_INDEX, all indexes run in parallel
+	// because commands of the task are executed on every index at once
+	Completions int32 `yaml:"completions,omitempty" json:"completions,omitempty" mapstructure:"completions"`
+}
+
+// IsIndexed returns true if job fans out to multiple indexed pods
+func (j *KubernetesJob) IsIndexed() bool {
+	return j != nil && j.Completions > 1
+}
+
+// WithDefaults fills unset fields from defaults
+func (j *KubernetesJob) WithDefaults(defaults *KubernetesJob) *KubernetesJob {
+	res := KubernetesJob{}
+	if j != nil {
+		res = *j
+	}
+	if defaults == nil {
+		return &res
+	}
+	if res.BackoffLimit == nil {
+		res.BackoffLimit = defaults.BackoffLimit
+	}
+	if res.ActiveDeadlineSeconds == nil {
+		res.ActiveDeadlineSeconds = defaults.ActiveDeadlineSeconds
+	}
+	if res.TTLSecondsAfterFinished == nil {
+		res.TTLSecondsAfterFinished = defaults.TTLSecondsAfterFinished
+	}
+	return &res
+}
+
+// Validate validates job settings
+func (j *KubernetesJob) Validate() error {
+	if j.BackoffLimit != nil && *j.BackoffLimit < 0 {
+		return fmt.Errorf("backoff_limit %d cannot be negative", *j.BackoffLimit)
+	}
+	if j.ActiveDeadlineSeconds != nil && *j.ActiveDeadlineSeconds <= 0 {
+		return fmt.Errorf("active_deadline_seconds %d must be positive", *j.ActiveDeadlineSeconds)
+	}
+	if j.TTLSecondsAfterFinished != nil && *j.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("ttl_seconds_after_finished %d cannot be negative", *j.TTLSecondsAfterFinished)
+	}
+	if j.Completions < 0 {
+		return fmt.Errorf("completions %d cannot be negative", j.Completions)
+	}
+	return nil
+}
+
 // KubernetesAffinity affinity
 //type KubernetesAffinity struct {
 //	NodeAffinity *KubernetesNodeAffinity `yaml:"node_affinity" json:"node_affinity" mapstructure:"node_affinity"`
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlacementStrategy defines how an ant is chosen among the ants that support method and tags of a task
+type PlacementStrategy string
+
+const (
+	// LeastLoadedPlacement chooses the ant with fewest allocated tasks
+	LeastLoadedPlacement PlacementStrategy = "LEAST_LOADED"
+	// BinPackPlacement chooses the busiest ant that still has capacity so that idle ants can be scaled down
+	BinPackPlacement PlacementStrategy = "BIN_PACK"
+	// SpreadPlacement chooses an ant in the zone with fewest tasks of the same request and then the least loaded ant
+	SpreadPlacement PlacementStrategy = "SPREAD"
+)
+
+// IsValid checks if strategy is supported
+func (s PlacementStrategy) IsValid() bool {
+	return s == LeastLoadedPlacement || s == BinPackPlacement || s == SpreadPlacement
+}
+
+// AffinityRule matches ants by their IDs, tags or zones
+type AffinityRule struct {
+	// AntIDs defines IDs of ants
+	AntIDs []string `yaml:"ant_ids,omitempty" json:"ant_ids,omitempty"`
+	// Labels defines tags of ants where an ant matches if it has any of the labels
+	Labels []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	// Zones defines zones of ants
+	Zones []string `yaml:"zones,omitempty" json:"zones,omitempty"`
+}
+
+// IsEmpty returns true if rule doesn't define any ant IDs, labels or zones
+func (r *AffinityRule) IsEmpty() bool {
+	return r == nil || (len(r.AntIDs) == 0 && len(r.Labels) == 0 && len(r.Zones) == 0)
+}
+
+// Matches returns true if ant ID, zone or any tag of the ant is defined by the rule
+func (r *AffinityRule) Matches(registration *AntRegistration) bool {
+	if r.IsEmpty() {
+		return false
+	}
+	for _, id := range r.AntIDs {
+		if id == registration.AntID {
+			return true
+		}
+	}
+	for _, zone := range r.Zones {
+		if registration.Zone != "" && strings.EqualFold(zone, registration.Zone) {
+			return true
+		}
+	}
+	for _, label := range r.Labels {
+		for _, tag := range registration.Tags {
+			if strings.EqualFold(label, tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PlacementPolicy defines rules for choosing ants of a task
+type PlacementPolicy struct {
+	// Strategy overrides placement_strategy of the server for the task
+	Strategy PlacementStrategy `yaml:"strategy,omitempty" json:"strategy,omitempty"`
+	// Affinity defines ants where the task can only run
+	Affinity *AffinityRule `yaml:"affinity,omitempty" json:"affinity,omitempty"`
+	// AntiAffinity defines ants where the task must not run
+	AntiAffinity *AffinityRule `yaml:"anti_affinity,omitempty" json:"anti_affinity,omitempty"`
+	// Sticky prefers the ant that last ran the task of the job so that the task can use its warm cache
+	Sticky bool `yaml:"sticky,omitempty" json:"sticky,omitempty"`
+}
+
+// Validate validates placement
+func (p *PlacementPolicy) Validate() error {
+	if p.Strategy != "" {
+		p.Strategy = PlacementStrategy(strings.ToUpper(string(p.Strategy)))
+		if !p.Strategy.IsValid() {
+			return fmt.Errorf("strategy %s is not supported", p.Strategy)
+		}
+	}
+	return nil
+}
+
+// Allows returns true if the ant matches affinity and doesn't match anti-affinity of the placement
+func (p *PlacementPolicy) Allows(registration *AntRegistration) bool {
+	if p == nil {
+		return true
+	}
+	if !p.Affinity.IsEmpty() && !p.Affinity.Matches(registration) {
+		return false
+	}
+	return !p.AntiAffinity.Matches(registration)
+}
+
+// PlacementRequest defines job type and placement policy of a task that is being reserved
+type PlacementRequest struct {
+	JobType string
+	Policy  *PlacementPolicy
+}
+
+// NewPlacementRequest constructor
+func NewPlacementRequest(jobType string, policy *PlacementPolicy) *PlacementRequest {
+	return &PlacementRequest{
+		JobType: jobType,
+		Policy:  policy,
+	}
+}
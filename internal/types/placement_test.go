@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldValidatePlacementPolicy(t *testing.T) {
+	// GIVEN placement with lower case strategy
+	policy := &PlacementPolicy{Strategy: "bin_pack"}
+	// WHEN validating
+	// THEN strategy should be normalized
+	require.NoError(t, policy.Validate())
+	require.Equal(t, BinPackPlacement, policy.Strategy)
+	// AND unknown strategy should fail
+	require.Error(t, (&PlacementPolicy{Strategy: "random"}).Validate())
+}
+
+func Test_ShouldMatchAffinityOfPlacementPolicy(t *testing.T) {
+	// GIVEN an ant with tags and zone
+	ant := &AntRegistration{AntID: "ant-1", Tags: []string{"ssd", "linux"}, Zone: "us-east-1a"}
+
+	// WHEN checking placement without rules
+	// THEN ant should be allowed
+	var policy *PlacementPolicy
+	require.True(t, policy.Allows(ant))
+	require.True(t, (&PlacementPolicy{Sticky: true}).Allows(ant))
+
+	// AND affinity should match ant ID, label or zone
+	require.True(t, (&PlacementPolicy{Affinity: &AffinityRule{AntIDs: []string{"ant-1"}}}).Allows(ant))
+	require.True(t, (&PlacementPolicy{Affinity: &AffinityRule{Labels: []string{"gpu", "SSD"}}}).Allows(ant))
+	require.True(t, (&PlacementPolicy{Affinity: &AffinityRule{Zones: []string{"us-east-1a"}}}).Allows(ant))
+	require.False(t, (&PlacementPolicy{Affinity: &AffinityRule{Labels: []string{"gpu"}}}).Allows(ant))
+
+	// AND anti-affinity should exclude matching ants
+	require.False(t, (&PlacementPolicy{AntiAffinity: &AffinityRule{AntIDs: []string{"ant-1"}}}).Allows(ant))
+	require.True(t, (&PlacementPolicy{AntiAffinity: &AffinityRule{Zones: []string{"us-east-1b"}}}).Allows(ant))
+}
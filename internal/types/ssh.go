@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// SSHOptions defines remote host for SSH task method that runs script on servers where an ant can't run.
+// Credentials are not stored in the task definition but are looked up from job configs or variables by name.
+type SSHOptions struct {
+	Host string `json:"host" yaml:"host"`
+	Port int    `json:"port,omitempty" yaml:"port,omitempty"`
+	User string `json:"user" yaml:"user"`
+	// PrivateKeyConfig is name of secret job config that holds PEM encoded private key
+	PrivateKeyConfig string `json:"private_key_config,omitempty" yaml:"private_key_config,omitempty"`
+	// PassphraseConfig is name of secret job config that holds passphrase of the private key
+	PassphraseConfig string `json:"passphrase_config,omitempty" yaml:"passphrase_config,omitempty"`
+	// HostKey is public key of the server in authorized_keys format, e.g. ssh-ed25519 AAAA...
+	HostKey string `json:"host_key,omitempty" yaml:"host_key,omitempty"`
+	// InsecureIgnoreHostKey skips verification of host key, only meant for lab machines
+	InsecureIgnoreHostKey bool `json:"insecure_ignore_host_key,omitempty" yaml:"insecure_ignore_host_key,omitempty"`
+}
+
+// Validate validates ssh options and sets defaults
+func (o *SSHOptions) Validate() error {
+	if o.Host == "" {
+		return fmt.Errorf("host is not specified for ssh")
+	}
+	if o.User == "" {
+		return fmt.Errorf("user is not specified for ssh")
+	}
+	if o.PrivateKeyConfig == "" {
+		return fmt.Errorf("private_key_config is not specified for ssh")
+	}
+	if o.Port == 0 {
+		o.Port = 22
+	}
+	if o.Port < 0 || o.Port > 65535 {
+		return fmt.Errorf("invalid ssh port %d", o.Port)
+	}
+	return nil
+}
+
+// Address returns host:port of the remote server
+func (o *SSHOptions) Address() string {
+	port := o.Port
+	if port == 0 {
+		port = 22
+	}
+	return net.JoinHostPort(o.Host, strconv.Itoa(port))
+}
+
+// IsSecretConfig checks if name refers to credentials of the ssh connection
+func (o *SSHOptions) IsSecretConfig(name string) bool {
+	return name != "" && (name == o.PrivateKeyConfig || name == o.PassphraseConfig)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_ShouldParseSSHOptions(t *testing.T) {
+	// GIVEN task definition with ssh method
+	serData := `
+method: SSH
+ssh:
+  host: gateway1
+  user: deployer
+  private_key_config: GatewayKey
+  passphrase_config: GatewayPassphrase
+`
+	opts := NewExecutorOptions("", "")
+	// WHEN unmarshalling and validating
+	err := yaml.Unmarshal([]byte(serData), opts)
+	require.NoError(t, err)
+	require.NoError(t, opts.Validate())
+
+	// THEN default port should be used
+	require.Equal(t, "gateway1:22", opts.SSH.Address())
+	require.True(t, opts.SSH.IsSecretConfig("GatewayKey"))
+	require.True(t, opts.SSH.IsSecretConfig("GatewayPassphrase"))
+	require.False(t, opts.SSH.IsSecretConfig("Version"))
+	require.True(t, opts.Method.SupportsCache())
+}
+
+func Test_ShouldValidateSSHOptions(t *testing.T) {
+	// GIVEN ssh method without ssh options WHEN validating THEN it should fail
+	opts := NewExecutorOptions("", SSH)
+	require.Error(t, opts.Validate())
+
+	// GIVEN ssh options without private key
+	opts.SSH = &SSHOptions{Host: "gateway1", User: "deployer"}
+	require.Error(t, opts.Validate())
+
+	// GIVEN ssh options with invalid port
+	opts.SSH = &SSHOptions{Host: "gateway1", User: "deployer", PrivateKeyConfig: "Key", Port: 70000}
+	require.Error(t, opts.Validate())
+}
@@ -34,6 +34,12 @@ const (
 	Docker TaskMethod = "DOCKER"
 	// Kubernetes method runs ant using kubernetes container
 	Kubernetes TaskMethod = "KUBERNETES"
+	// Podman method runs ant using podman container via its docker-compatible socket
+	Podman TaskMethod = "PODMAN"
+	// Containerd method runs ant using containerd container via nerdctl
+	Containerd TaskMethod = "CONTAINERD"
+	// SSH method runs ant script on a remote server over ssh
+	SSH TaskMethod = "SSH"
 	// Manual method runs manual task
 	Manual TaskMethod = "MANUAL"
 	// FanOutJob is the internal method used when a task has fan_out configured.
@@ -63,7 +69,10 @@ func (m TaskMethod) RequiresScript() bool {
 		m == HTTPPutJSON ||
 		m == Shell ||
 		m == Docker ||
-		m == Kubernetes
+		m == Kubernetes ||
+		m == Podman ||
+		m == Containerd ||
+		m == SSH
 }
 
 // IsValid checks method if it's valid
@@ -78,6 +87,9 @@ func (m TaskMethod) IsValid() bool {
 		m == Shell ||
 		m == Docker ||
 		m == Kubernetes ||
+		m == Podman ||
+		m == Containerd ||
+		m == SSH ||
 		m == ForkJob ||
 		m == AwaitForkedJob ||
 		m == Messaging ||
@@ -90,12 +102,30 @@ func (m TaskMethod) IsValid() bool {
 func (m TaskMethod) SupportsDependentArtifacts() bool {
 	return m == Shell ||
 		m == Docker ||
-		m == Kubernetes
+		m == Kubernetes ||
+		m == Podman ||
+		m == Containerd ||
+		m == SSH
 }
 
 // SupportsCache checks if method allows caching -- Shell doesn't need it because it can internally cache
 func (m TaskMethod) SupportsCache() bool {
-	return m == Docker || m == Kubernetes
+	return m == Docker || m == Kubernetes || m == Podman || m == Containerd || m == SSH
+}
+
+// SupportsImageBuild checks if method can build container images natively via image_build
+func (m TaskMethod) SupportsImageBuild() bool {
+	return m == Docker || m == Kubernetes || m == Podman
+}
+
+// IsQueenTasklet checks if method is executed by a tasklet of the queen instead of an ant
+func (m TaskMethod) IsQueenTasklet() bool {
+	return m == ForkJob ||
+		m == AwaitForkedJob ||
+		m == Messaging ||
+		m == ExpireArtifacts ||
+		m == Manual ||
+		m == FanOutJob
 }
 
 // IsHTTP check if method is HTTP API
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// ResourceStats defines a reading of resources used by containers or processes of a task, where cpu and
+// i/o are cumulative counters and memory is the current resident memory.
+type ResourceStats struct {
+	CPUNanos     uint64 `json:"cpu_nanos"`
+	MemoryBytes  int64  `json:"memory_bytes"`
+	IOReadBytes  int64  `json:"io_read_bytes"`
+	IOWriteBytes int64  `json:"io_write_bytes"`
+}
+
+// TaskProfile summarizes resources used by a task from stats sampled during its execution
+type TaskProfile struct {
+	// Samples is number of stats that were sampled
+	Samples int `json:"samples"`
+	// CPUPeakCores is the highest cpu usage in cores between two samples
+	CPUPeakCores float64 `json:"cpu_peak_cores"`
+	// CPUAvgCores is the average cpu usage in cores
+	CPUAvgCores float64 `json:"cpu_avg_cores"`
+	// MemoryPeakBytes is the highest resident memory
+	MemoryPeakBytes int64 `json:"memory_peak_bytes"`
+	// IOReadBytes is the number of bytes read from block devices
+	IOReadBytes int64 `json:"io_read_bytes"`
+	// IOWriteBytes is the number of bytes written to block devices
+	IOWriteBytes int64 `json:"io_write_bytes"`
+	firstAt      time.Time
+	lastAt       time.Time
+	last         *ResourceStats
+	cpuNanos     uint64
+}
+
+// Add adds stats sampled at the given time, counters that go backwards, e.g. when a process exits, are
+// treated as restarted so that only usage between samples is added.
+func (p *TaskProfile) Add(at time.Time, stats *ResourceStats) {
+	if stats == nil {
+		return
+	}
+	p.Samples++
+	if stats.MemoryBytes > p.MemoryPeakBytes {
+		p.MemoryPeakBytes = stats.MemoryBytes
+	}
+	if p.last == nil {
+		p.firstAt = at
+		p.IOReadBytes = stats.IOReadBytes
+		p.IOWriteBytes = stats.IOWriteBytes
+	} else if elapsed := at.Sub(p.lastAt); elapsed > 0 {
+		cpuNanos := stats.CPUNanos
+		if cpuNanos >= p.last.CPUNanos {
+			cpuNanos -= p.last.CPUNanos
+		}
+		p.cpuNanos += cpuNanos
+		if cores := float64(cpuNanos) / float64(elapsed.Nanoseconds()); cores > p.CPUPeakCores {
+			p.CPUPeakCores = cores
+		}
+		p.IOReadBytes += counterDelta(stats.IOReadBytes, p.last.IOReadBytes)
+		p.IOWriteBytes += counterDelta(stats.IOWriteBytes, p.last.IOWriteBytes)
+		p.CPUAvgCores = float64(p.cpuNanos) / float64(at.Sub(p.firstAt).Nanoseconds())
+	}
+	p.last = stats
+	p.lastAt = at
+}
+
+func (p *TaskProfile) String() string {
+	return fmt.Sprintf("Samples=%d CPUPeak=%.2f CPUAvg=%.2f MemoryPeak=%dMiB IORead=%dMiB IOWrite=%dMiB",
+		p.Samples, p.CPUPeakCores, p.CPUAvgCores, p.MemoryPeakBytes/1024/1024,
+		p.IOReadBytes/1024/1024, p.IOWriteBytes/1024/1024)
+}
+
+func counterDelta(current int64, previous int64) int64 {
+	if current >= previous {
+		return current - previous
+	}
+	return current
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldAddResourceStatsToTaskProfile(t *testing.T) {
+	// GIVEN a task profile
+	profile := &TaskProfile{}
+	now := time.Now()
+
+	// WHEN adding stats sampled every second
+	profile.Add(now, &ResourceStats{CPUNanos: 1e9, MemoryBytes: 10, IOReadBytes: 100, IOWriteBytes: 10})
+	profile.Add(now.Add(time.Second), &ResourceStats{CPUNanos: 3e9, MemoryBytes: 30, IOReadBytes: 200, IOWriteBytes: 20})
+	// AND counters are restarted
+	profile.Add(now.Add(2*time.Second), &ResourceStats{CPUNanos: 1e9, MemoryBytes: 20, IOReadBytes: 50, IOWriteBytes: 5})
+	profile.Add(now, nil)
+
+	// THEN profile should have peaks and averages of usage between samples
+	require.Equal(t, 3, profile.Samples)
+	require.InDelta(t, 2.0, profile.CPUPeakCores, 0.001)
+	require.InDelta(t, 1.5, profile.CPUAvgCores, 0.001)
+	require.Equal(t, int64(30), profile.MemoryPeakBytes)
+	require.Equal(t, int64(250), profile.IOReadBytes)
+	require.Equal(t, int64(25), profile.IOWriteBytes)
+	require.Contains(t, profile.String(), "CPUPeak=2.00")
+}
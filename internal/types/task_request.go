@@ -18,6 +18,9 @@ type TaskAction string
 // ForkedJob constant
 const ForkedJob = "ForkedJob"
 
+// ParentTraceParent is the param of a forked job holding W3C traceparent of the task that forked it
+const ParentTraceParent = "ParentTraceParent"
+
 const (
 	// PING action
 	PING TaskAction = "PING"
@@ -29,6 +32,8 @@ const (
 	TERMINATE TaskAction = "TERMINATE_CONTAINER"
 	// LIST action
 	LIST TaskAction = "LIST_CONTAINERS"
+	// DRAIN action
+	DRAIN TaskAction = "DRAIN"
 )
 
 // TaskRequest specifies the parameters for a task that is dispatched to a remote ant-worker for execution.
@@ -93,6 +93,7 @@ type TaskResponse struct {
 	Warnings        []string               `json:"warnings"`
 	Stdout          []string               `json:"stdout"`
 	CostFactor      float64                `json:"cost_factor"`
+	Profile         *TaskProfile           `json:"profile"`
 	Timings         TaskResponseTimings    `json:"timings"`
 }
 
@@ -0,0 +1,7 @@
+-- +goose Up
+-- cron_schedule_serialized stores timezone, additional cron expressions, blackout windows and holiday
+-- calendars of a job so that the scheduler can compute cron ticks without loading raw_yaml.
+ALTER TABLE formicary_job_definitions ADD COLUMN cron_schedule_serialized TEXT;
+
+-- +goose Down
+ALTER TABLE formicary_job_definitions DROP COLUMN cron_schedule_serialized;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- formicary_cron_backfills tracks backfills of cron jobs over a date range and catch-up runs of
+-- ticks that were missed while the server was down.
+CREATE TABLE IF NOT EXISTS formicary_cron_backfills (
+    -- 26-char ULID string
+    id              VARCHAR(128) NOT NULL PRIMARY KEY,
+    job_type        VARCHAR(255) NOT NULL,
+    user_id         VARCHAR(128),
+    organization_id VARCHAR(128),
+    -- range of cron ticks, start is inclusive and end is exclusive
+    start_time      TIMESTAMP    NOT NULL,
+    end_time        TIMESTAMP    NOT NULL,
+    max_parallel    INTEGER      NOT NULL DEFAULT 1,
+    catchup         BOOLEAN      NOT NULL DEFAULT FALSE,
+    state           VARCHAR(64)  NOT NULL,
+    total_ticks     INTEGER      NOT NULL DEFAULT 0,
+    skipped_ticks   INTEGER      NOT NULL DEFAULT 0,
+    submitted_ticks INTEGER      NOT NULL DEFAULT 0,
+    error_message   TEXT,
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX formicary_cron_backfills_job_type_ndx
+    ON formicary_cron_backfills(job_type);
+
+-- Index on state for FindActive queries of the scheduler
+CREATE INDEX formicary_cron_backfills_state_ndx
+    ON formicary_cron_backfills(state);
+
+-- logical_date records the cron tick of a request so that backfills can skip ticks that already ran
+-- because user_key of a request is released when it finishes.
+ALTER TABLE formicary_job_requests ADD COLUMN logical_date TIMESTAMP NULL;
+
+CREATE INDEX formicary_job_requests_logical_date_ndx
+    ON formicary_job_requests(job_type, logical_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS formicary_job_requests_logical_date_ndx;
+ALTER TABLE formicary_job_requests DROP COLUMN logical_date;
+DROP TABLE IF EXISTS formicary_cron_backfills;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- formicary_trigger_dead_letters keeps queue and S3 notification events whose job request couldn't
+-- be submitted, e.g. due to bad template, validation error or rate limit, so that they can be
+-- replayed or discarded.
+CREATE TABLE IF NOT EXISTS formicary_trigger_dead_letters (
+    -- 26-char ULID string
+    id                VARCHAR(128) NOT NULL PRIMARY KEY,
+    job_definition_id VARCHAR(128) NOT NULL,
+    job_type          VARCHAR(255) NOT NULL,
+    trigger_name      VARCHAR(255) NOT NULL,
+    trigger_type      VARCHAR(50)  NOT NULL,
+    -- JSON encoded template context of the event
+    payload           TEXT         NOT NULL,
+    error_message     TEXT,
+    -- FAILED, REPLAYED or DISCARDED
+    state             VARCHAR(50)  NOT NULL,
+    attempts          INTEGER      NOT NULL DEFAULT 0,
+    job_request_id    VARCHAR(128),
+    replayed_at       TIMESTAMP    NULL,
+    created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_trigger_dead_letters_job_def
+        FOREIGN KEY (job_definition_id) REFERENCES formicary_job_definitions(id)
+        ON DELETE CASCADE
+);
+
+-- Index for listing dead-letters of a job definition
+CREATE INDEX formicary_trigger_dead_letters_job_ndx
+    ON formicary_trigger_dead_letters(job_definition_id, state);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_trigger_dead_letters;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- stage records the approval stage a vote counts toward and on_behalf_of the away approver when
+-- the vote was cast by their delegate.
+ALTER TABLE formicary_approval_votes ADD COLUMN stage VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE formicary_approval_votes ADD COLUMN on_behalf_of VARCHAR(128) NOT NULL DEFAULT '';
+ALTER TABLE formicary_approval_policies ADD COLUMN escalation_voting BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- formicary_approval_delegations lets a delegate vote on behalf of an approver who is out of office.
+CREATE TABLE IF NOT EXISTS formicary_approval_delegations (
+    -- 26-char ULID string
+    id              VARCHAR(128) NOT NULL PRIMARY KEY,
+    delegator_id    VARCHAR(128) NOT NULL,
+    delegator_name  VARCHAR(255) NOT NULL DEFAULT '',
+    -- serialized roles of the delegator when the delegation was created
+    delegator_roles TEXT,
+    delegate_id     VARCHAR(128) NOT NULL,
+    organization_id VARCHAR(128),
+    starts_at       TIMESTAMP    NOT NULL,
+    ends_at         TIMESTAMP    NOT NULL,
+    reason          TEXT,
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX formicary_approval_delegations_delegator_ndx
+    ON formicary_approval_delegations(delegator_id, ends_at);
+CREATE INDEX formicary_approval_delegations_delegate_ndx
+    ON formicary_approval_delegations(delegate_id, ends_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_approval_delegations;
+ALTER TABLE formicary_approval_policies DROP COLUMN escalation_voting;
+ALTER TABLE formicary_approval_votes DROP COLUMN on_behalf_of;
+ALTER TABLE formicary_approval_votes DROP COLUMN stage;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- formicary_approval_action_redemptions records the use of signed approve/reject links from
+-- email and Slack notifications so that a link cannot be replayed by the same user.
+CREATE TABLE IF NOT EXISTS formicary_approval_action_redemptions (
+    -- 26-char ULID string
+    id          VARCHAR(128) NOT NULL PRIMARY KEY,
+    action_id   VARCHAR(128) NOT NULL,
+    user_id     VARCHAR(128) NOT NULL,
+    request_id  VARCHAR(128) NOT NULL DEFAULT '',
+    task_type   VARCHAR(100) NOT NULL DEFAULT '',
+    decision    VARCHAR(20)  NOT NULL DEFAULT '',
+    channel     VARCHAR(20)  NOT NULL DEFAULT '',
+    redeemed_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX formicary_approval_action_redemptions_ndx
+    ON formicary_approval_action_redemptions(action_id, user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_approval_action_redemptions;
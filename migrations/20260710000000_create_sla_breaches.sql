@@ -0,0 +1,29 @@
+-- +goose Up
+-- formicary_sla_breaches records job requests and tasks that didn't meet the SLA of their job definition.
+-- A request is recorded at most once per task and kind so that recipients are notified once.
+CREATE TABLE IF NOT EXISTS formicary_sla_breaches (
+    -- 26-char ULID string
+    id              VARCHAR(128) NOT NULL PRIMARY KEY,
+    job_request_id  VARCHAR(128) NOT NULL,
+    job_type        VARCHAR(255) NOT NULL,
+    user_id         VARCHAR(128),
+    organization_id VARCHAR(128),
+    -- empty for SLA of the job
+    task_type       VARCHAR(100) NOT NULL DEFAULT '',
+    -- QUEUE_WAIT, RUN_TIME or FINISH_BY
+    kind            VARCHAR(64)  NOT NULL,
+    expected        VARCHAR(100),
+    deadline        TIMESTAMP    NOT NULL,
+    job_state       VARCHAR(64),
+    created_at      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- unique index rejects a breach recorded concurrently by another server
+CREATE UNIQUE INDEX formicary_sla_breaches_request_ndx
+    ON formicary_sla_breaches(job_request_id, task_type, kind);
+
+CREATE INDEX formicary_sla_breaches_job_type_ndx
+    ON formicary_sla_breaches(job_type);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_sla_breaches;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- cost records the price of compute and artifact storage of a task execution.
+ALTER TABLE formicary_task_executions ADD COLUMN cost DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- formicary_cost_records records cost of resources used by task executions for chargeback of
+-- organizations, users, job types and tags.
+CREATE TABLE IF NOT EXISTS formicary_cost_records (
+    -- 26-char ULID string
+    id                VARCHAR(128) NOT NULL PRIMARY KEY,
+    -- COMPUTE, STORAGE or EGRESS
+    kind              VARCHAR(64)  NOT NULL,
+    job_request_id    VARCHAR(128),
+    job_execution_id  VARCHAR(128),
+    task_execution_id VARCHAR(128),
+    job_type          VARCHAR(255),
+    task_type         VARCHAR(100),
+    method            VARCHAR(64),
+    -- comma separated tags of the task
+    tags              TEXT,
+    user_id           VARCHAR(128),
+    organization_id   VARCHAR(128),
+    cpu_secs          DOUBLE PRECISION NOT NULL DEFAULT 0,
+    memory_gb_secs    DOUBLE PRECISION NOT NULL DEFAULT 0,
+    storage_gb_days   DOUBLE PRECISION NOT NULL DEFAULT 0,
+    egress_gb         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    cost              DOUBLE PRECISION NOT NULL DEFAULT 0,
+    currency          VARCHAR(16),
+    created_at        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX formicary_cost_records_created_ndx
+    ON formicary_cost_records(created_at);
+CREATE INDEX formicary_cost_records_task_ndx
+    ON formicary_cost_records(task_execution_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_cost_records;
+ALTER TABLE formicary_task_executions DROP COLUMN cost;
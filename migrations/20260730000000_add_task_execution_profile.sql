@@ -0,0 +1,14 @@
+-- +goose Up
+-- resources used by task executions as sampled by ants, which are used for right-sizing containers of tasks.
+ALTER TABLE formicary_task_executions ADD COLUMN cpu_peak_cores DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE formicary_task_executions ADD COLUMN cpu_avg_cores DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE formicary_task_executions ADD COLUMN memory_peak_bytes BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE formicary_task_executions ADD COLUMN io_read_bytes BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE formicary_task_executions ADD COLUMN io_write_bytes BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE formicary_task_executions DROP COLUMN io_write_bytes;
+ALTER TABLE formicary_task_executions DROP COLUMN io_read_bytes;
+ALTER TABLE formicary_task_executions DROP COLUMN memory_peak_bytes;
+ALTER TABLE formicary_task_executions DROP COLUMN cpu_avg_cores;
+ALTER TABLE formicary_task_executions DROP COLUMN cpu_peak_cores;
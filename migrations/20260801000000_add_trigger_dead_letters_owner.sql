@@ -0,0 +1,18 @@
+-- +goose Up
+-- dead-letters are looked up by job type and owner so that they can be replayed after the job definition
+-- is updated, which creates a new version with another id.
+ALTER TABLE formicary_trigger_dead_letters ADD COLUMN organization_id VARCHAR(128) NOT NULL DEFAULT '';
+ALTER TABLE formicary_trigger_dead_letters ADD COLUMN user_id VARCHAR(128) NOT NULL DEFAULT '';
+UPDATE formicary_trigger_dead_letters SET
+    organization_id = COALESCE((SELECT d.organization_id FROM formicary_job_definitions d
+                                WHERE d.id = formicary_trigger_dead_letters.job_definition_id), ''),
+    user_id = COALESCE((SELECT d.user_id FROM formicary_job_definitions d
+                        WHERE d.id = formicary_trigger_dead_letters.job_definition_id), '');
+
+CREATE INDEX formicary_trigger_dead_letters_job_type_ndx
+    ON formicary_trigger_dead_letters(job_type, organization_id, user_id, state);
+
+-- +goose Down
+DROP INDEX IF EXISTS formicary_trigger_dead_letters_job_type_ndx;
+ALTER TABLE formicary_trigger_dead_letters DROP COLUMN user_id;
+ALTER TABLE formicary_trigger_dead_letters DROP COLUMN organization_id;
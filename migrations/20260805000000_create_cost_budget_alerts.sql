@@ -0,0 +1,17 @@
+-- +goose Up
+-- formicary_cost_budget_alerts records budgets that were notified in their period so that recipients are
+-- notified once even after the server restarts.
+CREATE TABLE IF NOT EXISTS formicary_cost_budget_alerts (
+    -- 26-char ULID string
+    id           VARCHAR(128) NOT NULL PRIMARY KEY,
+    budget       VARCHAR(255) NOT NULL,
+    period_start TIMESTAMP    NOT NULL,
+    spent        DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX formicary_cost_budget_alerts_period_ndx
+    ON formicary_cost_budget_alerts(budget, period_start);
+
+-- +goose Down
+DROP TABLE IF EXISTS formicary_cost_budget_alerts;
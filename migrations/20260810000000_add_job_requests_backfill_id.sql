@@ -0,0 +1,27 @@
+-- +goose Up
+-- backfill_id marks requests submitted by a backfill or catch-up, which users cannot set unlike params.
+ALTER TABLE formicary_job_requests ADD COLUMN backfill_id VARCHAR(128);
+
+-- a cron tick of a job runs at most once even if catch-ups of multiple servers race, so duplicate
+-- ticks of existing requests are cleared before the index is made unique.
+UPDATE formicary_job_requests SET logical_date = NULL
+WHERE logical_date IS NOT NULL AND id NOT IN (
+    SELECT id FROM (
+        SELECT MIN(id) AS id FROM formicary_job_requests
+        WHERE logical_date IS NOT NULL
+        GROUP BY job_type, organization_id, user_id, logical_date
+    ) AS first_ticks
+);
+
+DROP INDEX IF EXISTS formicary_job_requests_logical_date_ndx;
+
+CREATE UNIQUE INDEX formicary_job_requests_logical_date_ndx
+    ON formicary_job_requests(job_type, organization_id, user_id, logical_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS formicary_job_requests_logical_date_ndx;
+
+CREATE INDEX formicary_job_requests_logical_date_ndx
+    ON formicary_job_requests(job_type, logical_date);
+
+ALTER TABLE formicary_job_requests DROP COLUMN backfill_id;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- delegated votes are authorized against current roles of the approver instead of roles that were
+-- captured when the delegation was created.
+ALTER TABLE formicary_approval_delegations DROP COLUMN delegator_roles;
+
+-- +goose Down
+ALTER TABLE formicary_approval_delegations ADD COLUMN delegator_roles TEXT;
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+package approval
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+// ActionLinks issues and redeems signed approve/reject links that let approvers vote from
+// email notifications and Slack buttons without logging into the dashboard. A link is
+// the base64url encoded claims followed by their HMAC-SHA256 signature; it expires after
+// the configured TTL and each user can use it only once.
+type ActionLinks struct {
+	secret     []byte
+	ttl        time.Duration
+	repository Repository
+}
+
+// NewActionLinks creates action links signed with the given secret.
+func NewActionLinks(secret string, ttl time.Duration, repository Repository) (*ActionLinks, error) {
+	if len(secret) < 16 {
+		return nil, fmt.Errorf("approval action link secret must be at least 16 characters")
+	}
+	if ttl <= 0 {
+		ttl = types.DefaultApprovalActionLinkTTL
+	}
+	return &ActionLinks{secret: []byte(secret), ttl: ttl, repository: repository}, nil
+}
+
+// Issue returns the approve and reject tokens for a task awaiting approval. The recipient is
+// the email the links are sent to, which identifies the voter; it is empty for Slack buttons.
+func (l *ActionLinks) Issue(requestID, taskType, recipient string) (approve string, reject string, err error) {
+	claims := &types.ApprovalActionClaims{
+		ID:        ulid.Make().String(),
+		RequestID: requestID,
+		TaskType:  taskType,
+		Recipient: strings.ToLower(strings.TrimSpace(recipient)),
+		ExpiresAt: time.Now().Add(l.ttl).Unix(),
+	}
+	claims.Decision = types.ApprovalDecisionApproved
+	if approve, err = l.sign(claims); err != nil {
+		return "", "", err
+	}
+	claims.Decision = types.ApprovalDecisionRejected
+	if reject, err = l.sign(claims); err != nil {
+		return "", "", err
+	}
+	return approve, reject, nil
+}
+
+// Verify checks the signature and expiration of a token and returns its claims.
+func (l *ActionLinks) Verify(token string) (*types.ApprovalActionClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, common.NewPermissionError("malformed approval link")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, common.NewPermissionError("malformed approval link")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, l.mac(payload)) {
+		return nil, common.NewPermissionError("invalid approval link signature")
+	}
+	claims := &types.ApprovalActionClaims{}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, common.NewPermissionError("malformed approval link")
+	}
+	if err = claims.Validate(); err != nil {
+		return nil, common.NewPermissionError(fmt.Sprintf("invalid approval link due to %s", err))
+	}
+	if claims.Expired(time.Now()) {
+		return nil, common.NewPermissionError("approval link has expired")
+	}
+	return claims, nil
+}
+
+// Redeem marks the link as used by the user and returns a ConflictError if the user
+// already used it.
+func (l *ActionLinks) Redeem(
+	claims *types.ApprovalActionClaims,
+	userID string,
+	channel string) (*types.ApprovalActionRedemption, error) {
+	return l.repository.SaveActionRedemption(&types.ApprovalActionRedemption{
+		ActionID:  claims.ID,
+		UserID:    userID,
+		RequestID: claims.RequestID,
+		TaskType:  claims.TaskType,
+		Decision:  claims.Decision,
+		Channel:   channel,
+	})
+}
+
+// Release undoes a redemption when the vote could not be cast so that the user can retry.
+func (l *ActionLinks) Release(redemption *types.ApprovalActionRedemption) error {
+	return l.repository.DeleteActionRedemption(redemption.ActionID, redemption.UserID)
+}
+
+func (l *ActionLinks) sign(claims *types.ApprovalActionClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(l.mac(payload)), nil
+}
+
+func (l *ActionLinks) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, l.secret)
+	h.Write(payload)
+	return h.Sum(nil)
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+package approval
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+func newTestActionLinks(t *testing.T, ttl time.Duration) *ActionLinks {
+	t.Helper()
+	links, err := NewActionLinks("test-approval-link-secret", ttl, newTestRepo(t))
+	require.NoError(t, err)
+	return links
+}
+
+func Test_ShouldRequireStrongActionLinkSecret(t *testing.T) {
+	// GIVEN/WHEN: a short secret
+	_, err := NewActionLinks("short", time.Hour, nil)
+	// THEN: it's rejected
+	require.Error(t, err)
+}
+
+func Test_ShouldIssueAndVerifyActionLinks(t *testing.T) {
+	// GIVEN: approve/reject links for a recipient
+	links := newTestActionLinks(t, time.Hour)
+	approve, reject, err := links.Issue("req-1", "deploy", "Approver@Example.com")
+	require.NoError(t, err)
+
+	// WHEN: verifying them
+	approveClaims, err := links.Verify(approve)
+	require.NoError(t, err)
+	rejectClaims, err := links.Verify(reject)
+	require.NoError(t, err)
+
+	// THEN: both links share the same id and carry their decision
+	assert.Equal(t, approveClaims.ID, rejectClaims.ID)
+	assert.Equal(t, "req-1", approveClaims.RequestID)
+	assert.Equal(t, "deploy", approveClaims.TaskType)
+	assert.Equal(t, "approver@example.com", approveClaims.Recipient)
+	assert.Equal(t, types.ApprovalDecisionApproved, approveClaims.Decision)
+	assert.Equal(t, types.ApprovalDecisionRejected, rejectClaims.Decision)
+}
+
+func Test_ShouldRejectTamperedOrExpiredActionLinks(t *testing.T) {
+	// GIVEN: a reject link
+	links := newTestActionLinks(t, time.Hour)
+	approve, reject, err := links.Issue("req-1", "deploy", "approver@example.com")
+	require.NoError(t, err)
+
+	// WHEN: combining the claims of the reject link with the signature of the approve link
+	tampered := strings.Split(reject, ".")[0] + "." + strings.Split(approve, ".")[1]
+	_, err = links.Verify(tampered)
+	// THEN: signature doesn't match
+	require.Error(t, err)
+	assert.IsType(t, &common.PermissionError{}, err)
+
+	// AND: links signed with another secret are rejected
+	other, err := NewActionLinks("another-approval-link-secret", time.Hour, nil)
+	require.NoError(t, err)
+	_, err = other.Verify(approve)
+	require.Error(t, err)
+
+	// AND: malformed links are rejected
+	_, err = links.Verify("not-a-token")
+	require.Error(t, err)
+
+	// AND: expired links are rejected
+	expiredLinks := newTestActionLinks(t, time.Hour)
+	expiredLinks.ttl = -time.Minute
+	expired, _, err := expiredLinks.Issue("req-1", "deploy", "approver@example.com")
+	require.NoError(t, err)
+	_, err = links.Verify(expired)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+}
+
+func Test_ShouldRedeemActionLinkOncePerUser(t *testing.T) {
+	// GIVEN: a link shared by a slack channel
+	links := newTestActionLinks(t, time.Hour)
+	approve, reject, err := links.Issue("req-1", "deploy", "")
+	require.NoError(t, err)
+	approveClaims, err := links.Verify(approve)
+	require.NoError(t, err)
+	rejectClaims, err := links.Verify(reject)
+	require.NoError(t, err)
+
+	// WHEN: a user redeems the approve link
+	redemption, err := links.Redeem(approveClaims, "user-1", "slack")
+	require.NoError(t, err)
+
+	// THEN: the same user cannot use it again or use the reject link of the same notification
+	_, err = links.Redeem(approveClaims, "user-1", "slack")
+	require.Error(t, err)
+	assert.IsType(t, &common.ConflictError{}, err)
+	_, err = links.Redeem(rejectClaims, "user-1", "slack")
+	require.Error(t, err)
+
+	// AND: another user of the channel can use it
+	_, err = links.Redeem(approveClaims, "user-2", "slack")
+	require.NoError(t, err)
+
+	// AND: a released redemption can be retried
+	require.NoError(t, links.Release(redemption))
+	_, err = links.Redeem(approveClaims, "user-1", "slack")
+	require.NoError(t, err)
+}
@@ -43,6 +43,36 @@ type Repository interface {
 	// ResolveDeadlineForTask marks all unresolved deadlines for a task execution as resolved.
 	ResolveDeadlineForTask(taskExecutionID string) error
 
+	// IsDeadlineEscalated returns true if the task execution has an unresolved deadline that was escalated.
+	IsDeadlineEscalated(taskExecutionID string) (bool, error)
+
+	// SaveDelegation persists an out-of-office delegation.
+	SaveDelegation(delegation *types.ApprovalDelegation) (*types.ApprovalDelegation, error)
+
+	// GetDelegation loads a delegation by id.
+	GetDelegation(id string) (*types.ApprovalDelegation, error)
+
+	// FindDelegations returns unexpired delegations where the user is the delegator or the delegate.
+	FindDelegations(userID string, now time.Time) ([]*types.ApprovalDelegation, error)
+
+	// FindActiveDelegation returns the delegation from delegator to delegate of the organization in effect at
+	// the given time (nil if none).
+	FindActiveDelegation(organizationID, delegatorID, delegateID string, now time.Time) (*types.ApprovalDelegation, error)
+
+	// FindActiveUser returns the active user of the organization (nil if none), e.g. to authorize a
+	// delegated vote against current roles of the approver.
+	FindActiveUser(organizationID, userID string) (*common.User, error)
+
+	// DeleteDelegation removes a delegation.
+	DeleteDelegation(id string) error
+
+	// SaveActionRedemption records the use of an approve/reject link by a user. Returns a
+	// ConflictError if the user already used the link.
+	SaveActionRedemption(redemption *types.ApprovalActionRedemption) (*types.ApprovalActionRedemption, error)
+
+	// DeleteActionRedemption removes the redemption of a link by a user so that it can be used again.
+	DeleteActionRedemption(actionID, userID string) error
+
 	// FindPendingApprovals returns task executions in MANUAL_APPROVAL_REQUIRED state.
 	FindPendingApprovals(qc *common.QueryContext, page, pageSize int) ([]*types.PendingApproval, int64, error)
 }
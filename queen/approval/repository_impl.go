@@ -170,6 +170,126 @@ func (r *RepositoryImpl) ResolveDeadlineForTask(taskExecutionID string) error {
 		Updates(map[string]interface{}{"resolved": true}).Error
 }
 
+// IsDeadlineEscalated returns true if an open deadline of the task execution was escalated.
+func (r *RepositoryImpl) IsDeadlineEscalated(taskExecutionID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&types.ApprovalDeadline{}).
+		Where("task_execution_id = ? AND resolved = ? AND escalated = ?", taskExecutionID, false, true).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// SaveDelegation persists an out-of-office delegation.
+func (r *RepositoryImpl) SaveDelegation(delegation *types.ApprovalDelegation) (*types.ApprovalDelegation, error) {
+	if err := delegation.Validate(); err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	if delegation.ID == "" {
+		delegation.ID = ulid.Make().String()
+	}
+	if delegation.CreatedAt.IsZero() {
+		delegation.CreatedAt = time.Now()
+	}
+	if res := r.db.Save(delegation); res.Error != nil {
+		return nil, res.Error
+	}
+	return delegation, nil
+}
+
+// GetDelegation loads a delegation by id.
+func (r *RepositoryImpl) GetDelegation(id string) (*types.ApprovalDelegation, error) {
+	var delegation types.ApprovalDelegation
+	res := r.db.Where("id = ?", id).First(&delegation)
+	if res.Error != nil {
+		if res.Error == gorm.ErrRecordNotFound {
+			return nil, common.NewNotFoundError(fmt.Errorf("approval delegation %s is not found", id))
+		}
+		return nil, res.Error
+	}
+	return &delegation, nil
+}
+
+// FindDelegations returns unexpired delegations of the user as delegator or delegate ordered by start time.
+func (r *RepositoryImpl) FindDelegations(userID string, now time.Time) ([]*types.ApprovalDelegation, error) {
+	var delegations []*types.ApprovalDelegation
+	err := r.db.Where("(delegator_id = ? OR delegate_id = ?) AND ends_at > ?", userID, userID, now).
+		Order("starts_at ASC").Find(&delegations).Error
+	return delegations, err
+}
+
+// FindActiveDelegation returns the delegation from delegator to delegate of the organization in effect at
+// the given time.
+func (r *RepositoryImpl) FindActiveDelegation(
+	organizationID, delegatorID, delegateID string, now time.Time) (*types.ApprovalDelegation, error) {
+	var delegations []*types.ApprovalDelegation
+	if err := r.db.Where(
+		"organization_id = ? AND delegator_id = ? AND delegate_id = ? AND starts_at <= ? AND ends_at > ?",
+		organizationID, delegatorID, delegateID, now, now).Limit(1).Find(&delegations).Error; err != nil {
+		return nil, err
+	}
+	if len(delegations) == 0 {
+		return nil, nil
+	}
+	return delegations[0], nil
+}
+
+// FindActiveUser returns the active user of the organization.
+func (r *RepositoryImpl) FindActiveUser(organizationID, userID string) (*common.User, error) {
+	var users []*common.User
+	if err := r.db.Where("id = ? AND organization_id = ? AND active = ?", userID, organizationID, true).
+		Limit(1).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return users[0], nil
+}
+
+// DeleteDelegation removes a delegation.
+func (r *RepositoryImpl) DeleteDelegation(id string) error {
+	res := r.db.Where("id = ?", id).Delete(&types.ApprovalDelegation{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return common.NewNotFoundError(fmt.Errorf("approval delegation %s is not found", id))
+	}
+	return nil
+}
+
+// SaveActionRedemption records the use of an approve/reject link by a user.
+func (r *RepositoryImpl) SaveActionRedemption(
+	redemption *types.ApprovalActionRedemption) (*types.ApprovalActionRedemption, error) {
+	if redemption.ActionID == "" || redemption.UserID == "" {
+		return nil, common.NewValidationError(fmt.Errorf("action_id and user_id are required"))
+	}
+	if redemption.ID == "" {
+		redemption.ID = ulid.Make().String()
+	}
+	if redemption.RedeemedAt.IsZero() {
+		redemption.RedeemedAt = time.Now()
+	}
+	// OnConflict DoNothing lets concurrent clicks race safely: only one of them inserts the row.
+	res := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "action_id"}, {Name: "user_id"}},
+		DoNothing: true,
+	}).Create(redemption)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, common.NewConflictError("approval link has already been used")
+	}
+	return redemption, nil
+}
+
+// DeleteActionRedemption removes the redemption of a link by a user.
+func (r *RepositoryImpl) DeleteActionRedemption(actionID, userID string) error {
+	return r.db.Where("action_id = ? AND user_id = ?", actionID, userID).
+		Delete(&types.ApprovalActionRedemption{}).Error
+}
+
 // FindPendingApprovals returns PendingApproval records for all task executions in
 // MANUAL_APPROVAL_REQUIRED state, scoped to the query context's org/user.
 func (r *RepositoryImpl) FindPendingApprovals(
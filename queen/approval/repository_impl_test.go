@@ -375,3 +375,49 @@ func Test_ShouldFindPendingApprovalsWithCorrectJoin(t *testing.T) {
 	}
 	assert.True(t, found, "task in MANUAL_APPROVAL_REQUIRED must appear in pending list")
 }
+
+func Test_ShouldFindActiveDelegationWithinPeriod(t *testing.T) {
+	// GIVEN: a delegation starting in an hour
+	repo := newTestRepo(t)
+	delegator := fmt.Sprintf("delegator-%d", time.Now().UnixNano())
+	start := time.Now().Add(time.Hour)
+	saved, err := repo.SaveDelegation(&types.ApprovalDelegation{
+		DelegatorID:    delegator,
+		DelegateID:     "delegate",
+		OrganizationID: "org",
+		StartsAt:       start,
+		EndsAt:         start.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.ID)
+
+	// WHEN/THEN: it's only active between start and end
+	active, err := repo.FindActiveDelegation("org", delegator, "delegate", time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, active)
+	active, err = repo.FindActiveDelegation("org", delegator, "delegate", start.Add(time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, saved.ID, active.ID)
+	// AND: it's not found for another organization
+	active, err = repo.FindActiveDelegation("other-org", delegator, "delegate", start.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, active)
+	active, err = repo.FindActiveDelegation("org", delegator, "delegate", start.Add(48*time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, active)
+
+	// AND: upcoming delegation is listed for the delegator
+	all, err := repo.FindDelegations(delegator, time.Now())
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	// AND: delegation to self is invalid
+	_, err = repo.SaveDelegation(&types.ApprovalDelegation{
+		DelegatorID: delegator,
+		DelegateID:  delegator,
+		StartsAt:    start,
+		EndsAt:      start.Add(time.Hour),
+	})
+	require.Error(t, err)
+}
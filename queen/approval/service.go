@@ -19,8 +19,9 @@ import (
 // Service handles multi-party approval logic: casting votes, evaluating quorum,
 // resolving task/job state transitions, and SLA deadline enforcement.
 type Service struct {
-	db         *gorm.DB
-	repository Repository
+	db          *gorm.DB
+	repository  Repository
+	actionLinks *ActionLinks
 }
 
 // NewService creates a new approval service.
@@ -28,6 +29,16 @@ func NewService(db *gorm.DB, repository Repository) *Service {
 	return &Service{db: db, repository: repository}
 }
 
+// SetActionLinks enables signed approve/reject links in notifications.
+func (s *Service) SetActionLinks(actionLinks *ActionLinks) {
+	s.actionLinks = actionLinks
+}
+
+// ActionLinks returns the signed approve/reject links (nil if not enabled).
+func (s *Service) ActionLinks() *ActionLinks {
+	return s.actionLinks
+}
+
 // CastVote records a vote for the given task execution and resolves quorum if reached.
 // Returns the current ApprovalStatus after the vote.
 func (s *Service) CastVote(
@@ -41,6 +52,27 @@ func (s *Service) CastVote(
 	voterName string,
 	decision types.ApprovalDecision,
 	comments string,
+) (*types.ApprovalStatus, error) {
+	return s.CastDelegatedVote(ctx, qc, request, taskType, taskExecutionID, policy,
+		voterID, voterName, "", decision, comments)
+}
+
+// CastDelegatedVote records a vote cast by voterID on behalf of the away approver onBehalfOf,
+// which requires an active delegation from the approver to the voter. An empty onBehalfOf casts
+// the voter's own vote. Votes count toward the current stage of the policy; the task is approved
+// once the quorum of the last stage is reached.
+func (s *Service) CastDelegatedVote(
+	ctx context.Context,
+	qc *common.QueryContext,
+	request *types.JobRequest,
+	taskType string,
+	taskExecutionID string,
+	policy *types.ApprovalPolicy,
+	voterID string,
+	voterName string,
+	onBehalfOf string,
+	decision types.ApprovalDecision,
+	comments string,
 ) (*types.ApprovalStatus, error) {
 	if request == nil {
 		return nil, common.NewValidationError(fmt.Errorf("job request is required"))
@@ -57,6 +89,9 @@ func (s *Service) CastVote(
 	if decision != types.ApprovalDecisionApproved && decision != types.ApprovalDecisionRejected {
 		return nil, common.NewValidationError(fmt.Errorf("decision must be APPROVED or REJECTED"))
 	}
+	if onBehalfOf == voterID {
+		onBehalfOf = ""
+	}
 
 	// Verify job is in MANUAL_APPROVAL_REQUIRED state.
 	if request.JobState != common.MANUAL_APPROVAL_REQUIRED {
@@ -64,16 +99,37 @@ func (s *Service) CastVote(
 			"job request %s is not awaiting approval (state: %s)", request.ID, request.JobState))
 	}
 
-	// Check voter authorization — always, default-deny when policy is nil.
-	if err := s.authorizeVoter(qc, policy, voterID); err != nil {
+	votes, err := s.repository.GetVotes(taskExecutionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+	before := tallyStages(policy, votes)
+	stage := before.definitions[before.current]
+
+	// Check voter authorization for the current stage — always, default-deny when policy is nil.
+	if err := s.authorizeVoter(qc, policy, stage, taskExecutionID, voterID, onBehalfOf); err != nil {
 		return nil, err
 	}
 
-	// Idempotency: if this voter already voted, return the current status without re-processing.
-	if alreadyVoted, err := s.repository.HasVoted(taskExecutionID, voterID); err != nil {
-		return nil, fmt.Errorf("failed to check prior vote: %w", err)
-	} else if alreadyVoted {
-		return s.GetStatus(taskExecutionID, request.ID, policy)
+	// Idempotency: if this voter already voted for the same approver, return the current status
+	// without re-processing. Otherwise each voter and each approver may only vote once per task,
+	// so that a delegate cannot count twice and a single person cannot approve multiple stages.
+	principal := voterID
+	if onBehalfOf != "" {
+		principal = onBehalfOf
+	}
+	for _, v := range votes {
+		if v.VoterID == voterID && v.Principal() == principal {
+			return s.buildStatus(taskExecutionID, request.ID, policy, votes, false), nil
+		}
+		if v.VoterID == voterID {
+			return nil, common.NewConflictError(fmt.Sprintf(
+				"voter %s has already voted on this task", voterID))
+		}
+		if v.Principal() == principal {
+			return nil, common.NewConflictError(fmt.Sprintf(
+				"approver %s has already voted on this task", principal))
+		}
 	}
 
 	// Rejection requires a comment explaining why.
@@ -87,6 +143,8 @@ func (s *Service) CastVote(
 		JobRequestID:    request.ID,
 		VoterID:         voterID,
 		VoterName:       voterName,
+		OnBehalfOf:      onBehalfOf,
+		Stage:           stage.Name,
 		Decision:        decision,
 		Comments:        comments,
 		VotedAt:         time.Now(),
@@ -95,72 +153,47 @@ func (s *Service) CastVote(
 		return nil, fmt.Errorf("failed to record vote: %w", err)
 	}
 
-	// Get updated vote counts.
-	approvals, rejections, err := s.repository.CountVotes(taskExecutionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to count votes: %w", err)
-	}
-
 	allVotes, err := s.repository.GetVotes(taskExecutionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get votes: %w", err)
 	}
-
-	minRequired := 1
-	if policy != nil {
-		minRequired = policy.MinApprovals
-	}
-
-	status := &types.ApprovalStatus{
-		TaskExecutionID:      taskExecutionID,
-		JobRequestID:         request.ID,
-		ApprovalsReceived:    approvals,
-		RejectionsReceived:   rejections,
-		MinApprovalsRequired: minRequired,
-		Votes:                allVotes,
-		Policy:               policy,
-	}
-
-	// Evaluate rejection threshold.
-	rejected := s.isRejected(approvals, rejections, policy)
-	// Evaluate quorum.
-	quorumReached := approvals >= minRequired
-
-	if rejected {
-		status.Rejected = true
+	after := tallyStages(policy, allVotes)
+	status := s.buildStatus(taskExecutionID, request.ID, policy, allVotes, false)
+	fields := logrus.Fields{
+		"Component":   "ApprovalService",
+		"RequestID":   request.ID,
+		"TaskType":    taskType,
+		"VoterID":     voterID,
+		"OnBehalfOf":  onBehalfOf,
+		"Stage":       stage.Name,
+		"Approvals":   status.ApprovalsReceived,
+		"Rejections":  status.RejectionsReceived,
+		"MinRequired": status.MinApprovalsRequired,
+	}
+
+	if after.rejected {
 		if err := s.finalizeRejection(ctx, qc, request, taskType, taskExecutionID, voterID, comments); err != nil {
 			return status, err
 		}
-		logrus.WithFields(logrus.Fields{
-			"Component":  "ApprovalService",
-			"RequestID":  request.ID,
-			"TaskType":   taskType,
-			"VoterID":    voterID,
-			"Approvals":  approvals,
-			"Rejections": rejections,
-		}).Info("Approval rejected")
-	} else if quorumReached {
-		status.QuorumReached = true
+		logrus.WithFields(fields).Info("Approval rejected")
+	} else if after.approved {
 		if err := s.finalizeApproval(ctx, qc, request, taskType, taskExecutionID, voterID, comments); err != nil {
 			return status, err
 		}
-		logrus.WithFields(logrus.Fields{
-			"Component":   "ApprovalService",
-			"RequestID":   request.ID,
-			"TaskType":    taskType,
-			"VoterID":     voterID,
-			"Approvals":   approvals,
-			"MinRequired": minRequired,
-		}).Info("Approval quorum reached")
+		logrus.WithFields(fields).Info("Approval quorum reached")
+	} else if after.current > before.current {
+		// The SLA applies to each stage, so the deadline of the completed stage is replaced by a
+		// new deadline for the next stage, which also ends escalation voting of the completed stage.
+		if err := s.repository.ResolveDeadlineForTask(taskExecutionID); err != nil {
+			return status, fmt.Errorf("failed to resolve deadline of completed stage: %w", err)
+		}
+		if err := s.CreateDeadlineIfNeeded(taskExecutionID, request.ID, policy); err != nil {
+			return status, fmt.Errorf("failed to create deadline of next stage: %w", err)
+		}
+		logrus.WithFields(fields).Infof("Approval stage completed, waiting for stage %s", status.CurrentStage)
 	} else {
-		logrus.WithFields(logrus.Fields{
-			"Component":   "ApprovalService",
-			"RequestID":   request.ID,
-			"TaskType":    taskType,
-			"VoterID":     voterID,
-			"Approvals":   approvals,
-			"MinRequired": minRequired,
-		}).Infof("Vote recorded, waiting for quorum (%d/%d)", approvals, minRequired)
+		logrus.WithFields(fields).Infof("Vote recorded, waiting for quorum (%d/%d)",
+			status.ApprovalsReceived, status.MinApprovalsRequired)
 	}
 
 	return status, nil
@@ -176,32 +209,67 @@ func (s *Service) GetStatus(
 	if err != nil {
 		return nil, err
 	}
+	escalated, err := s.repository.IsDeadlineEscalated(taskExecutionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildStatus(taskExecutionID, jobRequestID, policy, votes, escalated), nil
+}
 
-	var approvals, rejections int
-	for _, v := range votes {
-		if v.IsApproved() {
-			approvals++
-		} else {
-			rejections++
-		}
+// CreateDelegation lets the authenticated approver delegate their votes to another user while
+// they are out of office. The delegate can vote on role-restricted stages on their behalf as long
+// as the approver still has the role when the vote is cast.
+func (s *Service) CreateDelegation(
+	qc *common.QueryContext,
+	delegation *types.ApprovalDelegation,
+) (*types.ApprovalDelegation, error) {
+	if qc.GetUserID() == "" {
+		return nil, common.NewPermissionError(fmt.Errorf("approval delegation requires an authenticated user"))
+	}
+	if delegation == nil {
+		return nil, common.NewValidationError(fmt.Errorf("approval delegation is required"))
+	}
+	if !delegation.EndsAt.After(time.Now()) {
+		return nil, common.NewValidationError(fmt.Errorf("ends_at must be in the future"))
+	}
+	delegation.ID = ""
+	delegation.CreatedAt = time.Time{}
+	delegation.DelegatorID = qc.GetUserID()
+	delegation.DelegatorName = qc.GetUsername()
+	delegation.OrganizationID = qc.GetOrganizationID()
+	saved, err := s.repository.SaveDelegation(delegation)
+	if err != nil {
+		return nil, err
 	}
+	logrus.WithFields(logrus.Fields{
+		"Component":   "ApprovalService",
+		"DelegatorID": saved.DelegatorID,
+		"DelegateID":  saved.DelegateID,
+		"StartsAt":    saved.StartsAt,
+		"EndsAt":      saved.EndsAt,
+	}).Info("Approval delegation created")
+	return saved, nil
+}
 
-	minRequired := 1
-	if policy != nil {
-		minRequired = policy.MinApprovals
+// ListDelegations returns unexpired delegations from or to the authenticated user.
+func (s *Service) ListDelegations(qc *common.QueryContext) ([]*types.ApprovalDelegation, error) {
+	if qc.GetUserID() == "" {
+		return nil, common.NewPermissionError(fmt.Errorf("approval delegation requires an authenticated user"))
 	}
+	return s.repository.FindDelegations(qc.GetUserID(), time.Now())
+}
 
-	return &types.ApprovalStatus{
-		TaskExecutionID:      taskExecutionID,
-		JobRequestID:         jobRequestID,
-		ApprovalsReceived:    approvals,
-		RejectionsReceived:   rejections,
-		MinApprovalsRequired: minRequired,
-		QuorumReached:        approvals >= minRequired,
-		Rejected:             s.isRejected(approvals, rejections, policy),
-		Votes:                votes,
-		Policy:               policy,
-	}, nil
+// RevokeDelegation removes a delegation of the authenticated user, e.g. when they return early.
+func (s *Service) RevokeDelegation(qc *common.QueryContext, id string) error {
+	delegation, err := s.repository.GetDelegation(id)
+	if err != nil {
+		return err
+	}
+	// delegations of other users are hidden from the caller
+	if delegation.DelegatorID != qc.GetUserID() && !qc.IsAdmin() {
+		return common.NewNotFoundError(fmt.Errorf("approval delegation %s is not found", id))
+	}
+	return s.repository.DeleteDelegation(id)
 }
 
 // ListPendingApprovals returns tasks currently awaiting approval votes.
@@ -282,12 +350,20 @@ func (s *Service) CreateDeadlineIfNeeded(
 
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
 
-// authorizeVoter checks that the voter is in the allowed roles or user list.
+// authorizeVoter checks that the voter is in the allowed roles or user list of the current stage.
+// A delegated vote is authorized against the approver who delegated to the voter, and escalation
+// recipients may vote once the SLA of the stage is escalated when the policy enables escalation voting.
 // nil policy is default-deny: no policy configured means no one may vote externally.
-func (s *Service) authorizeVoter(qc *common.QueryContext, policy *types.ApprovalPolicy, voterID string) error {
+func (s *Service) authorizeVoter(
+	qc *common.QueryContext,
+	policy *types.ApprovalPolicy,
+	stage *types.ApprovalStage,
+	taskExecutionID string,
+	voterID string,
+	onBehalfOf string) error {
 	// SyntheticVoterID is reserved for internal SLA-timeout auto-decisions.
 	// No external caller — authenticated or not — may use this ID.
-	if voterID == types.SyntheticVoterID {
+	if voterID == types.SyntheticVoterID || onBehalfOf == types.SyntheticVoterID {
 		return common.NewPermissionError(fmt.Errorf(
 			"voter ID %q is reserved for system use", types.SyntheticVoterID))
 	}
@@ -296,55 +372,189 @@ func (s *Service) authorizeVoter(qc *common.QueryContext, policy *types.Approval
 		return common.NewPermissionError(fmt.Errorf("no approval policy configured for this task"))
 	}
 
-	allowedUsers := policy.AllowedUserList()
-	allowedRoles := policy.AllowedRoleList()
+	if onBehalfOf != "" {
+		delegation, err := s.repository.FindActiveDelegation(qc.GetOrganizationID(), onBehalfOf, voterID, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to find approval delegation: %w", err)
+		}
+		if delegation == nil {
+			return common.NewPermissionError(fmt.Errorf(
+				"voter %s has no active delegation from %s", voterID, onBehalfOf))
+		}
+		// roles of the approver are loaded when the vote is cast so that a revoked role isn't delegated
+		delegator, err := s.repository.FindActiveUser(delegation.OrganizationID, onBehalfOf)
+		if err != nil {
+			return fmt.Errorf("failed to find approver %s: %w", onBehalfOf, err)
+		}
+		if delegator == nil {
+			return common.NewPermissionError(fmt.Errorf(
+				"approver %s of the delegation is no longer an active user", onBehalfOf))
+		}
+		if allowedInStage(stage, []string{onBehalfOf}, delegator.GetRoles()) {
+			return nil
+		}
+		return common.NewPermissionError(fmt.Errorf(
+			"approver %s is not authorized to vote on stage %q of this task (allowed roles: %s, allowed users: %s)",
+			onBehalfOf, stage.Name, stage.AllowedRoles, stage.AllowedUsers))
+	}
 
-	// If neither list is configured, any authenticated user may vote.
-	if len(allowedUsers) == 0 && len(allowedRoles) == 0 {
+	var roles *acl.Roles
+	if qc.User != nil {
+		roles = qc.User.GetRoles()
+	}
+	if allowedInStage(stage, []string{voterID, qc.GetUserID()}, roles) {
 		return nil
 	}
 
-	// Check explicit user list.
-	for _, uid := range allowedUsers {
-		if uid == voterID || uid == qc.GetUserID() {
+	if policy.EscalationVoting && s.isEscalationRecipient(qc, policy, voterID) {
+		escalated, err := s.repository.IsDeadlineEscalated(taskExecutionID)
+		if err != nil {
+			return fmt.Errorf("failed to check approval deadline: %w", err)
+		}
+		if escalated {
 			return nil
 		}
 	}
 
-	// Check role membership via query context user.
-	if len(allowedRoles) > 0 && qc.User != nil {
+	return common.NewPermissionError(fmt.Errorf(
+		"voter %s is not authorized to vote on stage %q of this task (allowed roles: %s, allowed users: %s)",
+		voterID, stage.Name, stage.AllowedRoles, stage.AllowedUsers))
+}
+
+// isEscalationRecipient returns true if the voter id, username or email of the voter is one of
+// the escalation recipients of the policy.
+func (s *Service) isEscalationRecipient(qc *common.QueryContext, policy *types.ApprovalPolicy, voterID string) bool {
+	ids := []string{voterID, qc.GetUserID(), qc.GetUsername()}
+	if qc.User != nil {
+		ids = append(ids, qc.User.Email)
+	}
+	for _, recipient := range policy.EscalationRecipientList() {
+		for _, id := range ids {
+			if id != "" && strings.EqualFold(recipient, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// allowedInStage returns true if one of the user ids is in the allowed users of the stage or the
+// roles contain one of its allowed roles. Any user may vote when neither list is configured.
+func allowedInStage(stage *types.ApprovalStage, userIDs []string, roles *acl.Roles) bool {
+	allowedUsers := stage.AllowedUserList()
+	allowedRoles := stage.AllowedRoleList()
+	if len(allowedUsers) == 0 && len(allowedRoles) == 0 {
+		return true
+	}
+	for _, uid := range allowedUsers {
+		for _, id := range userIDs {
+			if id != "" && uid == id {
+				return true
+			}
+		}
+	}
+	if roles != nil {
 		for _, required := range allowedRoles {
-			if qc.User.HasRole(acl.RoleType(strings.TrimSpace(required))) {
-				return nil
+			if roles.HasRole(acl.RoleType(required)) {
+				return true
 			}
 		}
 	}
+	return false
+}
 
-	return common.NewPermissionError(fmt.Errorf(
-		"voter %s is not authorized to vote on this task (allowed roles: %s, allowed users: %s)",
-		voterID, policy.AllowedRoles, policy.AllowedUsers))
+// approvalTally is the result of evaluating votes against the ordered stages of a policy.
+type approvalTally struct {
+	definitions []*types.ApprovalStage
+	stages      []*types.ApprovalStageStatus
+	// current is the index of the stage awaiting votes, or the last stage once resolved.
+	current  int
+	approved bool
+	rejected bool
+}
+
+// tallyStages counts votes of each stage, where the first stage that has not reached its quorum
+// is the current stage. The approval is rejected when the current stage meets its rejection
+// threshold and approved when all stages reached their quorum.
+func tallyStages(policy *types.ApprovalPolicy, votes []*types.ApprovalVote) *approvalTally {
+	var stages []*types.ApprovalStage
+	if policy == nil {
+		// No policy and there's a rejection — fail.
+		stages = []*types.ApprovalStage{{MinApprovals: 1, RequireUnanimous: true}}
+	} else {
+		stages = policy.EffectiveStages()
+	}
+	tally := &approvalTally{definitions: stages, current: -1}
+	for i, stage := range stages {
+		stageStatus := &types.ApprovalStageStatus{
+			Name:                 stage.Name,
+			MinApprovalsRequired: stage.MinApprovals,
+		}
+		for _, v := range votes {
+			if v.Stage != stage.Name {
+				continue
+			}
+			if v.IsApproved() {
+				stageStatus.ApprovalsReceived++
+			} else {
+				stageStatus.RejectionsReceived++
+			}
+		}
+		rejected := isRejected(stageStatus.ApprovalsReceived, stageStatus.RejectionsReceived, stage)
+		stageStatus.Completed = !rejected && stageStatus.ApprovalsReceived >= stage.MinApprovals
+		tally.stages = append(tally.stages, stageStatus)
+		if tally.current == -1 && !stageStatus.Completed {
+			tally.current = i
+			tally.rejected = rejected
+		}
+	}
+	if tally.current == -1 {
+		tally.current = len(stages) - 1
+		tally.approved = true
+	}
+	return tally
 }
 
-// isRejected returns true when the rejection threshold is met.
+// buildStatus builds the approval status, where vote counts refer to the current stage.
+func (s *Service) buildStatus(
+	taskExecutionID string,
+	jobRequestID string,
+	policy *types.ApprovalPolicy,
+	votes []*types.ApprovalVote,
+	slaBreached bool) *types.ApprovalStatus {
+	tally := tallyStages(policy, votes)
+	current := tally.stages[tally.current]
+	return &types.ApprovalStatus{
+		TaskExecutionID:      taskExecutionID,
+		JobRequestID:         jobRequestID,
+		ApprovalsReceived:    current.ApprovalsReceived,
+		RejectionsReceived:   current.RejectionsReceived,
+		MinApprovalsRequired: current.MinApprovalsRequired,
+		QuorumReached:        tally.approved,
+		Rejected:             tally.rejected,
+		SLABreached:          slaBreached,
+		Votes:                votes,
+		Policy:               policy,
+		CurrentStage:         current.Name,
+		Stages:               tally.stages,
+	}
+}
+
+// isRejected returns true when the rejection threshold of the stage is met.
 // When require_unanimous=true: any single rejection fails.
 // When require_unanimous=false: only reject when quorum is mathematically impossible —
 // i.e., even all remaining potential approvers cannot reach min_approvals.
-func (s *Service) isRejected(approvals, rejections int, policy *types.ApprovalPolicy) bool {
+func isRejected(approvals, rejections int, stage *types.ApprovalStage) bool {
 	if rejections == 0 {
 		return false
 	}
-	if policy == nil {
-		// No policy and there's a rejection — fail.
-		return true
-	}
-	if policy.RequireUnanimous {
+	if stage.RequireUnanimous {
 		return true
 	}
 	// Non-unanimous: reject only when quorum is mathematically impossible.
 	// Total allowed voters = len(AllowedUsers); if 0, we can't bound the pool, so use
 	// the already-cast votes as the universe and check if approvals can still reach min.
-	allowedUsers := policy.AllowedUserList()
-	totalAllowed := len(allowedUsers)
+	totalAllowed := len(stage.AllowedUserList())
 	if totalAllowed == 0 {
 		// Pool size unknown — treat any rejection as failing (conservative).
 		return true
@@ -353,7 +563,7 @@ func (s *Service) isRejected(approvals, rejections int, policy *types.ApprovalPo
 	if remainingVoters < 0 {
 		remainingVoters = 0
 	}
-	return approvals+remainingVoters < policy.MinApprovals
+	return approvals+remainingVoters < stage.MinApprovals
 }
 
 // finalizeApproval transitions the task to COMPLETED and the job to PENDING.
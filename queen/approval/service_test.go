@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
+	"plexobject.com/formicary/internal/acl"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/repository"
 	"plexobject.com/formicary/queen/types"
@@ -463,3 +464,239 @@ func Test_ShouldGetStatusReturnsCurrentTally(t *testing.T) {
 	assert.True(t, status.QuorumReached)
 	assert.Len(t, status.Votes, 2)
 }
+
+// userQC returns a query context of an authenticated user with the given roles.
+// saveUserOfQC persists the user of the query context, e.g. so that roles of an away approver are found.
+func saveUserOfQC(t *testing.T, db *gorm.DB, qc *common.QueryContext) {
+	t.Helper()
+	require.NoError(t, db.Save(qc.User).Error)
+}
+
+func userQC(userID string, email string, roles ...acl.RoleType) *common.QueryContext {
+	r := acl.NewRoles("")
+	for _, role := range roles {
+		r.AddRole(role)
+	}
+	user := common.NewUser("", userID, userID, email, r)
+	user.ID = userID
+	return common.NewQueryContext(user, "")
+}
+
+// stagedPolicy returns a two-stage policy: team lead followed by change-advisory board.
+func stagedPolicy() *types.ApprovalPolicy {
+	return &types.ApprovalPolicy{
+		SLADeadline:   time.Hour,
+		TimeoutAction: types.TimeoutActionEscalate,
+		Stages: []*types.ApprovalStage{
+			{Name: "team-lead", MinApprovals: 1, AllowedUsers: "alice"},
+			{Name: "cab", MinApprovals: 2, AllowedUsers: "alice,carol,dave"},
+		},
+	}
+}
+
+func Test_ShouldApproveStagesInOrder(t *testing.T) {
+	// GIVEN: team lead then change-advisory board with an SLA per stage
+	policy := stagedPolicy()
+	require.NoError(t, policy.Validate())
+	f := newSvcFixture(t, policy)
+	ctx := context.Background()
+	require.NoError(t, f.svc.CreateDeadlineIfNeeded(f.taskExecID, f.jobReq.ID, policy))
+
+	// WHEN: a board member votes before the team lead
+	_, err := f.svc.CastVote(ctx, f.qc, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", types.ApprovalDecisionApproved, "")
+
+	// THEN: vote is denied because the first stage awaits votes
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "team-lead")
+
+	// WHEN: team lead approves
+	status, err := f.svc.CastVote(ctx, f.qc, f.jobReq, f.taskType, f.taskExecID,
+		policy, "alice", "Alice", types.ApprovalDecisionApproved, "")
+
+	// THEN: approval advances to the board without resuming the job
+	require.NoError(t, err)
+	assert.False(t, status.QuorumReached)
+	assert.Equal(t, "cab", status.CurrentStage)
+	assert.Equal(t, 0, status.ApprovalsReceived)
+	assert.Equal(t, 2, status.MinApprovalsRequired)
+	require.Len(t, status.Stages, 2)
+	assert.True(t, status.Stages[0].Completed)
+	assert.Equal(t, "team-lead", status.Votes[0].Stage)
+
+	// AND: deadline of the first stage is replaced by a deadline of the board
+	deadlines, err := f.repo.FindBreachedDeadlines(time.Now().Add(2*time.Hour), 1000)
+	require.NoError(t, err)
+	open := 0
+	for _, d := range deadlines {
+		if d.TaskExecutionID == f.taskExecID {
+			open++
+		}
+	}
+	assert.Equal(t, 1, open)
+
+	// WHEN: team lead tries to approve again as a board member
+	status, err = f.svc.CastVote(ctx, f.qc, f.jobReq, f.taskType, f.taskExecID,
+		policy, "alice", "Alice", types.ApprovalDecisionApproved, "")
+
+	// THEN: the prior vote is returned and does not count toward the board
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.ApprovalsReceived)
+
+	// WHEN: both board members approve
+	for _, voter := range []string{"carol", "dave"} {
+		status, err = f.svc.CastVote(ctx, f.qc, f.jobReq, f.taskType, f.taskExecID,
+			policy, voter, voter, types.ApprovalDecisionApproved, "")
+		require.NoError(t, err)
+	}
+
+	// THEN: quorum of the last stage approves the task
+	assert.True(t, status.QuorumReached)
+	assert.Equal(t, "cab", status.CurrentStage)
+	assert.Equal(t, 2, status.ApprovalsReceived)
+	var taskState string
+	require.NoError(t, f.db.Table("formicary_task_executions").Select("task_state").
+		Where("id = ?", f.taskExecID).Scan(&taskState).Error)
+	assert.Equal(t, string(common.COMPLETED), taskState)
+}
+
+func Test_ShouldCountDelegatedVoteForAwayApprover(t *testing.T) {
+	// GIVEN: a board restricted by role where bob is away and delegated to carol
+	policy := &types.ApprovalPolicy{
+		Stages: []*types.ApprovalStage{{Name: "cab", MinApprovals: 2, AllowedRoles: "cab"}},
+	}
+	f := newSvcFixture(t, policy)
+	ctx := context.Background()
+	bobQC := userQC("bob", "bob@example.com", "cab")
+	saveUserOfQC(t, f.db, bobQC)
+	carolQC := userQC("carol", "carol@example.com")
+	delegation, err := f.svc.CreateDelegation(bobQC, &types.ApprovalDelegation{
+		DelegatorID: "mallory", // always replaced by the caller
+		DelegateID:  "carol",
+		StartsAt:    time.Now().Add(-time.Minute),
+		EndsAt:      time.Now().Add(24 * time.Hour),
+		Reason:      "vacation",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", delegation.DelegatorID)
+
+	// WHEN: carol votes herself
+	_, err = f.svc.CastVote(ctx, carolQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", types.ApprovalDecisionApproved, "")
+
+	// THEN: she is not a board member
+	require.Error(t, err)
+
+	// WHEN: carol votes on behalf of someone who didn't delegate to her
+	_, err = f.svc.CastDelegatedVote(ctx, carolQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", "dave", types.ApprovalDecisionApproved, "")
+
+	// THEN: vote is denied
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no active delegation")
+
+	// WHEN: carol votes on behalf of bob
+	status, err := f.svc.CastDelegatedVote(ctx, carolQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", "bob", types.ApprovalDecisionApproved, "")
+
+	// THEN: vote counts for bob
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.ApprovalsReceived)
+	assert.Equal(t, "bob", status.Votes[0].OnBehalfOf)
+	assert.Equal(t, "bob", status.Votes[0].Principal())
+
+	// AND: bob cannot vote again when he is back
+	_, err = f.svc.CastVote(ctx, bobQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "bob", "Bob", types.ApprovalDecisionApproved, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already voted")
+
+	// AND: delegations are listed for both users, but only bob may revoke
+	delegations, err := f.svc.ListDelegations(carolQC)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Error(t, f.svc.RevokeDelegation(carolQC, delegation.ID))
+	require.NoError(t, f.svc.RevokeDelegation(bobQC, delegation.ID))
+	delegations, err = f.svc.ListDelegations(bobQC)
+	require.NoError(t, err)
+	require.Len(t, delegations, 0)
+}
+
+func Test_ShouldDenyDelegatedVoteWhenApproverLostRole(t *testing.T) {
+	// GIVEN: a board restricted by role where bob delegated to carol
+	policy := &types.ApprovalPolicy{
+		Stages: []*types.ApprovalStage{{Name: "cab", MinApprovals: 1, AllowedRoles: "cab"}},
+	}
+	f := newSvcFixture(t, policy)
+	ctx := context.Background()
+	bobQC := userQC("bob", "bob@example.com", "cab")
+	saveUserOfQC(t, f.db, bobQC)
+	carolQC := userQC("carol", "carol@example.com")
+	_, err := f.svc.CreateDelegation(bobQC, &types.ApprovalDelegation{
+		DelegateID: "carol",
+		StartsAt:   time.Now().Add(-time.Minute),
+		EndsAt:     time.Now().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// WHEN: bob is removed from the board before carol votes on his behalf
+	saveUserOfQC(t, f.db, userQC("bob", "bob@example.com"))
+	_, err = f.svc.CastDelegatedVote(ctx, carolQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", "bob", types.ApprovalDecisionApproved, "")
+
+	// THEN: vote is denied because bob no longer has the role
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not authorized")
+
+	// WHEN: a user of another organization votes on behalf of bob
+	otherQC := userQC("carol", "carol@example.com")
+	otherQC.User.OrganizationID = "other-org"
+	_, err = f.svc.CastDelegatedVote(ctx, otherQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "carol", "Carol", "bob", types.ApprovalDecisionApproved, "")
+
+	// THEN: delegation is not found
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no active delegation")
+}
+
+func Test_ShouldLetEscalationRecipientsVoteAfterSLABreach(t *testing.T) {
+	// GIVEN: a policy that lets escalation recipients vote after SLA breach
+	policy := &types.ApprovalPolicy{
+		MinApprovals:         1,
+		AllowedUsers:         "alice",
+		SLADeadline:          time.Hour,
+		TimeoutAction:        types.TimeoutActionEscalate,
+		EscalationRecipients: "ops@example.com",
+		EscalationVoting:     true,
+	}
+	f := newSvcFixture(t, policy)
+	ctx := context.Background()
+	opsQC := userQC("ops", "ops@example.com")
+
+	// WHEN: recipient votes before the SLA is breached
+	_, err := f.svc.CastVote(ctx, opsQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "ops", "Ops", types.ApprovalDecisionApproved, "")
+
+	// THEN: vote is denied
+	require.Error(t, err)
+
+	// WHEN: the SLA is breached and escalated
+	saved, err := f.repo.SaveDeadline(&types.ApprovalDeadline{
+		TaskExecutionID:      f.taskExecID,
+		JobRequestID:         f.jobReq.ID,
+		Deadline:             time.Now().Add(-time.Minute),
+		TimeoutAction:        types.TimeoutActionEscalate,
+		EscalationRecipients: policy.EscalationRecipients,
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.svc.HandleSLABreach(ctx, f.qc, saved, f.jobReq, f.taskType, nil))
+	status, err := f.svc.GetStatus(f.taskExecID, f.jobReq.ID, policy)
+	require.NoError(t, err)
+	assert.True(t, status.SLABreached)
+
+	// THEN: recipient can approve
+	status, err = f.svc.CastVote(ctx, opsQC, f.jobReq, f.taskType, f.taskExecID,
+		policy, "ops", "Ops", types.ApprovalDecisionApproved, "")
+	require.NoError(t, err)
+	assert.True(t, status.QuorumReached)
+}
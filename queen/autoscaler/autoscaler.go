@@ -0,0 +1,296 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/resource"
+	"plexobject.com/formicary/queen/types"
+)
+
+// PendingJobSource returns definitions of jobs that are waiting to be scheduled
+type PendingJobSource interface {
+	GetPendingJobDefinitions() []*types.JobDefinition
+}
+
+// LeaderSource tells whether this queen is the leader, only the leader scales pools
+type LeaderSource interface {
+	IsLeader() bool
+}
+
+// PoolStatus defines demand and size of an ant pool as computed by the last check of the autoscaler
+type PoolStatus struct {
+	Name     string                    `json:"name"`
+	Provider config.ScalerProviderType `json:"provider"`
+	MinAnts  int                       `json:"min_ants"`
+	MaxAnts  int                       `json:"max_ants"`
+	// PendingJobs is number of pending jobs that have tasks which can run on ants of the pool
+	PendingJobs int `json:"pending_jobs"`
+	// RunningTasks is number of tasks that are running on ants of the pool
+	RunningTasks int `json:"running_tasks"`
+	// RegisteredAnts is number of live ants of the pool
+	RegisteredAnts int `json:"registered_ants"`
+	// BusyAnts is number of ants that are running tasks, the pool is not scaled below them
+	BusyAnts int `json:"busy_ants"`
+	// CurrentAnts is number of ants reported by the provider
+	CurrentAnts int `json:"current_ants"`
+	// DesiredAnts is number of ants needed for running and pending tasks within min and max bounds
+	DesiredAnts  int        `json:"desired_ants"`
+	LastScaledAt *time.Time `json:"last_scaled_at"`
+	CheckedAt    time.Time  `json:"checked_at"`
+	Error        string     `json:"error"`
+}
+
+// poolState keeps track of scaling of a pool for cooldowns
+type poolState struct {
+	lastScaleUpAt time.Time
+	lastScaledAt  time.Time
+	belowSince    time.Time
+	status        *PoolStatus
+}
+
+// Autoscaler scales pools of ants based on pending jobs and tasks that are running on ants
+type Autoscaler struct {
+	serverCfg       *config.ServerConfig
+	resourceManager resource.Manager
+	jobSource       PendingJobSource
+	leader          LeaderSource
+	providers       map[string]ScalerProvider
+	metricsRegistry *metrics.Registry
+	states          map[string]*poolState
+	ticker          *time.Ticker
+	lock            sync.RWMutex
+}
+
+// New creates autoscaler for the pools of autoscaler config using the providers by pool name
+func New(
+	serverCfg *config.ServerConfig,
+	resourceManager resource.Manager,
+	jobSource PendingJobSource,
+	leader LeaderSource,
+	providers map[string]ScalerProvider,
+	metricsRegistry *metrics.Registry) (*Autoscaler, error) {
+	states := make(map[string]*poolState)
+	for _, pool := range serverCfg.Autoscaler.Pools {
+		if providers[pool.Name] == nil {
+			return nil, fmt.Errorf("scaler provider of ant pool %s is not defined", pool.Name)
+		}
+		states[pool.Name] = &poolState{}
+	}
+	return &Autoscaler{
+		serverCfg:       serverCfg,
+		resourceManager: resourceManager,
+		jobSource:       jobSource,
+		leader:          leader,
+		providers:       providers,
+		metricsRegistry: metricsRegistry,
+		states:          states,
+	}, nil
+}
+
+// Start - creates periodic ticker for scaling pools
+func (a *Autoscaler) Start(ctx context.Context) {
+	a.ticker = time.NewTicker(a.serverCfg.Autoscaler.Interval)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				a.ticker.Stop()
+				return
+			case <-a.ticker.C:
+				// other queens would scale the same pools with the same demand
+				if a.leader.IsLeader() {
+					a.Check(ctx, time.Now())
+				}
+			}
+		}
+	}()
+	logrus.WithFields(logrus.Fields{
+		"Component": "Autoscaler",
+		"Pools":     len(a.serverCfg.Autoscaler.Pools),
+	}).Infof("started autoscaler")
+}
+
+// Stop - stops background ticker
+func (a *Autoscaler) Stop() {
+	if a.ticker != nil {
+		a.ticker.Stop()
+	}
+}
+
+// Statuses returns status of all pools as of their last check
+func (a *Autoscaler) Statuses() []*PoolStatus {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	statuses := make([]*PoolStatus, 0)
+	for _, state := range a.states {
+		if state.status != nil {
+			statuses = append(statuses, state.status)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Check computes desired ants of each pool and scales pools whose cooldowns have passed
+func (a *Autoscaler) Check(ctx context.Context, now time.Time) {
+	jobs := a.jobSource.GetPendingJobDefinitions()
+	registrations := a.resourceManager.Registrations()
+	for i := range a.serverCfg.Autoscaler.Pools {
+		pool := &a.serverCfg.Autoscaler.Pools[i]
+		status := a.checkPool(ctx, pool, jobs, registrations, now)
+		a.metricsRegistry.Set("autoscaler_desired_ants", float64(status.DesiredAnts), map[string]string{"Pool": pool.Name})
+		a.metricsRegistry.Set("autoscaler_current_ants", float64(status.CurrentAnts), map[string]string{"Pool": pool.Name})
+		a.metricsRegistry.Set("autoscaler_pending_jobs", float64(status.PendingJobs), map[string]string{"Pool": pool.Name})
+	}
+}
+
+func (a *Autoscaler) checkPool(
+	ctx context.Context,
+	pool *config.AntPoolConfig,
+	jobs []*types.JobDefinition,
+	registrations []*common.AntRegistration,
+	now time.Time) *PoolStatus {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	state := a.states[pool.Name]
+	status := &PoolStatus{
+		Name:      pool.Name,
+		Provider:  pool.Provider,
+		MinAnts:   pool.MinAnts,
+		MaxAnts:   pool.MaxAnts,
+		CheckedAt: now,
+	}
+	if !state.lastScaledAt.IsZero() {
+		lastScaledAt := state.lastScaledAt
+		status.LastScaledAt = &lastScaledAt
+	}
+	state.status = status
+
+	for _, job := range jobs {
+		for _, task := range job.Tasks {
+			if pool.Matches(task.Method, task.Tags) {
+				status.PendingJobs++
+				break
+			}
+		}
+	}
+	capacity := 0
+	antLoads := make(map[string]int)
+	for _, registration := range registrations {
+		if !registration.Supports(pool.Method, pool.Tags, a.serverCfg.Jobs.AntRegistrationAliveTimeout) {
+			continue
+		}
+		antLoads[registration.AntID] = registration.CurrentLoad
+		status.RegisteredAnts++
+		status.RunningTasks += registration.CurrentLoad
+		capacity += registration.MaxCapacity
+		if registration.CurrentLoad > 0 {
+			status.BusyAnts++
+		}
+	}
+	antCapacity := pool.AntCapacity
+	if status.RegisteredAnts > 0 && capacity > 0 {
+		antCapacity = capacity / status.RegisteredAnts
+	}
+	status.DesiredAnts = desiredAnts(pool, status.RunningTasks+status.PendingJobs, antCapacity, status.BusyAnts)
+
+	provider := a.providers[pool.Name]
+	current, err := provider.Replicas(ctx)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.CurrentAnts = current
+
+	replicas := current
+	if status.DesiredAnts > current {
+		state.belowSince = time.Time{}
+		if now.Sub(state.lastScaleUpAt) >= pool.ScaleUpCooldown {
+			replicas = status.DesiredAnts
+		}
+	} else if status.DesiredAnts < current {
+		if state.belowSince.IsZero() {
+			state.belowSince = now
+		}
+		// scale down only after demand stayed low for the cooldown so that bursts don't cause thrashing
+		if now.Sub(state.belowSince) >= pool.ScaleDownCooldown && now.Sub(state.lastScaledAt) >= pool.ScaleDownCooldown {
+			replicas = status.DesiredAnts
+		}
+	} else {
+		state.belowSince = time.Time{}
+	}
+	if replicas == current {
+		return status
+	}
+	if preparer, ok := provider.(ScaleDownPreparer); ok && replicas < current {
+		// scaling down is deferred to the next check rather than letting the provider stop busy ants
+		if err = preparer.PrepareScaleDown(ctx, antLoads); err != nil {
+			status.Error = err.Error()
+			logrus.WithFields(logrus.Fields{
+				"Component": "Autoscaler",
+				"Pool":      pool.Name,
+				"Current":   current,
+				"Desired":   replicas,
+				"Error":     err,
+			}).Errorf("failed to prepare scaling down ant pool")
+			return status
+		}
+	}
+	if err = provider.Scale(ctx, replicas); err != nil {
+		status.Error = err.Error()
+		logrus.WithFields(logrus.Fields{
+			"Component": "Autoscaler",
+			"Pool":      pool.Name,
+			"Current":   current,
+			"Desired":   replicas,
+			"Error":     err,
+		}).Errorf("failed to scale ant pool")
+		return status
+	}
+	if replicas > current {
+		state.lastScaleUpAt = now
+	}
+	state.lastScaledAt = now
+	state.belowSince = time.Time{}
+	status.LastScaledAt = &now
+	status.CurrentAnts = replicas
+	a.metricsRegistry.Incr("autoscaler_scaled_total", map[string]string{"Pool": pool.Name})
+	logrus.WithFields(logrus.Fields{
+		"Component":    "Autoscaler",
+		"Pool":         pool.Name,
+		"Previous":     current,
+		"Replicas":     replicas,
+		"PendingJobs":  status.PendingJobs,
+		"RunningTasks": status.RunningTasks,
+		"BusyAnts":     status.BusyAnts,
+	}).Infof("scaled ant pool")
+	return status
+}
+
+// desiredAnts returns ants needed for the tasks with the given capacity of each ant, where busy ants are kept
+// so that running tasks aren't killed and the result is bounded by min and max ants of the pool.
+func desiredAnts(pool *config.AntPoolConfig, tasks int, antCapacity int, busyAnts int) int {
+	if antCapacity <= 0 {
+		antCapacity = 1
+	}
+	desired := (tasks + antCapacity - 1) / antCapacity
+	if desired < busyAnts {
+		desired = busyAnts
+	}
+	if desired < pool.MinAnts {
+		desired = pool.MinAnts
+	}
+	if desired > pool.MaxAnts {
+		desired = pool.MaxAnts
+	}
+	return desired
+}
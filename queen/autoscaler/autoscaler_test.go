@@ -0,0 +1,283 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/resource"
+	"plexobject.com/formicary/queen/types"
+)
+
+type fakeProvider struct {
+	replicas int
+	scaled   []int
+	err      error
+}
+
+func (p *fakeProvider) Replicas(context.Context) (int, error) {
+	return p.replicas, p.err
+}
+
+func (p *fakeProvider) Scale(_ context.Context, replicas int) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.replicas = replicas
+	p.scaled = append(p.scaled, replicas)
+	return nil
+}
+
+type fakePreparingProvider struct {
+	fakeProvider
+	antLoads map[string]int
+}
+
+func (p *fakePreparingProvider) PrepareScaleDown(_ context.Context, antLoads map[string]int) error {
+	p.antLoads = antLoads
+	return p.err
+}
+
+type fakeLeader struct {
+	leader atomic.Bool
+}
+
+func (l *fakeLeader) IsLeader() bool {
+	return l.leader.Load()
+}
+
+type fakeJobSource struct {
+	jobs []*types.JobDefinition
+}
+
+func (s *fakeJobSource) GetPendingJobDefinitions() []*types.JobDefinition {
+	return s.jobs
+}
+
+func newTestAutoscaler(t *testing.T) (*Autoscaler, *fakeProvider, *fakeJobSource, *resource.ManagerStub) {
+	scaler, provider, jobSource, resourceManager, leader := newTestAutoscalerWithLeader(t)
+	leader.leader.Store(true)
+	return scaler, provider, jobSource, resourceManager
+}
+
+func newTestAutoscalerWithLeader(t *testing.T) (*Autoscaler, *fakeProvider, *fakeJobSource, *resource.ManagerStub, *fakeLeader) {
+	serverCfg := config.TestServerConfig()
+	serverCfg.Autoscaler = config.AutoscalerConfig{
+		Enabled: true,
+		Pools: []config.AntPoolConfig{{
+			Name:     "docker-gpu",
+			Method:   common.Docker,
+			Tags:     []string{"gpu"},
+			MinAnts:  0,
+			MaxAnts:  5,
+			Provider: config.ExecScalerProvider,
+			Exec: config.ExecScalerConfig{
+				Command:         []string{"true"},
+				ReplicasCommand: []string{"echo", "0"},
+			},
+		}},
+	}
+	require.NoError(t, serverCfg.Autoscaler.Validate())
+	provider := &fakeProvider{}
+	jobSource := &fakeJobSource{}
+	resourceManager := resource.NewStub()
+	leader := &fakeLeader{}
+	scaler, err := New(serverCfg, resourceManager, jobSource, leader,
+		map[string]ScalerProvider{"docker-gpu": provider}, metrics.New())
+	require.NoError(t, err)
+	return scaler, provider, jobSource, resourceManager, leader
+}
+
+func newTestJob(method common.TaskMethod, tags ...string) *types.JobDefinition {
+	job := types.NewJobDefinition("job")
+	task := types.NewTaskDefinition("task", method)
+	task.Tags = tags
+	job.AddTask(task)
+	return job
+}
+
+func registerTestAnt(rm *resource.ManagerStub, id string, load int) {
+	_ = rm.Register(context.Background(), &common.AntRegistration{
+		AntID:       id,
+		Methods:     []common.TaskMethod{common.Docker},
+		Tags:        []string{"gpu", "linux"},
+		MaxCapacity: 2,
+		CurrentLoad: load,
+		ReceivedAt:  time.Now(),
+	})
+}
+
+func Test_ShouldFailAutoscalerWithoutProvider(t *testing.T) {
+	serverCfg := config.TestServerConfig()
+	serverCfg.Autoscaler.Pools = []config.AntPoolConfig{{Name: "pool"}}
+	_, err := New(serverCfg, resource.NewStub(), &fakeJobSource{}, &fakeLeader{}, map[string]ScalerProvider{}, metrics.New())
+	require.Error(t, err)
+}
+
+func Test_ShouldScaleOnlyOnLeader(t *testing.T) {
+	// GIVEN an autoscaler with an empty pool and a pending job on a queen that is not the leader
+	scaler, _, jobSource, _, leader := newTestAutoscalerWithLeader(t)
+	scaler.serverCfg.Autoscaler.Interval = 10 * time.Millisecond
+	jobSource.jobs = []*types.JobDefinition{newTestJob(common.Docker, "gpu")}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// WHEN the autoscaler runs
+	scaler.Start(ctx)
+	time.Sleep(50 * time.Millisecond)
+
+	// THEN it should not check or scale the pool
+	require.Empty(t, scaler.Statuses())
+
+	// WHEN the queen becomes the leader
+	leader.leader.Store(true)
+
+	// THEN the pool should be checked and scaled up
+	require.Eventually(t, func() bool { return len(scaler.Statuses()) == 1 }, time.Second, 10*time.Millisecond)
+	scaler.Stop()
+	require.Equal(t, 1, scaler.Statuses()[0].DesiredAnts)
+}
+
+func Test_ShouldScaleUpFromZeroForPendingJobs(t *testing.T) {
+	// GIVEN an autoscaler with an empty pool
+	scaler, provider, jobSource, _ := newTestAutoscaler(t)
+	// AND pending jobs where only some of them can run on the pool
+	for i := 0; i < 12; i++ {
+		jobSource.jobs = append(jobSource.jobs, newTestJob(common.Docker, "gpu"))
+	}
+	jobSource.jobs = append(jobSource.jobs, newTestJob(common.Kubernetes, "gpu"))
+	jobSource.jobs = append(jobSource.jobs, newTestJob(common.Docker, "arm"))
+
+	// WHEN checking the pool
+	scaler.Check(context.Background(), time.Now())
+
+	// THEN ants with default capacity of 10 should be started for 12 jobs
+	require.Equal(t, []int{2}, provider.scaled)
+	statuses := scaler.Statuses()
+	require.Len(t, statuses, 1)
+	require.Equal(t, 12, statuses[0].PendingJobs)
+	require.Equal(t, 2, statuses[0].DesiredAnts)
+	require.Equal(t, 2, statuses[0].CurrentAnts)
+	require.NotNil(t, statuses[0].LastScaledAt)
+}
+
+func Test_ShouldBoundScaleUpByMaxAntsAndCooldown(t *testing.T) {
+	// GIVEN an autoscaler with registered ants of capacity 2
+	scaler, provider, jobSource, rm := newTestAutoscaler(t)
+	registerTestAnt(rm, "ant-1", 2)
+	provider.replicas = 1
+	for i := 0; i < 5; i++ {
+		jobSource.jobs = append(jobSource.jobs, newTestJob(common.Docker, "gpu"))
+	}
+	now := time.Now()
+
+	// WHEN checking the pool
+	scaler.Check(context.Background(), now)
+	// THEN 2 running and 5 pending tasks need 4 ants
+	require.Equal(t, []int{4}, provider.scaled)
+
+	// WHEN more jobs are pending within the cooldown
+	for i := 0; i < 20; i++ {
+		jobSource.jobs = append(jobSource.jobs, newTestJob(common.Docker, "gpu"))
+	}
+	scaler.Check(context.Background(), now.Add(30*time.Second))
+	// THEN pool should not be scaled
+	require.Equal(t, []int{4}, provider.scaled)
+
+	// WHEN cooldown has passed
+	scaler.Check(context.Background(), now.Add(time.Minute))
+	// THEN pool should be scaled to max ants
+	require.Equal(t, []int{4, 5}, provider.scaled)
+}
+
+func Test_ShouldScaleDownToZeroAfterCooldownWithoutStoppingBusyAnts(t *testing.T) {
+	// GIVEN an autoscaler with a busy and two idle ants
+	scaler, provider, _, rm := newTestAutoscaler(t)
+	registerTestAnt(rm, "ant-1", 1)
+	registerTestAnt(rm, "ant-2", 0)
+	registerTestAnt(rm, "ant-3", 0)
+	provider.replicas = 3
+	now := time.Now()
+
+	// WHEN checking the pool before demand stayed low for the cooldown
+	scaler.Check(context.Background(), now)
+	scaler.Check(context.Background(), now.Add(5*time.Minute))
+	// THEN pool should not be scaled
+	require.Len(t, provider.scaled, 0)
+
+	// WHEN cooldown has passed
+	scaler.Check(context.Background(), now.Add(10*time.Minute))
+	// THEN pool should keep the busy ant
+	require.Equal(t, []int{1}, provider.scaled)
+
+	// WHEN the busy ant becomes idle and cooldown has passed again
+	rm.Registry["ant-1"].CurrentLoad = 0
+	scaler.Check(context.Background(), now.Add(15*time.Minute))
+	scaler.Check(context.Background(), now.Add(25*time.Minute))
+	// THEN pool should be scaled to zero
+	require.Equal(t, []int{1, 0}, provider.scaled)
+}
+
+func Test_ShouldPrepareProviderBeforeScalingDown(t *testing.T) {
+	// GIVEN an autoscaler whose provider can be told which ants to stop first
+	scaler, _, _, rm := newTestAutoscaler(t)
+	provider := &fakePreparingProvider{fakeProvider: fakeProvider{replicas: 2}}
+	scaler.providers["docker-gpu"] = provider
+	registerTestAnt(rm, "ant-1", 1)
+	registerTestAnt(rm, "ant-2", 0)
+	now := time.Now()
+
+	// WHEN checking the pool after the cooldown
+	scaler.Check(context.Background(), now)
+	scaler.Check(context.Background(), now.Add(10*time.Minute))
+
+	// THEN loads of ants should be passed before scaling down
+	require.Equal(t, map[string]int{"ant-1": 1, "ant-2": 0}, provider.antLoads)
+	require.Equal(t, []int{1}, provider.scaled)
+}
+
+func Test_ShouldReportProviderErrors(t *testing.T) {
+	// GIVEN an autoscaler whose provider fails
+	scaler, provider, jobSource, _ := newTestAutoscaler(t)
+	provider.err = fmt.Errorf("provider failed")
+	jobSource.jobs = append(jobSource.jobs, newTestJob(common.Docker, "gpu"))
+
+	// WHEN checking the pool
+	scaler.Check(context.Background(), time.Now())
+
+	// THEN error should be reported in the status
+	require.Equal(t, "provider failed", scaler.Statuses()[0].Error)
+	require.Len(t, provider.scaled, 0)
+}
+
+func Test_ShouldScaleWithExecProvider(t *testing.T) {
+	// GIVEN exec provider with a hook that echoes desired ants
+	pool := &config.AntPoolConfig{
+		Name:     "exec-pool",
+		Method:   common.Shell,
+		MaxAnts:  3,
+		Provider: config.ExecScalerProvider,
+		Exec: config.ExecScalerConfig{
+			Command:         []string{"sh", "-c", "test $FORMICARY_POOL = exec-pool"},
+			ReplicasCommand: []string{"sh", "-c", "echo 2"},
+		},
+	}
+	require.NoError(t, pool.Validate())
+	provider := newExecScalerProvider(pool)
+
+	// WHEN scaling and reading replicas
+	require.NoError(t, provider.Scale(context.Background(), 3))
+	replicas, err := provider.Replicas(context.Background())
+
+	// THEN replicas should be printed by the replicas command
+	require.NoError(t, err)
+	require.Equal(t, 2, replicas)
+	require.Equal(t, 3, provider.replicas)
+}
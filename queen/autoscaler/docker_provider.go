@@ -0,0 +1,177 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/oklog/ulid/v2"
+	"github.com/sirupsen/logrus"
+
+	"plexobject.com/formicary/queen/config"
+)
+
+// poolLabel is label of containers of ants that are started by the autoscaler
+const poolLabel = "formicary.autoscaler.pool"
+
+// dockerScalerProvider runs ants of the pool as containers on a Docker host
+type dockerScalerProvider struct {
+	pool     string
+	cfg      *config.DockerScalerConfig
+	cli      *client.Client
+	stopping map[string]bool
+	antLoads map[string]int
+	lock     sync.Mutex
+}
+
+func newDockerScalerProvider(pool *config.AntPoolConfig) (*dockerScalerProvider, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+	if pool.Docker.Host != "" {
+		opts = append(opts, client.WithHost(pool.Docker.Host))
+	}
+	cli, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &dockerScalerProvider{
+		pool:     pool.Name,
+		cfg:      &pool.Docker,
+		cli:      cli,
+		stopping: make(map[string]bool),
+	}, nil
+}
+
+// Replicas returns number of running containers of the pool that are not being stopped
+func (p *dockerScalerProvider) Replicas(ctx context.Context) (int, error) {
+	containers, err := p.list(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(containers), nil
+}
+
+// PrepareScaleDown keeps load of ants so that the next scale-down stops idle containers first. Ants are
+// matched by container name so ants must use their container name (also their hostname) as ant-id, otherwise
+// containers are stopped regardless of their load.
+func (p *dockerScalerProvider) PrepareScaleDown(_ context.Context, antLoads map[string]int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.antLoads = antLoads
+	return nil
+}
+
+// Scale starts new containers or stops idle containers of the pool before busy ones
+func (p *dockerScalerProvider) Scale(ctx context.Context, replicas int) error {
+	containers, err := p.list(ctx)
+	if err != nil {
+		return err
+	}
+	for i := len(containers); i < replicas; i++ {
+		if err = p.start(ctx); err != nil {
+			return err
+		}
+	}
+	p.lock.Lock()
+	antLoads := p.antLoads
+	p.antLoads = nil
+	p.lock.Unlock()
+	sortContainersForScaleDown(containers, antLoads)
+	for i := 0; i < len(containers)-replicas; i++ {
+		p.stop(containers[i].ID)
+	}
+	return nil
+}
+
+// sortContainersForScaleDown orders containers by load of their ants and then newest first, which are
+// least likely to have warm caches. Containers whose ants haven't registered yet are considered idle.
+func sortContainersForScaleDown(containers []container.Summary, antLoads map[string]int) {
+	load := func(c container.Summary) int {
+		for _, name := range c.Names {
+			if l, ok := antLoads[strings.TrimPrefix(name, "/")]; ok {
+				return l
+			}
+		}
+		return 0
+	}
+	sort.SliceStable(containers, func(i, j int) bool {
+		if li, lj := load(containers[i]), load(containers[j]); li != lj {
+			return li < lj
+		}
+		return containers[i].Created > containers[j].Created
+	})
+}
+
+func (p *dockerScalerProvider) list(ctx context.Context) ([]container.Summary, error) {
+	all, err := p.cli.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", poolLabel+"="+p.pool), filters.Arg("status", "running")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers of ant pool %s due to %w", p.pool, err)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	containers := make([]container.Summary, 0)
+	for _, c := range all {
+		if !p.stopping[c.ID] {
+			containers = append(containers, c)
+		}
+	}
+	return containers, nil
+}
+
+func (p *dockerScalerProvider) start(ctx context.Context) error {
+	name := fmt.Sprintf("formicary-ant-%s-%s", p.pool, ulid.Make().String())
+	resp, err := p.cli.ContainerCreate(
+		ctx,
+		&container.Config{
+			Hostname: name,
+			Image:    p.cfg.Image,
+			Cmd:      p.cfg.Command,
+			Env:      p.cfg.Environment,
+			Labels:   map[string]string{poolLabel: p.pool},
+		},
+		&container.HostConfig{
+			NetworkMode: container.NetworkMode(p.cfg.Network),
+			AutoRemove:  true,
+		},
+		nil,
+		nil,
+		name)
+	if err != nil {
+		return fmt.Errorf("failed to create container %s due to %w", name, err)
+	}
+	if err = p.cli.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		_ = p.cli.ContainerRemove(ctx, resp.ID, container.RemoveOptions{Force: true})
+		return fmt.Errorf("failed to start container %s due to %w", name, err)
+	}
+	return nil
+}
+
+// stop sends SIGTERM to the ant in background so that it can finish its tasks before it's killed
+func (p *dockerScalerProvider) stop(id string) {
+	p.lock.Lock()
+	p.stopping[id] = true
+	p.lock.Unlock()
+	go func() {
+		timeoutSecs := int(p.cfg.StopTimeout.Seconds())
+		ctx, cancel := context.WithTimeout(context.Background(), p.cfg.StopTimeout+time.Minute)
+		defer cancel()
+		if err := p.cli.ContainerStop(ctx, id, container.StopOptions{Timeout: &timeoutSecs}); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "Autoscaler",
+				"Pool":      p.pool,
+				"Container": id,
+				"Error":     err,
+			}).Warnf("failed to stop container of ant")
+		}
+		p.lock.Lock()
+		delete(p.stopping, id)
+		p.lock.Unlock()
+	}()
+}
@@ -0,0 +1,29 @@
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldStopIdleAntContainersBeforeBusyOnes(t *testing.T) {
+	// GIVEN containers of a busy, an idle, an unregistered and an older idle ant
+	containers := []container.Summary{
+		{ID: "busy", Names: []string{"/ant-busy"}, Created: 40},
+		{ID: "idle", Names: []string{"/ant-idle"}, Created: 30},
+		{ID: "unregistered", Names: []string{"/ant-new"}, Created: 20},
+		{ID: "old-idle", Names: []string{"/ant-old-idle"}, Created: 10},
+	}
+	antLoads := map[string]int{"ant-busy": 2, "ant-idle": 0, "ant-old-idle": 0}
+
+	// WHEN ordering containers for scaling down
+	sortContainersForScaleDown(containers, antLoads)
+
+	// THEN idle containers are stopped first, newest first, and busy containers last
+	ids := make([]string, len(containers))
+	for i, c := range containers {
+		ids[i] = c.ID
+	}
+	require.Equal(t, []string{"idle", "unregistered", "old-idle", "busy"}, ids)
+}
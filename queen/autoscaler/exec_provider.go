@@ -0,0 +1,69 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+
+	"plexobject.com/formicary/queen/config"
+)
+
+// execScalerProvider runs hook commands that scale ants of the pool, e.g. a script calling a cloud API
+type execScalerProvider struct {
+	pool     string
+	cfg      *config.ExecScalerConfig
+	replicas int
+	lock     sync.Mutex
+}
+
+func newExecScalerProvider(pool *config.AntPoolConfig) *execScalerProvider {
+	return &execScalerProvider{pool: pool.Name, cfg: &pool.Exec, replicas: pool.MinAnts}
+}
+
+// Replicas returns number printed by the replicas command or else the last scaled replicas
+func (p *execScalerProvider) Replicas(ctx context.Context) (int, error) {
+	p.lock.Lock()
+	last := p.replicas
+	p.lock.Unlock()
+	if len(p.cfg.ReplicasCommand) == 0 {
+		return last, nil
+	}
+	out, err := p.run(ctx, p.cfg.ReplicasCommand, last)
+	if err != nil {
+		return 0, err
+	}
+	replicas, err := strconv.Atoi(strings.TrimSpace(out))
+	if err != nil {
+		return 0, fmt.Errorf("replicas command of ant pool %s printed '%s' instead of number", p.pool, out)
+	}
+	return replicas, nil
+}
+
+// Scale runs the command with the desired replicas
+func (p *execScalerProvider) Scale(ctx context.Context, replicas int) error {
+	if _, err := p.run(ctx, p.cfg.Command, replicas); err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.replicas = replicas
+	return nil
+}
+
+func (p *execScalerProvider) run(ctx context.Context, command []string, replicas int) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"FORMICARY_POOL="+p.pool,
+		"FORMICARY_DESIRED_ANTS="+strconv.Itoa(replicas))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to run %v for ant pool %s due to %w: %s", command, p.pool, err, out)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,108 @@
+package autoscaler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"plexobject.com/formicary/queen/config"
+)
+
+// podDeletionCostAnnotation is used by the ReplicaSet controller to pick pods with lower cost first when
+// replicas are reduced
+const podDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+
+// kubernetesScalerProvider scales replicas of the Deployment of ants
+type kubernetesScalerProvider struct {
+	cfg *config.KubernetesScalerConfig
+	cli kubernetes.Interface
+}
+
+func newKubernetesScalerProvider(pool *config.AntPoolConfig) (*kubernetesScalerProvider, error) {
+	var restConfig *restclient.Config
+	var err error
+	if pool.Kubernetes.Kubeconfig == "" {
+		restConfig, err = restclient.InClusterConfig()
+	} else {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", pool.Kubernetes.Kubeconfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &kubernetesScalerProvider{cfg: &pool.Kubernetes, cli: cli}, nil
+}
+
+// Replicas returns desired replicas of the deployment
+func (p *kubernetesScalerProvider) Replicas(ctx context.Context) (int, error) {
+	scale, err := p.cli.AppsV1().Deployments(p.cfg.Namespace).GetScale(ctx, p.cfg.Deployment, metav1.GetOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get scale of deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	return int(scale.Spec.Replicas), nil
+}
+
+// Scale updates replicas of the deployment
+func (p *kubernetesScalerProvider) Scale(ctx context.Context, replicas int) error {
+	scale, err := p.cli.AppsV1().Deployments(p.cfg.Namespace).GetScale(ctx, p.cfg.Deployment, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get scale of deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	scale.Spec.Replicas = int32(replicas)
+	if _, err = p.cli.AppsV1().Deployments(p.cfg.Namespace).UpdateScale(
+		ctx, p.cfg.Deployment, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to scale deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	return nil
+}
+
+// PrepareScaleDown sets deletion cost of pods of the deployment to the load of their ants so that idle ants
+// are deleted first. Ants are matched by pod name so ants must use their pod name as ant-id, otherwise
+// Kubernetes picks the pods that are deleted.
+func (p *kubernetesScalerProvider) PrepareScaleDown(ctx context.Context, antLoads map[string]int) error {
+	deployment, err := p.cli.AppsV1().Deployments(p.cfg.Namespace).Get(ctx, p.cfg.Deployment, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("failed to parse selector of deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	pods, err := p.cli.CoreV1().Pods(p.cfg.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return fmt.Errorf("failed to list pods of deployment %s due to %w", p.cfg.Deployment, err)
+	}
+	for _, pod := range pods.Items {
+		load, ok := antLoads[pod.Name]
+		if !ok {
+			continue
+		}
+		cost := strconv.Itoa(load)
+		if pod.Annotations[podDeletionCostAnnotation] == cost {
+			continue
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{podDeletionCostAnnotation: cost},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = p.cli.CoreV1().Pods(p.cfg.Namespace).Patch(
+			ctx, pod.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return fmt.Errorf("failed to set deletion cost of pod %s due to %w", pod.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package autoscaler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"plexobject.com/formicary/queen/config"
+)
+
+func newTestAntPod(name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+}
+
+func Test_ShouldSetDeletionCostOfAntPodsBeforeScalingDown(t *testing.T) {
+	// GIVEN a deployment of ants with a busy, an idle and an unregistered pod
+	labels := map[string]string{"app": "formicary-ant"}
+	cli := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "formicary-ant", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+		},
+		newTestAntPod("ant-1", labels),
+		newTestAntPod("ant-2", labels),
+		newTestAntPod("ant-3", labels),
+		newTestAntPod("other", map[string]string{"app": "other"}),
+	)
+	provider := &kubernetesScalerProvider{
+		cfg: &config.KubernetesScalerConfig{Namespace: "default", Deployment: "formicary-ant"},
+		cli: cli,
+	}
+
+	// WHEN preparing to scale down
+	err := provider.PrepareScaleDown(context.Background(), map[string]int{"ant-1": 2, "ant-2": 0, "other": 1})
+
+	// THEN deletion cost of registered ant pods should be their load
+	require.NoError(t, err)
+	costs := make(map[string]string)
+	pods, err := cli.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	for _, pod := range pods.Items {
+		if cost, ok := pod.Annotations[podDeletionCostAnnotation]; ok {
+			costs[pod.Name] = cost
+		}
+	}
+	require.Equal(t, map[string]string{"ant-1": "2", "ant-2": "0"}, costs)
+}
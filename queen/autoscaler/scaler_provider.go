@@ -0,0 +1,48 @@
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+
+	"plexobject.com/formicary/queen/config"
+)
+
+// ScalerProvider starts and stops ants of a pool
+type ScalerProvider interface {
+	// Replicas returns number of ants of the pool that are running or starting
+	Replicas(ctx context.Context) (int, error)
+	// Scale changes number of ants of the pool to the given replicas
+	Scale(ctx context.Context, replicas int) error
+}
+
+// ScaleDownPreparer is implemented by providers that can be told which ants to stop first when the pool is
+// scaled down so that ants running tasks are not stopped
+type ScaleDownPreparer interface {
+	// PrepareScaleDown is called with current load of registered ants of the pool by ant-id before scaling down
+	PrepareScaleDown(ctx context.Context, antLoads map[string]int) error
+}
+
+// NewScalerProviders creates provider for each pool of the autoscaler config
+func NewScalerProviders(cfg *config.AutoscalerConfig) (map[string]ScalerProvider, error) {
+	providers := make(map[string]ScalerProvider)
+	for i := range cfg.Pools {
+		pool := &cfg.Pools[i]
+		var provider ScalerProvider
+		var err error
+		switch pool.Provider {
+		case config.KubernetesScalerProvider:
+			provider, err = newKubernetesScalerProvider(pool)
+		case config.DockerScalerProvider:
+			provider, err = newDockerScalerProvider(pool)
+		case config.ExecScalerProvider:
+			provider = newExecScalerProvider(pool)
+		default:
+			err = fmt.Errorf("provider '%s' is not supported", pool.Provider)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create scaler provider for ant pool %s due to %w", pool.Name, err)
+		}
+		providers[pool.Name] = provider
+	}
+	return providers, nil
+}
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/utils"
+)
+
+// ScalerProviderType defines how ants of a pool are started and stopped
+type ScalerProviderType string
+
+const (
+	// KubernetesScalerProvider scales replicas of a Kubernetes Deployment of ants
+	KubernetesScalerProvider ScalerProviderType = "KUBERNETES"
+	// DockerScalerProvider runs ants as containers on a Docker host
+	DockerScalerProvider ScalerProviderType = "DOCKER"
+	// ExecScalerProvider runs a hook command with the desired number of ants
+	ExecScalerProvider ScalerProviderType = "EXEC"
+)
+
+// AutoscalerConfig -- Defines pools of ants that are scaled by the queen based on pending jobs
+type AutoscalerConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled"`
+	// Interval is how often desired ants of pools are computed. Default 30s.
+	Interval time.Duration   `yaml:"interval" mapstructure:"interval"`
+	Pools    []AntPoolConfig `yaml:"pools" mapstructure:"pools"`
+}
+
+// AntPoolConfig -- Defines a pool of ants that support the method and tags along with bounds of its size
+type AntPoolConfig struct {
+	Name   string           `yaml:"name" mapstructure:"name" json:"name"`
+	Method types.TaskMethod `yaml:"method" mapstructure:"method" json:"method"`
+	Tags   []string         `yaml:"tags" mapstructure:"tags" json:"tags"`
+	// MinAnts is the least number of ants, zero allows the pool to scale to zero when it's idle
+	MinAnts int `yaml:"min_ants" mapstructure:"min_ants" json:"min_ants"`
+	// MaxAnts is the most number of ants
+	MaxAnts int `yaml:"max_ants" mapstructure:"max_ants" json:"max_ants"`
+	// AntCapacity is number of tasks an ant runs concurrently, which is used until ants of the pool register
+	// their max_capacity. Default 10.
+	AntCapacity int `yaml:"ant_capacity" mapstructure:"ant_capacity" json:"ant_capacity"`
+	// ScaleUpCooldown is the least time between two scale-ups. Default 1m.
+	ScaleUpCooldown time.Duration `yaml:"scale_up_cooldown" mapstructure:"scale_up_cooldown" json:"scale_up_cooldown"`
+	// ScaleDownCooldown is the least time after scaling before the pool is scaled down. Default 10m.
+	ScaleDownCooldown time.Duration          `yaml:"scale_down_cooldown" mapstructure:"scale_down_cooldown" json:"scale_down_cooldown"`
+	Provider          ScalerProviderType     `yaml:"provider" mapstructure:"provider" json:"provider"`
+	Kubernetes        KubernetesScalerConfig `yaml:"kubernetes" mapstructure:"kubernetes" json:"kubernetes"`
+	Docker            DockerScalerConfig     `yaml:"docker" mapstructure:"docker" json:"docker"`
+	Exec              ExecScalerConfig       `yaml:"exec" mapstructure:"exec" json:"exec"`
+}
+
+// KubernetesScalerConfig -- Defines Deployment whose replicas are the ants of the pool
+type KubernetesScalerConfig struct {
+	// Kubeconfig is path of kubeconfig, in-cluster config is used when it's empty
+	Kubeconfig string `yaml:"kubeconfig" mapstructure:"kubeconfig" json:"kubeconfig"`
+	Namespace  string `yaml:"namespace" mapstructure:"namespace" json:"namespace"`
+	Deployment string `yaml:"deployment" mapstructure:"deployment" json:"deployment"`
+}
+
+// DockerScalerConfig -- Defines containers of ants that are run on a Docker host
+type DockerScalerConfig struct {
+	// Host of docker daemon, e.g. unix:///var/run/docker.sock, environment is used when it's empty
+	Host        string   `yaml:"host" mapstructure:"host" json:"host"`
+	Image       string   `yaml:"image" mapstructure:"image" json:"image"`
+	Command     []string `yaml:"command" mapstructure:"command" json:"command"`
+	Environment []string `yaml:"environment" mapstructure:"environment" json:"environment"`
+	Network     string   `yaml:"network" mapstructure:"network" json:"network"`
+	// StopTimeout is how long a stopped ant can finish its tasks before it's killed. Default 5m.
+	StopTimeout time.Duration `yaml:"stop_timeout" mapstructure:"stop_timeout" json:"stop_timeout"`
+}
+
+// ExecScalerConfig -- Defines hook commands that scale ants of the pool
+type ExecScalerConfig struct {
+	// Command is run with FORMICARY_POOL and FORMICARY_DESIRED_ANTS environment variables when the pool is scaled
+	Command []string `yaml:"command" mapstructure:"command" json:"command"`
+	// ReplicasCommand prints number of ants of the pool, the last desired ants are used when it's empty.
+	// It's required when MinAnts is 0.
+	ReplicasCommand []string `yaml:"replicas_command" mapstructure:"replicas_command" json:"replicas_command"`
+	// Timeout of commands. Default 1m.
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" json:"timeout"`
+}
+
+// Validate validates autoscaler config
+func (c *AutoscalerConfig) Validate() error {
+	if c.Interval <= 0 {
+		c.Interval = 30 * time.Second
+	}
+	names := make(map[string]bool)
+	for i := range c.Pools {
+		pool := &c.Pools[i]
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		if names[pool.Name] {
+			return types.NewValidationError(fmt.Errorf("ant pool %s is defined more than once", pool.Name))
+		}
+		names[pool.Name] = true
+	}
+	return nil
+}
+
+// Validate validates pool config
+func (c *AntPoolConfig) Validate() error {
+	if c.Name == "" {
+		return types.NewValidationError(fmt.Errorf("name of ant pool is not specified"))
+	}
+	if c.Method == "" {
+		return types.NewValidationError(fmt.Errorf("method of ant pool %s is not specified", c.Name))
+	}
+	if c.MinAnts < 0 || c.MaxAnts <= 0 || c.MinAnts > c.MaxAnts {
+		return types.NewValidationError(fmt.Errorf("min_ants %d and max_ants %d of ant pool %s are not valid",
+			c.MinAnts, c.MaxAnts, c.Name))
+	}
+	if c.AntCapacity <= 0 {
+		c.AntCapacity = 10
+	}
+	if c.ScaleUpCooldown <= 0 {
+		c.ScaleUpCooldown = time.Minute
+	}
+	if c.ScaleDownCooldown <= 0 {
+		c.ScaleDownCooldown = 10 * time.Minute
+	}
+	switch c.Provider {
+	case KubernetesScalerProvider:
+		if c.Kubernetes.Deployment == "" {
+			return types.NewValidationError(fmt.Errorf("deployment of ant pool %s is not specified", c.Name))
+		}
+		if c.Kubernetes.Namespace == "" {
+			c.Kubernetes.Namespace = "default"
+		}
+	case DockerScalerProvider:
+		if c.Docker.Image == "" {
+			return types.NewValidationError(fmt.Errorf("image of ant pool %s is not specified", c.Name))
+		}
+		if c.Docker.StopTimeout <= 0 {
+			c.Docker.StopTimeout = 5 * time.Minute
+		}
+	case ExecScalerProvider:
+		if len(c.Exec.Command) == 0 {
+			return types.NewValidationError(fmt.Errorf("command of ant pool %s is not specified", c.Name))
+		}
+		// without replicas command, ants are assumed to be at min_ants after a restart so a pool that scales
+		// to zero would never stop ants that were started before the restart
+		if c.MinAnts == 0 && len(c.Exec.ReplicasCommand) == 0 {
+			return types.NewValidationError(fmt.Errorf(
+				"replicas_command of ant pool %s is required when min_ants is 0", c.Name))
+		}
+		if c.Exec.Timeout <= 0 {
+			c.Exec.Timeout = time.Minute
+		}
+	default:
+		return types.NewValidationError(fmt.Errorf("provider '%s' of ant pool %s is not supported",
+			c.Provider, c.Name))
+	}
+	return nil
+}
+
+// Matches returns true if an ant of the pool can run a task with the method and tags
+func (c *AntPoolConfig) Matches(method types.TaskMethod, tags []string) bool {
+	return c.Method == method && utils.MatchTagsArray(c.Tags, tags) == nil
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"plexobject.com/formicary/internal/types"
+)
+
+// DefaultCostRates is the key of rates used for methods that don't define their own rates
+const DefaultCostRates = "default"
+
+// CostPeriod defines period over which spending is compared with a budget
+type CostPeriod string
+
+const (
+	// DailyCostPeriod compares spending since start of the day with the budget
+	DailyCostPeriod CostPeriod = "daily"
+	// MonthlyCostPeriod compares spending since start of the month with the budget
+	MonthlyCostPeriod CostPeriod = "monthly"
+)
+
+// CostConfig -- Defines prices of resources used by tasks for chargeback of pipelines
+type CostConfig struct {
+	// Currency of rates and reported costs. Default USD.
+	Currency string `yaml:"currency" mapstructure:"currency"`
+	// Rates defines price of compute per executor method in lower case, e.g. kubernetes, docker or shell, and
+	// the default key is used for methods that don't define their own rates.
+	Rates map[string]CostRates `yaml:"rates" mapstructure:"rates"`
+	// StorageGBDay is price of storing a GB of artifacts for a day until the artifact expires.
+	StorageGBDay float64 `yaml:"storage_gb_day" mapstructure:"storage_gb_day"`
+	// ArtifactEgressGB is price of downloading a GB of artifacts.
+	ArtifactEgressGB float64 `yaml:"artifact_egress_gb" mapstructure:"artifact_egress_gb"`
+	// DefaultCPU is number of cores charged for containers that don't request cpu. Default 1.
+	DefaultCPU float64 `yaml:"default_cpu" mapstructure:"default_cpu"`
+	// DefaultMemoryGB is memory in GB charged for containers that don't request memory. Default 1.
+	DefaultMemoryGB float64 `yaml:"default_memory_gb" mapstructure:"default_memory_gb"`
+	// Budgets notifies recipients when spending of an organization, job type or tag exceeds its limit.
+	Budgets []CostBudget `yaml:"budgets" mapstructure:"budgets"`
+}
+
+// CostRates -- Defines price of compute used by a task
+type CostRates struct {
+	// CPUSecond is price of a core used for a second
+	CPUSecond float64 `yaml:"cpu_second" mapstructure:"cpu_second" json:"cpu_second"`
+	// MemoryGBSecond is price of a GB of memory used for a second
+	MemoryGBSecond float64 `yaml:"memory_gb_second" mapstructure:"memory_gb_second" json:"memory_gb_second"`
+}
+
+// CostBudget -- Defines limit of spending within a period for tasks matching organization, job type and tag,
+// where empty filters match all tasks.
+type CostBudget struct {
+	Name           string     `yaml:"name" mapstructure:"name" json:"name"`
+	OrganizationID string     `yaml:"organization_id" mapstructure:"organization_id" json:"organization_id"`
+	JobType        string     `yaml:"job_type" mapstructure:"job_type" json:"job_type"`
+	Tag            string     `yaml:"tag" mapstructure:"tag" json:"tag"`
+	Period         CostPeriod `yaml:"period" mapstructure:"period" json:"period"`
+	Limit          float64    `yaml:"limit" mapstructure:"limit" json:"limit"`
+	// Recipients are emails notified when spending exceeds the limit, once per period
+	Recipients []string `yaml:"recipients" mapstructure:"recipients" json:"recipients"`
+}
+
+// Validate validates cost config
+func (c *CostConfig) Validate() error {
+	if c.Currency == "" {
+		c.Currency = "USD"
+	}
+	if c.DefaultCPU <= 0 {
+		c.DefaultCPU = 1
+	}
+	if c.DefaultMemoryGB <= 0 {
+		c.DefaultMemoryGB = 1
+	}
+	if c.StorageGBDay < 0 || c.ArtifactEgressGB < 0 {
+		return types.NewValidationError(fmt.Errorf("storage_gb_day and artifact_egress_gb cannot be negative"))
+	}
+	rates := make(map[string]CostRates)
+	for method, rate := range c.Rates {
+		if rate.CPUSecond < 0 || rate.MemoryGBSecond < 0 {
+			return types.NewValidationError(fmt.Errorf("cost rates of %s cannot be negative", method))
+		}
+		rates[strings.ToLower(method)] = rate
+	}
+	c.Rates = rates
+	for i := range c.Budgets {
+		budget := &c.Budgets[i]
+		if budget.Name == "" {
+			return types.NewValidationError(fmt.Errorf("name of cost budget is not specified"))
+		}
+		if budget.Limit <= 0 {
+			return types.NewValidationError(fmt.Errorf("limit of cost budget %s must be positive", budget.Name))
+		}
+		if budget.Period == "" {
+			budget.Period = MonthlyCostPeriod
+		}
+		if budget.Period != DailyCostPeriod && budget.Period != MonthlyCostPeriod {
+			return types.NewValidationError(fmt.Errorf("period of cost budget %s must be daily or monthly", budget.Name))
+		}
+	}
+	return nil
+}
+
+// Enabled returns true if any price is configured so that costs of tasks are recorded
+func (c *CostConfig) Enabled() bool {
+	return len(c.Rates) > 0 || c.StorageGBDay > 0 || c.ArtifactEgressGB > 0
+}
+
+// GetRates returns price of compute of the executor method
+func (c *CostConfig) GetRates(method types.TaskMethod) CostRates {
+	if rates, ok := c.Rates[strings.ToLower(string(method))]; ok {
+		return rates
+	}
+	return c.Rates[DefaultCostRates]
+}
+
+// Matches returns true if the budget applies to tasks of the organization, job type and tags
+func (b *CostBudget) Matches(organizationID string, jobType string, tags []string) bool {
+	if b.OrganizationID != "" && b.OrganizationID != organizationID {
+		return false
+	}
+	if b.JobType != "" && b.JobType != jobType {
+		return false
+	}
+	if b.Tag == "" {
+		return true
+	}
+	for _, tag := range tags {
+		if tag == b.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// PeriodStart returns start of the budget period that contains the given time
+func (b *CostBudget) PeriodStart(now time.Time) time.Time {
+	if b.Period == DailyCostPeriod {
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+}
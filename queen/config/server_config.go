@@ -21,6 +21,8 @@ type ServerConfig struct {
 	Jobs                          JobsConfig            `yaml:"jobs" mapstructure:"jobs"`
 	SMTP                          SMTPConfig            `yaml:"smtp" mapstructure:"smtp" env:"SMTP"`
 	Notify                        NotifyConfig          `yaml:"notify" mapstructure:"notify"`
+	Cost                          CostConfig            `yaml:"cost" mapstructure:"cost"`
+	Autoscaler                    AutoscalerConfig      `yaml:"autoscaler" mapstructure:"autoscaler"`
 	EmbeddedAnt                   *ant_config.AntConfig `yaml:"embedded_ant" mapstructure:"embedded_ant"`
 	GatewaySubscriptions          map[string]bool       `yaml:"gateway_subscriptions" mapstructure:"gateway_subscriptions"`
 	URLPresignedExpirationMinutes time.Duration         `yaml:"url_presigned_expiration_minutes" mapstructure:"url_presigned_expiration_minutes"`
@@ -35,6 +37,13 @@ type NotifyConfig struct {
 	SlackJobsTemplateFile      string `yaml:"slack_jobs_template_file" mapstructure:"slack_jobs_template_file"`
 	VerifyEmailTemplateFile    string `yaml:"verify_email_template_file" mapstructure:"verify_email_template_file"`
 	UserInvitationTemplateFile string `yaml:"user_invitation_template_file" mapstructure:"user_invitation_template_file"`
+	// ApprovalLinkSecret signs approve/reject links in notifications; defaults to the JWT secret.
+	ApprovalLinkSecret string `yaml:"approval_link_secret" mapstructure:"approval_link_secret"`
+	// ApprovalLinkTTL is how long approve/reject links remain valid.
+	ApprovalLinkTTL time.Duration `yaml:"approval_link_ttl" mapstructure:"approval_link_ttl"`
+	// SlackSigningSecret verifies Slack interactivity requests for organizations that don't
+	// define the SlackSigningSecret config.
+	SlackSigningSecret string `yaml:"slack_signing_secret" mapstructure:"slack_signing_secret"`
 }
 
 // SMTPConfig -- Defines email config
@@ -94,6 +103,38 @@ type JobsConfig struct {
 	DisableTriggers                      bool          `yaml:"disable_triggers" mapstructure:"disable_triggers"`
 	// ApprovalSLACheckInterval is how often the scheduler checks for breached approval deadlines.
 	ApprovalSLACheckInterval             time.Duration `yaml:"approval_sla_check_interval" mapstructure:"approval_sla_check_interval"`
+	// SLACheckInterval is how often the scheduler checks for breached SLAs of jobs and tasks.
+	SLACheckInterval                     time.Duration `yaml:"sla_check_interval" mapstructure:"sla_check_interval"`
+	// AnomalySlowFactor flags a successful job that is slower than this multiple of its baseline. Default 2.
+	AnomalySlowFactor                    float64       `yaml:"anomaly_slow_factor" mapstructure:"anomaly_slow_factor"`
+	// AnomalyFailureRateIncrease flags a spike when failure rate of last 10 jobs exceeds baseline by this fraction. Default 0.3.
+	AnomalyFailureRateIncrease           float64       `yaml:"anomaly_failure_rate_increase" mapstructure:"anomaly_failure_rate_increase"`
+	// AnomalyMinRuns is number of runs of a job before anomalies are detected. Default 10.
+	AnomalyMinRuns                       int           `yaml:"anomaly_min_runs" mapstructure:"anomaly_min_runs"`
+	// FlakyTaskWindow is how far back execution history is used to score flakiness of tasks. Default 14 days.
+	FlakyTaskWindow                      time.Duration `yaml:"flaky_task_window" mapstructure:"flaky_task_window"`
+	// FlakyTaskScore is min flakiness score of a task to be quarantined by jobs that quarantine flaky tasks. Default 0.2.
+	FlakyTaskScore                       float64       `yaml:"flaky_task_score" mapstructure:"flaky_task_score"`
+	// FlakyTaskMinRuns is number of runs of a task within the window before it can be quarantined. Default 10.
+	FlakyTaskMinRuns                     int           `yaml:"flaky_task_min_runs" mapstructure:"flaky_task_min_runs"`
+	// ResourceProfileWindow is how far back profiled task executions are used to right-size tasks. Default 7 days.
+	ResourceProfileWindow                time.Duration `yaml:"resource_profile_window" mapstructure:"resource_profile_window"`
+	// RightSizingHeadroom is fraction added to used resources when recommending requests and limits. Default 0.2.
+	RightSizingHeadroom                  float64       `yaml:"right_sizing_headroom" mapstructure:"right_sizing_headroom"`
+	// AntDrainTimeout is how long a draining ant waits for its requests to finish before it exits. Default 1h.
+	AntDrainTimeout                      time.Duration `yaml:"ant_drain_timeout" mapstructure:"ant_drain_timeout"`
+	// PlacementStrategy is default strategy for choosing ants of tasks. Default LEAST_LOADED.
+	PlacementStrategy                    types.PlacementStrategy `yaml:"placement_strategy" mapstructure:"placement_strategy"`
+	// StickyPlacementTTL is how long the ant that ran a task is preferred for its retries and sticky tasks. Default 24h.
+	StickyPlacementTTL                   time.Duration `yaml:"sticky_placement_ttl" mapstructure:"sticky_placement_ttl"`
+	// HolidayCalendarDir is the directory of iCal files that job definitions refer to by name in
+	// holiday_calendars. Holiday calendars can't be used when it's empty.
+	HolidayCalendarDir                   string        `yaml:"holiday_calendar_dir" mapstructure:"holiday_calendar_dir"`
+	// HTTPTriggerAllowedHosts are hosts such as api.example.com or *.example.com that http triggers can poll.
+	// When it's empty, any host except localhost and internal addresses can be polled.
+	HTTPTriggerAllowedHosts              []string      `yaml:"http_trigger_allowed_hosts" mapstructure:"http_trigger_allowed_hosts"`
+	// FileTriggerWatchRoots are directories that file triggers can watch. File triggers can't be used when it's empty.
+	FileTriggerWatchRoots                []string      `yaml:"file_trigger_watch_roots" mapstructure:"file_trigger_watch_roots"`
 	// RetentionCheckInterval is how often the scheduler runs the history retention purge. Default 24h.
 	RetentionCheckInterval               time.Duration `yaml:"retention_check_interval" mapstructure:"retention_check_interval"`
 }
@@ -266,6 +307,46 @@ func (c *JobsConfig) Validate() error {
 	if c.ApprovalSLACheckInterval == 0 {
 		c.ApprovalSLACheckInterval = 60 * time.Second
 	}
+	if c.SLACheckInterval == 0 {
+		c.SLACheckInterval = 60 * time.Second
+	}
+	if c.AnomalySlowFactor <= 1 {
+		c.AnomalySlowFactor = 2
+	}
+	if c.AnomalyFailureRateIncrease <= 0 {
+		c.AnomalyFailureRateIncrease = 0.3
+	}
+	if c.AnomalyMinRuns <= 0 {
+		c.AnomalyMinRuns = 10
+	}
+	if c.FlakyTaskWindow <= 0 {
+		c.FlakyTaskWindow = 14 * 24 * time.Hour
+	}
+	if c.FlakyTaskScore <= 0 {
+		c.FlakyTaskScore = 0.2
+	}
+	if c.FlakyTaskMinRuns <= 0 {
+		c.FlakyTaskMinRuns = 10
+	}
+	if c.ResourceProfileWindow <= 0 {
+		c.ResourceProfileWindow = 7 * 24 * time.Hour
+	}
+	if c.RightSizingHeadroom <= 0 {
+		c.RightSizingHeadroom = 0.2
+	}
+	if c.AntDrainTimeout <= 0 {
+		c.AntDrainTimeout = time.Hour
+	}
+	if c.PlacementStrategy == "" {
+		c.PlacementStrategy = types.LeastLoadedPlacement
+	}
+	c.PlacementStrategy = types.PlacementStrategy(strings.ToUpper(string(c.PlacementStrategy)))
+	if !c.PlacementStrategy.IsValid() {
+		return fmt.Errorf("placement_strategy %s is not supported", c.PlacementStrategy)
+	}
+	if c.StickyPlacementTTL <= 0 {
+		c.StickyPlacementTTL = 24 * time.Hour
+	}
 	return nil
 }
 
@@ -306,9 +387,18 @@ func (c *ServerConfig) Validate() error {
 	if err := c.DB.Validate(); err != nil {
 		return err
 	}
+	if err := c.Cost.Validate(); err != nil {
+		return err
+	}
+	if err := c.Autoscaler.Validate(); err != nil {
+		return err
+	}
 	if err := c.Common.Auth.Validate(); err != nil {
 		return err
 	}
+	if c.Notify.ApprovalLinkSecret == "" {
+		c.Notify.ApprovalLinkSecret = c.Common.Auth.JWTSecret
+	}
 	if c.URLPresignedExpirationMinutes == 0 {
 		c.URLPresignedExpirationMinutes = 60 * 12
 	}
@@ -412,5 +502,8 @@ func (s *NotifyConfig) Validate(pubDir string) error {
 	if s.UserInvitationTemplateFile == "" {
 		s.UserInvitationTemplateFile = filepath.Join(pubDir, "views/notify/user_invitation.html")
 	}
+	if s.ApprovalLinkTTL == 0 {
+		s.ApprovalLinkTTL = 72 * time.Hour
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"plexobject.com/formicary/internal/types"
 	"testing"
+	"time"
 )
 
 func Test_ShouldLoadConfig(t *testing.T) {
@@ -57,3 +58,53 @@ func Test_ShouldValidateTopics(t *testing.T) {
 	require.Equal(t, "formicary-queue-task-ant-registration", c.GetResponseTopicAntRegistration())
 	require.Equal(t, "formicary-queue-task-reply", c.GetResponseTopicTaskReply())
 }
+
+func Test_ShouldValidateAutoscalerPools(t *testing.T) {
+	// GIVEN autoscaler config with a kubernetes pool
+	c := &AutoscalerConfig{Pools: []AntPoolConfig{{
+		Name:       "k8s",
+		Method:     types.Kubernetes,
+		MaxAnts:    3,
+		Provider:   KubernetesScalerProvider,
+		Kubernetes: KubernetesScalerConfig{Deployment: "formicary-ant"},
+	}}}
+	// WHEN validating
+	// THEN defaults should be set
+	require.NoError(t, c.Validate())
+	require.Equal(t, 30*time.Second, c.Interval)
+	require.Equal(t, "default", c.Pools[0].Kubernetes.Namespace)
+	require.Equal(t, 10, c.Pools[0].AntCapacity)
+	require.True(t, c.Pools[0].Matches(types.Kubernetes, nil))
+	require.False(t, c.Pools[0].Matches(types.Kubernetes, []string{"gpu"}))
+
+	// AND invalid bounds, providers and duplicate pools should fail
+	c.Pools[0].MinAnts = 5
+	require.Error(t, c.Validate())
+	c.Pools[0].MinAnts = 0
+	c.Pools[0].Provider = "CLOUD"
+	require.Error(t, c.Validate())
+	c.Pools[0].Provider = KubernetesScalerProvider
+	c.Pools = append(c.Pools, c.Pools[0])
+	require.Error(t, c.Validate())
+}
+
+func Test_ShouldRequireReplicasCommandOfExecPoolScalingToZero(t *testing.T) {
+	// GIVEN exec pool that scales to zero without replicas command
+	pool := &AntPoolConfig{
+		Name:     "exec",
+		Method:   types.Shell,
+		MaxAnts:  3,
+		Provider: ExecScalerProvider,
+		Exec:     ExecScalerConfig{Command: []string{"true"}},
+	}
+	// WHEN validating
+	// THEN it should fail
+	require.Error(t, pool.Validate())
+
+	// AND it should pass with replicas command or min ants
+	pool.Exec.ReplicasCommand = []string{"echo", "1"}
+	require.NoError(t, pool.Validate())
+	pool.Exec.ReplicasCommand = nil
+	pool.MinAnts = 1
+	require.NoError(t, pool.Validate())
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"net/http"
+
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+)
+
+// ApprovalActionAdminController structure
+type ApprovalActionAdminController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewApprovalActionAdminController confirmation page for approve/reject links of notifications.
+// The pages don't require login because the signed link identifies the approver; the vote is
+// only cast on POST so that mail scanners prefetching links don't vote.
+func NewApprovalActionAdminController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *ApprovalActionAdminController {
+	ctr := &ApprovalActionAdminController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/approvals/actions/:token", ctr.showApprovalAction, nil).Name = "show_approval_action"
+	webserver.POST("/approvals/actions/:token", ctr.castApprovalAction, nil).Name = "cast_approval_action"
+	return ctr
+}
+
+// ********************************* HTTP Handlers ***********************************
+// showApprovalAction - shows job awaiting approval and asks to confirm the decision
+func (ctr *ApprovalActionAdminController) showApprovalAction(c web.APIContext) error {
+	res := map[string]interface{}{"Token": c.Param("token")}
+	claims, request, err := ctr.jobManager.GetApprovalAction(c.Param("token"))
+	if err != nil {
+		res["Error"] = err
+	} else {
+		res["Claims"] = claims
+		res["Request"] = request
+	}
+	return c.Render(http.StatusOK, "approvals/action", res)
+}
+
+// castApprovalAction - casts the vote of the link
+func (ctr *ApprovalActionAdminController) castApprovalAction(c web.APIContext) error {
+	res := map[string]interface{}{"Token": c.Param("token")}
+	claims, request, err := ctr.jobManager.GetApprovalAction(c.Param("token"))
+	if err == nil {
+		res["Claims"] = claims
+		res["Request"] = request
+		status, user, voteErr := ctr.jobManager.CastApprovalVoteFromAction(
+			c.Request().Context(), c.Param("token"), "", "email")
+		res["Voter"] = user
+		if voteErr != nil {
+			err = voteErr
+		} else {
+			res["Status"] = status
+		}
+	}
+	if err != nil {
+		res["Error"] = err
+	}
+	return c.Render(http.StatusOK, "approvals/action", res)
+}
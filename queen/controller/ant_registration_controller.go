@@ -1,9 +1,12 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
 	"plexobject.com/formicary/internal/acl"
 	common "plexobject.com/formicary/internal/types"
+	"time"
+
 	"plexobject.com/formicary/internal/web"
 	"plexobject.com/formicary/queen/resource"
 )
@@ -24,6 +27,7 @@ func NewAntRegistrationController(
 	}
 	webserver.GET("/api/ants", wrc.queryAntRegistrations, acl.NewPermission(acl.AntExecutor, acl.Query)).Name = "query_ants"
 	webserver.GET("/api/ants/:id", wrc.getAntRegistration, acl.NewPermission(acl.AntExecutor, acl.View)).Name = "get_ant"
+	webserver.POST("/api/ants/:id/drain", wrc.drainAnt, acl.NewPermission(acl.AntExecutor, acl.Update)).Name = "drain_ant"
 	return wrc
 }
 
@@ -50,6 +54,27 @@ func (wrc *AntRegistrationController) getAntRegistration(c web.APIContext) error
 	return c.JSON(http.StatusOK, rec)
 }
 
+// Drains ant so that it stops accepting new tasks and exits after its running tasks finish or the timeout elapses.
+// `This requires admin access`
+// responses:
+//   200: antRegistrationResponse
+func (wrc *AntRegistrationController) drainAnt(c web.APIContext) error {
+	var timeout time.Duration
+	if timeoutParam := c.QueryParam("timeout"); timeoutParam != "" {
+		var err error
+		if timeout, err = time.ParseDuration(timeoutParam); err != nil {
+			return common.NewValidationError(fmt.Errorf("timeout %s is not a valid duration", timeoutParam))
+		}
+	}
+	if wrc.resourceManager.Registration(c.Param("id")) == nil {
+		return c.String(http.StatusNotFound, "could not find ant registration")
+	}
+	if err := wrc.resourceManager.Drain(c.Request().Context(), c.Param("id"), timeout); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, wrc.resourceManager.Registration(c.Param("id")))
+}
+
 // ********************************* Swagger types ***********************************
 
 // The parameter for querying ant registration
@@ -74,6 +99,15 @@ type antIDParams struct {
 	ID string `json:"id"`
 }
 
+// The parameters for draining ant
+type antDrainParams struct {
+	// in:path
+	ID string `json:"id"`
+	// Timeout for running tasks to finish such as 30m, defaults to ant_drain_timeout of jobs config
+	// in:query
+	Timeout string `json:"timeout"`
+}
+
 // Ant Registration body
 type antRegistrationResponseBody struct {
 	// in:body
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"plexobject.com/formicary/internal/metrics"
 	"plexobject.com/formicary/internal/queue"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/internal/web"
@@ -22,6 +23,7 @@ func Test_InitializeSwaggerStructsForAntRegistration(t *testing.T) {
 	_ = antRegistrationsQueryResponseBody{}
 	_ = antIDParams{}
 	_ = antRegistrationResponseBody{}
+	_ = antDrainParams{}
 }
 
 func Test_ShouldQueryAntRegistration(t *testing.T) {
@@ -69,8 +71,43 @@ func Test_ShouldGetAntRegistration(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldDrainAnt(t *testing.T) {
+	// GIVEN ant registration controller with a registered ant
+	cfg := config.TestServerConfig()
+	queueClient := buildTestQueueClient(cfg)
+	mgr := newTestResourceManager(cfg, queueClient, t)
+	require.NoError(t, mgr.Register(context.Background(), &common.AntRegistration{
+		AntID:       "drain-ant-id",
+		AntTopic:    "ant-topic",
+		Methods:     []common.TaskMethod{"test"},
+		MaxCapacity: 10,
+	}))
+	webServer := web.NewStubWebServer()
+	ctrl := NewAntRegistrationController(mgr, webServer)
+
+	// WHEN draining the ant with invalid timeout
+	reader := io.NopCloser(strings.NewReader(""))
+	req := &http.Request{Body: reader, URL: &url.URL{}}
+	ctx := web.NewStubContext(req)
+	ctx.Params["id"] = "drain-ant-id"
+	ctx.Params["timeout"] = "soon"
+	// THEN it should fail
+	require.Error(t, ctrl.drainAnt(ctx))
+
+	// WHEN draining the ant
+	ctx = web.NewStubContext(req)
+	ctx.Params["id"] = "drain-ant-id"
+	ctx.Params["timeout"] = "10m"
+	err := ctrl.drainAnt(ctx)
+
+	// THEN ant should be draining
+	require.NoError(t, err)
+	require.True(t, ctx.Result.(*common.AntRegistration).Draining)
+	require.Error(t, mgr.HasAntsForJobTags([]common.TaskMethod{"test"}, nil))
+}
+
 func newTestResourceManager(serverCfg *config.ServerConfig, queueClient queue.Client, t *testing.T) resource.Manager {
-	mgr := resource.New(serverCfg, queueClient)
+	mgr := resource.New(serverCfg, queueClient, metrics.New())
 	err := mgr.Start(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error %s", err)
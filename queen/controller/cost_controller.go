@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"plexobject.com/formicary/internal/acl"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// CostController structure
+type CostController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewCostController instantiates controller for reporting cost of jobs for chargeback
+func NewCostController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *CostController {
+	costCtrl := &CostController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/api/costs/report", costCtrl.getCostReport, acl.NewPermission(acl.Report, acl.View)).Name = "get_cost_report"
+	webserver.GET("/api/costs/report/csv", costCtrl.exportCostReport, acl.NewPermission(acl.Report, acl.View)).Name = "export_cost_report"
+	return costCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Returns cost of tasks aggregated by organization, user, job type or tag between from and to dates.
+// responses:
+//
+//	200: costReportResponse
+func (costCtrl *CostController) getCostReport(c web.APIContext) error {
+	report, err := costCtrl.buildReport(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// Exports cost of tasks aggregated by organization, user, job type or tag between from and to dates as CSV.
+// responses:
+//
+//	200: byteResponse
+func (costCtrl *CostController) exportCostReport(c web.APIContext) error {
+	report, err := costCtrl.buildReport(c)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err = report.WriteCSV(&buf); err != nil {
+		return err
+	}
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q",
+		fmt.Sprintf("costs-%s-%s.csv", report.GroupBy, report.From.Format("2006-01-02"))))
+	return c.Blob(http.StatusOK, "text/csv", buf.Bytes())
+}
+
+// buildReport parses group_by and inclusive from and to dates, which default to the last 30 days
+func (costCtrl *CostController) buildReport(c web.APIContext) (*types.CostReport, error) {
+	qc := web.BuildQueryContext(c)
+	groupBy := types.CostGroupBy(c.QueryParam("group_by"))
+	if groupBy == "" {
+		groupBy = types.CostByJobType
+	}
+	now := time.Now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if toParam := c.QueryParam("to"); toParam != "" {
+		var err error
+		if to, err = time.Parse("2006-01-02", toParam); err != nil {
+			return nil, common.NewValidationError(fmt.Errorf("to %s must be in YYYY-MM-DD format", toParam))
+		}
+	}
+	from := to.AddDate(0, 0, -30)
+	if fromParam := c.QueryParam("from"); fromParam != "" {
+		var err error
+		if from, err = time.Parse("2006-01-02", fromParam); err != nil {
+			return nil, common.NewValidationError(fmt.Errorf("from %s must be in YYYY-MM-DD format", fromParam))
+		}
+	}
+	return costCtrl.jobManager.GetCostReport(qc, groupBy, from, to.AddDate(0, 0, 1))
+}
+
+// ********************************* Swagger types ***********************************
+
+// The params for cost report.
+type costReportQueryParams struct {
+	// in:query
+	// GroupBy is org, user, job_type or tag
+	GroupBy string `json:"group_by"`
+	// From is inclusive start date in YYYY-MM-DD format
+	From string `json:"from"`
+	// To is inclusive end date in YYYY-MM-DD format
+	To string `json:"to"`
+}
+
+// Cost of tasks aggregated by organization, user, job type or tag
+type costReportBody struct {
+	// in:body
+	Body types.CostReport
+}
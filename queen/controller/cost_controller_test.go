@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForCosts(t *testing.T) {
+	_ = costReportQueryParams{}
+	_ = costReportBody{}
+}
+
+func Test_ShouldReportCosts(t *testing.T) {
+	// GIVEN cost controller with a price of shell tasks
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	serverCfg := config.TestServerConfig()
+	serverCfg.Cost.Rates = map[string]config.CostRates{"shell": {CPUSecond: 0.01}}
+	mgr := manager.AssertTestJobManager(serverCfg, t)
+	mgr.SetCostManager(manager.AssertTestCostManager(serverCfg, t))
+	webServer := web.NewStubWebServer()
+	ctrl := NewCostController(mgr, webServer)
+	// AND a shell task of the user that ran for a minute
+	req := types.NewRequest()
+	req.ID = ulid.Make().String()
+	req.JobType = "cost-job-" + ulid.Make().String()
+	req.UserID = qc.User.ID
+	req.OrganizationID = qc.User.OrganizationID
+	taskExec := &types.TaskExecution{
+		ID:        ulid.Make().String(),
+		TaskType:  "build",
+		Method:    common.Shell,
+		StartedAt: time.Now().Add(-time.Minute),
+	}
+	mgr.RecordTaskCost(req, taskExec, nil, nil)
+	require.InDelta(t, 0.6, taskExec.Cost, 0.01)
+
+	// WHEN querying costs by job type
+	ctx := web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader("")), URL: &url.URL{}})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["group_by"] = string(types.CostByJobType)
+	err = ctrl.getCostReport(ctx)
+
+	// THEN it should return cost of the job type
+	require.NoError(t, err)
+	report := ctx.Result.(*types.CostReport)
+	found := false
+	for _, s := range report.Summaries {
+		if s.Key == req.JobType {
+			found = true
+			require.InDelta(t, 0.6, s.ComputeCost, 0.01)
+		}
+	}
+	require.True(t, found)
+
+	// WHEN exporting costs as CSV
+	ctx.SetResponse(echo.NewResponse(httptest.NewRecorder(), nil))
+	err = ctrl.exportCostReport(ctx)
+	// THEN it should return CSV as attachment
+	require.NoError(t, err)
+	require.Equal(t, "text/csv", ctx.Result)
+	require.Contains(t, ctx.Response().Header().Get("Content-Disposition"), "costs-job_type-")
+
+	// WHEN querying costs with invalid dates or dimension
+	ctx.Params["from"] = "yesterday"
+	// THEN it should fail
+	require.Error(t, ctrl.getCostReport(ctx))
+	ctx.Params["from"] = ""
+	ctx.Params["group_by"] = "team"
+	require.Error(t, ctrl.getCostReport(ctx))
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"plexobject.com/formicary/internal/acl"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// CronBackfillController structure
+type CronBackfillController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewCronBackfillController instantiates controller for backfilling cron jobs
+func NewCronBackfillController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *CronBackfillController {
+	backfillCtrl := &CronBackfillController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/api/jobs/backfills", backfillCtrl.queryCronBackfills, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "query_cron_backfills"
+	webserver.GET("/api/jobs/backfills/:id", backfillCtrl.getCronBackfill, acl.NewPermission(acl.JobRequest, acl.View)).Name = "get_cron_backfill"
+	webserver.POST("/api/jobs/backfills", backfillCtrl.postCronBackfill, acl.NewPermission(acl.JobRequest, acl.Submit)).Name = "create_cron_backfill"
+	webserver.POST("/api/jobs/backfills/:id/cancel", backfillCtrl.cancelCronBackfill, acl.NewPermission(acl.JobRequest, acl.Cancel)).Name = "cancel_cron_backfill"
+	return backfillCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Queries recent backfills of a cron job or all cron jobs.
+// responses:
+//
+//	200: cronBackfillsResponse
+func (backfillCtrl *CronBackfillController) queryCronBackfills(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	recs, err := backfillCtrl.jobManager.QueryCronBackfills(qc, c.QueryParam("job_type"), limit)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, recs)
+}
+
+// Finds the backfill by id.
+// responses:
+//
+//	200: cronBackfill
+func (backfillCtrl *CronBackfillController) getCronBackfill(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	backfill, err := backfillCtrl.jobManager.GetCronBackfill(qc, c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, backfill)
+}
+
+// Submits a job-request for every cron tick of the job in the date range that hasn't run yet.
+// responses:
+//
+//	201: cronBackfill
+func (backfillCtrl *CronBackfillController) postCronBackfill(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	req := &types.CronBackfillRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(req); err != nil {
+		return common.NewValidationError(err)
+	}
+	backfill, err := backfillCtrl.jobManager.BackfillCronJob(qc, req.JobType, req.From, req.To, req.MaxParallel)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, backfill)
+}
+
+// Cancels a backfill and its pending job-requests.
+// responses:
+//
+//	200: cronBackfill
+func (backfillCtrl *CronBackfillController) cancelCronBackfill(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	backfill, err := backfillCtrl.jobManager.CancelCronBackfill(qc, c.Param("id"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, backfill)
+}
+
+// ********************************* Swagger types ***********************************
+
+// The params for querying backfills.
+type cronBackfillQueryParams struct {
+	// in:query
+	JobType string `json:"job_type"`
+	Limit   int    `json:"limit"`
+}
+
+// The parameters for finding backfill by id
+type cronBackfillIDParams struct {
+	// in:path
+	ID string `json:"id"`
+}
+
+// The request body for backfilling a cron job.
+type cronBackfillParams struct {
+	// in:body
+	Body types.CronBackfillRequest
+}
+
+// CronBackfill tracks job-requests submitted for cron ticks of a date range.
+type cronBackfillBody struct {
+	// in:body
+	Body types.CronBackfill
+}
+
+// Recent backfills
+type cronBackfillsBody struct {
+	// in:body
+	Body []types.CronBackfill
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForCronBackfill(t *testing.T) {
+	_ = cronBackfillQueryParams{}
+	_ = cronBackfillIDParams{}
+	_ = cronBackfillParams{}
+	_ = cronBackfillBody{}
+	_ = cronBackfillsBody{}
+}
+
+func Test_ShouldCreateQueryAndCancelCronBackfill(t *testing.T) {
+	// GIVEN backfill controller and an hourly cron job
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	mgr := manager.AssertTestJobManager(nil, t)
+	webServer := web.NewStubWebServer()
+	ctrl := NewCronBackfillController(mgr, webServer)
+	job := repository.NewTestJobDefinition(qc.User, "backfill-"+ulid.Make().String())
+	job.CronTrigger = "0 0 * * * * *"
+	_, err = mgr.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+
+	// WHEN backfilling last three hours
+	from := time.Now().Truncate(time.Hour).Add(-3 * time.Hour)
+	b, err := json.Marshal(&types.CronBackfillRequest{JobType: job.JobType, From: from, To: time.Now(), MaxParallel: 1})
+	require.NoError(t, err)
+	ctx := web.NewStubContext(&http.Request{Body: io.NopCloser(bytes.NewReader(b))})
+	ctx.Set(web.DBUser, qc.User)
+	err = ctrl.postCronBackfill(ctx)
+
+	// THEN backfill should be created
+	require.NoError(t, err)
+	backfill := ctx.Result.(*types.CronBackfill)
+	require.Equal(t, common.EXECUTING, backfill.State)
+	require.Equal(t, 1, backfill.SubmittedTicks)
+
+	// WHEN querying backfills of the job
+	ctx = web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader("")), URL: &url.URL{}})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["job_type"] = job.JobType
+	err = ctrl.queryCronBackfills(ctx)
+	// THEN it should return the backfill
+	require.NoError(t, err)
+	require.Len(t, ctx.Result.([]*types.CronBackfill), 1)
+
+	// WHEN cancelling the backfill
+	ctx = web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader(""))})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["id"] = backfill.ID
+	err = ctrl.cancelCronBackfill(ctx)
+	require.NoError(t, err)
+
+	// THEN getting it should return cancelled backfill
+	err = ctrl.getCronBackfill(ctx)
+	require.NoError(t, err)
+	require.Equal(t, common.CANCELLED, ctx.Result.(*types.CronBackfill).State)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"net/http"
+
+	"plexobject.com/formicary/internal/acl"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// FlakyTaskController structure
+type FlakyTaskController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewFlakyTaskController instantiates controller for reporting flaky tasks
+func NewFlakyTaskController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *FlakyTaskController {
+	flakyCtrl := &FlakyTaskController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/api/jobs/flaky-tasks", flakyCtrl.queryFlakyTasks, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "query_flaky_tasks"
+	return flakyCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Queries tasks of a job or all jobs that failed and then passed on retry or re-run, ordered by flakiness score.
+// responses:
+//
+//	200: flakyTasksResponse
+func (flakyCtrl *FlakyTaskController) queryFlakyTasks(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	tasks, err := flakyCtrl.jobManager.GetFlakyTasks(qc, c.QueryParam("job_type"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, tasks)
+}
+
+// ********************************* Swagger types ***********************************
+
+// The params for querying flaky tasks.
+type flakyTaskQueryParams struct {
+	// in:query
+	JobType string `json:"job_type"`
+}
+
+// Flaky tasks with their flakiness score
+type flakyTasksBody struct {
+	// in:body
+	Body []types.FlakyTask
+}
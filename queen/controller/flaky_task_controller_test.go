@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForFlakyTasks(t *testing.T) {
+	_ = flakyTaskQueryParams{}
+	_ = flakyTasksBody{}
+}
+
+func Test_ShouldQueryAndQuarantineFlakyTasks(t *testing.T) {
+	// GIVEN flaky-task controller and a job that quarantines flaky tasks
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	serverCfg := config.TestServerConfig()
+	serverCfg.Jobs.FlakyTaskMinRuns = 1
+	mgr := manager.AssertTestJobManager(serverCfg, t)
+	webServer := web.NewStubWebServer()
+	ctrl := NewFlakyTaskController(mgr, webServer)
+	job := repository.NewTestJobDefinition(qc.User, "flaky-"+ulid.Make().String())
+	job.QuarantineFlakyTasks = true
+	job, err = mgr.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	require.True(t, job.QuarantineFlakyTasks)
+	// AND an execution where the first task passed on retry
+	req, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	_, err = mgr.SaveJobRequest(qc, req)
+	require.NoError(t, err)
+	jobExec := types.NewJobExecution(req.ToInfo())
+	task := jobExec.AddTask(job.Tasks[0])
+	task.TaskState = common.COMPLETED
+	task.Retried = 1
+	task.StartedAt = time.Now()
+	_, err = mgr.CreateJobExecution(jobExec)
+	require.NoError(t, err)
+
+	// WHEN querying flaky tasks of the job
+	ctx := web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader("")), URL: &url.URL{}})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["job_type"] = job.JobType
+	err = ctrl.queryFlakyTasks(ctx)
+
+	// THEN it should return the task as quarantined
+	require.NoError(t, err)
+	tasks := ctx.Result.([]*types.FlakyTask)
+	require.Len(t, tasks, 1)
+	require.Equal(t, task.TaskType, tasks[0].TaskType)
+	require.Equal(t, 1.0, tasks[0].Score)
+	require.True(t, tasks[0].Quarantined)
+	// AND the task should be quarantined when it runs again
+	require.NotNil(t, mgr.GetQuarantinedTask(job, task.TaskType))
+	require.Nil(t, mgr.GetQuarantinedTask(job, job.Tasks[1].TaskType))
+}
@@ -41,6 +41,9 @@ func NewJobRequestController(
 	webserver.POST("/api/jobs/requests/:id/tasks/:taskType/vote", jobReqCtrl.voteOnApproval, acl.NewPermission(acl.JobRequest, acl.Approve)).Name = "vote_on_approval"
 	webserver.GET("/api/jobs/requests/:id/tasks/:taskType/approval", jobReqCtrl.getApprovalStatus, acl.NewPermission(acl.JobRequest, acl.View)).Name = "get_approval_status"
 	webserver.GET("/api/approvals/pending", jobReqCtrl.listPendingApprovals, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "list_pending_approvals"
+	webserver.GET("/api/approvals/delegations", jobReqCtrl.listApprovalDelegations, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "list_approval_delegations"
+	webserver.POST("/api/approvals/delegations", jobReqCtrl.createApprovalDelegation, acl.NewPermission(acl.JobRequest, acl.Approve)).Name = "create_approval_delegation"
+	webserver.DELETE("/api/approvals/delegations/:id", jobReqCtrl.revokeApprovalDelegation, acl.NewPermission(acl.JobRequest, acl.Approve)).Name = "revoke_approval_delegation"
 	webserver.POST("/api/jobs/requests/:id/pause", jobReqCtrl.pauseJobRequest, acl.NewPermission(acl.JobRequest, acl.Pause)).Name = "pause_job_request"
 	webserver.POST("/api/jobs/requests/:id/restart", jobReqCtrl.restartJobRequest, acl.NewPermission(acl.JobRequest, acl.Restart)).Name = "restart_job_request"
 	webserver.POST("/api/jobs/requests/:id/trigger", jobReqCtrl.triggerJobRequest, acl.NewPermission(acl.JobRequest, acl.Trigger)).Name = "trigger_job_request"
@@ -205,6 +208,48 @@ func (jobReqCtrl *JobRequestController) listPendingApprovals(c web.APIContext) e
 	return c.JSON(http.StatusOK, NewPaginatedResult(pending, total, page, pageSize))
 }
 
+// Lists unexpired approval delegations from or to the current user.
+// responses:
+//
+//	200: approvalDelegationsResponse
+func (jobReqCtrl *JobRequestController) listApprovalDelegations(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	delegations, err := jobReqCtrl.jobManager.ListApprovalDelegations(qc)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, delegations)
+}
+
+// Delegates approval votes of the current user to another user while the current user is out of office.
+// responses:
+//
+//	200: approvalDelegation
+func (jobReqCtrl *JobRequestController) createApprovalDelegation(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	delegation := &types.ApprovalDelegation{}
+	if err := json.NewDecoder(c.Request().Body).Decode(delegation); err != nil {
+		return err
+	}
+	saved, err := jobReqCtrl.jobManager.CreateApprovalDelegation(qc, delegation)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, saved)
+}
+
+// Revokes an approval delegation of the current user.
+// responses:
+//
+//	200: emptyResponse
+func (jobReqCtrl *JobRequestController) revokeApprovalDelegation(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	if err := jobReqCtrl.jobManager.RevokeApprovalDelegation(qc, c.Param("id")); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
 // Pauses a job-request that is already executing.
 // responses:
 //
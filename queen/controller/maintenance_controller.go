@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"plexobject.com/formicary/internal/acl"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// MaintenanceController structure
+type MaintenanceController struct {
+	maintenanceManager *manager.MaintenanceManager
+	webserver          web.Server
+}
+
+// NewMaintenanceController instantiates controller for cluster-wide maintenance mode
+func NewMaintenanceController(
+	maintenanceManager *manager.MaintenanceManager,
+	webserver web.Server) *MaintenanceController {
+	maintenanceCtrl := &MaintenanceController{
+		maintenanceManager: maintenanceManager,
+		webserver:          webserver,
+	}
+	webserver.GET("/api/maintenance", maintenanceCtrl.getMaintenanceMode, acl.NewPermission(acl.SystemConfig, acl.View)).Name = "get_maintenance"
+	webserver.PUT("/api/maintenance", maintenanceCtrl.putMaintenanceMode, acl.NewPermission(acl.SystemConfig, acl.Update)).Name = "update_maintenance"
+	return maintenanceCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Retrieves maintenance mode.
+// `This requires admin access`
+// responses:
+//
+//	200: maintenanceModeResponse
+func (maintenanceCtrl *MaintenanceController) getMaintenanceMode(c web.APIContext) error {
+	return c.JSON(http.StatusOK, maintenanceCtrl.maintenanceManager.Status())
+}
+
+// Enables or disables maintenance mode, which pauses scheduling of new jobs while running jobs complete.
+// `This requires admin access`
+// responses:
+//
+//	200: maintenanceModeResponse
+func (maintenanceCtrl *MaintenanceController) putMaintenanceMode(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	req := &types.MaintenanceMode{}
+	if err := json.NewDecoder(c.Request().Body).Decode(req); err != nil {
+		return common.NewValidationError(err)
+	}
+	mode, err := maintenanceCtrl.maintenanceManager.Update(qc, req.Enabled, req.Reason)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, mode)
+}
+
+// ********************************* Swagger types ***********************************
+
+// The request body for updating maintenance mode
+type maintenanceModeParams struct {
+	// in:body
+	Body struct {
+		Enabled bool   `json:"enabled"`
+		Reason  string `json:"reason"`
+	}
+}
+
+// Maintenance mode body
+type maintenanceModeResponseBody struct {
+	// in:body
+	Body types.MaintenanceMode
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/acl"
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForMaintenance(t *testing.T) {
+	_ = maintenanceModeParams{}
+	_ = maintenanceModeResponseBody{}
+}
+
+func Test_ShouldUpdateMaintenanceMode(t *testing.T) {
+	// GIVEN maintenance controller
+	admin := common.NewUser("", "admin", "name", "admin@formicary.io", acl.NewRolesWithAdmin())
+	repo, err := repository.NewTestSystemConfigRepository()
+	require.NoError(t, err)
+	mgr, err := manager.NewMaintenanceManager(repo, metrics.New())
+	require.NoError(t, err)
+	webServer := web.NewStubWebServer()
+	ctrl := NewMaintenanceController(mgr, webServer)
+
+	// WHEN enabling maintenance mode
+	reader := io.NopCloser(strings.NewReader(`{"enabled": true, "reason": "upgrading ants"}`))
+	ctx := web.NewStubContext(&http.Request{Body: reader})
+	ctx.Set(web.DBUser, admin)
+	err = ctrl.putMaintenanceMode(ctx)
+
+	// THEN it should be enabled
+	require.NoError(t, err)
+	require.True(t, ctx.Result.(*types.MaintenanceMode).Enabled)
+
+	// WHEN fetching maintenance mode
+	ctx = web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader(""))})
+	ctx.Set(web.DBUser, admin)
+	err = ctrl.getMaintenanceMode(ctx)
+
+	// THEN it should return the reason
+	require.NoError(t, err)
+	require.Equal(t, "upgrading ants", ctx.Result.(*types.MaintenanceMode).Reason)
+
+	// WHEN disabling maintenance mode
+	reader = io.NopCloser(strings.NewReader(`{"enabled": false}`))
+	ctx = web.NewStubContext(&http.Request{Body: reader})
+	ctx.Set(web.DBUser, admin)
+	err = ctrl.putMaintenanceMode(ctx)
+
+	// THEN it should be disabled
+	require.NoError(t, err)
+	require.False(t, mgr.InMaintenance())
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"plexobject.com/formicary/internal/acl"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// SLAController structure
+type SLAController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewSLAController instantiates controller for reporting SLA compliance of jobs
+func NewSLAController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *SLAController {
+	slaCtrl := &SLAController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/api/jobs/sla/report", slaCtrl.getSLAReport, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "get_sla_report"
+	webserver.GET("/api/jobs/sla/breaches", slaCtrl.querySLABreaches, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "query_sla_breaches"
+	return slaCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Returns SLA compliance per job type and day of requests that finished within the days.
+// responses:
+//
+//	200: slaReportResponse
+func (slaCtrl *SLAController) getSLAReport(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	days, _ := strconv.Atoi(c.QueryParam("days"))
+	if days <= 0 || days > 365 {
+		days = 30
+	}
+	report, err := slaCtrl.jobManager.GetSLAReport(qc, c.QueryParam("job_type"), days)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// Queries recent SLA breaches of a job or all jobs.
+// responses:
+//
+//	200: slaBreachesResponse
+func (slaCtrl *SLAController) querySLABreaches(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	breaches, err := slaCtrl.jobManager.QuerySLABreaches(qc, c.QueryParam("job_type"), limit)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, breaches)
+}
+
+// ********************************* Swagger types ***********************************
+
+// The params for SLA report.
+type slaReportQueryParams struct {
+	// in:query
+	JobType string `json:"job_type"`
+	Days    int    `json:"days"`
+}
+
+// The params for querying SLA breaches.
+type slaBreachQueryParams struct {
+	// in:query
+	JobType string `json:"job_type"`
+	Limit   int    `json:"limit"`
+}
+
+// SLA compliance per job type and day
+type slaReportBody struct {
+	// in:body
+	Body []types.SLACompliance
+}
+
+// Recent SLA breaches
+type slaBreachesBody struct {
+	// in:body
+	Body []types.SLABreach
+}
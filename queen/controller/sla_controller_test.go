@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForSLA(t *testing.T) {
+	_ = slaReportQueryParams{}
+	_ = slaBreachQueryParams{}
+	_ = slaReportBody{}
+	_ = slaBreachesBody{}
+}
+
+func Test_ShouldQuerySLABreachesAndReport(t *testing.T) {
+	// GIVEN sla controller and a pending request of a cron tick that missed its finish_by time
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	mgr := manager.AssertTestJobManager(nil, t)
+	webServer := web.NewStubWebServer()
+	ctrl := NewSLAController(mgr, webServer)
+	tick := time.Now().Add(-25 * time.Hour).Truncate(time.Minute)
+	job := repository.NewTestJobDefinition(qc.User, "sla-ctrl-"+ulid.Make().String())
+	job.CronTrigger = "0 0 * * * * *"
+	job.SLA = &types.SLAConfig{FinishBy: tick.Add(time.Hour).Format("15:04")}
+	job, err = mgr.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	req, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	req.UserKey = ""
+	req.SetLogicalDate(tick)
+	// a catch-up request keeps the logical date of its tick
+	req.BackfillID = "sla-backfill"
+	_, err = mgr.SaveJobRequest(qc, req)
+	require.NoError(t, err)
+	_, err = mgr.CheckSLABreaches(time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+
+	// WHEN querying breaches of the job
+	ctx := web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader("")), URL: &url.URL{}})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["job_type"] = job.JobType
+	err = ctrl.querySLABreaches(ctx)
+	// THEN it should return the breach
+	require.NoError(t, err)
+	breaches := ctx.Result.([]*types.SLABreach)
+	require.Len(t, breaches, 1)
+	require.Equal(t, types.SLAFinishBy, breaches[0].Kind)
+
+	// WHEN querying report of the job
+	err = ctrl.getSLAReport(ctx)
+	// THEN it should succeed without finished requests
+	require.NoError(t, err)
+	require.Len(t, ctx.Result.([]*types.SLACompliance), 0)
+}
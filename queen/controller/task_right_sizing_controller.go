@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"net/http"
+
+	"plexobject.com/formicary/internal/acl"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// TaskRightSizingController structure
+type TaskRightSizingController struct {
+	jobManager *manager.JobManager
+	webserver  web.Server
+}
+
+// NewTaskRightSizingController instantiates controller for reporting resources used by tasks
+func NewTaskRightSizingController(
+	jobManager *manager.JobManager,
+	webserver web.Server) *TaskRightSizingController {
+	sizingCtrl := &TaskRightSizingController{
+		jobManager: jobManager,
+		webserver:  webserver,
+	}
+	webserver.GET("/api/jobs/right-sizing", sizingCtrl.queryTaskRightSizing, acl.NewPermission(acl.JobRequest, acl.Query)).Name = "query_task_right_sizing"
+	return sizingCtrl
+}
+
+// ********************************* HTTP Handlers ***********************************
+
+// Queries cpu, memory and i/o used by profiled tasks of a job or all jobs along with recommended requests and
+// limits of their containers.
+// responses:
+//
+//	200: taskRightSizingResponse
+func (sizingCtrl *TaskRightSizingController) queryTaskRightSizing(c web.APIContext) error {
+	qc := web.BuildQueryContext(c)
+	profiles, err := sizingCtrl.jobManager.GetTaskRightSizing(qc, c.QueryParam("job_type"))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, profiles)
+}
+
+// ********************************* Swagger types ***********************************
+
+// The params for querying right-sizing of tasks.
+type taskRightSizingQueryParams struct {
+	// in:query
+	JobType string `json:"job_type"`
+}
+
+// Resources used by tasks with recommended requests and limits
+type taskRightSizingBody struct {
+	// in:body
+	Body []types.TaskRightSizing
+}
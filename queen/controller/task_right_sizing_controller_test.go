@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_InitializeSwaggerStructsForTaskRightSizing(t *testing.T) {
+	_ = taskRightSizingQueryParams{}
+	_ = taskRightSizingBody{}
+}
+
+func Test_ShouldQueryTaskRightSizing(t *testing.T) {
+	// GIVEN right-sizing controller and a job
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	mgr := manager.AssertTestJobManager(config.TestServerConfig(), t)
+	webServer := web.NewStubWebServer()
+	ctrl := NewTaskRightSizingController(mgr, webServer)
+	job := repository.NewTestJobDefinition(qc.User, "sizing-"+ulid.Make().String())
+	job, err = mgr.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	// AND an execution where the first task was profiled
+	req, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	_, err = mgr.SaveJobRequest(qc, req)
+	require.NoError(t, err)
+	jobExec := types.NewJobExecution(req.ToInfo())
+	task := jobExec.AddTask(job.Tasks[0])
+	task.TaskState = common.COMPLETED
+	task.StartedAt = time.Now()
+	task.CPUAvgCores = 0.5
+	task.CPUPeakCores = 1
+	task.MemoryPeakBytes = 100 * 1024 * 1024
+	_, err = mgr.CreateJobExecution(jobExec)
+	require.NoError(t, err)
+
+	// WHEN querying right-sizing of the job
+	ctx := web.NewStubContext(&http.Request{Body: io.NopCloser(strings.NewReader("")), URL: &url.URL{}})
+	ctx.Set(web.DBUser, qc.User)
+	ctx.Params["job_type"] = job.JobType
+	err = ctrl.queryTaskRightSizing(ctx)
+
+	// THEN it should return recommendation for the profiled task
+	require.NoError(t, err)
+	profiles := ctx.Result.([]*types.TaskRightSizing)
+	require.Len(t, profiles, 1)
+	require.Equal(t, task.TaskType, profiles[0].TaskType)
+	require.Equal(t, int64(1), profiles[0].Runs)
+	require.Equal(t, "600m", profiles[0].CPURequest)
+	require.Equal(t, "1200m", profiles[0].CPULimit)
+	require.Equal(t, "120Mi", profiles[0].MemoryLimit)
+}
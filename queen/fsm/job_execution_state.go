@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"runtime/debug"
 	"strings"
+	"sync"
 	"time"
 
 	"plexobject.com/formicary/internal/metrics"
@@ -22,6 +23,9 @@ import (
 	"plexobject.com/formicary/queen/config"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/types"
 )
@@ -57,6 +61,7 @@ type JobExecutionStateMachine struct {
 	LastJobExecution    *types.JobExecution
 	User                *common.User
 	Reservations        map[string]*common.AntReservation
+	DynamicTasks        *types.DynamicTaskGraph
 	StartedAt           time.Time
 	revertState         common.RequestState
 	id                  string
@@ -64,6 +69,8 @@ type JobExecutionStateMachine struct {
 	errorCode           *common.ErrorCode
 	cpuUsage            types.ResourceUsage
 	diskUsage           types.ResourceUsage
+	// executionLock guards tasks and contexts of job execution that are updated by tasks running in parallel
+	executionLock sync.RWMutex
 }
 
 // NewJobExecutionStateMachine creates new state machine for request execution
@@ -90,6 +97,7 @@ func NewJobExecutionStateMachine(
 		MetricsRegistry:     metricsRegistry,
 		Request:             request,
 		Reservations:        reservations,
+		DynamicTasks:        types.NewDynamicTaskGraph(),
 		StartedAt:           time.Now(),
 	}
 }
@@ -347,8 +355,24 @@ func (jsm *JobExecutionStateMachine) CreateJobExecution(ctx context.Context) (db
 	jsm.Request.SetJobExecutionID(jsm.JobExecution.ID)
 	jsm.Request.SetJobState(common.READY)
 
+	// launch event carries the trace of the job execution so that spans of the job are under its root span
+	ctx, span := tracing.Tracer("formicary.queen").Start(
+		tracing.ExecutionContext(ctx, jsm.JobExecution.ID),
+		"job.schedule",
+		trace.WithAttributes(
+			attribute.String("job.type", jsm.Request.GetJobType()),
+			attribute.String("job.request_id", jsm.Request.GetID()),
+			attribute.String("job.execution_id", jsm.JobExecution.ID),
+			attribute.Int("job.schedule_attempts", jsm.Request.GetScheduleAttempts()),
+		),
+		trace.WithLinks(jsm.ParentTraceLinks()...),
+	)
+	defer span.End()
+
 	// in case of failure, sendLaunchJobEvent will put the job back in PENDING state
 	if eventError = jsm.sendLaunchJobEvent(ctx); eventError != nil {
+		span.RecordError(eventError)
+		span.SetStatus(codes.Error, eventError.Error())
 		return nil,
 			fmt.Errorf("failed to send launch event after creating job-execution due to %s", eventError)
 	}
@@ -383,6 +407,32 @@ func (jsm *JobExecutionStateMachine) UpdateJobRequestTimestampAndCheckQuota(_ co
 	return jsm.JobManager.UpdateJobRequestTimestamp(jsm.Request.GetID())
 }
 
+// SaveDynamicTasks keeps tasks emitted at runtime in the job context so that the execution can run
+// them when it's resumed, e.g. after approval
+func (jsm *JobExecutionStateMachine) SaveDynamicTasks() error {
+	serialized, err := jsm.DynamicTasks.Marshal()
+	if err != nil {
+		return err
+	}
+	jsm.executionLock.Lock()
+	defer jsm.executionLock.Unlock()
+	if _, err = jsm.JobExecution.AddContext(types.DynamicTasksContext, serialized); err != nil {
+		return err
+	}
+	return jsm.JobManager.UpdateJobExecutionContext(jsm.JobExecution.ID, jsm.JobExecution.Contexts)
+}
+
+// RestoreDynamicTasks loads tasks that were emitted before the execution was resumed from the job context
+func (jsm *JobExecutionStateMachine) RestoreDynamicTasks() ([]*types.DynamicTask, error) {
+	jsm.executionLock.RLock()
+	c := jsm.JobExecution.GetContext(types.DynamicTasksContext)
+	jsm.executionLock.RUnlock()
+	if c == nil {
+		return nil, nil
+	}
+	return jsm.DynamicTasks.Restore(c.Value)
+}
+
 // SetJobStatusToExecuting sets job request/execution status to EXECUTING
 func (jsm *JobExecutionStateMachine) SetJobStatusToExecuting(_ context.Context) (err error) {
 	// Mark job request and execution to EXECUTING (from READY unless execution was already running)
@@ -573,7 +623,17 @@ func (jsm *JobExecutionStateMachine) CheckAntResourcesAndConcurrencyForJob() err
 		m = strings.TrimSpace(m)
 		methods = append(methods, common.TaskMethod(m))
 	}
-	return jsm.ResourceManager.HasAntsForJobTags(methods, tags)
+	if err := jsm.ResourceManager.HasAntsForJobTags(methods, tags); err != nil {
+		return err
+	}
+	// affinity of a task may exclude all ants that support its method and tags
+	for _, task := range jsm.JobDefinition.Tasks {
+		if task.Placement != nil {
+			_, err := jsm.ResourceManager.CheckJobResources(jsm.JobDefinition)
+			return err
+		}
+	}
+	return nil
 }
 
 // CheckSubscriptionQuota checks quota
@@ -1035,11 +1095,13 @@ func (jsm *JobExecutionStateMachine) buildDynamicParams(taskDefParams map[string
 		}
 	}
 	if jsm.JobExecution != nil {
+		jsm.executionLock.RLock()
 		for _, next := range jsm.JobExecution.Contexts {
 			if vv, err := next.GetVariableValue(); err == nil {
 				res[next.Name] = vv
 			}
 		}
+		jsm.executionLock.RUnlock()
 	}
 	return res
 }
@@ -1135,6 +1197,20 @@ func (jsm *JobExecutionStateMachine) publishJobWebhook(ctx context.Context, even
 	}
 }
 
+// ParentTraceLinks returns span link to the task that forked the job so that traces of parent and
+// forked jobs are connected
+func (jsm *JobExecutionStateMachine) ParentTraceLinks() []trace.Link {
+	for _, next := range jsm.Request.GetParams() {
+		if next.Name != common.ParentTraceParent {
+			continue
+		}
+		if link, ok := tracing.LinkFromTraceParent(next.Value); ok {
+			return []trace.Link{link}
+		}
+	}
+	return nil
+}
+
 // Fire event to start the job by job-launcher that listens to incoming request in queue and executes it
 func (jsm *JobExecutionStateMachine) sendLaunchJobEvent(
 	ctx context.Context) error {
@@ -155,3 +155,21 @@ func Test_ShouldLoadOrgConfigsWhenUserIsNil(t *testing.T) {
 	require.Equal(t, "myorg", configs["GitHubOrg"].Value)
 	require.Equal(t, "myrepo", configs["GitHubRepo"].Value)
 }
+
+func Test_ShouldNotScheduleJobWhenAffinityMatchesNoAnt(t *testing.T) {
+	// GIVEN job state machine with ants for its tasks
+	jsm, err := NewTestJobStateMachine()
+	require.NoError(t, err)
+	jsm.Reservations = make(map[string]*common.AntReservation)
+	require.NoError(t, jsm.Validate())
+	jsm.JobDefinition.MaxConcurrency = 10
+	jsm.User.MaxConcurrency = 10
+	require.NoError(t, jsm.CheckAntResourcesAndConcurrencyForJob())
+
+	// WHEN a task requires affinity to an ant that is not registered
+	jsm.JobDefinition.Tasks[0].Placement = &common.PlacementPolicy{
+		Affinity: &common.AffinityRule{AntIDs: []string{"missing-ant"}}}
+
+	// THEN ant resources should not be available for the job
+	require.Error(t, jsm.CheckAntResourcesAndConcurrencyForJob())
+}
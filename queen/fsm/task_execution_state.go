@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"plexobject.com/formicary/internal/metrics"
 	"plexobject.com/formicary/internal/queue"
+	"regexp"
 	"strings"
 	"time"
 
@@ -17,6 +19,8 @@ import (
 	"plexobject.com/formicary/queen/types"
 )
 
+var dependencyParamPrefix = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
 // TaskExecutionStateMachine for managing state of task and its execution
 type TaskExecutionStateMachine struct {
 	*JobExecutionStateMachine
@@ -38,12 +42,23 @@ func NewTaskExecutionStateMachine(
 		taskType:                 taskType,
 	}
 
+	tsm.executionLock.RLock()
 	_, tsm.TaskExecution = tsm.JobExecution.GetTask("", tsm.taskType)
+	tsm.executionLock.RUnlock()
 
 	// Load task definition using job params because task is not built yet.
 	// Pass JobManager as querier so SubmitJobsFromJSON / CountByJobTypeAndState
 	// work when rendering task environment variables (e.g. SUBMITTED_IDS).
-	if tsm.TaskDefinition, tsm.ExecutorOptions, err = tsm.JobDefinition.GetDynamicTaskWithQuerier(
+	// Tasks emitted by other tasks at runtime are not part of the job definition.
+	if emitted := tsm.DynamicTasks.Get(tsm.taskType); emitted != nil {
+		if tsm.TaskDefinition, tsm.ExecutorOptions, err = tsm.JobDefinition.GetDynamicTaskFromYaml(
+			tsm.taskType,
+			emitted.Yaml,
+			tsm.JobExecutionStateMachine.buildDynamicParams(nil),
+			tsm.JobManager); err != nil {
+			return nil, err
+		}
+	} else if tsm.TaskDefinition, tsm.ExecutorOptions, err = tsm.JobDefinition.GetDynamicTaskWithQuerier(
 		tsm.taskType,
 		tsm.JobExecutionStateMachine.buildDynamicParams(nil),
 		tsm.JobManager); err != nil {
@@ -59,15 +74,33 @@ func NewTaskExecutionStateMachine(
 		}
 		previousExecutionCostSecs = tsm.TaskExecution.ExecutionCostSecs()
 		previousTaskExecution = tsm.TaskExecution
+		tsm.executionLock.Lock()
 		tsm.JobExecution.DeleteTask(tsm.TaskExecution.ID)
+		tsm.executionLock.Unlock()
 		// otherwise, let's remove last incomplete or failed task
 		if err = tsm.JobManager.DeleteExecutionTask(tsm.TaskExecution.ID); err != nil {
 			return nil, fmt.Errorf("failed to delete old task due to %w", err)
 		}
 	}
 
+	// known-flaky tasks of jobs that quarantine flaky tasks can fail without failing the job
+	var quarantined *types.FlakyTask
+	if !tsm.TaskDefinition.AllowFailure {
+		if quarantined = tsm.JobManager.GetQuarantinedTask(tsm.JobDefinition, tsm.taskType); quarantined != nil {
+			tsm.TaskDefinition.AllowFailure = true
+			logrus.WithFields(tsm.JobExecutionStateMachine.LogFields("TaskExecutionStateMachine")).
+				WithField("FlakyTask", quarantined.String()).
+				Warnf("allowing failure of quarantined flaky task %s", tsm.taskType)
+		}
+	}
+
 	// create new task execution
+	tsm.executionLock.Lock()
+	defer tsm.executionLock.Unlock()
 	tsm.TaskExecution = tsm.JobExecution.AddTask(tsm.TaskDefinition)
+	if quarantined != nil {
+		_, _ = tsm.TaskExecution.AddContext(types.QuarantinedFlakyTaskContext, quarantined.Warning())
+	}
 	if previousExecutionCostSecs > 0 {
 		tsm.TaskExecution.AddPreviousExecutionCostSecs(previousTaskExecution.ID, previousExecutionCostSecs)
 		logrus.WithFields(logrus.Fields{
@@ -180,15 +213,27 @@ func (tsm *TaskExecutionStateMachine) FinalizeTaskState(
 	}
 
 	tsm.TaskExecution.EndedAt = &now
+	tsm.JobManager.RecordTaskCost(tsm.Request, tsm.TaskExecution, tsm.ExecutorOptions, tsm.TaskDefinition.Tags)
+	tsm.MetricsRegistry.Observe(
+		"task_duration_secs",
+		now.Sub(tsm.TaskExecution.StartedAt).Seconds(),
+		metrics.DurationBuckets,
+		map[string]string{
+			"Job":    tsm.JobDefinition.JobType,
+			"Task":   tsm.TaskDefinition.TaskType,
+			"Method": string(tsm.TaskDefinition.Method),
+			"Status": string(tsm.TaskExecution.TaskState)})
 	// optionally release resource if completed
 
-	// SaveFile job context from task result
+	// SaveFile job context from task result, saving also updates the task that is shared with parallel tasks
+	tsm.executionLock.Lock()
 	_ = tsm.JobManager.UpdateJobExecutionContext(
 		tsm.JobExecution.ID,
 		tsm.JobExecution.Contexts)
 
 	// we will return save error at the end
 	_, err = tsm.JobManager.SaveExecutionTask(tsm.TaskExecution)
+	tsm.executionLock.Unlock()
 
 	// treating error sending lifecycle event as non-fatal error
 	// using fresh context in case deadline reached
@@ -223,6 +268,7 @@ func (tsm *TaskExecutionStateMachine) BuildTaskRequest() (*common.TaskRequest, e
 	// Add dependent artifacts if exist
 	tsm.ExecutorOptions.DependentArtifactIDs = tsm.TaskDefinition.ArtifactIDs
 	// find all dependent artifacts
+	tsm.executionLock.RLock()
 	for _, dep := range tsm.TaskDefinition.Dependencies {
 		matched := false
 		for _, task := range tsm.JobExecution.Tasks {
@@ -238,11 +284,14 @@ func (tsm *TaskExecutionStateMachine) BuildTaskRequest() (*common.TaskRequest, e
 			}
 		}
 
-		if !matched {
+		// a join of any or n_of dependencies may start before other dependencies have run
+		if !matched && (tsm.TaskDefinition.Join == nil || tsm.TaskDefinition.Join.Mode == types.JoinAll) {
+			tsm.executionLock.RUnlock()
 			return nil, fmt.Errorf("failed to find artifacts from dependent task '%s' for task '%s'",
 				dep, tsm.TaskDefinition.TaskType)
 		}
 	}
+	tsm.executionLock.RUnlock()
 
 	taskReq := &common.TaskRequest{
 		UserID:          tsm.Request.GetUserID(),
@@ -336,6 +385,16 @@ func (tsm *TaskExecutionStateMachine) BuildTaskResponseFromPreviousResult() (*co
 	return taskResp, nil
 }
 
+// SetCancelled marks task execution as cancelled, e.g. when another task of the job failed
+func (tsm *TaskExecutionStateMachine) SetCancelled(err error) {
+	logrus.WithFields(tsm.LogFields("TaskSupervisor", err)).
+		Warnf("[tsm] overriding status as cancelled")
+	_, _ = tsm.TaskExecution.AddContext("OldStatus", tsm.TaskExecution.TaskState)
+	tsm.TaskExecution.TaskState = common.CANCELLED
+	tsm.TaskExecution.ErrorCode = common.ErrorTaskCancelled
+	tsm.TaskExecution.ErrorMessage = err.Error()
+}
+
 // SetFailed marks task execution as failed
 func (tsm *TaskExecutionStateMachine) SetFailed(err error) {
 	logrus.WithFields(logrus.Fields{
@@ -375,9 +434,16 @@ func (tsm *TaskExecutionStateMachine) UpdateTaskFromResponse(
 	for k, v := range taskResp.TaskContext {
 		_, _ = tsm.TaskExecution.AddContext(k, v)
 	}
+	// outputs of tasks running in parallel are also kept by the task so that a join can merge them
+	scheduledByDependencies := tsm.JobDefinition.ScheduledByDependencies()
+	tsm.executionLock.Lock()
 	for k, v := range taskResp.JobContext {
 		_, _ = tsm.JobExecution.AddContext(k, v)
+		if scheduledByDependencies {
+			_, _ = tsm.TaskExecution.AddContext(k, v)
+		}
 	}
+	tsm.executionLock.Unlock()
 
 	tsm.TaskExecution.AntID = tsm.Reservation.AntID
 	tsm.TaskExecution.AntHost = taskResp.Host
@@ -385,6 +451,13 @@ func (tsm *TaskExecutionStateMachine) UpdateTaskFromResponse(
 	tsm.TaskExecution.ExitCode = taskResp.ExitCode
 	tsm.TaskExecution.ExitMessage = taskResp.ExitMessage
 	tsm.TaskExecution.CostFactor = taskResp.CostFactor
+	if taskResp.Profile != nil {
+		tsm.TaskExecution.CPUPeakCores = taskResp.Profile.CPUPeakCores
+		tsm.TaskExecution.CPUAvgCores = taskResp.Profile.CPUAvgCores
+		tsm.TaskExecution.MemoryPeakBytes = taskResp.Profile.MemoryPeakBytes
+		tsm.TaskExecution.IOReadBytes = taskResp.Profile.IOReadBytes
+		tsm.TaskExecution.IOWriteBytes = taskResp.Profile.IOWriteBytes
+	}
 	tsm.TaskExecution.CountServices = len(taskReq.ExecutorOpts.Services)
 	for _, svc := range taskReq.ExecutorOpts.Services {
 		if svc.Instances > 1 {
@@ -470,7 +543,8 @@ func (tsm *TaskExecutionStateMachine) validateAntAllocation(
 			tsm.Request.GetID(),
 			tsm.TaskExecution.TaskType,
 			taskDefinition.Method,
-			taskDefinition.Tags)
+			taskDefinition.Tags,
+			common.NewPlacementRequest(tsm.JobDefinition.JobType, taskDefinition.Placement))
 	}
 	if !ant.Supports(taskDefinition.Method, taskDefinition.Tags, tsm.serverCfg.Jobs.AntRegistrationAliveTimeout) {
 		_ = tsm.ResourceManager.Release(allocation)
@@ -478,7 +552,8 @@ func (tsm *TaskExecutionStateMachine) validateAntAllocation(
 			tsm.Request.GetID(),
 			tsm.TaskExecution.TaskType,
 			taskDefinition.Method,
-			taskDefinition.Tags)
+			taskDefinition.Tags,
+			common.NewPlacementRequest(tsm.JobDefinition.JobType, taskDefinition.Placement))
 	}
 	return allocation, nil
 }
@@ -488,9 +563,34 @@ func (tsm *TaskExecutionStateMachine) buildDynamicParams() map[string]common.Var
 		tsm.TaskDefinition.GetNameValueVariables())
 	res["TaskType"] = common.NewVariableValue(tsm.taskType, false)
 	res["TaskRetry"] = common.NewVariableValue(tsm.TaskExecution.Retried, false)
+	if len(tsm.TaskDefinition.Dependencies) > 0 && tsm.JobDefinition.ScheduledByDependencies() {
+		tsm.addDependencyParams(res)
+	}
 	return res
 }
 
+// addDependencyParams merges outputs of completed dependencies as <dependency>_<name> variables so that
+// a task joining parallel tasks can tell their outputs apart.
+func (tsm *TaskExecutionStateMachine) addDependencyParams(res map[string]common.VariableValue) {
+	tsm.executionLock.RLock()
+	defer tsm.executionLock.RUnlock()
+	completed := make([]string, 0)
+	for _, dep := range tsm.TaskDefinition.Dependencies {
+		_, task := tsm.JobExecution.GetTask("", dep)
+		if task == nil || !task.TaskState.Completed() {
+			continue
+		}
+		completed = append(completed, dep)
+		prefix := dependencyParamPrefix.ReplaceAllString(dep, "_")
+		for _, c := range task.Contexts {
+			if vv, err := c.GetVariableValue(); err == nil {
+				res[prefix+"_"+c.Name] = vv
+			}
+		}
+	}
+	res["CompletedDependencies"] = common.NewVariableValue(strings.Join(completed, ","), false)
+}
+
 // Fire event to notify task state
 func (tsm *TaskExecutionStateMachine) sendTaskExecutionLifecycleEvent(
 	ctx context.Context) (err error) {
@@ -595,8 +695,17 @@ func (tsm *TaskExecutionStateMachine) updateArtifactsFromResponse(taskResp *comm
 				"failed to save artifact %v due to '%v'",
 				artifact, saveErr), true)
 		} else {
+			tsm.MetricsRegistry.Observe(
+				"artifact_upload_bytes",
+				float64(artifact.ContentLength),
+				metrics.SizeBuckets,
+				map[string]string{
+					"Job":  tsm.JobDefinition.JobType,
+					"Task": tsm.taskType})
 			artifactContextKey := fmt.Sprintf("%s_ArtifactURL_%d", tsm.taskType, i+1)
+			tsm.executionLock.Lock()
 			_, _ = tsm.JobExecution.AddContext(artifactContextKey, artifact.URL)
+			tsm.executionLock.Unlock()
 			tsm.TaskExecution.AddArtifact(artifact)
 		}
 	}
@@ -81,6 +81,23 @@ tasks:
 // that always returns exit code 3. This is the exact regression scenario for the
 // PAUSED→FAILED bug.
 func NewTestJobStateMachineForPause() (*JobExecutionStateMachine, error) {
+	// Mock ant: always return exit code 3 (triggers PAUSE_JOB for poll-task)
+	return NewTestJobStateMachineFromYaml(pauseJobYAML, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.FAILED
+		res.ExitCode = "3"
+		return res
+	})
+}
+
+// NewTestJobStateMachineFromYaml saves the job definition from YAML and builds a job state machine
+// for a new request of the job, whose mock ant returns a response built by the provided callback.
+func NewTestJobStateMachineFromYaml(
+	jobYaml string,
+	antResponse func(req *common.TaskRequest) *common.TaskResponse,
+) (*JobExecutionStateMachine, error) {
 	cfg := config.TestServerConfig()
 	queueClient, err := queue.NewClientManager().GetClient(context.Background(), &cfg.Common)
 	if err != nil {
@@ -105,19 +122,23 @@ func NewTestJobStateMachineForPause() (*JobExecutionStateMachine, error) {
 		return nil, err
 	}
 
-	// Mock ant: always return exit code 3 (triggers PAUSE_JOB for poll-task)
 	if channelClient, ok := queueClient.(*queue.ClientChannel); ok {
-		channelClient.SetSendReceivePayloadFunc(func(_ context.Context, inReq *queue.SendReceiveRequest) ([]byte, error) {
+		channelClient.SetSendReceivePayloadFunc(func(ctx context.Context, inReq *queue.SendReceiveRequest) ([]byte, error) {
 			var req common.TaskRequest
 			if unmarshalErr := json.Unmarshal(inReq.Payload, &req); unmarshalErr != nil {
 				return nil, unmarshalErr
 			}
-			res := common.NewTaskResponse(&req)
-			res.AntID = "test-ant"
-			res.Host = "test-host"
-			res.Status = common.FAILED
-			res.ExitCode = "3"
-			return json.Marshal(res)
+			// like a remote ant, the response isn't awaited once the request is cancelled
+			responses := make(chan *common.TaskResponse, 1)
+			go func() {
+				responses <- antResponse(&req)
+			}()
+			select {
+			case res := <-responses:
+				return json.Marshal(res)
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
 		})
 	}
 
@@ -130,7 +151,7 @@ func NewTestJobStateMachineForPause() (*JobExecutionStateMachine, error) {
 		Allocations: make(map[string]*common.AntAllocation),
 	}
 
-	// Create user and save job definition from YAML so on_exit_code is persisted
+	// Create user and save job definition from YAML so that transient properties such as on_exit_code are persisted
 	user := common.NewUser("", ulid.Make().String()+"@formicary.io", "name", "", acl.NewRoles(""))
 	user, err = userManager.CreateUser(common.NewQueryContextFromIDs("", ""), user)
 	if err != nil {
@@ -138,15 +159,15 @@ func NewTestJobStateMachineForPause() (*JobExecutionStateMachine, error) {
 	}
 	qc := common.NewQueryContext(user, "")
 
-	jobDef, err := qtypes.NewJobDefinitionFromYaml([]byte(pauseJobYAML))
+	jobDef, err := qtypes.NewJobDefinitionFromYaml([]byte(jobYaml))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse pause job YAML: %w", err)
+		return nil, fmt.Errorf("failed to parse job YAML: %w", err)
 	}
 	jobDef.UserID = user.ID
 	jobDef.OrganizationID = user.OrganizationID
 	jobDef, err = jobManager.SaveJobDefinition(qc, jobDef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save pause job definition: %w", err)
+		return nil, fmt.Errorf("failed to save job definition: %w", err)
 	}
 
 	req, err := qtypes.NewJobRequestFromDefinition(jobDef)
@@ -158,9 +179,9 @@ func NewTestJobStateMachineForPause() (*JobExecutionStateMachine, error) {
 		return nil, err
 	}
 
-	reservations := map[string]*common.AntReservation{
-		"poll-task": {AntID: "test-ant", AntTopic: "ant-1-topic"},
-		"done":      {AntID: "test-ant", AntTopic: "ant-1-topic"},
+	reservations := make(map[string]*common.AntReservation)
+	for _, task := range jobDef.Tasks {
+		reservations[task.TaskType] = &common.AntReservation{AntID: "test-ant", AntTopic: "ant-1-topic"}
 	}
 
 	jsm := NewJobExecutionStateMachine(
@@ -5,6 +5,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -675,6 +676,65 @@ func Test_ShouldApprovalInteg_VoterIDEmptyRejected(t *testing.T) {
 
 // ─── helper: TestJobManager variant with approval service ─────────────────────
 
+func Test_ShouldApprovalInteg_VoteFromSignedEmailLink(t *testing.T) {
+	// GIVEN: job awaiting approval and approve/reject links emailed to the job owner
+	jm, _, approvalSvc := newApprovalJobManager(t)
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	locator, err := repository.NewTestLocator()
+	require.NoError(t, err)
+	approvalRepo, err := approval.NewRepositoryImpl(locator.DB)
+	require.NoError(t, err)
+	actionLinks, err := approval.NewActionLinks("test-approval-link-secret", time.Hour, approvalRepo)
+	require.NoError(t, err)
+	approvalSvc.SetActionLinks(actionLinks)
+
+	policy := &types.ApprovalPolicy{MinApprovals: 1}
+	jobDef := buildApprovalJobDef(t, qc, jm, fmt.Sprintf("email-link-%d", time.Now().UnixNano()), policy)
+	savedReq, _ := createRequestInApprovalState(t, qc, jm, jobDef, locator)
+	approve, reject, err := actionLinks.Issue(savedReq.ID, "approval-gate", qc.User.Email)
+	require.NoError(t, err)
+
+	// WHEN: the owner clicks the approve link without permission to approve jobs
+	_, _, err = jm.CastApprovalVoteFromAction(context.Background(), approve, "", "email")
+	// THEN: the vote is denied
+	require.Error(t, err)
+	assert.IsType(t, &common.PermissionError{}, err)
+
+	// WHEN: an approver allowed to approve jobs clicks the approve link without logging in
+	approver := common.NewUser(qc.GetOrganizationID(), ulid.Make().String(), "approver",
+		ulid.Make().String()+"@formicary.io", acl.NewRoles(""))
+	approver.SerializedPerms = acl.MarshalPermissions([]*acl.Permission{
+		acl.NewPermission(acl.JobRequest, acl.View|acl.Approve)})
+	approver, err = locator.UserRepository.Create(approver)
+	require.NoError(t, err)
+	// emails saved before they were lowercased are matched ignoring case
+	require.NoError(t, locator.DB.Model(&common.User{}).Where("id = ?", approver.ID).
+		Update("email", strings.ToUpper(approver.Email)).Error)
+	approve, reject, err = actionLinks.Issue(savedReq.ID, "approval-gate", approver.Email)
+	require.NoError(t, err)
+	status, voter, err := jm.CastApprovalVoteFromAction(context.Background(), approve, "", "email")
+
+	// THEN: the vote is cast as the recipient of the link
+	require.NoError(t, err)
+	require.NotNil(t, voter)
+	assert.Equal(t, approver.ID, voter.ID)
+	assert.True(t, status.QuorumReached)
+
+	// AND: neither link of the notification can be used again
+	_, _, err = jm.CastApprovalVoteFromAction(context.Background(), approve, "", "email")
+	require.Error(t, err)
+	_, _, err = jm.CastApprovalVoteFromAction(context.Background(), reject, "", "email")
+	require.Error(t, err)
+
+	// AND: links for unknown recipients are denied
+	unknown, _, err := actionLinks.Issue(savedReq.ID, "approval-gate", "stranger@example.com")
+	require.NoError(t, err)
+	_, _, err = jm.CastApprovalVoteFromAction(context.Background(), unknown, "", "email")
+	require.Error(t, err)
+	assert.IsType(t, &common.PermissionError{}, err)
+}
+
 func newJobManagerWithApproval(serverCfg *config.ServerConfig, approvalSvc *approval.Service) (*JobManager, error) {
 	if err := serverCfg.Validate(); err != nil {
 		return nil, err
@@ -696,6 +756,14 @@ func newJobManagerWithApproval(serverCfg *config.ServerConfig, approvalSvc *appr
 	if err != nil {
 		return nil, err
 	}
+	cronBackfillRepo, err := repository.NewTestCronBackfillRepository()
+	if err != nil {
+		return nil, err
+	}
+	slaBreachRepo, err := repository.NewTestSLABreachRepository()
+	if err != nil {
+		return nil, err
+	}
 	emailVerifRepo, err := repository.NewTestEmailVerificationRepository()
 	if err != nil {
 		return nil, err
@@ -725,7 +793,7 @@ func newJobManagerWithApproval(serverCfg *config.ServerConfig, approvalSvc *appr
 		return nil, err
 	}
 
-	resourceManager := resource.New(serverCfg, queueClient)
+	resourceManager := resource.New(serverCfg, queueClient, metrics.New())
 
 	return NewJobManager(
 		context.Background(),
@@ -734,6 +802,8 @@ func newJobManagerWithApproval(serverCfg *config.ServerConfig, approvalSvc *appr
 		jobDefRepo,
 		jobReqRepo,
 		jobExecRepo,
+		cronBackfillRepo,
+		slaBreachRepo,
 		userManager,
 		resourceManager,
 		artifactManager,
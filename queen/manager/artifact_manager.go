@@ -23,6 +23,7 @@ type ArtifactManager struct {
 	artifactRepository repository.ArtifactRepository
 	logEventRepository repository.LogEventRepository
 	artifactService    artifacts.Service
+	costManager        *CostManager
 }
 
 // NewArtifactManager manages artifacts
@@ -180,9 +181,47 @@ func (am *ArtifactManager) DownloadArtifactBySHA256(
 	reader, err := am.artifactService.Get(
 		ctx,
 		art.ID)
+	if err == nil && am.costManager != nil {
+		if costErr := am.costManager.RecordArtifactEgress(art); costErr != nil {
+			logrus.WithFields(
+				logrus.Fields{
+					"Component": "ArtifactManager",
+					"Artifact":  art.ID,
+					"Error":     costErr,
+				}).Warnf("failed to record egress cost of artifact")
+		}
+	}
 	return reader, art.Name, art.ContentType, err
 }
 
+// RecordArtifactsEgress records egress cost of artifacts that were downloaded by ants from the artifact store
+// such as dependent artifacts of a task
+func (am *ArtifactManager) RecordArtifactsEgress(ids []string) {
+	if am.costManager == nil || am.serverCfg.Cost.ArtifactEgressGB <= 0 {
+		return
+	}
+	qc := common.NewQueryContext(nil, "").WithAdmin()
+	for _, id := range ids {
+		art, err := am.artifactRepository.Get(qc, id)
+		if err == nil {
+			err = am.costManager.RecordArtifactEgress(art)
+		}
+		if err != nil {
+			logrus.WithFields(
+				logrus.Fields{
+					"Component": "ArtifactManager",
+					"Artifact":  id,
+					"Error":     err,
+				}).Warnf("failed to record egress cost of artifact")
+		}
+	}
+}
+
+// SetCostManager sets manager that records egress cost of downloaded artifacts
+func (am *ArtifactManager) SetCostManager(costManager *CostManager) {
+	am.costManager = costManager
+}
+
 // GetArtifact - finds artifact by id
 func (am *ArtifactManager) GetArtifact(
 	ctx context.Context,
@@ -0,0 +1,289 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/notify"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+const bytesPerGB = 1024 * 1024 * 1024
+
+// CostManager records cost of resources used by tasks based on prices of the cost config, reports costs for
+// chargeback and notifies recipients of budgets that are exceeded.
+type CostManager struct {
+	serverCfg            *config.ServerConfig
+	costRecordRepository repository.CostRecordRepository
+	notifier             notify.Notifier
+	metricsRegistry      *metrics.Registry
+	// alertedBudgets caches budgets already notified in their current period, which are also saved so that
+	// they aren't notified again after restart
+	alertedBudgets map[string]bool
+	lock           sync.Mutex
+}
+
+// NewCostManager creates a new CostManager.
+func NewCostManager(
+	serverCfg *config.ServerConfig,
+	costRecordRepository repository.CostRecordRepository,
+	notifier notify.Notifier,
+	metricsRegistry *metrics.Registry) (*CostManager, error) {
+	if serverCfg == nil {
+		return nil, fmt.Errorf("server-config is not specified")
+	}
+	if costRecordRepository == nil {
+		return nil, fmt.Errorf("cost-record-repository is not specified")
+	}
+	if notifier == nil {
+		return nil, fmt.Errorf("notifier is not specified")
+	}
+	if metricsRegistry == nil {
+		return nil, fmt.Errorf("metrics-registry is not specified")
+	}
+	return &CostManager{
+		serverCfg:            serverCfg,
+		costRecordRepository: costRecordRepository,
+		notifier:             notifier,
+		metricsRegistry:      metricsRegistry,
+		alertedBudgets:       make(map[string]bool),
+	}, nil
+}
+
+// RecordTaskCost records cost of compute of the task and storage of its artifacts until they expire, and sets
+// total cost of the task execution. It doesn't record anything if no prices are configured, and compute isn't
+// recorded for tasks executed by tasklets of the queen, e.g., fork or await of jobs and manual approvals.
+func (cm *CostManager) RecordTaskCost(
+	request types.IJobRequest,
+	taskExec *types.TaskExecution,
+	opts *common.ExecutorOptions,
+	tags []string) error {
+	costCfg := &cm.serverCfg.Cost
+	if !costCfg.Enabled() {
+		return nil
+	}
+	now := time.Now()
+	ended := now
+	if taskExec.EndedAt != nil {
+		ended = *taskExec.EndedAt
+	}
+	secs := ended.Sub(taskExec.StartedAt).Seconds()
+	if secs < 0 {
+		secs = 0
+	}
+	records := make([]*types.CostRecord, 0)
+	if !taskExec.Method.IsQueenTasklet() {
+		cpu, memoryGB := types.TaskResources(opts, costCfg.DefaultCPU, costCfg.DefaultMemoryGB)
+		rates := costCfg.GetRates(taskExec.Method)
+		compute := cm.newRecord(types.ComputeCost, request, taskExec, tags)
+		compute.CPUSecs = cpu * secs
+		compute.MemoryGBSecs = memoryGB * secs
+		compute.Cost = compute.CPUSecs*rates.CPUSecond + compute.MemoryGBSecs*rates.MemoryGBSecond
+		records = append(records, compute)
+	}
+
+	if costCfg.StorageGBDay > 0 && len(taskExec.Artifacts) > 0 {
+		storage := cm.newRecord(types.StorageCost, request, taskExec, tags)
+		for _, art := range taskExec.Artifacts {
+			expires := art.ExpiresAt
+			if expires.IsZero() {
+				expires = now.Add(cm.serverCfg.DefaultArtifactExpiration)
+			}
+			if days := expires.Sub(now).Hours() / 24; days > 0 {
+				storage.StorageGBDays += float64(art.ContentLength) / bytesPerGB * days
+			}
+		}
+		storage.Cost = storage.StorageGBDays * costCfg.StorageGBDay
+		records = append(records, storage)
+	}
+
+	taskExec.Cost = 0
+	for _, record := range records {
+		taskExec.Cost += record.Cost
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	if err := cm.costRecordRepository.Save(records...); err != nil {
+		return fmt.Errorf("failed to save cost of task %s due to %w", taskExec.TaskType, err)
+	}
+	go cm.checkBudgets(request.GetOrganizationID(), request.GetJobType(), tags)
+	return nil
+}
+
+// RecordArtifactEgress records cost of downloading the artifact, which is charged to the job and task that
+// created the artifact.
+func (cm *CostManager) RecordArtifactEgress(art *common.Artifact) error {
+	costCfg := &cm.serverCfg.Cost
+	if costCfg.ArtifactEgressGB <= 0 || art.ContentLength <= 0 {
+		return nil
+	}
+	record := &types.CostRecord{
+		Kind:            types.EgressCost,
+		JobRequestID:    art.JobRequestID,
+		JobExecutionID:  art.JobExecutionID,
+		TaskExecutionID: art.TaskExecutionID,
+		TaskType:        art.TaskType,
+		UserID:          art.UserID,
+		OrganizationID:  art.OrganizationID,
+		EgressGB:        float64(art.ContentLength) / bytesPerGB,
+		Currency:        costCfg.Currency,
+	}
+	record.Cost = record.EgressGB * costCfg.ArtifactEgressGB
+	if art.TaskExecutionID != "" {
+		if compute, err := cm.costRecordRepository.GetComputeRecord(art.TaskExecutionID); err == nil {
+			record.JobType = compute.JobType
+			record.Method = compute.Method
+			record.Tags = compute.Tags
+		}
+	}
+	if err := cm.costRecordRepository.Save(record); err != nil {
+		return fmt.Errorf("failed to save egress cost of artifact %s due to %w", art.ID, err)
+	}
+	go cm.checkBudgets(record.OrganizationID, record.JobType, record.GetTags())
+	return nil
+}
+
+// GetCostReport aggregates costs recorded between from and to by organization, user, job type or tag
+func (cm *CostManager) GetCostReport(
+	qc *common.QueryContext,
+	groupBy types.CostGroupBy,
+	from time.Time,
+	to time.Time) (*types.CostReport, error) {
+	if !to.After(from) {
+		return nil, common.NewValidationError(fmt.Errorf("to %s must be after from %s",
+			to.Format(time.RFC3339), from.Format(time.RFC3339)))
+	}
+	summaries, err := cm.costRecordRepository.Summarize(qc, groupBy, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewCostReport(groupBy, from, to, cm.serverCfg.Cost.Currency, summaries), nil
+}
+
+// checkBudgets notifies recipients of budgets matching the task whose spending exceeded the limit for the first
+// time in the current period
+func (cm *CostManager) checkBudgets(
+	organizationID string,
+	jobType string,
+	tags []string) {
+	now := time.Now()
+	for i := range cm.serverCfg.Cost.Budgets {
+		budget := &cm.serverCfg.Cost.Budgets[i]
+		if !budget.Matches(organizationID, jobType, tags) {
+			continue
+		}
+		key := budget.Name + ":" + budget.PeriodStart(now).Format("2006-01-02")
+		cm.lock.Lock()
+		alerted := cm.alertedBudgets[key]
+		cm.lock.Unlock()
+		if alerted {
+			continue
+		}
+		spent, err := cm.costRecordRepository.TotalCost(
+			budget.OrganizationID, budget.JobType, budget.Tag, budget.PeriodStart(now))
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "CostManager",
+				"Budget":    budget.Name,
+				"Error":     err,
+			}).Warnf("failed to find spending of cost budget")
+			continue
+		}
+		if spent < budget.Limit {
+			continue
+		}
+		cm.lock.Lock()
+		alerted = cm.alertedBudgets[key]
+		cm.alertedBudgets[key] = true
+		cm.lock.Unlock()
+		if alerted {
+			continue
+		}
+		saved, err := cm.costRecordRepository.SaveBudgetAlert(&types.CostBudgetAlert{
+			Budget:      budget.Name,
+			PeriodStart: budget.PeriodStart(now),
+			Spent:       spent,
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "CostManager",
+				"Budget":    budget.Name,
+				"Error":     err,
+			}).Warnf("failed to save cost budget alert")
+			cm.lock.Lock()
+			delete(cm.alertedBudgets, key)
+			cm.lock.Unlock()
+			continue
+		}
+		if !saved {
+			// already alerted before restart or by another server
+			continue
+		}
+		logrus.WithFields(logrus.Fields{
+			"Component": "CostManager",
+			"Budget":    budget.Name,
+			"Period":    budget.Period,
+			"Limit":     budget.Limit,
+			"Spent":     spent,
+		}).Warnf("cost budget exceeded")
+		cm.metricsRegistry.Incr("cost_budget_exceeded_total", map[string]string{
+			"Budget": budget.Name,
+		})
+		if err = cm.notifier.NotifyCostBudget(
+			common.NewQueryContextFromIDs("", organizationID),
+			budget,
+			spent,
+			cm.serverCfg.Cost.Currency); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "CostManager",
+				"Budget":    budget.Name,
+				"Error":     err,
+			}).Warnf("failed to send cost budget notification")
+		}
+	}
+}
+
+func (cm *CostManager) newRecord(
+	kind types.CostKind,
+	request types.IJobRequest,
+	taskExec *types.TaskExecution,
+	tags []string) *types.CostRecord {
+	return &types.CostRecord{
+		Kind:            kind,
+		JobRequestID:    request.GetID(),
+		JobExecutionID:  taskExec.JobExecutionID,
+		TaskExecutionID: taskExec.ID,
+		JobType:         request.GetJobType(),
+		TaskType:        taskExec.TaskType,
+		Method:          string(taskExec.Method),
+		Tags:            joinTags(tags),
+		UserID:          request.GetUserID(),
+		OrganizationID:  request.GetOrganizationID(),
+		Currency:        cm.serverCfg.Cost.Currency,
+	}
+}
+
+// joinTags joins tags without commas in tags so that they can be split again
+func joinTags(tags []string) string {
+	joined := ""
+	for _, tag := range tags {
+		tag = strings.TrimSpace(strings.ReplaceAll(tag, ",", ""))
+		if tag == "" {
+			continue
+		}
+		if joined != "" {
+			joined += ","
+		}
+		joined += tag
+	}
+	return joined
+}
@@ -0,0 +1,117 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_ShouldRecordTaskCostAndAlertBudget(t *testing.T) {
+	// GIVEN cost manager with prices of kubernetes tasks and storage and a budget of the organization
+	org := "cost-org-" + ulid.Make().String()
+	serverCfg := config.TestServerConfig()
+	serverCfg.Cost.Rates = map[string]config.CostRates{"kubernetes": {CPUSecond: 0.01, MemoryGBSecond: 0.001}}
+	serverCfg.Cost.StorageGBDay = 1
+	serverCfg.Cost.Budgets = []config.CostBudget{{Name: "team-" + org, OrganizationID: org, Limit: 1}}
+	mgr := AssertTestCostManager(serverCfg, t)
+	// AND a kubernetes task that ran for 100 seconds with 2 cores and 4GB and created a 1GB artifact
+	req := types.NewRequest()
+	req.ID = ulid.Make().String()
+	req.JobType = "cost-job"
+	req.OrganizationID = org
+	opts := common.NewExecutorOptions("build", common.Kubernetes)
+	opts.MainContainer.CPURequest = "2"
+	opts.MainContainer.MemoryRequest = "4Gi"
+	taskExec := &types.TaskExecution{
+		ID:        ulid.Make().String(),
+		TaskType:  "build",
+		Method:    common.Kubernetes,
+		StartedAt: time.Now().Add(-100 * time.Second),
+		Artifacts: []*common.Artifact{{ContentLength: 1024 * 1024 * 1024, ExpiresAt: time.Now().Add(48 * time.Hour)}},
+	}
+	ended := taskExec.StartedAt.Add(100 * time.Second)
+	taskExec.EndedAt = &ended
+
+	// WHEN recording cost of the task
+	err := mgr.RecordTaskCost(req, taskExec, opts, []string{"linux"})
+
+	// THEN compute and storage cost of the task is set
+	require.NoError(t, err)
+	require.InDelta(t, 2.0+0.4+2.0, taskExec.Cost, 0.01)
+
+	// AND reported for the job type
+	report, err := mgr.GetCostReport(
+		common.NewQueryContextFromIDs("", org), types.CostByJobType, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, report.Summaries, 1)
+	require.Equal(t, int64(1), report.Summaries[0].Tasks)
+	require.InDelta(t, 2.4, report.Summaries[0].ComputeCost, 0.01)
+	require.InDelta(t, 2.0, report.Summaries[0].StorageCost, 0.01)
+
+	// WHEN checking budgets of the organization
+	mgr.checkBudgets(org, req.JobType, nil)
+	// THEN exceeded budget is alerted once in its period
+	mgr.checkBudgets(org, req.JobType, nil)
+	mgr.lock.Lock()
+	require.Len(t, mgr.alertedBudgets, 1)
+	mgr.lock.Unlock()
+	// AND the alert is saved so that it isn't notified again after restart
+	saved, err := mgr.costRecordRepository.SaveBudgetAlert(&types.CostBudgetAlert{
+		Budget: "team-" + org, PeriodStart: serverCfg.Cost.Budgets[0].PeriodStart(time.Now())})
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	// WHEN the artifact is downloaded
+	taskExec.Artifacts[0].TaskExecutionID = taskExec.ID
+	taskExec.Artifacts[0].OrganizationID = org
+	serverCfg.Cost.ArtifactEgressGB = 0.1
+	require.NoError(t, mgr.RecordArtifactEgress(taskExec.Artifacts[0]))
+	// THEN egress is charged to the job of the artifact
+	report, err = mgr.GetCostReport(
+		common.NewQueryContextFromIDs("", org), types.CostByTag, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, report.Summaries, 1)
+	require.Equal(t, "linux", report.Summaries[0].Key)
+	require.InDelta(t, 0.1, report.Summaries[0].EgressCost, 0.001)
+}
+
+func Test_ShouldNotRecordTaskCostWithoutPrices(t *testing.T) {
+	// GIVEN cost manager without prices
+	mgr := AssertTestCostManager(nil, t)
+	taskExec := &types.TaskExecution{TaskType: "build", StartedAt: time.Now().Add(-time.Minute)}
+
+	// WHEN recording cost of a task
+	err := mgr.RecordTaskCost(types.NewRequest(), taskExec, nil, nil)
+
+	// THEN nothing is recorded
+	require.NoError(t, err)
+	require.Equal(t, 0.0, taskExec.Cost)
+}
+
+func Test_ShouldNotRecordComputeCostOfQueenTasklets(t *testing.T) {
+	// GIVEN cost manager with prices of fork tasks
+	serverCfg := config.TestServerConfig()
+	serverCfg.Cost.Rates = map[string]config.CostRates{"fork_job": {CPUSecond: 0.01, MemoryGBSecond: 0.001}}
+	mgr := AssertTestCostManager(serverCfg, t)
+	taskExec := &types.TaskExecution{
+		ID:        ulid.Make().String(),
+		TaskType:  "fork",
+		Method:    common.ForkJob,
+		StartedAt: time.Now().Add(-time.Hour),
+	}
+
+	// WHEN recording cost of the task that is executed by the queen
+	err := mgr.RecordTaskCost(types.NewRequest(), taskExec, nil, nil)
+
+	// THEN compute is not charged
+	require.NoError(t, err)
+	require.Equal(t, 0.0, taskExec.Cost)
+	_, err = mgr.costRecordRepository.GetComputeRecord(taskExec.ID)
+	require.Error(t, err)
+}
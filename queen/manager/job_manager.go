@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"plexobject.com/formicary/queen/security"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/karlseguin/ccache/v3"
 	"github.com/oklog/ulid/v2"
 
 	"plexobject.com/formicary/queen/notify"
@@ -23,6 +25,7 @@ import (
 	"plexobject.com/formicary/queen/diagrams"
 	"plexobject.com/formicary/queen/resource"
 
+	"plexobject.com/formicary/internal/acl"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/approval"
 	"plexobject.com/formicary/queen/repository"
@@ -31,6 +34,9 @@ import (
 
 const maxForkJobs = 10
 
+// slaCheckPageSize defines number of requests loaded at a time for evaluating SLA breaches
+const slaCheckPageSize = 1000
+
 // JobManager for managing state of request and its execution
 type JobManager struct {
 	serverCfg               *config.ServerConfig
@@ -38,6 +44,8 @@ type JobManager struct {
 	jobDefinitionRepository repository.JobDefinitionRepository
 	jobRequestRepository    repository.JobRequestRepository
 	jobExecutionRepository  repository.JobExecutionRepository
+	cronBackfillRepository  repository.CronBackfillRepository
+	slaBreachRepository     repository.SLABreachRepository
 	userManager             *UserManager
 	resourceManager         resource.Manager
 	artifactManager         *ArtifactManager
@@ -48,6 +56,9 @@ type JobManager struct {
 	approvalService         *approval.Service
 	schedulerTriggerCh      chan struct{}
 	jobIdsTicker            *time.Ticker
+	flakyTaskCache          *ccache.Cache[map[string]*types.FlakyTask]
+	costManager             *CostManager
+	maintenanceManager      *MaintenanceManager
 }
 
 // NewJobManager manages job request, definition and execution
@@ -58,6 +69,8 @@ func NewJobManager(
 	jobDefinitionRepository repository.JobDefinitionRepository,
 	jobRequestRepository repository.JobRequestRepository,
 	jobExecutionRepository repository.JobExecutionRepository,
+	cronBackfillRepository repository.CronBackfillRepository,
+	slaBreachRepository repository.SLABreachRepository,
 	userManager *UserManager,
 	resourceManager resource.Manager,
 	artifactManager *ArtifactManager,
@@ -82,6 +95,12 @@ func NewJobManager(
 	if jobExecutionRepository == nil {
 		return nil, fmt.Errorf("job-execution-repository is not specified")
 	}
+	if cronBackfillRepository == nil {
+		return nil, fmt.Errorf("cron-backfill-repository is not specified")
+	}
+	if slaBreachRepository == nil {
+		return nil, fmt.Errorf("sla-breach-repository is not specified")
+	}
 	if userManager == nil {
 		return nil, fmt.Errorf("user-manager is not specified")
 	}
@@ -109,6 +128,8 @@ func NewJobManager(
 		jobDefinitionRepository: jobDefinitionRepository,
 		jobRequestRepository:    jobRequestRepository,
 		jobExecutionRepository:  jobExecutionRepository,
+		cronBackfillRepository:  cronBackfillRepository,
+		slaBreachRepository:     slaBreachRepository,
 		userManager:             userManager,
 		resourceManager:         resourceManager,
 		artifactManager:         artifactManager,
@@ -118,6 +139,7 @@ func NewJobManager(
 		jobsNotifier:            jobsNotifier,
 		approvalService:         approvalSvc,
 		schedulerTriggerCh:      schedulerTriggerCh,
+		flakyTaskCache:          ccache.New(ccache.Configure[map[string]*types.FlakyTask]().MaxSize(serverCfg.Jobs.DBObjectCacheSize)),
 	}
 
 	if err := jm.startRecentlyCompletedJobIdsTicker(ctx); err != nil {
@@ -246,15 +268,25 @@ func (jm *JobManager) scheduleCronRequest(jobDefinition *types.JobDefinition, ol
 		case *types.JobRequestInfo:
 			oldReqFull, _ = jm.jobRequestRepository.Get(qc, oldReq.GetID())
 		}
+		lastTick := oldReq.GetScheduledAt()
 		if oldReqFull != nil {
 			for _, p := range oldReqFull.Params {
+				if p.Name == types.BackfillIDParam || p.Name == types.LogicalDateParam {
+					continue
+				}
 				v, _ := p.GetParsedValue()
 				_, _ = request.AddParam(p.Name, v)
 			}
+			if oldReqFull.LogicalDate != nil {
+				lastTick = *oldReqFull.LogicalDate
+			}
 		}
 		request.ParentID = oldReq.GetID()
 		request.UserID = oldReq.GetUserID()
 		request.OrganizationID = oldReq.GetOrganizationID()
+		if jobDefinition.Catchup {
+			jm.catchupMissedCronTicks(jobDefinition, lastTick)
+		}
 	}
 
 	if request.UserKey != "" {
@@ -262,6 +294,11 @@ func (jm *JobManager) scheduleCronRequest(jobDefinition *types.JobDefinition, ol
 			return nil // cron job for this schedule already exists
 		}
 	}
+	// user-key is released when a request finishes so a tick that already ran is found by its logical-date
+	if request.LogicalDate != nil && jm.isCronTickSubmitted(
+		request.JobType, request.OrganizationID, request.UserID, *request.LogicalDate) {
+		return nil
+	}
 
 	if _, err := jm.SaveJobRequest(qc, request); err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -403,9 +440,53 @@ func (jm *JobManager) GetWaitEstimate(
 	if q.EstimatedWait > 0 {
 		q.ErrorMessage = ""
 	}
+	if jm.maintenanceManager != nil {
+		if mode := jm.maintenanceManager.Status(); mode.Enabled {
+			q.ErrorMessage = "scheduling of jobs is paused for maintenance"
+			if mode.Reason != "" {
+				q.ErrorMessage += ": " + mode.Reason
+			}
+		}
+	}
 	return
 }
 
+// SetMaintenanceManager sets manager of maintenance mode that pauses scheduling of pending jobs
+func (jm *JobManager) SetMaintenanceManager(maintenanceManager *MaintenanceManager) {
+	jm.maintenanceManager = maintenanceManager
+}
+
+// GetPendingJobDefinitions returns job-definition of each pending job that is due to be scheduled, so the
+// same definition is returned for every pending request of a job type.
+func (jm *JobManager) GetPendingJobDefinitions() []*types.JobDefinition {
+	jobs := make([]*types.JobDefinition, 0)
+	byID := make(map[string]*types.JobDefinition)
+	for _, pending := range jm.jobStatsRegistry.PendingJobs() {
+		info, ok := pending.(*types.JobRequestInfo)
+		if !ok {
+			continue
+		}
+		job := byID[info.JobDefinitionID]
+		if job == nil {
+			var err error
+			job, err = jm.jobDefinitionRepository.Get(
+				common.NewQueryContextFromIDs(info.UserID, info.OrganizationID), info.JobDefinitionID)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":       "JobManager",
+					"JobType":         info.JobType,
+					"JobDefinitionID": info.JobDefinitionID,
+					"Error":           err,
+				}).Warnf("failed to find job-definition of pending job")
+				continue
+			}
+			byID[info.JobDefinitionID] = job
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
 // DisableJobDefinition - update job-definition -- only admin can do it so no need for query context
 func (jm *JobManager) DisableJobDefinition(
 	qc *common.QueryContext,
@@ -613,9 +694,13 @@ func (jm *JobManager) SaveJobRequest(
 	request.Retried = 0
 	request.CreatedAt = time.Now()
 	request.UpdatedAt = time.Now()
-	request.UpdateUserKeyFromScheduleIfCronJob(jobDefinition)
+	// backfill requests keep the user-key of their cron tick and run right away
+	backfill := request.IsBackfill()
+	if !backfill {
+		request.UpdateUserKeyFromScheduleIfCronJob(jobDefinition)
+	}
 
-	if !request.UpdateScheduledAtFromCronTrigger(jobDefinition) &&
+	if (backfill || !request.UpdateScheduledAtFromCronTrigger(jobDefinition)) &&
 		(request.ScheduledAt.IsZero() || request.ScheduledAt.Unix() < time.Now().Unix()-1) {
 		request.ScheduledAt = time.Now()
 	}
@@ -933,8 +1018,8 @@ func (jm *JobManager) CastApprovalVote(ctx context.Context,
 	// Cast the vote.
 	var status *types.ApprovalStatus
 	if jm.approvalService != nil {
-		status, err = jm.approvalService.CastVote(ctx, qc, request, voteRequest.TaskType,
-			taskExec.ID, policy, voteRequest.VoterID, voteRequest.VoterName,
+		status, err = jm.approvalService.CastDelegatedVote(ctx, qc, request, voteRequest.TaskType,
+			taskExec.ID, policy, voteRequest.VoterID, voteRequest.VoterName, voteRequest.OnBehalfOf,
 			voteRequest.Decision, voteRequest.Comments)
 	} else {
 		return nil, fmt.Errorf("approval service not configured")
@@ -970,6 +1055,8 @@ func (jm *JobManager) CastApprovalVote(ctx context.Context,
 		"RequestID":  voteRequest.RequestID,
 		"TaskType":   voteRequest.TaskType,
 		"VoterID":    voteRequest.VoterID,
+		"OnBehalfOf": voteRequest.OnBehalfOf,
+		"Stage":      status.CurrentStage,
 		"Decision":   voteRequest.Decision,
 		"Approvals":  status.ApprovalsReceived,
 		"MinNeeded":  status.MinApprovalsRequired,
@@ -1071,6 +1158,119 @@ func (jm *JobManager) ListPendingApprovals(_ context.Context,
 	return jm.approvalService.ListPendingApprovals(qc, page, pageSize)
 }
 
+// GetApprovalAction verifies a signed approve/reject link from a notification and returns its
+// claims along with the job request awaiting approval.
+func (jm *JobManager) GetApprovalAction(token string) (*types.ApprovalActionClaims, *types.JobRequest, error) {
+	if jm.approvalService == nil || jm.approvalService.ActionLinks() == nil {
+		return nil, nil, common.NewValidationError("approval links are not enabled")
+	}
+	claims, err := jm.approvalService.ActionLinks().Verify(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	request, err := jm.jobRequestRepository.Get(common.NewQueryContextFromIDs("", "").WithAdmin(), claims.RequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return claims, request, nil
+}
+
+// findApprovalVoter finds the user with the email in the organization of the job. Emails are only unique
+// within an organization, so jobs without organization can only be voted on by their owner.
+func (jm *JobManager) findApprovalVoter(request *types.JobRequest, email string) (*common.User, error) {
+	if request.OrganizationID != "" {
+		return jm.userManager.GetUserByEmail(request.OrganizationID, email)
+	}
+	owner, err := jm.userManager.GetUser(common.NewQueryContextFromIDs("", "").WithAdmin(), request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Active || !strings.EqualFold(strings.TrimSpace(email), owner.Email) {
+		return nil, common.NewNotFoundError(fmt.Errorf("user with email %s is not found", email))
+	}
+	return owner, nil
+}
+
+// CastApprovalVoteFromAction casts the decision of a signed approve/reject link as the identified
+// user. Email links identify the voter by their recipient whereas Slack buttons are shared by the
+// channel, so the caller passes the email of the Slack user who clicked. The voter must belong to
+// the organization of the job and have permission to approve; each voter can use a link once.
+func (jm *JobManager) CastApprovalVoteFromAction(
+	ctx context.Context,
+	token string,
+	voterEmail string,
+	channel string) (*types.ApprovalStatus, *common.User, error) {
+	claims, request, err := jm.GetApprovalAction(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claims.Recipient != "" {
+		voterEmail = claims.Recipient
+	}
+	if voterEmail == "" {
+		return nil, nil, common.NewPermissionError("approver could not be identified")
+	}
+	user, err := jm.findApprovalVoter(request, voterEmail)
+	if err != nil {
+		return nil, nil, common.NewPermissionError(
+			fmt.Sprintf("%s is not allowed to vote on %s due to %s", voterEmail, claims.RequestID, err))
+	}
+	if !user.HasPermission(acl.JobRequest, acl.Approve) {
+		return nil, user, common.NewPermissionError(
+			fmt.Sprintf("%s is not allowed to approve jobs", voterEmail))
+	}
+	redemption, err := jm.approvalService.ActionLinks().Redeem(claims, user.ID, channel)
+	if err != nil {
+		return nil, user, err
+	}
+	status, err := jm.CastApprovalVote(ctx, common.NewQueryContext(user, ""), &types.ApprovalVoteRequest{
+		RequestID: claims.RequestID,
+		TaskType:  claims.TaskType,
+		VoterID:   user.ID,
+		VoterName: user.Name,
+		Decision:  claims.Decision,
+		Comments:  fmt.Sprintf("voted from %s", channel),
+	})
+	if err != nil {
+		// let the approver retry, e.g. if the vote failed because of a transient database error
+		if releaseErr := jm.approvalService.ActionLinks().Release(redemption); releaseErr != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "JobManager",
+				"RequestID": claims.RequestID,
+				"ActionID":  claims.ID,
+				"Error":     releaseErr,
+			}).Warn("failed to release approval link after failed vote")
+		}
+		return nil, user, err
+	}
+	return status, user, nil
+}
+
+// CreateApprovalDelegation delegates approval votes of the caller to another user while the caller is away.
+func (jm *JobManager) CreateApprovalDelegation(
+	qc *common.QueryContext, delegation *types.ApprovalDelegation) (*types.ApprovalDelegation, error) {
+	if jm.approvalService == nil {
+		return nil, fmt.Errorf("approval service not configured")
+	}
+	return jm.approvalService.CreateDelegation(qc, delegation)
+}
+
+// ListApprovalDelegations returns unexpired approval delegations from or to the caller.
+func (jm *JobManager) ListApprovalDelegations(qc *common.QueryContext) ([]*types.ApprovalDelegation, error) {
+	if jm.approvalService == nil {
+		return nil, fmt.Errorf("approval service not configured")
+	}
+	return jm.approvalService.ListDelegations(qc)
+}
+
+// RevokeApprovalDelegation removes an approval delegation of the caller.
+func (jm *JobManager) RevokeApprovalDelegation(qc *common.QueryContext, id string) error {
+	if jm.approvalService == nil {
+		return fmt.Errorf("approval service not configured")
+	}
+	return jm.approvalService.RevokeDelegation(qc, id)
+}
+
 // CreateApprovalDeadlineIfNeeded creates an SLA deadline row when a task enters MANUAL_APPROVAL_REQUIRED.
 func (jm *JobManager) CreateApprovalDeadlineIfNeeded(
 	taskExecutionID string, jobRequestID string, policy *types.ApprovalPolicy) error {
@@ -1142,6 +1342,10 @@ func (jm *JobManager) FindMissingCronScheduledJobsByType(
 	}
 	res := make([]types.JobTypeCronTrigger, 0)
 	for _, jobType := range jobTypes {
+		// no tick to schedule, e.g. all remaining ticks fall in blackout windows or holidays
+		if scheduledAt, _ := jobType.GetCronScheduleTimeAndUserKey(); scheduledAt == nil {
+			continue
+		}
 		matched := false
 		for _, active := range activeJobInfos {
 			if jobType.JobType == active.JobType &&
@@ -1615,6 +1819,601 @@ func (jm *JobManager) BuildGithubPostWebhookHandler() security.GithubPostWebhook
 	}
 }
 
+/////////////////////////////////////////// CRON BACKFILL METHODS ////////////////////////////////////////////
+
+// BackfillCronJob submits a job request for every cron tick of the job in [from, to), where ticks that
+// already ran are skipped and at most maxParallel requests of the backfill run at a time. Remaining
+// ticks are submitted by the scheduler as earlier requests finish.
+func (jm *JobManager) BackfillCronJob(
+	qc *common.QueryContext,
+	jobType string,
+	from time.Time,
+	to time.Time,
+	maxParallel int) (*types.CronBackfill, error) {
+	jobDefinition, err := jm.GetJobDefinitionByType(qc, jobType, "")
+	if err != nil {
+		return nil, err
+	}
+	if jobDefinition.CronTrigger == "" {
+		return nil, common.NewValidationError(
+			fmt.Errorf("job %s doesn't define cron_trigger", jobType))
+	}
+	// future ticks are scheduled by cron
+	if now := time.Now(); to.After(now) {
+		to = now
+	}
+	backfill := types.NewCronBackfill(jobDefinition, from, to, maxParallel)
+	if err = backfill.Validate(); err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	ticks, err := jobDefinition.GetCronSchedule().Ticks(from, to, types.MaxCronBackfillTicks+1)
+	if err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	if len(ticks) > types.MaxCronBackfillTicks {
+		return nil, common.NewValidationError(
+			fmt.Errorf("backfill of %s cannot have more than %d cron ticks", jobType, types.MaxCronBackfillTicks))
+	}
+	if backfill, err = jm.cronBackfillRepository.Save(backfill); err != nil {
+		return nil, err
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component": "JobManager",
+		"Backfill":  backfill.String(),
+		"Ticks":     len(ticks),
+	}).Infof("starting cron backfill")
+	return jm.processCronBackfill(backfill)
+}
+
+// GetCronBackfill returns backfill by id
+func (jm *JobManager) GetCronBackfill(
+	qc *common.QueryContext,
+	id string) (*types.CronBackfill, error) {
+	return jm.cronBackfillRepository.Get(qc, id)
+}
+
+// QueryCronBackfills returns recent backfills of the job type or of all job types
+func (jm *JobManager) QueryCronBackfills(
+	qc *common.QueryContext,
+	jobType string,
+	limit int) ([]*types.CronBackfill, error) {
+	return jm.cronBackfillRepository.Query(qc, jobType, limit)
+}
+
+// CancelCronBackfill stops submitting ticks of the backfill and cancels its pending requests
+func (jm *JobManager) CancelCronBackfill(
+	qc *common.QueryContext,
+	id string) (*types.CronBackfill, error) {
+	backfill, err := jm.cronBackfillRepository.Get(qc, id)
+	if err != nil {
+		return nil, err
+	}
+	if backfill.Done() {
+		return nil, common.NewValidationError(
+			fmt.Errorf("backfill %s is already %s", id, backfill.State))
+	}
+	if backfill, err = jm.finishCronBackfill(backfill, common.CANCELLED, ""); err != nil {
+		return nil, err
+	}
+	infos, err := jm.findCronBackfillRequests(backfill)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.JobState == common.PENDING && !info.CronTriggered {
+			if err = jm.CancelJobRequest(qc, info.ID); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":    "JobManager",
+					"BackfillID":   backfill.ID,
+					"JobRequestID": info.ID,
+					"Error":        err,
+				}).Warnf("failed to cancel request of cron backfill")
+			}
+		}
+	}
+	return backfill, nil
+}
+
+// ProcessCronBackfills submits remaining ticks of active backfills as their earlier requests finish
+func (jm *JobManager) ProcessCronBackfills() error {
+	backfills, err := jm.cronBackfillRepository.FindActive()
+	if err != nil {
+		return err
+	}
+	for _, backfill := range backfills {
+		if _, err = jm.processCronBackfill(backfill); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "JobManager",
+				"Backfill":  backfill.String(),
+				"Error":     err,
+			}).Warnf("failed to process cron backfill")
+		}
+	}
+	return nil
+}
+
+// processCronBackfill submits requests for ticks that haven't run yet and completes the backfill
+// when every tick has finished
+func (jm *JobManager) processCronBackfill(backfill *types.CronBackfill) (*types.CronBackfill, error) {
+	qc := common.NewQueryContextFromIDs(backfill.UserID, backfill.OrganizationID)
+	jobDefinition, err := jm.GetJobDefinitionByType(qc, backfill.JobType, "")
+	if err != nil {
+		return jm.finishCronBackfill(backfill, common.FAILED, err.Error())
+	}
+	ticks, err := jobDefinition.GetCronSchedule().Ticks(
+		backfill.StartTime, backfill.EndTime, types.MaxCronBackfillTicks)
+	if err != nil {
+		return jm.finishCronBackfill(backfill, common.FAILED, err.Error())
+	}
+	states, running, err := jm.cronBackfillTickStates(backfill)
+	if err != nil {
+		return nil, err
+	}
+	if backfill.TotalTicks == 0 {
+		backfill.TotalTicks = len(ticks)
+		backfill.SkippedTicks = len(states)
+	}
+	submitted := 0
+	for _, tick := range ticks {
+		if running >= backfill.MaxParallel {
+			break
+		}
+		if _, ok := states[tick.Unix()]; ok {
+			continue
+		}
+		if err = jm.submitCronBackfillRequest(qc, jobDefinition, backfill, tick); err != nil {
+			// another server may have submitted the tick concurrently, which the unique logical-date rejects
+			if !jm.isCronTickSubmitted(backfill.JobType, backfill.OrganizationID, backfill.UserID, tick) {
+				return jm.finishCronBackfill(backfill, common.FAILED, err.Error())
+			}
+			states[tick.Unix()] = common.PENDING
+			continue
+		}
+		states[tick.Unix()] = common.PENDING
+		running++
+		submitted++
+	}
+	if running == 0 && len(states) >= len(ticks) {
+		return jm.finishCronBackfill(backfill, common.COMPLETED, "")
+	}
+	if submitted == 0 {
+		return backfill, nil
+	}
+	backfill.SubmittedTicks += submitted
+	return jm.cronBackfillRepository.Save(backfill)
+}
+
+// catchupMissedCronTicks starts a backfill for ticks after the given tick that were missed such as
+// while the server was down or the previous run overran the next tick
+func (jm *JobManager) catchupMissedCronTicks(jobDefinition *types.JobDefinition, lastTick time.Time) {
+	now := time.Now()
+	since := lastTick.Add(time.Second)
+	if !since.Before(now) {
+		return
+	}
+	backfill := types.NewCronBackfill(jobDefinition, since, now, 1)
+	backfill.Catchup = true
+	ticks, err := jobDefinition.GetCronSchedule().Ticks(since, now, types.MaxCronBackfillTicks)
+	if err != nil || len(ticks) == 0 {
+		return
+	}
+	states, _, err := jm.cronBackfillTickStates(backfill)
+	if err != nil || len(states) >= len(ticks) || jm.hasActiveCronCatchup(backfill) {
+		return
+	}
+	if backfill, err = jm.cronBackfillRepository.Save(backfill); err == nil {
+		_, err = jm.processCronBackfill(backfill)
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component": "JobManager",
+		"Backfill":  backfill.String(),
+		"Missed":    len(ticks) - len(states),
+		"Error":     err,
+	}).Infof("catching up missed cron ticks")
+}
+
+// hasActiveCronCatchup returns true if a catch-up of the backfill's job is still submitting its ticks
+func (jm *JobManager) hasActiveCronCatchup(backfill *types.CronBackfill) bool {
+	active, err := jm.cronBackfillRepository.FindActive()
+	if err != nil {
+		return true
+	}
+	for _, other := range active {
+		if other.Catchup && other.JobType == backfill.JobType &&
+			other.OrganizationOrUserID() == backfill.OrganizationOrUserID() {
+			return true
+		}
+	}
+	return false
+}
+
+// cronBackfillTickStates returns states of requests by their tick and the number of requests that
+// haven't finished
+func (jm *JobManager) cronBackfillTickStates(
+	backfill *types.CronBackfill) (map[int64]common.RequestState, int, error) {
+	infos, err := jm.findCronBackfillRequests(backfill)
+	if err != nil {
+		return nil, 0, err
+	}
+	states := make(map[int64]common.RequestState)
+	running := 0
+	for _, info := range infos {
+		states[info.LogicalDate.Unix()] = info.JobState
+		if !info.JobState.IsTerminal() {
+			running++
+		}
+	}
+	return states, running, nil
+}
+
+// isCronTickSubmitted returns true if a request of the job already runs the tick
+func (jm *JobManager) isCronTickSubmitted(
+	jobType string,
+	organizationID string,
+	userID string,
+	tick time.Time) bool {
+	infos, err := jm.jobRequestRepository.FindByLogicalDate(
+		jobType,
+		organizationID,
+		userID,
+		tick,
+		tick.Add(time.Second))
+	return err == nil && len(infos) > 0
+}
+
+func (jm *JobManager) findCronBackfillRequests(backfill *types.CronBackfill) ([]*types.JobRequestInfo, error) {
+	return jm.jobRequestRepository.FindByLogicalDate(
+		backfill.JobType,
+		backfill.OrganizationID,
+		backfill.UserID,
+		backfill.StartTime,
+		backfill.EndTime)
+}
+
+func (jm *JobManager) submitCronBackfillRequest(
+	qc *common.QueryContext,
+	jobDefinition *types.JobDefinition,
+	backfill *types.CronBackfill,
+	tick time.Time) error {
+	request, err := types.NewJobRequestFromDefinition(jobDefinition)
+	if err != nil {
+		return err
+	}
+	// user-key of the tick prevents a duplicate run if the regular cron request of the tick is pending
+	request.UserKey = types.CronUserKey(backfill.OrganizationOrUserID(), backfill.JobType, tick)
+	request.CronTriggered = false
+	request.ScheduledAt = time.Now()
+	request.SetLogicalDate(tick)
+	request.BackfillID = backfill.ID
+	if _, err = request.AddParam(types.LogicalDateParam, tick.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	if _, err = request.AddParam(types.BackfillIDParam, backfill.ID); err != nil {
+		return err
+	}
+	_, err = jm.SaveJobRequest(qc, request)
+	return err
+}
+
+func (jm *JobManager) finishCronBackfill(
+	backfill *types.CronBackfill,
+	state common.RequestState,
+	errorMessage string) (*types.CronBackfill, error) {
+	backfill.State = state
+	backfill.ErrorMessage = errorMessage
+	logrus.WithFields(logrus.Fields{
+		"Component": "JobManager",
+		"Backfill":  backfill.String(),
+		"Error":     errorMessage,
+	}).Infof("finished cron backfill")
+	return jm.cronBackfillRepository.Save(backfill)
+}
+
+/////////////////////////////////////////// SLA METHODS ////////////////////////////////////////////
+
+// CheckSLABreaches evaluates SLA of requests that haven't finished or that finished since the given time,
+// and records, publishes and notifies breaches that weren't found before. It returns number of new breaches.
+func (jm *JobManager) CheckSLABreaches(finishedSince time.Time) (int, error) {
+	qc := common.NewQueryContext(nil, "").WithAdmin()
+	now := time.Now()
+	jobDefinitions := make(map[string]*types.JobDefinition)
+	total := 0
+	// all pages are evaluated so that old pending requests of jobs without SLA don't hide other requests
+	for page := 0; ; page++ {
+		infos, err := jm.jobRequestRepository.FindForSLACheck(finishedSince, page, slaCheckPageSize)
+		if err != nil {
+			return total, err
+		}
+		total += jm.checkSLABreaches(qc, infos, jobDefinitions, now)
+		if len(infos) < slaCheckPageSize {
+			return total, nil
+		}
+	}
+}
+
+// checkSLABreaches evaluates SLA of requests and returns number of new breaches, where job definitions
+// are cached by their IDs
+func (jm *JobManager) checkSLABreaches(
+	qc *common.QueryContext,
+	infos []*types.JobRequestInfo,
+	jobDefinitions map[string]*types.JobDefinition,
+	now time.Time) (total int) {
+	var err error
+	for _, info := range infos {
+		jobDefinition, ok := jobDefinitions[info.JobDefinitionID]
+		if !ok {
+			if jobDefinition, err = jm.GetJobDefinition(qc, info.JobDefinitionID); err != nil {
+				jobDefinition = nil
+			}
+			jobDefinitions[info.JobDefinitionID] = jobDefinition
+		}
+		if jobDefinition == nil || !jobDefinition.HasSLA() {
+			continue
+		}
+		var jobExec *types.JobExecution
+		if info.JobExecutionID != "" {
+			if jobExec, err = jm.GetJobExecution(info.JobExecutionID); err != nil {
+				jobExec = nil
+			}
+		}
+		for _, breach := range types.EvaluateSLA(jobDefinition, info, jobExec, now) {
+			saved, saveErr := jm.slaBreachRepository.Save(breach)
+			if saveErr != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component": "JobManager",
+					"Breach":    breach.String(),
+					"Error":     saveErr,
+				}).Warnf("failed to save SLA breach")
+				continue
+			}
+			if saved {
+				total++
+				jm.handleSLABreach(jobDefinition, breach)
+			}
+		}
+	}
+	return
+}
+
+// QuerySLABreaches returns recent SLA breaches of the job type or of all job types
+func (jm *JobManager) QuerySLABreaches(
+	qc *common.QueryContext,
+	jobType string,
+	limit int) ([]*types.SLABreach, error) {
+	return jm.slaBreachRepository.Query(qc, jobType, limit)
+}
+
+// GetSLAReport returns SLA compliance per day of requests that finished within the days for the job type or
+// for all job types that define an SLA
+func (jm *JobManager) GetSLAReport(
+	qc *common.QueryContext,
+	jobType string,
+	days int) ([]*types.SLACompliance, error) {
+	since := time.Now().AddDate(0, 0, -days).Truncate(24 * time.Hour)
+	compliance, err := jm.slaBreachRepository.Compliance(qc, jobType, since)
+	if err != nil || jobType != "" {
+		return compliance, err
+	}
+	hasSLA := make(map[string]bool)
+	res := make([]*types.SLACompliance, 0)
+	for _, c := range compliance {
+		found, ok := hasSLA[c.JobType]
+		if !ok {
+			jobDefinition, jobErr := jm.GetJobDefinitionByType(qc, c.JobType, "")
+			found = jobErr == nil && jobDefinition.HasSLA()
+			hasSLA[c.JobType] = found
+		}
+		if found {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+// handleSLABreach publishes the breach and notifies recipients of the job
+func (jm *JobManager) handleSLABreach(
+	jobDefinition *types.JobDefinition,
+	breach *types.SLABreach) {
+	logrus.WithFields(logrus.Fields{
+		"Component": "JobManager",
+		"Breach":    breach.String(),
+	}).Warnf("SLA breached")
+	if jm.metricsRegistry != nil {
+		jm.metricsRegistry.Incr("sla_breaches_total", map[string]string{
+			"JobType": breach.JobType,
+			"Kind":    string(breach.Kind),
+		})
+	}
+	if err := jm.fireSLABreach(breach); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "JobManager",
+			"Breach":    breach.String(),
+			"Error":     err,
+		}).Warnf("failed to publish SLA breach")
+	}
+	qc := common.NewQueryContextFromIDs(breach.UserID, breach.OrganizationID)
+	var user *common.User
+	if breach.UserID != "" {
+		user, _ = jm.userManager.GetUser(common.NewQueryContext(nil, "").WithAdmin(), breach.UserID)
+	}
+	// Send notification asynchronously
+	go func() {
+		if err := jm.jobsNotifier.NotifySLABreach(qc, user, jobDefinition, breach); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "JobManager",
+				"Breach":    breach.String(),
+				"Error":     err,
+			}).Warnf("failed to send SLA breach notification")
+		}
+	}()
+}
+
+// handleJobAnomaly notifies recipients of the job about a run that deviated from the baseline of the job
+func (jm *JobManager) handleJobAnomaly(
+	qc *common.QueryContext,
+	user *common.User,
+	jobDefinition *types.JobDefinition,
+	anomaly *types.JobAnomaly) {
+	logrus.WithFields(logrus.Fields{
+		"Component": "JobManager",
+		"Anomaly":   anomaly.String(),
+	}).Warnf("job anomaly detected")
+	if jm.metricsRegistry != nil {
+		jm.metricsRegistry.Incr("job_anomalies_total", map[string]string{
+			"JobType": anomaly.JobType,
+			"Kind":    string(anomaly.Kind),
+		})
+	}
+	if jobDefinition == nil {
+		return
+	}
+	// Send notification asynchronously
+	go func() {
+		if err := jm.jobsNotifier.NotifyJobAnomaly(qc, user, jobDefinition, anomaly); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component": "JobManager",
+				"Anomaly":   anomaly.String(),
+				"Error":     err,
+			}).Warnf("failed to send job anomaly notification")
+		}
+	}()
+}
+
+/////////////////////////////////////////// FLAKY TASK METHODS ////////////////////////////////////////////
+
+// GetFlakyTasks returns tasks of the job type, or of all job types when job type is empty, that failed and then
+// passed on retry or re-run within the flaky-task window, ordered by flakiness score
+func (jm *JobManager) GetFlakyTasks(
+	qc *common.QueryContext,
+	jobType string) ([]*types.FlakyTask, error) {
+	all, err := jm.jobExecutionRepository.GetTaskFlakiness(
+		qc, jobType, time.Now().Add(-jm.serverCfg.Jobs.FlakyTaskWindow))
+	if err != nil {
+		return nil, err
+	}
+	quarantines := make(map[string]bool)
+	res := make([]*types.FlakyTask, 0)
+	for _, task := range all {
+		if task.Score == 0 {
+			continue
+		}
+		quarantine, ok := quarantines[task.JobType]
+		if !ok {
+			jobDefinition, jobErr := jm.GetJobDefinitionByType(qc, task.JobType, "")
+			quarantine = jobErr == nil && jobDefinition.QuarantineFlakyTasks
+			quarantines[task.JobType] = quarantine
+		}
+		task.Quarantined = quarantine && task.IsFlaky(jm.serverCfg.Jobs.FlakyTaskMinRuns, jm.serverCfg.Jobs.FlakyTaskScore)
+		res = append(res, task)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+	return res, nil
+}
+
+// GetQuarantinedTask returns flakiness of the task if the job quarantines flaky tasks and the task is flaky
+func (jm *JobManager) GetQuarantinedTask(
+	jobDefinition *types.JobDefinition,
+	taskType string) *types.FlakyTask {
+	if jobDefinition == nil || !jobDefinition.QuarantineFlakyTasks {
+		return nil
+	}
+	key := jobDefinition.OrganizationID + ":" + jobDefinition.UserID + ":" + jobDefinition.JobType
+	item, err := jm.flakyTaskCache.Fetch(key, jm.serverCfg.Jobs.DBObjectCache,
+		func() (map[string]*types.FlakyTask, error) {
+			qc := common.NewQueryContextFromIDs(jobDefinition.UserID, jobDefinition.OrganizationID)
+			all, err := jm.jobExecutionRepository.GetTaskFlakiness(
+				qc, jobDefinition.JobType, time.Now().Add(-jm.serverCfg.Jobs.FlakyTaskWindow))
+			if err != nil {
+				return nil, err
+			}
+			byTask := make(map[string]*types.FlakyTask)
+			for _, task := range all {
+				byTask[task.TaskType] = task
+			}
+			return byTask, nil
+		})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "JobManager",
+			"JobType":   jobDefinition.JobType,
+			"TaskType":  taskType,
+			"Error":     err,
+		}).Warnf("failed to find flakiness of task")
+		return nil
+	}
+	task := item.Value()[taskType]
+	if task == nil || !task.IsFlaky(jm.serverCfg.Jobs.FlakyTaskMinRuns, jm.serverCfg.Jobs.FlakyTaskScore) {
+		return nil
+	}
+	return task
+}
+
+/////////////////////////////////////////// RIGHT-SIZING METHODS ////////////////////////////////////////////
+
+// GetTaskRightSizing returns resources used by profiled tasks of the job type, or of all job types when job type
+// is empty, within the resource-profile window along with recommended requests and limits of their containers
+func (jm *JobManager) GetTaskRightSizing(
+	qc *common.QueryContext,
+	jobType string) ([]*types.TaskRightSizing, error) {
+	profiles, err := jm.jobExecutionRepository.GetTaskProfiles(
+		qc, jobType, time.Now().Add(-jm.serverCfg.Jobs.ResourceProfileWindow))
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		profile.Recommend(jm.serverCfg.Jobs.RightSizingHeadroom)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].JobType == profiles[j].JobType {
+			return profiles[i].TaskType < profiles[j].TaskType
+		}
+		return profiles[i].JobType < profiles[j].JobType
+	})
+	return profiles, nil
+}
+
+/////////////////////////////////////////// COST METHODS ////////////////////////////////////////////
+
+// SetCostManager sets manager that records cost of tasks
+func (jm *JobManager) SetCostManager(costManager *CostManager) {
+	jm.costManager = costManager
+}
+
+// RecordTaskCost records cost of the task that finished and sets cost of the task execution if prices
+// are configured. Dependent artifacts that the ant downloaded are charged to the tasks that created them.
+func (jm *JobManager) RecordTaskCost(
+	request types.IJobRequest,
+	taskExec *types.TaskExecution,
+	opts *common.ExecutorOptions,
+	tags []string) {
+	if jm.costManager == nil {
+		return
+	}
+	if err := jm.costManager.RecordTaskCost(request, taskExec, opts, tags); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "JobManager",
+			"RequestID": request.GetID(),
+			"TaskType":  taskExec.TaskType,
+			"Error":     err,
+		}).Warnf("failed to record cost of task")
+	}
+	if opts != nil && !taskExec.Method.IsQueenTasklet() {
+		jm.artifactManager.RecordArtifactsEgress(opts.DependentArtifactIDs)
+	}
+}
+
+// GetCostReport aggregates costs of tasks recorded between from and to by organization, user, job type or tag
+func (jm *JobManager) GetCostReport(
+	qc *common.QueryContext,
+	groupBy types.CostGroupBy,
+	from time.Time,
+	to time.Time) (*types.CostReport, error) {
+	if jm.costManager == nil {
+		return nil, fmt.Errorf("cost accounting is not enabled")
+	}
+	return jm.costManager.GetCostReport(qc, groupBy, from, to)
+}
+
 /////////////////////////////////////////// JOB EXECUTION METHODS ////////////////////////////////////////////
 
 // GetJobExecution method finds JobExecution by id
@@ -1699,12 +2498,16 @@ func (jm *JobManager) FinalizeJobRequestAndExecutionState(
 		scheduleDelay,
 		retried,
 		req.GetPausedCount())
+	var anomaly *types.JobAnomaly
 	if req.GetJobState().Failed() {
-		jm.jobStatsRegistry.Failed(jobExec, jobExec.ElapsedMillis())
+		anomaly = jm.jobStatsRegistry.Failed(jobExec, jobExec.ElapsedMillis())
 	} else if req.GetJobState().Paused() {
 		jm.jobStatsRegistry.Paused(jobExec, jobExec.ElapsedMillis())
 	} else {
-		jm.jobStatsRegistry.Succeeded(jobExec, jobExec.ElapsedMillis())
+		anomaly = jm.jobStatsRegistry.Succeeded(jobExec, jobExec.ElapsedMillis())
+	}
+	if anomaly != nil {
+		jm.handleJobAnomaly(qc, user, job, anomaly)
 	}
 	forkedJob := false
 	for _, p := range req.GetParams() {
@@ -1848,6 +2651,39 @@ func (jm *JobManager) fireJobDefinitionChange(
 	return nil
 }
 
+// Fire event to SLA breach topic
+func (jm *JobManager) fireSLABreach(breach *types.SLABreach) (err error) {
+	event := events.NewSLABreachEvent(
+		jm.serverCfg.Common.ID,
+		breach.UserID,
+		breach.OrganizationID,
+		breach.JobRequestID,
+		breach.JobType,
+		breach.TaskType,
+		string(breach.Kind),
+		breach.Expected,
+		breach.Deadline,
+		breach.JobState)
+	var payload []byte
+	if payload, err = event.Marshal(); err != nil {
+		return fmt.Errorf("failed to marshal sla-breach event due to %w", err)
+	}
+	if _, err = jm.queueClient.Publish(
+		context.Background(),
+		jm.serverCfg.Common.GetSLABreachTopic(),
+		payload,
+		queue.NewMessageHeaders(
+			queue.DisableBatchingKey, "true",
+			"RequestID", breach.JobRequestID,
+			"JobType", breach.JobType,
+			"UserID", breach.UserID,
+		),
+	); err != nil {
+		return fmt.Errorf("failed to send sla-breach event due to %w", err)
+	}
+	return nil
+}
+
 // Fire event to job-request lifecycle event
 func (jm *JobManager) fireJobRequestChange(req *types.JobRequest) (err error) {
 	event := events.NewJobRequestLifecycleEvent(
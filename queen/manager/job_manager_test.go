@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"os"
 	"testing"
+	"time"
 
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/internal/metrics"
@@ -392,6 +393,10 @@ func Test_ShouldSignalSchedulerOnTrigger(t *testing.T) {
 	require.NoError(t, err)
 	jobExecRepo, err := repository.NewTestJobExecutionRepository()
 	require.NoError(t, err)
+	cronBackfillRepo, err := repository.NewTestCronBackfillRepository()
+	require.NoError(t, err)
+	slaBreachRepo, err := repository.NewTestSLABreachRepository()
+	require.NoError(t, err)
 	emailVerifRepo, err := repository.NewTestEmailVerificationRepository()
 	require.NoError(t, err)
 	logRepo, err := repository.NewTestLogEventRepository()
@@ -413,8 +418,10 @@ func Test_ShouldSignalSchedulerOnTrigger(t *testing.T) {
 		jobDefRepo,
 		jobReqRepo,
 		jobExecRepo,
+		cronBackfillRepo,
+		slaBreachRepo,
 		userMgr,
-		resource.New(serverCfg, queueClient),
+		resource.New(serverCfg, queueClient, metrics.New()),
 		artifactMgr,
 		stats.NewJobStatsRegistry(),
 		metrics.New(),
@@ -459,3 +466,257 @@ func Test_ShouldSignalSchedulerOnTrigger(t *testing.T) {
 		t.Fatal("expected schedulerTriggerCh to receive a signal after TriggerJobRequest")
 	}
 }
+
+func saveHourlyCronJob(t *testing.T, jobManager *JobManager, qc *common.QueryContext, catchup bool) *types.JobDefinition {
+	job := repository.NewTestJobDefinition(qc.User, "backfill-"+ulid.Make().String())
+	job.CronTrigger = "0 0 * * * * *"
+	job.Catchup = catchup
+	saved, err := jobManager.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	return saved
+}
+
+func finishCronTickRequests(
+	t *testing.T,
+	jobReqRepo *repository.JobRequestRepositoryImpl,
+	job *types.JobDefinition,
+	from time.Time,
+	to time.Time) []*types.JobRequestInfo {
+	infos, err := jobReqRepo.FindByLogicalDate(job.JobType, job.OrganizationID, job.UserID, from, to)
+	require.NoError(t, err)
+	for _, info := range infos {
+		if !info.JobState.IsTerminal() {
+			require.NoError(t, jobReqRepo.UpdateJobState(
+				info.ID, info.JobState, common.FAILED, "", "", 0, 0, 0))
+		}
+	}
+	return infos
+}
+
+func Test_ShouldBackfillCronJobForDateRange(t *testing.T) {
+	// GIVEN an hourly cron job
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, jobReqRepo, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	job := saveHourlyCronJob(t, jobManager, qc, false)
+	from := time.Now().Truncate(time.Hour).Add(-5 * time.Hour)
+	to := from.Add(5*time.Hour - time.Minute)
+	ticks, err := job.GetCronSchedule().Ticks(from, to, 100)
+	require.NoError(t, err)
+	require.Len(t, ticks, 5)
+	// AND a tick that already ran
+	ran, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	ran.UserKey = ""
+	ran.SetLogicalDate(ticks[1])
+	_, err = jobReqRepo.Save(qc, ran)
+	require.NoError(t, err)
+	require.Len(t, finishCronTickRequests(t, jobReqRepo, job, from, to), 1)
+
+	// WHEN backfilling the range with max-parallel of 2
+	backfill, err := jobManager.BackfillCronJob(qc, job.JobType, from, to, 2)
+
+	// THEN requests of first two missing ticks should be submitted
+	require.NoError(t, err)
+	require.Equal(t, common.EXECUTING, backfill.State)
+	require.Equal(t, 5, backfill.TotalTicks)
+	require.Equal(t, 1, backfill.SkippedTicks)
+	require.Equal(t, 2, backfill.SubmittedTicks)
+	infos, err := jobReqRepo.FindByLogicalDate(job.JobType, job.OrganizationID, job.UserID, from, to)
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	req, err := jobReqRepo.GetByUserKey(qc, types.CronUserKey(job.OrganizationID, job.JobType, ticks[0]))
+	require.NoError(t, err)
+	require.False(t, req.CronTriggered)
+	require.Equal(t, ticks[0].Unix(), req.LogicalDate.Unix())
+	require.Equal(t, ticks[0].Format(time.RFC3339), req.GetParam(types.LogicalDateParam).Value)
+	require.Equal(t, backfill.ID, req.GetParam(types.BackfillIDParam).Value)
+	require.Equal(t, backfill.ID, req.BackfillID)
+	require.True(t, req.IsBackfill())
+	require.True(t, req.ScheduledAt.Before(time.Now().Add(time.Second)))
+
+	// WHEN processing backfills while requests are still running
+	require.NoError(t, jobManager.ProcessCronBackfills())
+	// THEN no more ticks should be submitted
+	backfill, err = jobManager.GetCronBackfill(qc, backfill.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, backfill.SubmittedTicks)
+
+	// WHEN requests finish
+	for i := 0; i < 3 && !backfill.Done(); i++ {
+		finishCronTickRequests(t, jobReqRepo, job, from, to)
+		require.NoError(t, jobManager.ProcessCronBackfills())
+		backfill, err = jobManager.GetCronBackfill(qc, backfill.ID)
+		require.NoError(t, err)
+	}
+	// THEN remaining ticks should run and backfill should complete
+	require.Equal(t, common.COMPLETED, backfill.State)
+	require.Equal(t, 4, backfill.SubmittedTicks)
+	backfills, err := jobManager.QueryCronBackfills(qc, job.JobType, 10)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+}
+
+func Test_ShouldValidateCronBackfill(t *testing.T) {
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, _, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	job := saveHourlyCronJob(t, jobManager, qc, false)
+	now := time.Now()
+
+	// range must end after it starts
+	_, err = jobManager.BackfillCronJob(qc, job.JobType, now, now.Add(-time.Hour), 1)
+	require.Error(t, err)
+	// range must be in the past
+	_, err = jobManager.BackfillCronJob(qc, job.JobType, now.Add(time.Hour), now.Add(2*time.Hour), 1)
+	require.Error(t, err)
+	// range cannot have too many ticks
+	_, err = jobManager.BackfillCronJob(qc, job.JobType, now.AddDate(0, 0, -60), now, 1)
+	require.Error(t, err)
+
+	// job must have cron trigger
+	plain := repository.NewTestJobDefinition(qc.User, "backfill-"+ulid.Make().String())
+	_, err = jobManager.SaveJobDefinition(qc, plain)
+	require.NoError(t, err)
+	_, err = jobManager.BackfillCronJob(qc, plain.JobType, now.Add(-time.Hour), now, 1)
+	require.Error(t, err)
+}
+
+func Test_ShouldCancelCronBackfill(t *testing.T) {
+	// GIVEN a backfill that has submitted a request
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, jobReqRepo, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	job := saveHourlyCronJob(t, jobManager, qc, false)
+	from := time.Now().Truncate(time.Hour).Add(-3 * time.Hour)
+	backfill, err := jobManager.BackfillCronJob(qc, job.JobType, from, from.Add(3*time.Hour), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, backfill.SubmittedTicks)
+
+	// WHEN cancelling the backfill
+	backfill, err = jobManager.CancelCronBackfill(qc, backfill.ID)
+
+	// THEN backfill and its pending request should be cancelled
+	require.NoError(t, err)
+	require.Equal(t, common.CANCELLED, backfill.State)
+	infos, err := jobReqRepo.FindByLogicalDate(job.JobType, job.OrganizationID, job.UserID, from, time.Now())
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, common.CANCELLED, infos[0].JobState)
+	// AND it cannot be cancelled again
+	_, err = jobManager.CancelCronBackfill(qc, backfill.ID)
+	require.Error(t, err)
+}
+
+func Test_ShouldCatchupMissedCronTicks(t *testing.T) {
+	// GIVEN an hourly cron job with catchup
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, _, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	job := saveHourlyCronJob(t, jobManager, qc, true)
+	require.True(t, job.Catchup)
+	// AND the previous request ran a tick before the server went down for three hours
+	lastTick := time.Now().Truncate(time.Hour).Add(-3 * time.Hour)
+	oldReq, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	oldReq.ID = ulid.Make().String()
+	oldReq.SetLogicalDate(lastTick)
+
+	// WHEN the previous request finishes
+	require.NoError(t, jobManager.scheduleCronRequest(job, oldReq))
+
+	// THEN a catch-up backfill should run missed ticks one at a time
+	backfills, err := jobManager.QueryCronBackfills(qc, job.JobType, 10)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	require.True(t, backfills[0].Catchup)
+	require.Equal(t, 1, backfills[0].MaxParallel)
+	require.Equal(t, 3, backfills[0].TotalTicks)
+	require.Equal(t, 1, backfills[0].SubmittedTicks)
+
+	// WHEN a job without catchup finishes after downtime
+	job = saveHourlyCronJob(t, jobManager, qc, false)
+	oldReq, err = types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	oldReq.ID = ulid.Make().String()
+	oldReq.SetLogicalDate(lastTick)
+	require.NoError(t, jobManager.scheduleCronRequest(job, oldReq))
+
+	// THEN missed ticks should not run
+	backfills, err = jobManager.QueryCronBackfills(qc, job.JobType, 10)
+	require.NoError(t, err)
+	require.Len(t, backfills, 0)
+}
+
+func Test_ShouldCheckSLABreachesOnce(t *testing.T) {
+	// GIVEN a cron job that must finish an hour after its tick
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, jobReqRepo, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	tick := time.Now().Add(-25 * time.Hour).Truncate(time.Minute)
+	job := repository.NewTestJobDefinition(qc.User, "sla-"+ulid.Make().String())
+	job.CronTrigger = "0 0 * * * * *"
+	job.SLA = &types.SLAConfig{FinishBy: tick.Add(time.Hour).Format("15:04")}
+	job, err = jobManager.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	// AND a request of the tick that is still pending
+	req, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	req.UserKey = ""
+	req.SetLogicalDate(tick)
+	_, err = jobReqRepo.Save(qc, req)
+	require.NoError(t, err)
+
+	// WHEN checking SLA breaches
+	total, err := jobManager.CheckSLABreaches(time.Now().Add(-time.Minute))
+	// THEN finish_by should be breached
+	require.NoError(t, err)
+	require.True(t, total >= 1)
+	breaches, err := jobManager.QuerySLABreaches(qc, job.JobType, 10)
+	require.NoError(t, err)
+	require.Len(t, breaches, 1)
+	require.Equal(t, types.SLAFinishBy, breaches[0].Kind)
+	require.Equal(t, req.ID, breaches[0].JobRequestID)
+
+	// WHEN checking SLA breaches again
+	_, err = jobManager.CheckSLABreaches(time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	// THEN the breach should not be recorded again
+	breaches, err = jobManager.QuerySLABreaches(qc, job.JobType, 10)
+	require.NoError(t, err)
+	require.Len(t, breaches, 1)
+}
+
+func Test_ShouldGetPendingJobDefinitions(t *testing.T) {
+	// GIVEN a job with two pending requests
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	jobManager, _, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	job := repository.NewTestJobDefinition(qc.User, "pending-"+ulid.Make().String())
+	job, err = jobManager.SaveJobDefinition(qc, job)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		req, err := types.NewJobRequestFromDefinition(job)
+		require.NoError(t, err)
+		_, err = jobManager.SaveJobRequest(qc, req)
+		require.NoError(t, err)
+	}
+
+	// WHEN fetching definitions of pending jobs
+	jobs := jobManager.GetPendingJobDefinitions()
+
+	// THEN definition should be returned for each pending request
+	matched := 0
+	for _, pending := range jobs {
+		if pending.ID == job.ID {
+			matched++
+		}
+	}
+	require.Equal(t, 2, matched)
+}
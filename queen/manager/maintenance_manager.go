@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+const (
+	maintenanceConfigScope = "default"
+	maintenanceConfigKind  = "maintenance"
+	maintenanceConfigName  = "mode"
+	// maintenanceRefreshInterval is how long maintenance mode is cached as scheduler checks it on every tick
+	maintenanceRefreshInterval = 10 * time.Second
+)
+
+// MaintenanceManager keeps cluster-wide maintenance mode in the system config so that all queen servers
+// pause scheduling of new jobs while it's enabled.
+type MaintenanceManager struct {
+	systemConfigRepository repository.SystemConfigRepository
+	metricsRegistry        *metrics.Registry
+	mode                   *types.MaintenanceMode
+	loadedAt               time.Time
+	lock                   sync.RWMutex
+}
+
+// NewMaintenanceManager creates a new MaintenanceManager.
+func NewMaintenanceManager(
+	systemConfigRepository repository.SystemConfigRepository,
+	metricsRegistry *metrics.Registry) (*MaintenanceManager, error) {
+	if systemConfigRepository == nil {
+		return nil, fmt.Errorf("system-config-repository is not specified")
+	}
+	if metricsRegistry == nil {
+		return nil, fmt.Errorf("metrics-registry is not specified")
+	}
+	return &MaintenanceManager{
+		systemConfigRepository: systemConfigRepository,
+		metricsRegistry:        metricsRegistry,
+		mode:                   &types.MaintenanceMode{},
+	}, nil
+}
+
+// InMaintenance returns true if scheduling of new jobs is paused
+func (mm *MaintenanceManager) InMaintenance() bool {
+	return mm.Status().Enabled
+}
+
+// Status returns maintenance mode, it's reloaded periodically to pick up changes made by other servers
+func (mm *MaintenanceManager) Status() *types.MaintenanceMode {
+	mm.lock.RLock()
+	if time.Since(mm.loadedAt) < maintenanceRefreshInterval {
+		defer mm.lock.RUnlock()
+		return mm.mode
+	}
+	mm.lock.RUnlock()
+
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.loadedAt = time.Now()
+	cfg, err := mm.systemConfigRepository.GetByKindName(maintenanceConfigKind, maintenanceConfigName)
+	if err != nil {
+		// maintenance mode has never been set
+		return mm.mode
+	}
+	mode, err := types.UnmarshalMaintenanceMode([]byte(cfg.Value))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "MaintenanceManager",
+			"Value":     cfg.Value,
+			"Error":     err,
+		}).Warn("failed to unmarshal maintenance mode")
+		return mm.mode
+	}
+	mm.setMode(mode)
+	return mm.mode
+}
+
+// Update enables or disables maintenance mode
+func (mm *MaintenanceManager) Update(
+	qc *common.QueryContext,
+	enabled bool,
+	reason string) (*types.MaintenanceMode, error) {
+	if !qc.IsAdmin() {
+		return nil, common.NewPermissionError("only admin can update maintenance mode")
+	}
+	mode := &types.MaintenanceMode{
+		Enabled:   enabled,
+		Reason:    reason,
+		UpdatedBy: qc.GetUsername(),
+		UpdatedAt: time.Now(),
+	}
+	b, err := mode.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = mm.systemConfigRepository.Save(types.NewSystemConfig(
+		maintenanceConfigScope, maintenanceConfigKind, maintenanceConfigName, string(b))); err != nil {
+		return nil, err
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	mm.loadedAt = time.Now()
+	mm.setMode(mode)
+	logrus.WithFields(logrus.Fields{
+		"Component": "MaintenanceManager",
+		"Enabled":   mode.Enabled,
+		"Reason":    mode.Reason,
+		"UpdatedBy": mode.UpdatedBy,
+	}).Warn("updated maintenance mode")
+	return mode, nil
+}
+
+func (mm *MaintenanceManager) setMode(mode *types.MaintenanceMode) {
+	mm.mode = mode
+	if mode.Enabled {
+		mm.metricsRegistry.Set("maintenance_mode", 1, nil)
+	} else {
+		mm.metricsRegistry.Set("maintenance_mode", 0, nil)
+	}
+}
@@ -0,0 +1,86 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/acl"
+	"plexobject.com/formicary/internal/metrics"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_ShouldUpdateMaintenanceModeForAllServers(t *testing.T) {
+	// GIVEN maintenance managers of two servers sharing the system config
+	repo, err := repository.NewTestSystemConfigRepository()
+	require.NoError(t, err)
+	mgr, err := NewMaintenanceManager(repo, metrics.New())
+	require.NoError(t, err)
+	admin := common.NewQueryContext(common.NewUser("", "admin", "", "", acl.NewRolesWithAdmin()), "")
+
+	// WHEN a user without admin access enables maintenance mode
+	user := common.NewQueryContext(common.NewUser("", "user", "", "", acl.NewRoles("")), "")
+	_, err = mgr.Update(user, true, "upgrade")
+	// THEN it should fail
+	require.Error(t, err)
+
+	// WHEN admin enables maintenance mode
+	mode, err := mgr.Update(admin, true, "upgrading ants")
+	require.NoError(t, err)
+
+	// THEN it should be enabled on all servers
+	require.True(t, mode.Enabled)
+	require.Equal(t, "admin", mode.UpdatedBy)
+	require.True(t, mgr.InMaintenance())
+	other, err := NewMaintenanceManager(repo, metrics.New())
+	require.NoError(t, err)
+	require.True(t, other.InMaintenance())
+	require.Equal(t, "upgrading ants", other.Status().Reason)
+
+	// WHEN admin disables maintenance mode
+	_, err = mgr.Update(admin, false, "")
+	require.NoError(t, err)
+
+	// THEN it should be disabled
+	require.False(t, mgr.InMaintenance())
+	other, err = NewMaintenanceManager(repo, metrics.New())
+	require.NoError(t, err)
+	require.False(t, other.InMaintenance())
+}
+
+func Test_ShouldShowMaintenanceReasonInWaitEstimateOfPendingJobs(t *testing.T) {
+	// GIVEN a pending job request
+	jobManager, jobRequestRepo, err := newTestJobManager(config.TestServerConfig())
+	require.NoError(t, err)
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	job, err := jobManager.SaveJobDefinition(
+		qc, repository.NewTestJobDefinition(qc.User, "maintenance-wait-"+ulid.Make().String()))
+	require.NoError(t, err)
+	request, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	request.UserKey = ulid.Make().String()
+	request, err = jobRequestRepo.Save(qc, request)
+	require.NoError(t, err)
+
+	// AND maintenance mode is enabled
+	repo, err := repository.NewTestSystemConfigRepository()
+	require.NoError(t, err)
+	mgr, err := NewMaintenanceManager(repo, metrics.New())
+	require.NoError(t, err)
+	jobManager.SetMaintenanceManager(mgr)
+	admin := common.NewQueryContext(common.NewUser("", "admin", "", "", acl.NewRolesWithAdmin()), "")
+	_, err = mgr.Update(admin, true, "upgrading database")
+	require.NoError(t, err)
+
+	// WHEN estimating wait time of the job
+	estimate, err := jobManager.GetWaitEstimate(qc, request.ID)
+
+	// THEN reason of the maintenance should be shown
+	require.NoError(t, err)
+	require.Equal(t, "scheduling of jobs is paused for maintenance: upgrading database", estimate.ErrorMessage)
+}
@@ -131,6 +131,48 @@ func TestArtifactManager(serverCfg *config.ServerConfig) (manager *ArtifactManag
 		artifactService)
 }
 
+// AssertTestCostManager for testing
+func AssertTestCostManager(serverCfg *config.ServerConfig, t *testing.T) *CostManager {
+	mgr, err := TestCostManager(serverCfg)
+	require.NoError(t, err)
+	return mgr
+}
+
+// TestCostManager for testing
+func TestCostManager(serverCfg *config.ServerConfig) (manager *CostManager, err error) {
+	if serverCfg == nil {
+		serverCfg = config.TestServerConfig()
+	}
+	err = serverCfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	costRecordRepository, err := repository.NewTestCostRecordRepository()
+	if err != nil {
+		return nil, err
+	}
+	emailVerificationRepository, err := repository.NewTestEmailVerificationRepository()
+	if err != nil {
+		return nil, err
+	}
+	logRepository, err := repository.NewTestLogEventRepository()
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := notify.New(
+		serverCfg,
+		logRepository,
+		emailVerificationRepository)
+	if err != nil {
+		return nil, err
+	}
+	return NewCostManager(
+		serverCfg,
+		costRecordRepository,
+		notifier,
+		metrics.New())
+}
+
 // TestResourceManager for testing
 func TestResourceManager(serverCfg *config.ServerConfig) resource.Manager {
 	if serverCfg == nil {
@@ -140,7 +182,7 @@ func TestResourceManager(serverCfg *config.ServerConfig) resource.Manager {
 	if err != nil {
 		panic(err)
 	}
-	return resource.New(serverCfg, queueClient)
+	return resource.New(serverCfg, queueClient, metrics.New())
 }
 
 // AssertTestJobManager for testing
@@ -175,6 +217,14 @@ func TestJobManager(serverCfg *config.ServerConfig) (manager *JobManager, err er
 	if err != nil {
 		return nil, err
 	}
+	cronBackfillRepository, err := repository.NewTestCronBackfillRepository()
+	if err != nil {
+		return nil, err
+	}
+	slaBreachRepository, err := repository.NewTestSLABreachRepository()
+	if err != nil {
+		return nil, err
+	}
 	emailVerificationRepository, err := repository.NewTestEmailVerificationRepository()
 	if err != nil {
 		return nil, err
@@ -204,7 +254,7 @@ func TestJobManager(serverCfg *config.ServerConfig) (manager *JobManager, err er
 	if err != nil {
 		return nil, err
 	}
-	resourceManager := resource.New(serverCfg, queueClient)
+	resourceManager := resource.New(serverCfg, queueClient, metrics.New())
 	return NewJobManager(
 		context.Background(),
 		serverCfg,
@@ -212,6 +262,8 @@ func TestJobManager(serverCfg *config.ServerConfig) (manager *JobManager, err er
 		jobDefinitionRepository,
 		jobRequestRepository,
 		jobExecutionRepository,
+		cronBackfillRepository,
+		slaBreachRepository,
 		userManager,
 		resourceManager,
 		artifactManager,
@@ -256,6 +308,14 @@ func NewTestJobManagerWithApproval(approvalSvc *approval.Service, templateRoot .
 	if err != nil {
 		return nil, err
 	}
+	cronBackfillRepo, err := repository.NewTestCronBackfillRepository()
+	if err != nil {
+		return nil, err
+	}
+	slaBreachRepo, err := repository.NewTestSLABreachRepository()
+	if err != nil {
+		return nil, err
+	}
 	emailVerifRepo, err := repository.NewTestEmailVerificationRepository()
 	if err != nil {
 		return nil, err
@@ -280,7 +340,7 @@ func NewTestJobManagerWithApproval(approvalSvc *approval.Service, templateRoot .
 	if err != nil {
 		return nil, err
 	}
-	resourceManager := resource.New(serverCfg, queueClient)
+	resourceManager := resource.New(serverCfg, queueClient, metrics.New())
 	return NewJobManager(
 		context.Background(),
 		serverCfg,
@@ -288,6 +348,8 @@ func NewTestJobManagerWithApproval(approvalSvc *approval.Service, templateRoot .
 		jobDefRepo,
 		jobReqRepo,
 		jobExecRepo,
+		cronBackfillRepo,
+		slaBreachRepo,
 		userManager,
 		resourceManager,
 		artifactManager,
@@ -193,6 +193,33 @@ func (m *UserManager) GetUser(
 	return m.userRepository.Get(qc, userID)
 }
 
+// GetUserByEmail finds the active user with the given email within the organization, which is
+// used to identify approvers who vote from notification links. The organization is required because
+// emails are only unique within an organization, and users without organization can't be found.
+func (m *UserManager) GetUserByEmail(
+	orgID string,
+	email string,
+) (*common.User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, common.NewValidationError("email is not specified")
+	}
+	if orgID == "" {
+		return nil, common.NewValidationError("organization is not specified")
+	}
+	recs, err := m.userRepository.FindByEmail(orgID, email, 2)
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) == 0 {
+		return nil, common.NewNotFoundError(fmt.Errorf("user with email %s is not found", email))
+	}
+	if len(recs) > 1 {
+		return nil, common.NewConflictError(fmt.Sprintf("multiple users found with email %s", email))
+	}
+	return m.userRepository.Get(common.NewQueryContextFromIDs("", "").WithAdmin(), recs[0].ID)
+}
+
 // DeleteUser deletes user by id
 func (m *UserManager) DeleteUser(
 	qc *common.QueryContext,
@@ -155,3 +155,16 @@ func Test_ShouldAutoGenerateBundleIDForOrgSignup(t *testing.T) {
 	require.NotEmpty(t, user.BundleID, "BundleID must be auto-generated when empty")
 	require.Contains(t, user.BundleID, ".formicary.io")
 }
+
+// Emails are only unique within an organization so that lookups by email require the organization.
+func Test_ShouldRequireOrganizationToGetUserByEmail(t *testing.T) {
+	userMgr, err := TestUserManager(nil)
+	require.NoError(t, err)
+	user := common.NewUser("", "no-org-user", "name", "no-org-user@formicary.io", acl.NewRoles(""))
+	_, err = userMgr.CreateUser(common.NewQueryContext(nil, "").WithAdmin(), user)
+	require.NoError(t, err)
+
+	_, err = userMgr.GetUserByEmail("", "no-org-user@formicary.io")
+	require.Error(t, err)
+	require.IsType(t, &common.ValidationError{}, err)
+}
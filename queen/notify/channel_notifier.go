@@ -7,12 +7,14 @@ import (
 	"path/filepath"
 	common "plexobject.com/formicary/internal/types"
 	cutils "plexobject.com/formicary/internal/utils"
+	"plexobject.com/formicary/queen/approval"
 	"plexobject.com/formicary/queen/config"
 	"plexobject.com/formicary/queen/repository"
 	"plexobject.com/formicary/queen/types"
 	"plexobject.com/formicary/queen/utils"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Notifier defines operations to notify job results
@@ -24,6 +26,21 @@ type Notifier interface {
 		request types.IJobRequest,
 		jobExec *types.JobExecution,
 		lastRequestState common.RequestState) error
+	NotifySLABreach(
+		qc *common.QueryContext,
+		user *common.User,
+		job *types.JobDefinition,
+		breach *types.SLABreach) error
+	NotifyJobAnomaly(
+		qc *common.QueryContext,
+		user *common.User,
+		job *types.JobDefinition,
+		anomaly *types.JobAnomaly) error
+	NotifyCostBudget(
+		qc *common.QueryContext,
+		budget *config.CostBudget,
+		spent float64,
+		currency string) error
 	SendEmailVerification(
 		qc *common.QueryContext,
 		user *common.User,
@@ -45,6 +62,7 @@ type DefaultNotifier struct {
 	jobsTemplates          map[string]string
 	verifyEmailTemplate    string
 	userInvitationTemplate string
+	approvalActionLinks    *approval.ActionLinks
 	lock                   sync.RWMutex
 }
 
@@ -80,6 +98,11 @@ func (n *DefaultNotifier) AddSender(channel common.NotifyChannel, sender types.S
 	n.senders[channel] = sender
 }
 
+// SetApprovalActionLinks adds approve/reject links to notifications of jobs awaiting approval
+func (n *DefaultNotifier) SetApprovalActionLinks(actionLinks *approval.ActionLinks) {
+	n.approvalActionLinks = actionLinks
+}
+
 // SendEmailVerification sends email with code to verify
 func (n *DefaultNotifier) SendEmailVerification(
 	qc *common.QueryContext,
@@ -223,6 +246,15 @@ func (n *DefaultNotifier) NotifyJob(
 		types.Link:  link,
 		types.Emoji: request.GetJobState().Emoji(),
 	}
+	approvalTask := n.awaitingApprovalTask(request, jobExec)
+	if approvalTask != "" {
+		params["ApprovalTask"] = approvalTask
+		// slack buttons are shared by the channel so slack identifies the voter instead of the link
+		if approve, reject, err := n.approvalActionLinks.Issue(request.GetID(), approvalTask, ""); err == nil {
+			opts[types.ApproveAction] = approve
+			opts[types.RejectAction] = reject
+		}
+	}
 
 	var recipients []string
 	var unverified []string
@@ -300,12 +332,19 @@ func (n *DefaultNotifier) NotifyJob(
 						}
 					}
 				}
+				recipientMsg := msg
+				if approvalTask != "" && k == common.EmailChannel {
+					var linkErr error
+					if recipientMsg, linkErr = n.addApprovalLinks(tmpl, params, request, approvalTask, recipient); linkErr != nil {
+						return linkErr
+					}
+				}
 				if sendErr := sender.SendMessage(
 					qc,
 					user,
 					[]string{recipient},
 					subject,
-					msg,
+					recipientMsg,
 					opts); sendErr != nil {
 					err = sendErr
 					failed = append(failed, recipient)
@@ -338,6 +377,192 @@ func (n *DefaultNotifier) NotifyJob(
 	return
 }
 
+// NotifySLABreach sends SLA breach of a job request to recipients of the job except those only notified on success
+func (n *DefaultNotifier) NotifySLABreach(
+	qc *common.QueryContext,
+	user *common.User,
+	job *types.JobDefinition,
+	breach *types.SLABreach) (err error) {
+	subject := fmt.Sprintf("SLA Breached for Job %s - %s", job.JobType, breach.JobRequestID)
+	link := fmt.Sprintf("%s/dashboard/jobs/requests/%s", n.cfg.Common.ExternalBaseURL, breach.JobRequestID)
+	msg := fmt.Sprintf("%s, the deadline was %s and the job is %s. %s",
+		breach.Description(), breach.Deadline.Format(time.RFC3339), breach.JobState, link)
+	opts := map[string]interface{}{
+		types.Color: common.FAILED.SlackColor(),
+		types.Link:  link,
+		types.Emoji: "⏰",
+	}
+	recipients, unverified, failed, err := n.notifyJobRecipients(qc, user, job, subject, msg, opts)
+
+	logrus.WithFields(logrus.Fields{
+		"Component":  "DefaultNotifier",
+		"Breach":     breach.String(),
+		"Unverified": unverified,
+		"Failed":     failed,
+		"Recipients": recipients,
+		"Subject":    subject,
+		"Error":      err,
+	}).Infof("notified SLA breach")
+	return
+}
+
+// NotifyJobAnomaly sends a slow run or failure spike of a job to recipients of the job except those only
+// notified on success
+func (n *DefaultNotifier) NotifyJobAnomaly(
+	qc *common.QueryContext,
+	user *common.User,
+	job *types.JobDefinition,
+	anomaly *types.JobAnomaly) (err error) {
+	subject := fmt.Sprintf("Slow Run of Job %s - %s", job.JobType, anomaly.JobRequestID)
+	if anomaly.Kind == types.AnomalyFailureSpike {
+		subject = fmt.Sprintf("Failure Spike of Job %s - %s", job.JobType, anomaly.JobRequestID)
+	}
+	link := fmt.Sprintf("%s/dashboard/jobs/requests/%s", n.cfg.Common.ExternalBaseURL, anomaly.JobRequestID)
+	msg := fmt.Sprintf("%s. %s", anomaly.Description(), link)
+	opts := map[string]interface{}{
+		types.Color: common.FAILED.SlackColor(),
+		types.Link:  link,
+		types.Emoji: "📈",
+	}
+	recipients, unverified, failed, err := n.notifyJobRecipients(qc, user, job, subject, msg, opts)
+
+	logrus.WithFields(logrus.Fields{
+		"Component":  "DefaultNotifier",
+		"Anomaly":    anomaly.String(),
+		"Unverified": unverified,
+		"Failed":     failed,
+		"Recipients": recipients,
+		"Subject":    subject,
+		"Error":      err,
+	}).Infof("notified job anomaly")
+	return
+}
+
+// NotifyCostBudget emails recipients of the budget when spending within its period exceeds the limit
+func (n *DefaultNotifier) NotifyCostBudget(
+	qc *common.QueryContext,
+	budget *config.CostBudget,
+	spent float64,
+	currency string) (err error) {
+	sender := n.senders[common.EmailChannel]
+	if sender == nil {
+		return fmt.Errorf("no sender for %s", common.EmailChannel)
+	}
+	subject := fmt.Sprintf("Cost Budget %s Exceeded", budget.Name)
+	link := fmt.Sprintf("%s/api/costs/report?group_by=%s", n.cfg.Common.ExternalBaseURL, types.CostByJobType)
+	msg := fmt.Sprintf("Spending of %.2f %s exceeded the %s budget %s of %.2f %s. %s",
+		spent, currency, budget.Period, budget.Name, budget.Limit, currency, link)
+	failed := make([]string, 0)
+	for _, recipient := range budget.Recipients {
+		if sendErr := sender.SendMessage(
+			qc,
+			nil,
+			[]string{recipient},
+			subject,
+			msg,
+			map[string]interface{}{types.Link: link}); sendErr != nil {
+			err = sendErr
+			failed = append(failed, recipient)
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"Component":  "DefaultNotifier",
+		"Budget":     budget.Name,
+		"Spent":      spent,
+		"Limit":      budget.Limit,
+		"Failed":     failed,
+		"Recipients": budget.Recipients,
+		"Error":      err,
+	}).Infof("notified cost budget")
+	return
+}
+
+// notifyJobRecipients sends message to recipients of the job, or of the user if the job has none, except those
+// only notified on success. Emails other than user's own email must be verified.
+func (n *DefaultNotifier) notifyJobRecipients(
+	qc *common.QueryContext,
+	user *common.User,
+	job *types.JobDefinition,
+	subject string,
+	msg string,
+	opts map[string]interface{}) (recipients []string, unverified []string, failed []string, err error) {
+	jobNotify := job.Notify
+	if len(jobNotify) == 0 && user != nil {
+		jobNotify = user.Notify
+	}
+	var verifiedEmails map[string]bool
+	for k, v := range jobNotify {
+		sender := n.senders[k]
+		if sender == nil {
+			return recipients, unverified, failed, fmt.Errorf("no sender for %s", k)
+		}
+		if v.When == common.NotifyWhenOnSuccess {
+			continue
+		}
+		for _, recipient := range v.Recipients {
+			if k == common.EmailChannel && user != nil && recipient != user.Email {
+				if len(verifiedEmails) == 0 {
+					verifiedEmails = n.emailRepository.GetVerifiedEmails(
+						common.NewQueryContext(user, ""),
+						user)
+				}
+				if !verifiedEmails[recipient] {
+					unverified = append(unverified, recipient)
+					continue
+				}
+			}
+			if sendErr := sender.SendMessage(
+				qc,
+				user,
+				[]string{recipient},
+				subject,
+				msg,
+				opts); sendErr != nil {
+				err = sendErr
+				failed = append(failed, recipient)
+			} else {
+				recipients = append(recipients, recipient)
+			}
+		}
+	}
+	return
+}
+
+// awaitingApprovalTask returns the task waiting for approval votes if approval links are enabled.
+func (n *DefaultNotifier) awaitingApprovalTask(request types.IJobRequest, jobExec *types.JobExecution) string {
+	if n.approvalActionLinks == nil || jobExec == nil ||
+		request.GetJobState() != common.MANUAL_APPROVAL_REQUIRED {
+		return ""
+	}
+	for _, task := range jobExec.Tasks {
+		if task.TaskState == common.MANUAL_APPROVAL_REQUIRED {
+			return task.TaskType
+		}
+	}
+	return ""
+}
+
+// addApprovalLinks renders the email with approve/reject links signed for the recipient.
+func (n *DefaultNotifier) addApprovalLinks(
+	tmpl string,
+	params map[string]interface{},
+	request types.IJobRequest,
+	approvalTask string,
+	recipient string) (string, error) {
+	approve, reject, err := n.approvalActionLinks.Issue(request.GetID(), approvalTask, recipient)
+	if err != nil {
+		return "", err
+	}
+	params["ApproveLink"] = fmt.Sprintf("%s/approvals/actions/%s", n.cfg.Common.ExternalBaseURL, approve)
+	params["RejectLink"] = fmt.Sprintf("%s/approvals/actions/%s", n.cfg.Common.ExternalBaseURL, reject)
+	defer func() {
+		delete(params, "ApproveLink")
+		delete(params, "RejectLink")
+	}()
+	return utils.ParseTemplate(tmpl, params)
+}
+
 func (n *DefaultNotifier) loadJobsTemplate(sender types.Sender) (string, error) {
 	n.lock.Lock()
 	defer n.lock.Unlock()
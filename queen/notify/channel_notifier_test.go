@@ -3,6 +3,7 @@ package notify
 import (
 	"github.com/oklog/ulid/v2"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	common "plexobject.com/formicary/internal/types"
@@ -144,6 +145,79 @@ func Test_ShouldNotifyFailedJobWithoutUser(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldNotifySLABreach(t *testing.T) {
+	serverCfg := config.TestServerConfig()
+	qc := common.NewQueryContext(nil, "")
+	emailVerificationRepository, err := repository.NewTestEmailVerificationRepository()
+	require.NoError(t, err)
+	logRepository, err := repository.NewTestLogEventRepository()
+	require.NoError(t, err)
+	notifier, err := New(
+		serverCfg,
+		logRepository,
+		emailVerificationRepository)
+	require.NoError(t, err)
+	emailSender := &mockSender{}
+	slackSender := &mockSender{}
+	notifier.AddSender(common.EmailChannel, emailSender)
+	notifier.AddSender(common.SlackChannel, slackSender)
+	user, job, req := newUserJobRequest("notify-job-sla", common.EXECUTING)
+	job.Notify = map[common.NotifyChannel]common.JobNotifyConfig{
+		common.EmailChannel: {Recipients: []string{"support@formicary.io", "blah@formicary.io"}},
+		common.SlackChannel: {Recipients: []string{"#builds"}, When: common.NotifyWhenOnSuccess},
+	}
+
+	// WHEN notifying SLA breach of the request
+	err = notifier.NotifySLABreach(qc, user, job, &types.SLABreach{
+		JobRequestID: req.ID,
+		JobType:      job.JobType,
+		Kind:         types.SLAFinishBy,
+		Expected:     "06:00",
+		JobState:     req.JobState,
+	})
+
+	// THEN it should only notify verified recipients that are not limited to successful jobs
+	require.NoError(t, err)
+	require.Equal(t, 1, emailSender.sent)
+	require.Equal(t, 0, slackSender.sent)
+}
+
+func Test_ShouldNotifyJobAnomaly(t *testing.T) {
+	serverCfg := config.TestServerConfig()
+	qc := common.NewQueryContext(nil, "")
+	emailVerificationRepository, err := repository.NewTestEmailVerificationRepository()
+	require.NoError(t, err)
+	logRepository, err := repository.NewTestLogEventRepository()
+	require.NoError(t, err)
+	notifier, err := New(
+		serverCfg,
+		logRepository,
+		emailVerificationRepository)
+	require.NoError(t, err)
+	emailSender := &mockSender{}
+	slackSender := &mockSender{}
+	notifier.AddSender(common.EmailChannel, emailSender)
+	notifier.AddSender(common.SlackChannel, slackSender)
+	user, job, req := newUserJobRequest("notify-job-anomaly", common.COMPLETED)
+	job.Notify = map[common.NotifyChannel]common.JobNotifyConfig{
+		common.SlackChannel: {Recipients: []string{"#builds"}, When: common.NotifyWhenOnFailure},
+	}
+
+	// WHEN notifying slow run of the request
+	err = notifier.NotifyJobAnomaly(qc, user, job, &types.JobAnomaly{
+		JobRequestID:     req.ID,
+		JobType:          job.JobType,
+		Kind:             types.AnomalySlowRun,
+		Duration:         time.Hour,
+		BaselineDuration: time.Minute,
+	})
+
+	// THEN it should notify recipients of the job
+	require.NoError(t, err)
+	require.Equal(t, 0, emailSender.sent)
+	require.Equal(t, 1, slackSender.sent)
+}
+
 func newUserJobRequest(
 	name string,
 	state common.RequestState) (user *common.User, job *types.JobDefinition, request *types.JobRequest) {
@@ -25,11 +25,13 @@ import (
 	"plexobject.com/formicary/internal/web"
 	"plexobject.com/formicary/queen/config"
 	"plexobject.com/formicary/queen/approval"
+	"plexobject.com/formicary/queen/autoscaler"
 	"plexobject.com/formicary/queen/launcher"
 	"plexobject.com/formicary/queen/repository"
 	"plexobject.com/formicary/queen/resource"
 	"plexobject.com/formicary/queen/scheduler"
 	"plexobject.com/formicary/queen/server"
+	"plexobject.com/formicary/queen/types"
 )
 
 // Start starts all services for formicary server
@@ -45,6 +47,12 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		_ = tracingShutdown(shutdownCtx)
 	}()
 
+	types.SetHolidayCalendarDir(serverCfg.Jobs.HolidayCalendarDir)
+	types.SetTriggerLimits(types.TriggerLimits{
+		AllowedHosts: serverCfg.Jobs.HTTPTriggerAllowedHosts,
+		WatchRoots:   serverCfg.Jobs.FileTriggerWatchRoots,
+	})
+
 	repoFactory, err := repository.NewLocator(serverCfg)
 	if err != nil {
 		return err
@@ -62,8 +70,10 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		return err
 	}
 
+	metricsRegistry := metrics.New()
+
 	// Create resource manager for keeping track of ants
-	resourceManager := resource.New(serverCfg, queueClient)
+	resourceManager := resource.New(serverCfg, queueClient, metricsRegistry)
 	if err = resourceManager.Start(ctx); err != nil {
 		return err
 	}
@@ -100,6 +110,11 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		}
 	}
 	jobStatsRegistry := stats.NewJobStatsRegistryWithCache(cacheRepo)
+	jobStatsRegistry.SetAnomalyThresholds(stats.AnomalyThresholds{
+		SlowFactor:          serverCfg.Jobs.AnomalySlowFactor,
+		FailureRateIncrease: serverCfg.Jobs.AnomalyFailureRateIncrease,
+		MinRuns:             serverCfg.Jobs.AnomalyMinRuns,
+	})
 
 	dashboardStats := manager.NewDashboardManager(
 		serverCfg,
@@ -108,8 +123,6 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		resourceManager,
 		healthMonitor)
 
-	metricsRegistry := metrics.New()
-
 	artifactManager, err := manager.NewArtifactManager(
 		serverCfg,
 		repoFactory.LogEventRepository,
@@ -171,6 +184,17 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		return fmt.Errorf("failed to create approval repository: %w", err)
 	}
 	approvalSvc := approval.NewService(repoFactory.DB, approvalRepo)
+	if actionLinks, err := approval.NewActionLinks(
+		serverCfg.Notify.ApprovalLinkSecret, serverCfg.Notify.ApprovalLinkTTL, approvalRepo); err == nil {
+		approvalSvc.SetActionLinks(actionLinks)
+		notifier.SetApprovalActionLinks(actionLinks)
+	} else {
+		logrus.WithFields(logrus.Fields{
+			"Component": "Queen",
+			"ID":        serverCfg.Common.ID,
+			"Error":     err,
+		}).Warnf("approve/reject links in notifications are disabled")
+	}
 
 	// schedulerTriggerCh is shared between JobManager and JobScheduler so that
 	// TriggerJobRequest can wake the scheduler immediately without polling delay.
@@ -184,6 +208,8 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		repoFactory.JobDefinitionRepository,
 		repoFactory.JobRequestRepository,
 		repoFactory.JobExecutionRepository,
+		repoFactory.CronBackfillRepository,
+		repoFactory.SLABreachRepository,
 		userManager,
 		resourceManager,
 		artifactManager,
@@ -197,6 +223,17 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 	if err != nil {
 		return err
 	}
+	costManager, err := manager.NewCostManager(
+		serverCfg,
+		repoFactory.CostRecordRepository,
+		notifier,
+		metricsRegistry,
+	)
+	if err != nil {
+		return err
+	}
+	jobManager.SetCostManager(costManager)
+	artifactManager.SetCostManager(costManager)
 	retentionManager, err := manager.NewRetentionManager(
 		repoFactory.JobDefinitionRepository,
 		repoFactory.JobRequestRepository,
@@ -206,6 +243,15 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		return err
 	}
 
+	maintenanceManager, err := manager.NewMaintenanceManager(
+		repoFactory.SystemConfigRepository,
+		metricsRegistry,
+	)
+	if err != nil {
+		return err
+	}
+	jobManager.SetMaintenanceManager(maintenanceManager)
+
 	// JobScheduler needs to run as a leader so that it can properly manage resources
 	// DisableJobScheduler can be used to disable job scheduler if multiple instances of
 	// queen servers are running that can execute jobs but only one of them can schedule jobs.
@@ -224,11 +270,24 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 			metricsRegistry,
 			approvalSvc,
 			retentionManager,
+			maintenanceManager,
 			schedulerTriggerCh,
 		)
 		if err = jobScheduler.Start(ctx); err != nil {
 			return err
 		}
+		// autoscaler uses pending jobs of the scheduler so it runs with the scheduler and only on its leader
+		if serverCfg.Autoscaler.Enabled {
+			providers, err := autoscaler.NewScalerProviders(&serverCfg.Autoscaler)
+			if err != nil {
+				return err
+			}
+			antScaler, err := autoscaler.New(serverCfg, resourceManager, jobManager, jobScheduler, providers, metricsRegistry)
+			if err != nil {
+				return err
+			}
+			antScaler.Start(ctx)
+		}
 	}
 
 	// request registry keeps track of requests and is used by tasklet
@@ -385,6 +444,7 @@ func Start(ctx context.Context, serverCfg *config.ServerConfig) error {
 		userManager,
 		jobManager,
 		retentionManager,
+		maintenanceManager,
 		dashboardStats,
 		resourceManager,
 		requestRegistry,
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"time"
+
+	common "plexobject.com/formicary/internal/types"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+// CostRecordRepository provides persistence for costs of resources used by task executions.
+type CostRecordRepository interface {
+	// Save inserts cost records.
+	Save(records ...*types.CostRecord) error
+	// GetComputeRecord returns compute cost recorded for the task execution.
+	GetComputeRecord(taskExecutionID string) (*types.CostRecord, error)
+	// Summarize aggregates costs recorded between from and to by the dimension.
+	Summarize(
+		qc *common.QueryContext,
+		groupBy types.CostGroupBy,
+		from time.Time,
+		to time.Time) ([]*types.CostSummary, error)
+	// TotalCost returns cost recorded since the given time for the organization, job type and tag, where empty
+	// filters match all records.
+	TotalCost(organizationID string, jobType string, tag string, since time.Time) (float64, error)
+	// SaveBudgetAlert inserts the alert unless the budget was already alerted in the period, and returns true
+	// if it was inserted.
+	SaveBudgetAlert(alert *types.CostBudgetAlert) (bool, error)
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+var _ CostRecordRepository = &CostRecordRepositoryImpl{}
+
+// CostRecordRepositoryImpl implements CostRecordRepository using GORM.
+type CostRecordRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCostRecordRepositoryImpl creates a new CostRecordRepositoryImpl.
+func NewCostRecordRepositoryImpl(db *gorm.DB) (*CostRecordRepositoryImpl, error) {
+	return &CostRecordRepositoryImpl{db: db}, nil
+}
+
+// Save inserts cost records.
+func (r *CostRecordRepositoryImpl) Save(records ...*types.CostRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return common.NewValidationError(err)
+		}
+		record.ID = ulid.Make().String()
+		if record.CreatedAt.IsZero() {
+			record.CreatedAt = time.Now()
+		}
+	}
+	return r.db.Create(records).Error
+}
+
+// GetComputeRecord returns compute cost recorded for the task execution.
+func (r *CostRecordRepositoryImpl) GetComputeRecord(taskExecutionID string) (*types.CostRecord, error) {
+	var record types.CostRecord
+	res := r.db.Where("task_execution_id = ? AND kind = ?", taskExecutionID, types.ComputeCost).
+		First(&record)
+	if res.Error != nil {
+		return nil, common.NewNotFoundError(res.Error)
+	}
+	return &record, nil
+}
+
+// Summarize aggregates costs recorded between from and to by the dimension.
+func (r *CostRecordRepositoryImpl) Summarize(
+	qc *common.QueryContext,
+	groupBy types.CostGroupBy,
+	from time.Time,
+	to time.Time) ([]*types.CostSummary, error) {
+	column, err := groupBy.Column()
+	if err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	tx := qc.AddOrgElseUserWhere(r.db.Model(&types.CostRecord{}), true).
+		Select(column+" as group_key, "+
+			"SUM(CASE WHEN kind = ? THEN 1 ELSE 0 END) as tasks, "+
+			"SUM(cpu_secs) as cpu_secs, SUM(memory_gb_secs) as memory_gb_secs, "+
+			"SUM(storage_gb_days) as storage_gb_days, SUM(egress_gb) as egress_gb, "+
+			"SUM(CASE WHEN kind = ? THEN cost ELSE 0 END) as compute_cost, "+
+			"SUM(CASE WHEN kind = ? THEN cost ELSE 0 END) as storage_cost, "+
+			"SUM(CASE WHEN kind = ? THEN cost ELSE 0 END) as egress_cost, "+
+			"SUM(cost) as cost",
+			types.ComputeCost, types.ComputeCost, types.StorageCost, types.EgressCost).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group(column)
+	rows := make([]*costSummaryRow, 0)
+	if res := tx.Scan(&rows); res.Error != nil {
+		return nil, res.Error
+	}
+	summaries := make([]*types.CostSummary, len(rows))
+	for i, row := range rows {
+		row.CostSummary.Key = row.GroupKey
+		summaries[i] = &row.CostSummary
+	}
+	return summaries, nil
+}
+
+// TotalCost returns cost recorded since the given time for the organization, job type and tag, where empty
+// filters match all records.
+func (r *CostRecordRepositoryImpl) TotalCost(
+	organizationID string,
+	jobType string,
+	tag string,
+	since time.Time) (total float64, err error) {
+	tx := r.db.Model(&types.CostRecord{}).Where("created_at >= ?", since)
+	if organizationID != "" {
+		tx = tx.Where("organization_id = ?", organizationID)
+	}
+	if jobType != "" {
+		tx = tx.Where("job_type = ?", jobType)
+	}
+	if tag != "" {
+		// wildcards in the tag must match literally
+		escaped := likeEscaper.Replace(tag)
+		tx = tx.Where("(tags = ? OR tags LIKE ? ESCAPE '!' OR tags LIKE ? ESCAPE '!' OR tags LIKE ? ESCAPE '!')",
+			tag, escaped+",%", "%,"+escaped, "%,"+escaped+",%")
+	}
+	var sum *float64
+	if err = tx.Select("SUM(cost)").Row().Scan(&sum); err != nil || sum == nil {
+		return 0, err
+	}
+	return *sum, nil
+}
+
+// likeEscaper escapes wildcards of LIKE patterns with `!`, which needs no quoting in any of the supported databases
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// costSummaryRow scans the dimension separately because key is reserved in some databases
+type costSummaryRow struct {
+	GroupKey string
+	types.CostSummary
+}
+
+// SaveBudgetAlert inserts the alert unless the budget was already alerted in the period, and returns true
+// if it was inserted.
+func (r *CostRecordRepositoryImpl) SaveBudgetAlert(alert *types.CostBudgetAlert) (bool, error) {
+	if alert.Budget == "" {
+		return false, common.NewValidationError(fmt.Errorf("budget is not specified"))
+	}
+	var count int64
+	res := r.db.Model(&types.CostBudgetAlert{}).
+		Where("budget = ? AND period_start = ?", alert.Budget, alert.PeriodStart).
+		Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if count > 0 {
+		return false, nil
+	}
+	alert.ID = ulid.Make().String()
+	alert.CreatedAt = time.Now()
+	// unique index on budget and period rejects an alert saved concurrently by another server
+	if res = r.db.Create(alert); res.Error != nil {
+		return false, res.Error
+	}
+	return true, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_ShouldSummarizeCostRecords(t *testing.T) {
+	// GIVEN cost-record repository with compute and egress costs of two job types
+	repo, err := NewTestCostRecordRepository()
+	require.NoError(t, err)
+	org := "cost-org-" + ulid.Make().String()
+	jobType := "cost-job-" + ulid.Make().String()
+	taskExecID := ulid.Make().String()
+	require.NoError(t, repo.Save(
+		&types.CostRecord{Kind: types.ComputeCost, JobType: jobType, TaskExecutionID: taskExecID,
+			OrganizationID: org, Tags: "gpu,linux", CPUSecs: 100, Cost: 2},
+		&types.CostRecord{Kind: types.EgressCost, JobType: jobType, TaskExecutionID: taskExecID,
+			OrganizationID: org, Tags: "gpu,linux", EgressGB: 1, Cost: 0.5},
+		&types.CostRecord{Kind: types.ComputeCost, JobType: jobType + "-other",
+			OrganizationID: org, Tags: "linux", CPUSecs: 10, Cost: 1},
+	))
+	qc := common.NewQueryContextFromIDs("", org)
+
+	// WHEN summarizing costs by job type
+	summaries, err := repo.Summarize(qc, types.CostByJobType, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+	// THEN costs are aggregated per job type and kind
+	require.NoError(t, err)
+	byKey := make(map[string]*types.CostSummary)
+	for _, s := range summaries {
+		byKey[s.Key] = s
+	}
+	require.NotNil(t, byKey[jobType])
+	require.Equal(t, int64(1), byKey[jobType].Tasks)
+	require.Equal(t, 100.0, byKey[jobType].CPUSecs)
+	require.Equal(t, 2.0, byKey[jobType].ComputeCost)
+	require.Equal(t, 0.5, byKey[jobType].EgressCost)
+	require.Equal(t, 2.5, byKey[jobType].Cost)
+
+	// WHEN summarizing by an unknown dimension
+	_, err = repo.Summarize(qc, "team", time.Now().Add(-time.Hour), time.Now())
+	// THEN it should fail
+	require.Error(t, err)
+
+	// WHEN finding total cost of the organization and of a tag
+	total, err := repo.TotalCost(org, "", "", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	// THEN all records of the organization are included
+	require.Equal(t, 3.5, total)
+	total, err = repo.TotalCost(org, "", "gpu", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, total)
+	// AND wildcards in the tag should not match other tags
+	total, err = repo.TotalCost(org, "", "g_u", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, total)
+	total, err = repo.TotalCost(org, "", "%", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, total)
+
+	// WHEN finding compute record of the task execution
+	compute, err := repo.GetComputeRecord(taskExecID)
+	// THEN it should return the record
+	require.NoError(t, err)
+	require.Equal(t, jobType, compute.JobType)
+}
+
+func Test_ShouldSaveCostBudgetAlertOncePerPeriod(t *testing.T) {
+	// GIVEN cost-record repository
+	repo, err := NewTestCostRecordRepository()
+	require.NoError(t, err)
+	budget := "budget-" + ulid.Make().String()
+	period := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// WHEN saving alert of the budget
+	saved, err := repo.SaveBudgetAlert(&types.CostBudgetAlert{Budget: budget, PeriodStart: period, Spent: 10})
+	// THEN it should be saved
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	// WHEN saving alert of the budget again in the same period
+	saved, err = repo.SaveBudgetAlert(&types.CostBudgetAlert{Budget: budget, PeriodStart: period, Spent: 12})
+	// THEN it should not be saved
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	// WHEN saving alert of the budget in the next period
+	saved, err = repo.SaveBudgetAlert(&types.CostBudgetAlert{Budget: budget, PeriodStart: period.AddDate(0, 1, 0)})
+	// THEN it should be saved
+	require.NoError(t, err)
+	require.True(t, saved)
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	common "plexobject.com/formicary/internal/types"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+// CronBackfillRepository provides persistence for backfills of cron jobs.
+type CronBackfillRepository interface {
+	// Get returns backfill by id.
+	Get(qc *common.QueryContext, id string) (*types.CronBackfill, error)
+	// Query returns recent backfills of the job type, or of all job types when job type is empty.
+	Query(qc *common.QueryContext, jobType string, limit int) ([]*types.CronBackfill, error)
+	// FindActive returns backfills that are still submitting requests -- only used internally by the scheduler.
+	FindActive() ([]*types.CronBackfill, error)
+	// Save inserts or updates the backfill.
+	Save(backfill *types.CronBackfill) (*types.CronBackfill, error)
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+var _ CronBackfillRepository = &CronBackfillRepositoryImpl{}
+
+// CronBackfillRepositoryImpl implements CronBackfillRepository using GORM.
+type CronBackfillRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCronBackfillRepositoryImpl creates a new CronBackfillRepositoryImpl.
+func NewCronBackfillRepositoryImpl(db *gorm.DB) (*CronBackfillRepositoryImpl, error) {
+	return &CronBackfillRepositoryImpl{db: db}, nil
+}
+
+// Get returns backfill by id.
+func (r *CronBackfillRepositoryImpl) Get(qc *common.QueryContext, id string) (*types.CronBackfill, error) {
+	var backfill types.CronBackfill
+	res := qc.AddOrgElseUserWhere(r.db, false).Where("id = ?", id).First(&backfill)
+	if res.Error != nil {
+		return nil, common.NewNotFoundError(res.Error)
+	}
+	return &backfill, nil
+}
+
+// Query returns recent backfills of the job type, or of all job types when job type is empty.
+func (r *CronBackfillRepositoryImpl) Query(
+	qc *common.QueryContext,
+	jobType string,
+	limit int) ([]*types.CronBackfill, error) {
+	tx := qc.AddOrgElseUserWhere(r.db, false)
+	if jobType != "" {
+		tx = tx.Where("job_type = ?", jobType)
+	}
+	backfills := make([]*types.CronBackfill, 0)
+	res := tx.Order("created_at desc").Limit(limit).Find(&backfills)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return backfills, nil
+}
+
+// FindActive returns backfills that are still submitting requests.
+func (r *CronBackfillRepositoryImpl) FindActive() ([]*types.CronBackfill, error) {
+	backfills := make([]*types.CronBackfill, 0)
+	res := r.db.Where("state = ?", common.EXECUTING).Order("created_at").Find(&backfills)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return backfills, nil
+}
+
+// Save inserts or updates the backfill.
+func (r *CronBackfillRepositoryImpl) Save(backfill *types.CronBackfill) (*types.CronBackfill, error) {
+	if backfill == nil {
+		return nil, fmt.Errorf("backfill is required")
+	}
+	if err := backfill.Validate(); err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	now := time.Now()
+	if backfill.ID == "" {
+		backfill.ID = ulid.Make().String()
+		backfill.CreatedAt = now
+	}
+	backfill.UpdatedAt = now
+	res := r.db.Save(backfill)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return backfill, nil
+}
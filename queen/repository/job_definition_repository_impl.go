@@ -252,7 +252,7 @@ func (jdr *JobDefinitionRepositoryImpl) SetMaxConcurrency(id string, concurrency
 // GetJobTypesAndCronTrigger returns types of jobs and cron trigger -- only admin can do it so no need for query context
 func (jdr *JobDefinitionRepositoryImpl) GetJobTypesAndCronTrigger(
 	qc *common.QueryContext) ([]types.JobTypeCronTrigger, error) {
-	sql := "SELECT distinct user_id, organization_id, job_type, cron_trigger FROM formicary_job_definitions WHERE active = ? "
+	sql := "SELECT distinct user_id, organization_id, job_type, cron_trigger, cron_schedule_serialized FROM formicary_job_definitions WHERE active = ? "
 	args := []interface{}{true}
 	if qc.IsAdmin() {
 	} else if qc.GetOrganizationID() != "" {
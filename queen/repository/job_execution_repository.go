@@ -61,5 +61,14 @@ type JobExecutionRepository interface {
 	GetResourceUsage(
 		qc *common.QueryContext,
 		ranges []types.DateRange) ([]types.ResourceUsage, error)
-
+	// GetTaskFlakiness - finds runs, failures and flaky passes of tasks that started since the given time
+	GetTaskFlakiness(
+		qc *common.QueryContext,
+		jobType string,
+		since time.Time) ([]*types.FlakyTask, error)
+	// GetTaskProfiles - finds resources used by profiled tasks that started since the given time
+	GetTaskProfiles(
+		qc *common.QueryContext,
+		jobType string,
+		since time.Time) ([]*types.TaskRightSizing, error)
 }
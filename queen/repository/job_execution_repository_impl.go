@@ -200,6 +200,104 @@ func (jer *JobExecutionRepositoryImpl) GetResourceUsage(
 	return res, nil
 }
 
+// GetTaskFlakiness - finds runs, failures and flaky passes of tasks of the job type, or all job types when
+// job type is empty, that started since the given time. A run is flaky if the task passed on retry or if
+// the task failed and passed in different executions of the same job request.
+func (jer *JobExecutionRepositoryImpl) GetTaskFlakiness(
+	qc *common.QueryContext,
+	jobType string,
+	since time.Time) ([]*types.FlakyTask, error) {
+	orgSQL, orgArg := qc.AddOrgUserWhereSQL(true)
+	jobTypeSQL, jobTypeArg := "'1' = ?", "1"
+	if jobType != "" {
+		jobTypeSQL, jobTypeArg = "j.job_type = ?", jobType
+	}
+	from := "FROM formicary_task_executions t JOIN formicary_job_executions j ON t.job_execution_id = j.id " +
+		"WHERE t.started_at >= ? AND " + jobTypeSQL + " AND " + orgSQL
+	args := []interface{}{since, jobTypeArg, orgArg}
+
+	runs := make([]*types.FlakyTask, 0)
+	sql := "SELECT j.job_type AS job_type, t.task_type AS task_type, COUNT(*) AS runs, " +
+		"SUM(CASE WHEN t.task_state = 'FAILED' THEN 1 ELSE 0 END) AS failed, " +
+		"SUM(CASE WHEN t.task_state = 'COMPLETED' AND t.retried > 0 THEN 1 ELSE 0 END) AS passed_on_retry " +
+		from + " GROUP BY j.job_type, t.task_type"
+	if res := jer.db.Raw(sql, args...).Scan(&runs); res.Error != nil {
+		return nil, res.Error
+	}
+
+	reruns := make([]*types.FlakyTask, 0)
+	sql = "SELECT job_type, task_type, COUNT(*) AS passed_on_rerun FROM (" +
+		"SELECT j.job_type AS job_type, t.task_type AS task_type, j.job_request_id AS job_request_id " +
+		from + " GROUP BY j.job_type, t.task_type, j.job_request_id " +
+		"HAVING SUM(CASE WHEN t.task_state = 'FAILED' THEN 1 ELSE 0 END) > 0 " +
+		"AND SUM(CASE WHEN t.task_state = 'COMPLETED' THEN 1 ELSE 0 END) > 0) reruns " +
+		"GROUP BY job_type, task_type"
+	if res := jer.db.Raw(sql, args...).Scan(&reruns); res.Error != nil {
+		return nil, res.Error
+	}
+	for _, rerun := range reruns {
+		for _, run := range runs {
+			if run.JobType == rerun.JobType && run.TaskType == rerun.TaskType {
+				run.PassedOnRerun = rerun.PassedOnRerun
+			}
+		}
+	}
+	for _, run := range runs {
+		run.CalculateScore()
+	}
+	return runs, nil
+}
+
+// GetTaskProfiles - finds resources used by tasks of the job type, or all job types when job type is empty,
+// that started since the given time and were profiled by ants.
+func (jer *JobExecutionRepositoryImpl) GetTaskProfiles(
+	qc *common.QueryContext,
+	jobType string,
+	since time.Time) ([]*types.TaskRightSizing, error) {
+	orgSQL, orgArg := qc.AddOrgUserWhereSQL(true)
+	jobTypeSQL, jobTypeArg := "'1' = ?", "1"
+	if jobType != "" {
+		jobTypeSQL, jobTypeArg = "j.job_type = ?", jobType
+	}
+	// averages are scanned as floats because databases return decimals for averages of integers
+	rows := make([]*struct {
+		JobType            string
+		TaskType           string
+		Runs               int64
+		CPUAvgCores        float64
+		CPUPeakCores       float64
+		MemoryAvgPeakBytes float64
+		MemoryPeakBytes    int64
+		IOReadBytes        float64
+		IOWriteBytes       float64
+	}, 0)
+	sql := "SELECT j.job_type AS job_type, t.task_type AS task_type, COUNT(*) AS runs, " +
+		"AVG(t.cpu_avg_cores) AS cpu_avg_cores, MAX(t.cpu_peak_cores) AS cpu_peak_cores, " +
+		"AVG(t.memory_peak_bytes) AS memory_avg_peak_bytes, MAX(t.memory_peak_bytes) AS memory_peak_bytes, " +
+		"AVG(t.io_read_bytes) AS io_read_bytes, AVG(t.io_write_bytes) AS io_write_bytes " +
+		"FROM formicary_task_executions t JOIN formicary_job_executions j ON t.job_execution_id = j.id " +
+		"WHERE t.started_at >= ? AND t.memory_peak_bytes > 0 AND " + jobTypeSQL + " AND " + orgSQL +
+		" GROUP BY j.job_type, t.task_type"
+	if res := jer.db.Raw(sql, since, jobTypeArg, orgArg).Scan(&rows); res.Error != nil {
+		return nil, res.Error
+	}
+	profiles := make([]*types.TaskRightSizing, len(rows))
+	for i, row := range rows {
+		profiles[i] = &types.TaskRightSizing{
+			JobType:            row.JobType,
+			TaskType:           row.TaskType,
+			Runs:               row.Runs,
+			CPUAvgCores:        row.CPUAvgCores,
+			CPUPeakCores:       row.CPUPeakCores,
+			MemoryAvgPeakBytes: int64(row.MemoryAvgPeakBytes),
+			MemoryPeakBytes:    row.MemoryPeakBytes,
+			IOReadBytes:        int64(row.IOReadBytes),
+			IOWriteBytes:       int64(row.IOWriteBytes),
+		}
+	}
+	return profiles, nil
+}
+
 // FinalizeJobRequestAndExecutionState updates final state of job-execution and job-request
 func (jer *JobExecutionRepositoryImpl) FinalizeJobRequestAndExecutionState(
 	id string,
@@ -887,3 +887,82 @@ func Test_ShouldSelfHealDuplicateActiveTasksOnGet(t *testing.T) {
 	require.NoError(t, repo.db.First(&staleCheck, "id = ?", staleTask.ID).Error)
 	require.False(t, staleCheck.Active, "stale task must be deactivated in DB after Get self-heal")
 }
+
+// Test flakiness of tasks that passed on retry or rerun
+func Test_ShouldGetTaskFlakiness(t *testing.T) {
+	// GIVEN job-execution repository
+	repo, err := NewTestJobExecutionRepository()
+	require.NoError(t, err)
+	repo.clear()
+	qc, err := NewTestQC()
+	require.NoError(t, err)
+	// AND a job execution where first task passed on retry and second task failed
+	req, jobExec, err := NewTestJobExecution(qc, "job-exec-flaky")
+	require.NoError(t, err)
+	first, second := jobExec.Tasks[0], jobExec.Tasks[1]
+	first.TaskState = common.COMPLETED
+	first.Retried = 1
+	second.TaskState = common.FAILED
+	_, err = repo.Save(jobExec)
+	require.NoError(t, err)
+	// AND second execution of the same request where second task passed
+	rerun := types.NewJobExecution(req.ToInfo())
+	task := rerun.AddTask(types.NewTaskDefinition(second.TaskType, common.Shell))
+	task.TaskState = common.COMPLETED
+	task.StartedAt = time.Now()
+	_, err = repo.Save(rerun)
+	require.NoError(t, err)
+
+	// WHEN querying flakiness of the job
+	flaky, err := repo.GetTaskFlakiness(qc, jobExec.JobType, time.Now().Add(-time.Hour))
+
+	// THEN both tasks should be flaky
+	require.NoError(t, err)
+	byTask := make(map[string]*types.FlakyTask)
+	for _, f := range flaky {
+		byTask[f.TaskType] = f
+	}
+	require.Equal(t, int64(1), byTask[first.TaskType].Runs)
+	require.Equal(t, int64(1), byTask[first.TaskType].PassedOnRetry)
+	require.Equal(t, 1.0, byTask[first.TaskType].Score)
+	require.Equal(t, int64(2), byTask[second.TaskType].Runs)
+	require.Equal(t, int64(1), byTask[second.TaskType].Failed)
+	require.Equal(t, int64(1), byTask[second.TaskType].PassedOnRerun)
+	require.Equal(t, 0.5, byTask[second.TaskType].Score)
+}
+
+func Test_ShouldGetTaskProfiles(t *testing.T) {
+	// GIVEN job-execution repository
+	repo, err := NewTestJobExecutionRepository()
+	require.NoError(t, err)
+	repo.clear()
+	qc, err := NewTestQC()
+	require.NoError(t, err)
+	// AND two executions of a job where first task was profiled
+	var jobType string
+	for i := 1; i <= 2; i++ {
+		_, jobExec, err := NewTestJobExecution(qc, "job-exec-profile")
+		require.NoError(t, err)
+		jobType = jobExec.JobType
+		first := jobExec.Tasks[0]
+		first.CPUAvgCores = 0.5 * float64(i)
+		first.CPUPeakCores = float64(i)
+		first.MemoryPeakBytes = int64(i) * 100 * 1024 * 1024
+		first.IOReadBytes = 1024
+		_, err = repo.Save(jobExec)
+		require.NoError(t, err)
+	}
+
+	// WHEN querying profiles of the job
+	profiles, err := repo.GetTaskProfiles(qc, jobType, time.Now().Add(-time.Hour))
+
+	// THEN only the profiled task should be returned with aggregated usage
+	require.NoError(t, err)
+	require.Len(t, profiles, 1)
+	require.Equal(t, int64(2), profiles[0].Runs)
+	require.InDelta(t, 0.75, profiles[0].CPUAvgCores, 0.001)
+	require.InDelta(t, 2.0, profiles[0].CPUPeakCores, 0.001)
+	require.Equal(t, int64(150*1024*1024), profiles[0].MemoryAvgPeakBytes)
+	require.Equal(t, int64(200*1024*1024), profiles[0].MemoryPeakBytes)
+	require.Equal(t, int64(1024), profiles[0].IOReadBytes)
+}
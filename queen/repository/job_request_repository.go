@@ -21,6 +21,18 @@ type JobRequestRepository interface {
 	GetByUserKey(
 		qc *common.QueryContext,
 		userKey string) (*types.JobRequest, error)
+	// FindByLogicalDate returns requests of the job that run cron ticks in [start, end)
+	FindByLogicalDate(
+		jobType string,
+		organizationID string,
+		userID string,
+		start time.Time,
+		end time.Time) ([]*types.JobRequestInfo, error)
+	// FindForSLACheck returns a page of requests that haven't finished or that finished since the given time
+	FindForSLACheck(
+		finishedSince time.Time,
+		page int,
+		pageSize int) ([]*types.JobRequestInfo, error)
 	// UpdateJobState sets state of job-request
 	UpdateJobState(
 		id string,
@@ -90,6 +90,61 @@ func (jrr *JobRequestRepositoryImpl) GetByUserKey(
 	return &req, nil
 }
 
+// FindByLogicalDate returns requests of the job that run cron ticks in [start, end)
+func (jrr *JobRequestRepositoryImpl) FindByLogicalDate(
+	jobType string,
+	organizationID string,
+	userID string,
+	start time.Time,
+	end time.Time) ([]*types.JobRequestInfo, error) {
+	sql := "SELECT id, job_type, job_version, organization_id, user_id, job_state, cron_triggered, logical_date, " +
+		" scheduled_at, created_at FROM formicary_job_requests WHERE job_type = ? AND logical_date >= ? AND logical_date < ?"
+	args := []interface{}{jobType, start.UTC(), end.UTC()}
+	if organizationID != "" {
+		sql += " AND organization_id = ?"
+		args = append(args, organizationID)
+	} else {
+		sql += " AND user_id = ?"
+		args = append(args, userID)
+	}
+	rows, err := jrr.db.Raw(sql, args...).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	infos := make([]*types.JobRequestInfo, 0)
+	for rows.Next() {
+		info := types.JobRequestInfo{}
+		if err = jrr.db.ScanRows(rows, &info); err != nil {
+			return nil, err
+		}
+		infos = append(infos, &info)
+	}
+	return infos, nil
+}
+
+// FindForSLACheck returns a page of requests that haven't finished or that finished since the given time
+func (jrr *JobRequestRepositoryImpl) FindForSLACheck(
+	finishedSince time.Time,
+	page int,
+	pageSize int) ([]*types.JobRequestInfo, error) {
+	infos := make([]*types.JobRequestInfo, 0)
+	res := jrr.db.Model(&types.JobRequest{}).
+		Select("id, job_definition_id, job_execution_id, job_type, job_version, organization_id, user_id, "+
+			"job_state, schedule_attempts, cron_triggered, logical_date, scheduled_at, created_at").
+		Where("job_state NOT IN ? OR updated_at >= ?", common.TerminalStates, finishedSince).
+		Order("created_at, id").
+		Limit(pageSize).
+		Offset(page * pageSize).
+		Scan(&infos)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return infos, nil
+}
+
 // Clear - for testing
 func (jrr *JobRequestRepositoryImpl) Clear() {
 	clearDB(jrr.db)
@@ -557,7 +612,9 @@ func (jrr *JobRequestRepositoryImpl) Trigger(
 	qc *common.QueryContext,
 	id string) error {
 	// TODO check for cron schedule
-	sql := "UPDATE formicary_job_requests SET scheduled_at = ?, updated_at = ?, user_key = ? WHERE id = ? AND cron_triggered = ? AND job_state = ?"
+	// logical_date is cleared so that the cron tick is still scheduled after the triggered request
+	sql := "UPDATE formicary_job_requests SET scheduled_at = ?, updated_at = ?, user_key = ?, logical_date = NULL " +
+		"WHERE id = ? AND cron_triggered = ? AND job_state = ?"
 	args := []interface{}{time.Now(), time.Now(), ulid.Make().String(), id, true, common.PENDING}
 	if !qc.IsAdmin() {
 		if qc.HasOrganization() {
@@ -896,7 +953,7 @@ func (jrr *JobRequestRepositoryImpl) FindActiveCronScheduledJobsByJobType(
 		if typeAndTrigger.UserID != "" {
 			userIDs = append(userIDs, typeAndTrigger.UserID)
 		}
-		_, userKeys[i] = types.GetCronScheduleTimeAndUserKey(typeAndTrigger.OrganizationOrUserID(), typeAndTrigger.JobType, typeAndTrigger.CronTrigger)
+		_, userKeys[i] = typeAndTrigger.GetCronScheduleTimeAndUserKey()
 	}
 	jobStates := []common.RequestState{common.PENDING, common.PAUSED, common.MANUAL_APPROVAL_REQUIRED,
 		common.READY, common.STARTED, common.EXECUTING}
@@ -967,7 +1024,7 @@ func (jrr *JobRequestRepositoryImpl) NextSchedulableJobsByTypes(
 	state []common.RequestState,
 	limit int) ([]*types.JobRequestInfo, error) {
 	sql := "SELECT id, job_type, job_version, organization_id, user_id, job_priority, job_state, schedule_attempts, scheduled_at, created_at, " +
-		" job_definition_id, job_execution_id, last_job_execution_id, cron_triggered, current_task, retried FROM formicary_job_requests WHERE job_type in " +
+		" job_definition_id, job_execution_id, last_job_execution_id, cron_triggered, current_task, retried, parent_id FROM formicary_job_requests WHERE job_type in " +
 		" (SELECT job_type FROM formicary_job_definitions WHERE disabled is false AND active is true AND " +
 		" (user_id = formicary_job_requests.user_id OR organization_id = formicary_job_requests.organization_id)) " +
 		" AND job_state IN ? AND scheduled_at <= ? "
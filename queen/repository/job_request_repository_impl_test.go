@@ -140,6 +140,47 @@ func Test_ShouldSaveValidJobRequest(t *testing.T) {
 	require.Equal(t, 3, len(params))
 }
 
+// Saving another request for a cron tick that already has a request should fail
+func Test_ShouldNotSaveDuplicateLogicalDateOfJobRequest(t *testing.T) {
+	// GIVEN a job-request repository
+	repo, err := NewTestJobRequestRepository()
+	require.NoError(t, err)
+	repo.Clear()
+	qc, err := NewTestQC()
+	require.NoError(t, err)
+	job, err := SaveTestJobDefinition(qc, "test-job-for-request-logical-date", "")
+	require.NoError(t, err)
+	tick := time.Now().Truncate(time.Hour)
+
+	// AND a request for a cron tick
+	req, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	req.SetLogicalDate(tick)
+	_, err = repo.Save(qc, req)
+	require.NoError(t, err)
+	// AND it can be updated
+	_, err = repo.Save(qc, req)
+	require.NoError(t, err)
+
+	// WHEN saving another request for the same tick
+	dup, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	dup.SetLogicalDate(tick)
+	_, err = repo.Save(qc, dup)
+
+	// THEN it should fail
+	require.Error(t, err)
+
+	// WHEN saving a request for the next tick
+	next, err := types.NewJobRequestFromDefinition(job)
+	require.NoError(t, err)
+	next.SetLogicalDate(tick.Add(time.Hour))
+	_, err = repo.Save(qc, next)
+
+	// THEN it should not fail
+	require.NoError(t, err)
+}
+
 // Updating state of job-request should succeed
 func Test_ShouldUpdateStateOfJobRequest(t *testing.T) {
 	// GIVEN a job-resource repository
@@ -575,6 +616,7 @@ func Test_ShouldFindActiveCronScheduledJobs(t *testing.T) {
 				req.OrganizationID = qc.User.OrganizationID
 				if k > 0 || j > 0 {
 					req.UserKey = "" // avoid unique constraint violation for test data
+					req.LogicalDate = nil
 				}
 				_, err = repo.Save(qc, req)
 				require.NoError(t, err)
@@ -1301,3 +1343,48 @@ func Test_ShouldErrorOnEmptyParentID(t *testing.T) {
 	_, err = repo.FindActiveChildRequests("")
 	require.Error(t, err)
 }
+
+// Test requests checked for SLA breaches
+func Test_ShouldFindJobRequestsForSLACheck(t *testing.T) {
+	// GIVEN a job-request repository
+	repo, err := NewTestJobRequestRepository()
+	require.NoError(t, err)
+	repo.Clear()
+	qc, err := NewTestQC()
+	require.NoError(t, err)
+	job, err := SaveTestJobDefinition(qc, fmt.Sprintf("job-for-sla-%v", time.Now().Unix()), "")
+	require.NoError(t, err)
+
+	// GIVEN a request in each state
+	for _, state := range []string{"PENDING", "EXECUTING", "FAILED", "COMPLETED", "CANCELLED"} {
+		req, err := types.NewJobRequestFromDefinition(job)
+		require.NoError(t, err)
+		_, err = repo.Save(qc, req)
+		require.NoError(t, err)
+		req.JobState = common.NewRequestState(state)
+		_, err = repo.Save(qc, req)
+		require.NoError(t, err)
+	}
+
+	// WHEN querying requests that finished recently
+	infos, err := repo.FindForSLACheck(time.Now().Add(-time.Minute), 0, 100)
+	// THEN all requests are returned
+	require.NoError(t, err)
+	require.Len(t, infos, 5)
+	require.Equal(t, job.ID, infos[0].JobDefinitionID)
+
+	// WHEN querying next page of recently finished requests
+	infos, err = repo.FindForSLACheck(time.Now().Add(-time.Minute), 1, 3)
+	// THEN remaining requests are returned
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	// WHEN querying after requests finished
+	infos, err = repo.FindForSLACheck(time.Now().Add(time.Minute), 0, 100)
+	// THEN only requests that haven't finished are returned
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	for _, info := range infos {
+		require.False(t, info.JobState.IsTerminal())
+	}
+}
@@ -42,6 +42,10 @@ type Locator struct {
 	EmailVerificationRepository EmailVerificationRepository
 	AuditRecordRepository       AuditRecordRepository
 	TriggerStateRepository      TriggerStateRepository
+	CronBackfillRepository      CronBackfillRepository
+	SLABreachRepository         SLABreachRepository
+	CostRecordRepository        CostRecordRepository
+	TriggerDeadLetterRepository TriggerDeadLetterRepository
 	DB                          *gorm.DB
 }
 
@@ -219,6 +223,22 @@ func NewLocator(serverCfg *config.ServerConfig) (locator *Locator, err error) {
 	if err != nil {
 		return nil, err
 	}
+	cronBackfillRepository, err := NewCronBackfillRepositoryImpl(db)
+	if err != nil {
+		return nil, err
+	}
+	slaBreachRepository, err := NewSLABreachRepositoryImpl(db, serverCfg.DB.Type)
+	if err != nil {
+		return nil, err
+	}
+	costRecordRepository, err := NewCostRecordRepositoryImpl(db)
+	if err != nil {
+		return nil, err
+	}
+	triggerDeadLetterRepository, err := NewTriggerDeadLetterRepositoryImpl(db)
+	if err != nil {
+		return nil, err
+	}
 
 	// Run GORM AutoMigrate for all SQLite databases (both local dev and tests).
 	// Non-SQLite production databases are managed by goose migrations (migrate.sh).
@@ -300,6 +320,10 @@ func NewLocator(serverCfg *config.ServerConfig) (locator *Locator, err error) {
 		SubscriptionRepository:      subscriptionRepository,
 		EmailVerificationRepository: cachedEmailVerificationRepository,
 		TriggerStateRepository:      triggerStateRepository,
+		CronBackfillRepository:      cronBackfillRepository,
+		SLABreachRepository:         slaBreachRepository,
+		CostRecordRepository:        costRecordRepository,
+		TriggerDeadLetterRepository: triggerDeadLetterRepository,
 	}
 	return f, nil
 }
@@ -403,6 +427,27 @@ func migrate(db *gorm.DB) error {
 	if err := db.AutoMigrate(&types.ApprovalDeadline{}); err != nil {
 		return err
 	}
+	if err := db.AutoMigrate(&types.ApprovalDelegation{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.ApprovalActionRedemption{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.CronBackfill{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.SLABreach{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.CostRecord{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.CostBudgetAlert{}); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&types.TriggerDeadLetter{}); err != nil {
+		return err
+	}
 
 	log.Infof("Migrated test database...")
 	return nil
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"time"
+
+	common "plexobject.com/formicary/internal/types"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+// SLABreachRepository provides persistence for SLA breaches of job requests.
+type SLABreachRepository interface {
+	// Save inserts the breach and returns false if the request already breached the same SLA.
+	Save(breach *types.SLABreach) (bool, error)
+	// Query returns recent breaches of the job type, or of all job types when job type is empty.
+	Query(qc *common.QueryContext, jobType string, limit int) ([]*types.SLABreach, error)
+	// Compliance returns number of requests that finished and that breached SLA per job type and day
+	// since the given time, for the job type or all job types when job type is empty.
+	Compliance(qc *common.QueryContext, jobType string, since time.Time) ([]*types.SLACompliance, error)
+}
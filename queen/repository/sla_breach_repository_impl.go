@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+var _ SLABreachRepository = &SLABreachRepositoryImpl{}
+
+// SLABreachRepositoryImpl implements SLABreachRepository using GORM.
+type SLABreachRepositoryImpl struct {
+	db     *gorm.DB
+	dbType string
+}
+
+// NewSLABreachRepositoryImpl creates a new SLABreachRepositoryImpl.
+func NewSLABreachRepositoryImpl(db *gorm.DB, dbType string) (*SLABreachRepositoryImpl, error) {
+	return &SLABreachRepositoryImpl{db: db, dbType: dbType}, nil
+}
+
+// Save inserts the breach and returns false if the request already breached the same SLA.
+func (r *SLABreachRepositoryImpl) Save(breach *types.SLABreach) (bool, error) {
+	if breach == nil {
+		return false, fmt.Errorf("breach is required")
+	}
+	if err := breach.Validate(); err != nil {
+		return false, common.NewValidationError(err)
+	}
+	var count int64
+	res := r.db.Model(&types.SLABreach{}).
+		Where("job_request_id = ? AND task_type = ? AND kind = ?", breach.JobRequestID, breach.TaskType, breach.Kind).
+		Count(&count)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if count > 0 {
+		return false, nil
+	}
+	breach.ID = ulid.Make().String()
+	breach.CreatedAt = time.Now()
+	// unique index on request, task and kind rejects a breach recorded concurrently by another server
+	if res = r.db.Create(breach); res.Error != nil {
+		return false, res.Error
+	}
+	return true, nil
+}
+
+// Query returns recent breaches of the job type, or of all job types when job type is empty.
+func (r *SLABreachRepositoryImpl) Query(
+	qc *common.QueryContext,
+	jobType string,
+	limit int) ([]*types.SLABreach, error) {
+	tx := qc.AddOrgElseUserWhere(r.db, true)
+	if jobType != "" {
+		tx = tx.Where("job_type = ?", jobType)
+	}
+	breaches := make([]*types.SLABreach, 0)
+	res := tx.Order("created_at desc").Limit(limit).Find(&breaches)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return breaches, nil
+}
+
+// Compliance returns number of requests that finished and that breached SLA per job type and day
+// since the given time.
+func (r *SLABreachRepositoryImpl) Compliance(
+	qc *common.QueryContext,
+	jobType string,
+	since time.Time) ([]*types.SLACompliance, error) {
+	day := "cast(updated_at as date) as start_time"
+	group := "job_type, start_time"
+	if r.dbType == "sqlite" {
+		day = "date(updated_at) as day"
+		group = "job_type, day"
+	}
+	tx := qc.AddOrgElseUserWhere(r.db.Table("formicary_job_requests"), true).
+		Select("job_type, "+day+", count(*) as total, "+
+			"sum(CASE WHEN id IN (SELECT job_request_id FROM formicary_sla_breaches) THEN 1 ELSE 0 END) as breached").
+		Where("job_state IN ? AND updated_at >= ?", common.TerminalStates, since)
+	if jobType != "" {
+		tx = tx.Where("job_type = ?", jobType)
+	}
+	rows := make([]*slaComplianceRow, 0)
+	if res := tx.Group(group).Order(group).Scan(&rows); res.Error != nil {
+		return nil, res.Error
+	}
+	compliance := make([]*types.SLACompliance, len(rows))
+	for i, row := range rows {
+		if row.Day == "" {
+			row.Day = row.StartTime.Format("2006-01-02")
+		}
+		compliance[i] = types.NewSLACompliance(row.JobType, row.Day, row.Total, row.Breached)
+	}
+	return compliance, nil
+}
+
+// slaComplianceRow scans day as string for sqlite and as date for other databases
+type slaComplianceRow struct {
+	JobType   string
+	Day       string
+	StartTime time.Time
+	Total     int64
+	Breached  int64
+}
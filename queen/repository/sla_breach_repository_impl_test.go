@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_ShouldSaveSLABreachOnce(t *testing.T) {
+	// GIVEN sla-breach repository
+	repo, err := NewTestSLABreachRepository()
+	require.NoError(t, err)
+	breach := &types.SLABreach{
+		JobRequestID: "req-sla-once",
+		JobType:      "sla-job",
+		Kind:         types.SLARunTime,
+		Expected:     "1h0m0s",
+		Deadline:     time.Now(),
+		JobState:     common.EXECUTING,
+	}
+
+	// WHEN saving the breach twice
+	saved, err := repo.Save(breach)
+	require.NoError(t, err)
+	require.True(t, saved)
+	dup := *breach
+	dup.ID = ""
+	saved, err = repo.Save(&dup)
+
+	// THEN it should be recorded once
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	// WHEN the same request breaches another SLA
+	other := dup
+	other.Kind = types.SLAFinishBy
+	saved, err = repo.Save(&other)
+	// THEN it should be recorded
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	// WHEN saving invalid breach
+	_, err = repo.Save(&types.SLABreach{JobType: "sla-job"})
+	// THEN it should fail
+	require.Error(t, err)
+}
+
+func Test_ShouldReportSLACompliance(t *testing.T) {
+	// GIVEN finished requests of which one breached SLA
+	locator, err := NewTestLocator()
+	require.NoError(t, err)
+	jobRepo := locator.JobRequestRepository
+	jobRepo.Clear()
+	repo := locator.SLABreachRepository
+	qc := common.NewQueryContextFromIDs("sla-user", "")
+	ids := make([]string, 3)
+	for i := range ids {
+		req := types.NewRequest()
+		req.JobType = "sla-report-job"
+		req.JobDefinitionID = "sla-job-def-id"
+		req.UserKey = fmt.Sprintf("sla-key-%d", i)
+		saved, err := jobRepo.Save(qc, req)
+		require.NoError(t, err)
+		res := jobRepo.db.Exec(
+			"UPDATE formicary_job_requests SET job_state = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+			common.COMPLETED, saved.ID)
+		require.NoError(t, res.Error)
+		ids[i] = saved.ID
+	}
+	for _, kind := range []types.SLAKind{types.SLARunTime, types.SLAFinishBy} {
+		_, err = repo.Save(&types.SLABreach{
+			JobRequestID: ids[0],
+			JobType:      "sla-report-job",
+			UserID:       "sla-user",
+			Kind:         kind,
+		})
+		require.NoError(t, err)
+	}
+
+	// WHEN querying compliance of the job type
+	compliance, err := repo.Compliance(qc, "sla-report-job", time.Now().Add(-24*time.Hour))
+
+	// THEN requests with multiple breaches are counted once
+	require.NoError(t, err)
+	require.Len(t, compliance, 1)
+	require.Equal(t, int64(3), compliance[0].Total)
+	require.Equal(t, int64(1), compliance[0].Breached)
+	require.NotEqual(t, "", compliance[0].Day)
+	require.InDelta(t, 66.7, compliance[0].Compliance, 0.1)
+
+	// WHEN querying breaches of the job type
+	breaches, err := repo.Query(qc, "sla-report-job", 10)
+	// THEN both breaches are returned
+	require.NoError(t, err)
+	require.Len(t, breaches, 2)
+}
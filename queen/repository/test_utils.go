@@ -172,6 +172,42 @@ func NewTestConfigRepository() (*ConfigRepositoryImpl, error) {
 	return f.ConfigRepository, nil
 }
 
+// NewTestCronBackfillRepository creates a test repository for cron backfills.
+func NewTestCronBackfillRepository() (CronBackfillRepository, error) {
+	f, err := NewTestLocator()
+	if err != nil {
+		return nil, err
+	}
+	return f.CronBackfillRepository, nil
+}
+
+// NewTestSLABreachRepository creates a test repository for SLA breaches.
+func NewTestSLABreachRepository() (SLABreachRepository, error) {
+	f, err := NewTestLocator()
+	if err != nil {
+		return nil, err
+	}
+	return f.SLABreachRepository, nil
+}
+
+// NewTestCostRecordRepository creates a test repository for cost records.
+func NewTestCostRecordRepository() (CostRecordRepository, error) {
+	f, err := NewTestLocator()
+	if err != nil {
+		return nil, err
+	}
+	return f.CostRecordRepository, nil
+}
+
+// NewTestTriggerDeadLetterRepository creates a test repository for trigger dead-letters.
+func NewTestTriggerDeadLetterRepository() (TriggerDeadLetterRepository, error) {
+	f, err := NewTestLocator()
+	if err != nil {
+		return nil, err
+	}
+	return f.TriggerDeadLetterRepository, nil
+}
+
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
 // clearDB - for testing purpose clear data before each test
 func clearDB(db *gorm.DB) {
@@ -203,4 +239,9 @@ func clearDB(db *gorm.DB) {
 	db.Where("id != ''").Delete(types.ApprovalDeadline{})
 	db.Where("id != ''").Delete(types.ApprovalVote{})
 	db.Where("id != ''").Delete(types.ApprovalPolicy{})
+	db.Where("id != ''").Delete(types.ApprovalDelegation{})
+	db.Where("id != ''").Delete(types.ApprovalActionRedemption{})
+	db.Where("id != ''").Delete(types.CronBackfill{})
+	db.Where("id != ''").Delete(types.SLABreach{})
+	db.Where("id != ''").Delete(types.TriggerDeadLetter{})
 }
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"plexobject.com/formicary/queen/types"
+)
+
+// TriggerDeadLetterRepository provides persistence for trigger events that failed to submit a job request.
+type TriggerDeadLetterRepository interface {
+	// Get returns dead-letter by id.
+	Get(id string) (*types.TriggerDeadLetter, error)
+	// Query returns newest dead-letters of all versions of the job definition, where empty trigger name or
+	// state matches all.
+	Query(jobDef *types.JobDefinition, triggerName string, state string, limit int) ([]*types.TriggerDeadLetter, error)
+	// Save inserts or updates a dead-letter.
+	Save(dl *types.TriggerDeadLetter) (*types.TriggerDeadLetter, error)
+	// Claim increments attempts of the dead-letter if it's still failed and wasn't claimed since it was
+	// loaded, and returns false if another replay claimed it.
+	Claim(dl *types.TriggerDeadLetter) (bool, error)
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+var _ TriggerDeadLetterRepository = &TriggerDeadLetterRepositoryImpl{}
+
+// TriggerDeadLetterRepositoryImpl implements TriggerDeadLetterRepository using GORM.
+type TriggerDeadLetterRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTriggerDeadLetterRepositoryImpl creates a new TriggerDeadLetterRepositoryImpl.
+func NewTriggerDeadLetterRepositoryImpl(db *gorm.DB) (*TriggerDeadLetterRepositoryImpl, error) {
+	return &TriggerDeadLetterRepositoryImpl{db: db}, nil
+}
+
+// Get returns dead-letter by id.
+func (r *TriggerDeadLetterRepositoryImpl) Get(id string) (*types.TriggerDeadLetter, error) {
+	var dl types.TriggerDeadLetter
+	res := r.db.Where("id = ?", id).First(&dl)
+	if res.Error != nil {
+		return nil, common.NewNotFoundError(res.Error)
+	}
+	return &dl, nil
+}
+
+// Query returns newest dead-letters of all versions of the job definition, where empty trigger name or
+// state matches all.
+func (r *TriggerDeadLetterRepositoryImpl) Query(
+	jobDef *types.JobDefinition,
+	triggerName string,
+	state string,
+	limit int) ([]*types.TriggerDeadLetter, error) {
+	if jobDef == nil || jobDef.JobType == "" {
+		return nil, fmt.Errorf("job_type is required")
+	}
+	tx := r.db.Where("job_type = ? AND organization_id = ?", jobDef.JobType, jobDef.OrganizationID)
+	if jobDef.OrganizationID == "" {
+		tx = tx.Where("user_id = ?", jobDef.UserID)
+	}
+	if triggerName != "" {
+		tx = tx.Where("trigger_name = ?", triggerName)
+	}
+	if state != "" {
+		tx = tx.Where("state = ?", state)
+	}
+	var dls []*types.TriggerDeadLetter
+	res := tx.Order("created_at desc").Limit(limit).Find(&dls)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return dls, nil
+}
+
+// Save inserts or updates a dead-letter.
+func (r *TriggerDeadLetterRepositoryImpl) Save(dl *types.TriggerDeadLetter) (*types.TriggerDeadLetter, error) {
+	if dl == nil {
+		return nil, fmt.Errorf("dead-letter is required")
+	}
+	if err := dl.Validate(); err != nil {
+		return nil, common.NewValidationError(err)
+	}
+	now := time.Now()
+	if dl.ID == "" {
+		dl.ID = ulid.Make().String()
+		dl.CreatedAt = now
+	}
+	dl.UpdatedAt = now
+	res := r.db.Save(dl)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return dl, nil
+}
+
+// Claim increments attempts of the dead-letter if it's still failed and wasn't claimed since it was
+// loaded, and returns false if another replay claimed it.
+func (r *TriggerDeadLetterRepositoryImpl) Claim(dl *types.TriggerDeadLetter) (bool, error) {
+	if dl == nil || dl.ID == "" {
+		return false, fmt.Errorf("dead-letter id is required")
+	}
+	now := time.Now()
+	res := r.db.Model(&types.TriggerDeadLetter{}).
+		Where("id = ? AND state = ? AND attempts = ?", dl.ID, types.DeadLetterFailed, dl.Attempts).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"updated_at": now,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	dl.Attempts++
+	dl.UpdatedAt = now
+	return true, nil
+}
@@ -25,7 +25,8 @@ type TriggerStateRepository interface {
 	// Creates the row if it doesn't exist yet.
 	RecordFired(jobDefinitionID, triggerName string) error
 	// Reset clears LastSeenKey, LastSeenTime, WindowStart, and WindowCount for a trigger.
-	// For S3 poll triggers this also resets the key cursor so old objects are re-processed.
+	// For S3 poll triggers this also resets the key cursor so old objects are re-processed, and for
+	// http and file triggers it resets the condition and file cursor.
 	// For webhook/queue triggers this resets the rate-limit window counter.
 	Reset(jobDefinitionID, triggerName string) error
 	// DeleteByJobDefinitionID removes all trigger states for a job definition.
@@ -15,6 +15,11 @@ type UserRepository interface {
 	GetByUsername(
 		qc *common.QueryContext,
 		username string) (*common.User, error)
+	// FindByEmail - finds active users of the organization with the email ignoring case
+	FindByEmail(
+		orgID string,
+		email string,
+		limit int) ([]*common.User, error)
 	// Delete User
 	Delete(
 		qc *common.QueryContext,
@@ -62,6 +62,14 @@ func (urc *UserRepositoryCached) GetByUsername(
 	return
 }
 
+// FindByEmail method finds active users of the organization with the email ignoring case
+func (urc *UserRepositoryCached) FindByEmail(
+	orgID string,
+	email string,
+	limit int) ([]*common.User, error) {
+	return urc.adapter.FindByEmail(orgID, email, limit)
+}
+
 // Delete org
 func (urc *UserRepositoryCached) Delete(
 	qc *common.QueryContext,
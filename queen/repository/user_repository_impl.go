@@ -3,6 +3,7 @@ package repository
 import (
 	"fmt"
 	"github.com/sirupsen/logrus"
+	"strings"
 	"time"
 
 	common "plexobject.com/formicary/internal/types"
@@ -73,6 +74,29 @@ func (ur *UserRepositoryImpl) GetByUsername(
 	return &user, nil
 }
 
+// FindByEmail method finds active users of the organization with the email ignoring case
+func (ur *UserRepositoryImpl) FindByEmail(
+	orgID string,
+	email string,
+	limit int) ([]*common.User, error) {
+	if orgID == "" {
+		return nil, common.NewValidationError("organization is not specified")
+	}
+	recs := make([]*common.User, 0)
+	res := ur.db.Where("organization_id = ?", orgID).
+		Where("LOWER(email) = ?", strings.ToLower(strings.TrimSpace(email))).
+		Where("active = ?", true).
+		Limit(limit).
+		Find(&recs)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	for _, rec := range recs {
+		_ = rec.AfterLoad()
+	}
+	return recs, nil
+}
+
 // lookupUsername method finds User by username
 func (ur *UserRepositoryImpl) lookupUsername(
 	username string) (*common.User, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"plexobject.com/formicary/internal/events"
 	"plexobject.com/formicary/internal/math"
+	"plexobject.com/formicary/internal/metrics"
 	"sort"
 	"sync"
 	"time"
@@ -37,7 +38,8 @@ type Manager interface {
 		requestID string,
 		taskType string,
 		method common.TaskMethod,
-		tags []string) (*common.AntReservation, error)
+		tags []string,
+		placement *common.PlacementRequest) (*common.AntReservation, error)
 	ReserveJobResources(
 		requestID string,
 		def *types.JobDefinition) (reservations map[string]*common.AntReservation, err error)
@@ -47,6 +49,7 @@ type Manager interface {
 	GetContainerEvents(offset int, limit int, sortBy string) (all []*events.ContainerLifecycleEvent, total int)
 	TerminateContainer(ctx context.Context, id string, antID string, method common.TaskMethod) (err error)
 	CountContainerEvents() map[common.TaskMethod]int
+	Drain(ctx context.Context, antID string, timeout time.Duration) error
 }
 
 // ManagerImpl for resources
@@ -56,6 +59,8 @@ type ManagerImpl struct {
 	queueClient       queue.Client
 	registrationTopic string
 	state             *State
+	metricsRegistry   *metrics.Registry
+	reportedAnts      map[string]bool
 	ticker            *time.Ticker
 	stopped           bool
 	lock              sync.RWMutex
@@ -69,7 +74,8 @@ type ManagerImpl struct {
 // New - creates new ManagerImpl for resources
 func New(
 	serverCfg *config.ServerConfig,
-	queueClient queue.Client) *ManagerImpl {
+	queueClient queue.Client,
+	metricsRegistry *metrics.Registry) *ManagerImpl {
 	registrationTopic := serverCfg.Common.GetRegistrationTopic()
 	return &ManagerImpl{
 		id:                serverCfg.Common.ID + "-resource-manager",
@@ -77,6 +83,8 @@ func New(
 		queueClient:       queueClient,
 		registrationTopic: registrationTopic,
 		state:             NewState(serverCfg, queueClient),
+		metricsRegistry:   metricsRegistry,
+		reportedAnts:      make(map[string]bool),
 	}
 }
 
@@ -193,6 +201,10 @@ func (rm *ManagerImpl) HasAntsForJobTags(
 				errors = append(errors, fmt.Sprintf("AntID=%s stale (last seen %s)", antID, registration.ReceivedAt.Format("15:04:05")))
 				continue
 			}
+			if registration.Draining {
+				errors = append(errors, fmt.Sprintf("AntID=%s draining", antID))
+				continue
+			}
 			if float64(len(allocations)) <= float64(registration.MaxCapacity) {
 				matched = true
 				break
@@ -244,12 +256,14 @@ func (rm *ManagerImpl) Reserve(
 	requestID string,
 	taskType string,
 	method common.TaskMethod,
-	tags []string) (*common.AntReservation, error) {
+	tags []string,
+	placement *common.PlacementRequest) (*common.AntReservation, error) {
 	return rm.doReserve(
 		requestID,
 		taskType,
 		method,
 		tags,
+		placement,
 		false)
 }
 
@@ -292,6 +306,15 @@ func (rm *ManagerImpl) TerminateContainer(ctx context.Context, id string, antID
 	return rm.state.terminateContainer(ctx, id, antID, method)
 }
 
+// Drain stops reserving the ant for new work and lets it exit after its current requests finish
+// or the timeout elapses, which defaults to ant-drain-timeout of jobs config.
+func (rm *ManagerImpl) Drain(ctx context.Context, antID string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = rm.serverCfg.Jobs.AntDrainTimeout
+	}
+	return rm.state.drainAnt(ctx, antID, timeout)
+}
+
 // CountContainerEvents returns counts of events
 func (rm *ManagerImpl) CountContainerEvents() map[common.TaskMethod]int {
 	return rm.state.countContainerEvents()
@@ -364,11 +387,12 @@ func (rm *ManagerImpl) doReserve(
 	taskType string,
 	method common.TaskMethod,
 	tags []string,
+	placement *common.PlacementRequest,
 	dryRun bool) (*common.AntReservation, error) {
 	if method == "" {
 		return nil, fmt.Errorf("method not specified")
 	}
-	return rm.state.reserve(requestID, taskType, method, tags, dryRun)
+	return rm.state.reserve(requestID, taskType, method, tags, placement, dryRun)
 }
 
 // Reserve resources for the job
@@ -385,6 +409,7 @@ func (rm *ManagerImpl) doReserveJobResources(
 			task.TaskType,
 			task.Method,
 			task.Tags,
+			common.NewPlacementRequest(def.JobType, task.Placement),
 			dryRun)
 		if err == nil {
 			reservations[task.TaskType] = alloc
@@ -0,0 +1,158 @@
+package resource
+
+import (
+	"sort"
+	"time"
+
+	common "plexobject.com/formicary/internal/types"
+)
+
+// placementCandidate is an ant that supports method, tags and affinity of a task
+type placementCandidate struct {
+	reservation *common.AntReservation
+	maxCapacity int
+	zone        string
+	// zoneRequestLoad is number of tasks of the same request that are allocated to ants in the zone
+	zoneRequestLoad int
+	// zoneLoad is number of tasks that are allocated to ants in the zone
+	zoneLoad int
+}
+
+func (c *placementCandidate) hasCapacity() bool {
+	return c.reservation.CurrentLoad < c.maxCapacity
+}
+
+// placer orders candidate ants of a task where the first ant is reserved
+type placer func(candidates []*placementCandidate)
+
+// placers defines supported placement strategies
+var placers = map[common.PlacementStrategy]placer{
+	common.LeastLoadedPlacement: placeLeastLoaded,
+	common.BinPackPlacement:     placeBinPack,
+	common.SpreadPlacement:      placeSpread,
+}
+
+// getPlacer returns placer of the strategy or least-loaded placer by default
+func getPlacer(strategy common.PlacementStrategy) placer {
+	if p := placers[strategy]; p != nil {
+		return p
+	}
+	return placeLeastLoaded
+}
+
+func placeLeastLoaded(candidates []*placementCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return lessLoaded(candidates[i], candidates[j])
+	})
+}
+
+// placeBinPack fills the busiest ants first so that idle ants can be scaled down
+func placeBinPack(candidates []*placementCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].hasCapacity() != candidates[j].hasCapacity() {
+			return candidates[i].hasCapacity()
+		}
+		if candidates[i].reservation.CurrentLoad != candidates[j].reservation.CurrentLoad {
+			return candidates[i].reservation.CurrentLoad > candidates[j].reservation.CurrentLoad
+		}
+		return candidates[i].reservation.AntID < candidates[j].reservation.AntID
+	})
+}
+
+// placeSpread prefers zones with fewer tasks of the same request and then fewer tasks overall
+func placeSpread(candidates []*placementCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].zoneRequestLoad != candidates[j].zoneRequestLoad {
+			return candidates[i].zoneRequestLoad < candidates[j].zoneRequestLoad
+		}
+		if candidates[i].zoneLoad != candidates[j].zoneLoad {
+			return candidates[i].zoneLoad < candidates[j].zoneLoad
+		}
+		return lessLoaded(candidates[i], candidates[j])
+	})
+}
+
+func lessLoaded(a *placementCandidate, b *placementCandidate) bool {
+	if a.reservation.CurrentLoad != b.reservation.CurrentLoad {
+		return a.reservation.CurrentLoad < b.reservation.CurrentLoad
+	}
+	if a.reservation.TotalExecuted != b.reservation.TotalExecuted {
+		return a.reservation.TotalExecuted < b.reservation.TotalExecuted
+	}
+	return a.reservation.AntID < b.reservation.AntID
+}
+
+// stickyAnt is the ant that last ran a task
+type stickyAnt struct {
+	antID      string
+	reservedAt time.Time
+}
+
+// stickyKeys returns keys of ants that are preferred for the task, i.e., the ant that ran the task of
+// same request for retries and the ant that ran the task of same job type for sticky tasks.
+func stickyKeys(
+	requestID string,
+	taskType string,
+	placement *common.PlacementRequest) (keys []string) {
+	keys = []string{"request:" + requestID + ":" + taskType}
+	if placement != nil && placement.Policy != nil && placement.Policy.Sticky && placement.JobType != "" {
+		keys = append(keys, "job:"+placement.JobType+":"+taskType)
+	}
+	return
+}
+
+// preferSticky moves the ant that last ran the task to the front if it still has capacity
+func (s *State) preferSticky(
+	candidates []*placementCandidate,
+	keys []string) {
+	for _, key := range keys {
+		sticky := s.stickyAnts[key]
+		if sticky == nil {
+			continue
+		}
+		for i, candidate := range candidates {
+			if candidate.reservation.AntID == sticky.antID && candidate.hasCapacity() {
+				copy(candidates[1:i+1], candidates[0:i])
+				candidates[0] = candidate
+				return
+			}
+		}
+	}
+}
+
+func (s *State) addStickyAnts(
+	keys []string,
+	antID string) {
+	now := time.Now()
+	for _, key := range keys {
+		s.stickyAnts[key] = &stickyAnt{antID: antID, reservedAt: now}
+	}
+}
+
+// reapStickyAnts removes sticky ants that were reserved before ttl
+func (s *State) reapStickyAnts(ttl time.Duration) (removed int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	for key, sticky := range s.stickyAnts {
+		if now.Sub(sticky.reservedAt) > ttl {
+			delete(s.stickyAnts, key)
+			removed++
+		}
+	}
+	return
+}
+
+// loadByZone returns tasks allocated to ants of each zone and tasks of the request allocated to ants of each zone
+func (s *State) loadByZone(requestID string) (load map[string]int, requestLoad map[string]int) {
+	load = make(map[string]int)
+	requestLoad = make(map[string]int)
+	for antID, registration := range s.antRegistrations {
+		allocations := s.allocationsByAnt[antID]
+		load[registration.Zone] += calculateLoad(allocations)
+		if alloc := allocations[requestID]; alloc != nil {
+			requestLoad[registration.Zone] += alloc.Load()
+		}
+	}
+	return
+}
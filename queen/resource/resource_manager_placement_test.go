@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/metrics"
+	"plexobject.com/formicary/internal/queue"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+)
+
+// newPlacementTestManager creates resource manager with ant-a in zone-1 and ant-b, ant-c in zone-2
+func newPlacementTestManager(t *testing.T, strategy common.PlacementStrategy) *ManagerImpl {
+	conf := config.TestServerConfig()
+	conf.Jobs.PlacementStrategy = strategy
+	require.NoError(t, conf.Validate())
+	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
+	require.NoError(t, err)
+	mgr := New(conf, client, metrics.New())
+	for _, ant := range []struct{ id, zone, tag string }{
+		{"ant-a", "zone-1", "hdd"},
+		{"ant-b", "zone-2", "ssd"},
+		{"ant-c", "zone-2", "hdd"},
+	} {
+		require.NoError(t, mgr.Register(context.Background(), &common.AntRegistration{
+			AntID:       ant.id,
+			AntTopic:    testIncomingTopic,
+			MaxCapacity: 2,
+			Tags:        []string{"build", ant.tag},
+			Methods:     []common.TaskMethod{common.Docker},
+			Zone:        ant.zone,
+			Allocations: make(map[string]*common.AntAllocation),
+		}))
+	}
+	return mgr
+}
+
+func reserveAnt(t *testing.T, mgr *ManagerImpl, requestID string, taskType string,
+	placement *common.PlacementRequest) string {
+	reservation, err := mgr.Reserve(requestID, taskType, common.Docker, []string{"build"}, placement)
+	require.NoError(t, err)
+	return reservation.AntID
+}
+
+func Test_ShouldPlaceTasksOnLeastLoadedAnts(t *testing.T) {
+	// GIVEN resource manager with least-loaded strategy
+	mgr := newPlacementTestManager(t, common.LeastLoadedPlacement)
+
+	// WHEN reserving tasks of different requests
+	// THEN tasks should be placed on the idle ants first
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-1", "task", nil))
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-2", "task", nil))
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-3", "task", nil))
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-4", "task", nil))
+}
+
+func Test_ShouldBinPackTasksOnBusiestAnts(t *testing.T) {
+	// GIVEN resource manager with bin-pack strategy
+	mgr := newPlacementTestManager(t, common.BinPackPlacement)
+
+	// WHEN reserving tasks of different requests
+	// THEN tasks should fill an ant before using the next ant
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-1", "task", nil))
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-2", "task", nil))
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-3", "task", nil))
+
+	// AND a task can override strategy of the server
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-4", "task",
+		common.NewPlacementRequest("job", &common.PlacementPolicy{Strategy: common.LeastLoadedPlacement})))
+}
+
+func Test_ShouldSpreadTasksOfRequestAcrossZones(t *testing.T) {
+	// GIVEN resource manager with spread strategy
+	mgr := newPlacementTestManager(t, common.SpreadPlacement)
+
+	// WHEN reserving tasks of the same request
+	// THEN tasks should alternate between zones
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-1", "task1", nil))
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-1", "task2", nil))
+	// both zones have a task of the request and same load so the least loaded ant is used
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-1", "task3", nil))
+}
+
+func Test_ShouldPlaceTasksByAffinityAndAntiAffinity(t *testing.T) {
+	// GIVEN resource manager with least-loaded strategy
+	mgr := newPlacementTestManager(t, common.LeastLoadedPlacement)
+
+	// WHEN reserving with affinity to a label
+	// THEN only the ant with the label should be used
+	ssd := common.NewPlacementRequest("job", &common.PlacementPolicy{
+		Affinity: &common.AffinityRule{Labels: []string{"ssd"}}})
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-1", "task", ssd))
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-2", "task", ssd))
+
+	// AND anti-affinity should exclude ants by ID and zone
+	notZone1 := common.NewPlacementRequest("job", &common.PlacementPolicy{
+		AntiAffinity: &common.AffinityRule{AntIDs: []string{"ant-b"}, Zones: []string{"zone-1"}}})
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-3", "task", notZone1))
+
+	// AND reservation should fail when no ant is allowed
+	_, err := mgr.Reserve("req-4", "task", common.Docker, []string{"build"},
+		common.NewPlacementRequest("job", &common.PlacementPolicy{
+			Affinity: &common.AffinityRule{AntIDs: []string{"ant-x"}}}))
+	require.Error(t, err)
+}
+
+func Test_ShouldPreferStickyAntsForRetriesAndStickyTasks(t *testing.T) {
+	// GIVEN resource manager with least-loaded strategy
+	mgr := newPlacementTestManager(t, common.LeastLoadedPlacement)
+	sticky := common.NewPlacementRequest("build-job", &common.PlacementPolicy{Sticky: true})
+
+	// WHEN reserving a sticky task for another request of the same job
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-1", "compile", sticky))
+	// THEN the ant with warm cache should be used even though other ants are idle
+	require.Equal(t, "ant-a", reserveAnt(t, mgr, "req-2", "compile", sticky))
+	// AND the next least loaded ant is used after the sticky ant is full
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-3", "compile", sticky))
+
+	// WHEN a task that isn't sticky is reserved again for the same request
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-4", "test", nil))
+	require.NoError(t, mgr.ReleaseJobResources("req-4"))
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-5", "test", nil))
+	// THEN the retry should prefer the ant that ran it before
+	require.Equal(t, "ant-c", reserveAnt(t, mgr, "req-4", "test", nil))
+
+	// WHEN sticky ants expire
+	require.True(t, mgr.state.reapStickyAnts(0) > 0)
+	// THEN the least loaded ant should be used
+	require.NoError(t, mgr.ReleaseJobResources("req-4"))
+	require.Equal(t, "ant-b", reserveAnt(t, mgr, "req-4", "test", nil))
+}
@@ -20,6 +20,8 @@ func (rm *ManagerImpl) startReaperTicker(ctx context.Context) {
 			case <-rm.ticker.C:
 				rm.reapStaleAnts(ctx)
 				rm.reapStaleAllocations(ctx)
+				rm.state.reapStickyAnts(rm.serverCfg.Jobs.StickyPlacementTTL)
+				rm.updateAllocationMetrics()
 			}
 		}
 	}()
@@ -99,3 +101,39 @@ func (rm *ManagerImpl) reapStaleAllocations(_ context.Context) int {
 	}
 	return len(removeReservation)
 }
+
+// updateAllocationMetrics reports tasks allocated to each ant against its capacity and removes
+// metrics of ants that are no longer registered.
+func (rm *ManagerImpl) updateAllocationMetrics() {
+	if rm.metricsRegistry == nil {
+		return
+	}
+	loadByAnt := rm.state.getLoadByAnt()
+	for _, registration := range rm.state.getRegistrations() {
+		load, ok := loadByAnt[registration.AntID]
+		if !ok {
+			continue
+		}
+		labels := map[string]string{"Ant": registration.AntID}
+		rm.metricsRegistry.Set("ant_allocated_tasks", float64(load), labels)
+		rm.metricsRegistry.Set("ant_max_capacity", float64(registration.MaxCapacity), labels)
+		if registration.MaxCapacity > 0 {
+			rm.metricsRegistry.Set("ant_capacity_utilization",
+				float64(load)/float64(registration.MaxCapacity), labels)
+		}
+	}
+	rm.lock.Lock()
+	defer rm.lock.Unlock()
+	for antID := range rm.reportedAnts {
+		if _, ok := loadByAnt[antID]; !ok {
+			labels := map[string]string{"Ant": antID}
+			rm.metricsRegistry.Delete("ant_allocated_tasks", labels)
+			rm.metricsRegistry.Delete("ant_max_capacity", labels)
+			rm.metricsRegistry.Delete("ant_capacity_utilization", labels)
+			delete(rm.reportedAnts, antID)
+		}
+	}
+	for antID := range loadByAnt {
+		rm.reportedAnts[antID] = true
+	}
+}
@@ -32,6 +32,7 @@ type State struct {
 	allocationsByAnt               map[string]map[string]*common.AntAllocation // ant-id => [request-id: allocation]
 	containersEvents               map[string]*events.ContainerLifecycleEvent  // method+container-name: container event
 	containersEventKeysByRequestID map[string]map[string]bool                  // for removing containers by request
+	stickyAnts                     map[string]*stickyAnt                       // request or job type + task-type => ant
 	lock                           sync.RWMutex
 }
 
@@ -49,6 +50,7 @@ func NewState(
 		allocationsByAnt:               make(map[string]map[string]*common.AntAllocation), // ant-id => [request-id: allocation]
 		containersEvents:               make(map[string]*events.ContainerLifecycleEvent),  // method + container-name: container event
 		containersEventKeysByRequestID: make(map[string]map[string]bool),                  // for removing containers by request
+		stickyAnts:                     make(map[string]*stickyAnt),                       // request or job type + task-type => ant
 	}
 }
 
@@ -63,6 +65,7 @@ func (s *State) reserve(
 	taskType string,
 	method common.TaskMethod,
 	tags []string,
+	placement *common.PlacementRequest,
 	dryRun bool) (*common.AntReservation, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -105,8 +108,17 @@ func (s *State) reserve(
 		}
 	}
 
-	// Select ant with the least workload
-	reservations := make([]*common.AntReservation, 0)
+	var policy *common.PlacementPolicy
+	if placement != nil {
+		policy = placement.Policy
+	}
+	strategy := s.serverCfg.Jobs.PlacementStrategy
+	if policy != nil && policy.Strategy != "" {
+		strategy = policy.Strategy
+	}
+	zoneLoad, zoneRequestLoad := s.loadByZone(requestID)
+
+	candidates := make([]*placementCandidate, 0)
 	for antID, count := range availableAnts {
 		if count != 2 { // 1 for method + 1 for tag
 			continue
@@ -114,16 +126,17 @@ func (s *State) reserve(
 
 		// Checking registration
 		registration := s.antRegistrations[antID]
-		allocationsByAnt := s.allocationsByAnt[registration.AntID] // ant-id => [request-id: allocation]
+		allocationsByAnt := s.allocationsByAnt[antID] // ant-id => [request-id: allocation]
 		if registration == nil || allocationsByAnt == nil {
 			continue // shouldn't happen
 		}
 
-		// matching all tags for the ant
+		// matching all tags and affinity for the ant
 		// Note: we won't check capacity here as it's already checked in HasAntsForJobTags
-		if registration.Supports(method, tags, s.serverCfg.Jobs.AntRegistrationAliveTimeout) {
-			reservations = append(reservations,
-				common.NewAntReservation(
+		if registration.Supports(method, tags, s.serverCfg.Jobs.AntRegistrationAliveTimeout) &&
+			policy.Allows(registration) {
+			candidates = append(candidates, &placementCandidate{
+				reservation: common.NewAntReservation(
 					registration.AntID,
 					registration.AntTopic,
 					requestID,
@@ -131,47 +144,55 @@ func (s *State) reserve(
 					registration.EncryptionKey,
 					calculateLoad(allocationsByAnt),
 					registration.TotalExecuted,
-				))
+				),
+				maxCapacity:     registration.MaxCapacity,
+				zone:            registration.Zone,
+				zoneLoad:        zoneLoad[registration.Zone],
+				zoneRequestLoad: zoneRequestLoad[registration.Zone],
+			})
 		}
 	}
 
 	// reservations must be available to continue
-	if len(reservations) == 0 {
+	if len(candidates) == 0 {
 		return nil, fmt.Errorf("no ants could be reserved for method=%s, tags=%v available=%v "+
-			"ants-by-methods=%d ants-by-tags=%d",
-			method, tags, availableAnts, len(s.antByMethod), len(s.antByTag))
+			"ants-by-methods=%d ants-by-tags=%d placement=%v",
+			method, tags, availableAnts, len(s.antByMethod), len(s.antByTag), policy)
 	}
 
+	keys := stickyKeys(requestID, taskType, placement)
 	if !dryRun {
-		// sorting based on least current load
-		sort.Slice(reservations, func(i, j int) bool {
-			return reservations[i].CurrentLoad < reservations[j].CurrentLoad
-		})
+		getPlacer(strategy)(candidates)
+		s.preferSticky(candidates, keys)
 	}
-	// least load is first
+	// preferred ant is first
 	reservation := common.NewAntReservation(
-		reservations[0].AntID,
-		reservations[0].AntTopic,
-		reservations[0].JobRequestID,
-		reservations[0].TaskType,
-		reservations[0].EncryptionKey,
-		reservations[0].CurrentLoad,
-		reservations[0].TotalExecuted,
+		candidates[0].reservation.AntID,
+		candidates[0].reservation.AntTopic,
+		candidates[0].reservation.JobRequestID,
+		candidates[0].reservation.TaskType,
+		candidates[0].reservation.EncryptionKey,
+		candidates[0].reservation.CurrentLoad,
+		candidates[0].reservation.TotalExecuted,
 	)
-	reservation.TotalReservations = len(reservations)
+	reservation.TotalReservations = len(candidates)
 	if !dryRun {
 		s.addAllocationsByAnt(requestID, taskType, reservation)
 
 		s.addAntsByRequest(requestID, reservation.AntID)
 
+		s.addStickyAnts(keys, reservation.AntID)
+
 		if logrus.IsLevelEnabled(logrus.DebugLevel) {
 			logrus.WithFields(logrus.Fields{
 				"Component":         "ResourceManager",
 				"Load":              reservation.CurrentLoad,
 				"AntID":             reservation.AntID,
+				"Zone":              candidates[0].zone,
 				"RequestID":         requestID,
 				"TaskType":          taskType,
 				"Method":            method,
+				"Strategy":          strategy,
 				"TotalReservations": reservation.TotalReservations,
 				"Tags":              tags,
 			}).Debug("reserving ant to task request")
@@ -295,6 +316,17 @@ func (s *State) release(
 	return nil
 }
 
+// getLoadByAnt returns number of tasks allocated to each registered ant
+func (s *State) getLoadByAnt() map[string]int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	res := make(map[string]int)
+	for antID := range s.antRegistrations {
+		res[antID] = calculateLoad(s.allocationsByAnt[antID])
+	}
+	return res
+}
+
 func (s *State) getAllocationsByAnt(
 	antID string) map[string]*common.AntAllocation {
 	s.lock.RLock()
@@ -479,6 +511,66 @@ func (s *State) terminateContainer(
 	return
 }
 
+// drainAnt asks the ant to stop accepting new work and exit after its current requests finish
+func (s *State) drainAnt(
+	ctx context.Context,
+	antID string,
+	timeout time.Duration) (err error) {
+	registration := s.getRegistrationByAnt(antID)
+	if registration == nil {
+		return fmt.Errorf("failed to find ant with id %s", antID)
+	}
+	taskReq := &common.TaskRequest{
+		JobExecutionID:  "DRAIN_000",
+		TaskExecutionID: "DRAIN_000",
+		JobType:         "DefaultResourceManager",
+		TaskType:        "DefaultResourceManager",
+		Action:          common.DRAIN,
+		Timeout:         timeout,
+		ExecutorOpts:    common.NewExecutorOptions("", registration.Methods[0]),
+		StartedAt:       time.Now(),
+	}
+	var b []byte
+	if b, err = taskReq.Marshal(registration.EncryptionKey); err != nil {
+		return err
+	}
+	req := &queue.SendReceiveRequest{
+		OutTopic: registration.AntTopic,
+		InTopic:  s.serverCfg.GetResponseTopicAntRegistration(),
+		Payload:  b,
+		Props:    make(map[string]string),
+		Timeout:  taskTimeout,
+	}
+	res, err := s.queueClient.SendReceive(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to send drain request to %s due to %w", antID, err)
+	}
+	defer res.Ack() // auto-ack
+	taskResp, err := common.UnmarshalTaskResponse(registration.EncryptionKey, res.Event.Payload)
+	if err != nil {
+		return err
+	}
+	if taskResp.Status.Failed() {
+		return fmt.Errorf("failed to drain %s due to %s", antID, taskResp.ErrorMessage)
+	}
+	// mark it right away so that no work is reserved before the next heartbeat of the ant
+	s.markDraining(antID)
+	logrus.WithFields(logrus.Fields{
+		"Component": "ResourceManager",
+		"AntID":     antID,
+		"Timeout":   timeout,
+	}).Warn("draining ant")
+	return nil
+}
+
+func (s *State) markDraining(antID string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if registration := s.antRegistrations[antID]; registration != nil {
+		registration.Draining = true
+	}
+}
+
 // addContainers for adding containers after ant registration
 func (s *State) addContainers(
 	ctx context.Context,
@@ -574,6 +666,11 @@ func (s *State) addRegistration(
 	registration *common.AntRegistration) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	// a heartbeat sent before the ant received drain request must not undo draining, unless the ant was restarted
+	if old := s.antRegistrations[registration.AntID]; old != nil && old.Draining &&
+		old.AntStartedAt.Equal(registration.AntStartedAt) {
+		registration.Draining = true
+	}
 	// update mapping of ant-id => registration
 	s.antRegistrations[registration.AntID] = registration
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"plexobject.com/formicary/internal/events"
 	"plexobject.com/formicary/queen/types"
@@ -118,12 +119,14 @@ func (rm *ManagerStub) Reserve(
 	requestID string,
 	taskType string,
 	method common.TaskMethod,
-	tags []string) (*common.AntReservation, error) {
+	tags []string,
+	placement *common.PlacementRequest) (*common.AntReservation, error) {
 	return rm.doReserve(
 		requestID,
 		taskType,
 		method,
 		tags,
+		placement,
 		false)
 }
 
@@ -148,6 +151,16 @@ func (rm *ManagerStub) TerminateContainer(
 	return nil
 }
 
+// Drain marks the ant as draining
+func (rm *ManagerStub) Drain(_ context.Context, antID string, _ time.Duration) error {
+	reg := rm.Registry[antID]
+	if reg == nil {
+		return fmt.Errorf("failed to find ant with id %s", antID)
+	}
+	reg.Draining = true
+	return nil
+}
+
 // CountContainerEvents returns counts of events
 func (rm *ManagerStub) CountContainerEvents() (res map[common.TaskMethod]int) {
 	res = make(map[common.TaskMethod]int)
@@ -169,11 +182,15 @@ func (rm *ManagerStub) doReserve(
 	taskType string,
 	method common.TaskMethod,
 	tags []string,
+	placement *common.PlacementRequest,
 	dryRun bool) (*common.AntReservation, error) {
 	reg, err := rm.getMatchingReservation([]common.TaskMethod{method}, tags)
 	if err != nil {
 		return nil, err
 	}
+	if placement != nil && !placement.Policy.Allows(reg) {
+		return nil, fmt.Errorf("no mocked ant matches placement of task %s", taskType)
+	}
 
 	res := common.NewAntReservation(
 		reg.AntID,
@@ -207,6 +224,7 @@ func (rm *ManagerStub) doReserveJobResources(
 			task.TaskType,
 			task.Method,
 			task.Tags,
+			common.NewPlacementRequest(def.JobType, task.Placement),
 			dryRun)
 		if err == nil {
 			reservations[task.TaskType] = alloc
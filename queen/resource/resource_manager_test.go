@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/oklog/ulid/v2"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
 
 	"plexobject.com/formicary/queen/types"
 
+	"plexobject.com/formicary/internal/metrics"
 	"plexobject.com/formicary/internal/queue"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/config"
@@ -24,7 +26,7 @@ func Test_ShouldFindAntsForGivenMethodsAndTasks(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -70,7 +72,7 @@ func Test_ShouldNotFindAntsWithoutRequiredMethods(t *testing.T) {
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
 
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -110,7 +112,7 @@ func Test_ShouldNotFindAntsWithoutRequiredTags(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -149,7 +151,7 @@ func Test_ShouldReturnErrorWhenReleasingWithoutReservingFirst(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -172,12 +174,12 @@ func Test_ShouldReserveTasks(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
 	// WHEN Reserving without registration
-	alloc, err := mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"client-1", "aws"})
+	alloc, err := mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"client-1", "aws"}, nil)
 	// THEN it should fail
 	require.Error(t, err)
 
@@ -192,7 +194,7 @@ func Test_ShouldReserveTasks(t *testing.T) {
 
 	allocs := make([]*common.AntReservation, 0)
 	for i := 0; i < 10; i++ {
-		alloc, err = mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"})
+		alloc, err = mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"}, nil)
 		require.NoError(t, err)
 		allocs = append(allocs, alloc)
 	}
@@ -232,6 +234,50 @@ func Test_ShouldReserveTasks(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldReportAllocationsByAnt(t *testing.T) {
+	// GIVEN resource manager with a registered ant
+	conf := config.TestServerConfig()
+	err := conf.Validate()
+	require.NoError(t, err)
+	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
+	require.NoError(t, err)
+	mgr := New(conf, client, metrics.New())
+	err = mgr.Start(context.Background())
+	require.NoError(t, err)
+	err = registerAnt(
+		client,
+		conf.Common.GetRegistrationTopic(),
+		[]common.TaskMethod{"DOCKER"},
+		[]string{"metrics"},
+		0)
+	require.NoError(t, err)
+	antID := fmt.Sprintf("ant-id-%d", testAntID)
+
+	// WHEN reserving tasks and updating metrics
+	for i := 0; i < 2; i++ {
+		_, err = mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"metrics"}, nil)
+		require.NoError(t, err)
+	}
+	mgr.updateAllocationMetrics()
+
+	// THEN allocations are reported against capacity of the ant
+	require.Equal(t, float64(2), gaugeValue(t, "ant_allocated_tasks", antID))
+	require.Equal(t, float64(10), gaugeValue(t, "ant_max_capacity", antID))
+	require.Equal(t, 0.2, gaugeValue(t, "ant_capacity_utilization", antID))
+
+	// WHEN the ant is unregistered
+	_, err = mgr.Unregister(context.Background(), antID)
+	require.NoError(t, err)
+	mgr.updateAllocationMetrics()
+
+	// THEN metrics of the ant are removed
+	require.False(t, mgr.reportedAnts[antID])
+	require.Equal(t, float64(-1), gaugeValue(t, "ant_allocated_tasks", antID))
+
+	err = mgr.Stop(context.Background())
+	require.NoError(t, err)
+}
+
 func Test_ShouldReserveJobs(t *testing.T) {
 	// GIVEN resource manager is constructed
 	conf := config.TestServerConfig()
@@ -239,7 +285,7 @@ func Test_ShouldReserveJobs(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
@@ -316,7 +362,7 @@ func Test_ShouldFailReservationWithoutMethod(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -330,7 +376,7 @@ func Test_ShouldFailReservationWithoutMethod(t *testing.T) {
 	require.NoError(t, err)
 
 	// THEN reservation should fail because `KUBERNETES` method is not available
-	_, err = mgr.Reserve(ulid.Make().String(), "task", "KUBERNETES", []string{"client-1", "aws"})
+	_, err = mgr.Reserve(ulid.Make().String(), "task", "KUBERNETES", []string{"client-1", "aws"}, nil)
 	require.Error(t, err)
 }
 
@@ -344,7 +390,7 @@ func Test_ShouldFailReservationWithoutTag(t *testing.T) {
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
 
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -365,7 +411,7 @@ func Test_ShouldFailReservationWithoutTag(t *testing.T) {
 	require.NoError(t, err)
 
 	// WHEN reservation by tags `DOCKER` and `client-2` is not available
-	_, err = mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"client-2", "aws"})
+	_, err = mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"client-2", "aws"}, nil)
 	// THEN reservation should fail because `DOCKER` and `client-2` is not available
 	require.Error(t, err)
 	err = mgr.Stop(context.Background())
@@ -381,7 +427,7 @@ func Test_ShouldReapStaleAllocations(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -403,7 +449,7 @@ func Test_ShouldReapStaleAllocations(t *testing.T) {
 
 	// THEN reservation should succeed up to max-capacity 10
 	for i := 0; i < 10; i++ {
-		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"})
+		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"}, nil)
 		require.NoError(t, err)
 		require.Contains(t, alloc.AntID, "ant-id-") // ant-id-1 or ant-id-2
 	}
@@ -436,7 +482,7 @@ func Test_ShouldReapStaleAnts(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -472,7 +518,7 @@ func Test_ShouldReapStaleAnts(t *testing.T) {
 
 	for i := 0; i < 10; i++ {
 		// WHEN making reservation
-		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"})
+		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"}, nil)
 		// THEN reservation should succeed
 		require.NoError(t, err)
 		require.Contains(t, alloc.AntID, "ant-id-") // ant-id-1 or ant-id-2
@@ -505,7 +551,7 @@ func Test_ShouldFindAntWithLeastLoad(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -541,14 +587,14 @@ func Test_ShouldFindAntWithLeastLoad(t *testing.T) {
 
 	for i := 0; i < 10; i++ {
 		// WHEN making reservation
-		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"})
+		alloc, err := mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"}, nil)
 		// THEN reservation should succeed
 		require.NoError(t, err)
 		require.Contains(t, alloc.AntID, "ant-id-") // ant-id-1 or ant-id-2
 	}
 
 	for i := 0; i < 20; i++ {
-		_, err = mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"})
+		_, err = mgr.Reserve(ulid.Make().String(), "my-task", "DOCKER", []string{"client-1", "aws"}, nil)
 		require.NoError(t, err)
 	}
 
@@ -565,7 +611,7 @@ func Test_ShouldIncrementLoadAfterAReservation(t *testing.T) {
 	require.NoError(t, err)
 	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
 	require.NoError(t, err)
-	mgr := New(conf, client)
+	mgr := New(conf, client, metrics.New())
 	err = mgr.Start(context.Background())
 	require.NoError(t, err)
 
@@ -593,7 +639,7 @@ func Test_ShouldIncrementLoadAfterAReservation(t *testing.T) {
 	require.NoError(t, err)
 
 	// THEN reservation add load
-	alloc, err := mgr.Reserve(ulid.Make().String(), "task", "KUBERNETES", []string{"client-2", "aws"})
+	alloc, err := mgr.Reserve(ulid.Make().String(), "task", "KUBERNETES", []string{"client-2", "aws"}, nil)
 	require.NoError(t, err)
 	require.Equal(t, 4, alloc.CurrentLoad)
 
@@ -601,8 +647,76 @@ func Test_ShouldIncrementLoadAfterAReservation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldNotReserveDrainingAnts(t *testing.T) {
+	// GIVEN resource manager with a registered ant
+	conf := config.TestServerConfig()
+	err := conf.Validate()
+	require.NoError(t, err)
+	client, err := queue.NewClientManager().GetClient(context.Background(), &conf.Common)
+	require.NoError(t, err)
+	mgr := New(conf, client, metrics.New())
+	startedAt := time.Now()
+	registration := &common.AntRegistration{
+		AntID:        "draining-ant",
+		AntTopic:     testIncomingTopic,
+		MaxCapacity:  10,
+		Tags:         []string{"drain"},
+		Methods:      []common.TaskMethod{"DOCKER"},
+		Allocations:  make(map[string]*common.AntAllocation),
+		AntStartedAt: startedAt,
+	}
+	require.NoError(t, mgr.Register(context.Background(), registration))
+	_, err = mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"drain"}, nil)
+	require.NoError(t, err)
+
+	// WHEN draining the ant
+	mgr.state.markDraining("draining-ant")
+
+	// THEN it should not be reserved
+	_, err = mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"drain"}, nil)
+	require.Error(t, err)
+	require.Error(t, mgr.HasAntsForJobTags([]common.TaskMethod{"DOCKER"}, []string{"drain"}))
+
+	// AND a stale heartbeat of the ant should not undo draining
+	heartbeat := *registration
+	heartbeat.Draining = false
+	require.NoError(t, mgr.Register(context.Background(), &heartbeat))
+	require.True(t, mgr.Registration("draining-ant").Draining)
+
+	// AND a restarted ant should not be draining
+	restarted := *registration
+	restarted.Draining = false
+	restarted.AntStartedAt = startedAt.Add(time.Minute)
+	require.NoError(t, mgr.Register(context.Background(), &restarted))
+	require.False(t, mgr.Registration("draining-ant").Draining)
+	_, err = mgr.Reserve(ulid.Make().String(), "task", "DOCKER", []string{"drain"}, nil)
+	require.NoError(t, err)
+
+	// AND draining an unknown ant should fail
+	require.Error(t, mgr.Drain(context.Background(), "unknown-ant", time.Minute))
+}
+
 var testAntID int
 
+// gaugeValue returns value of gauge for the ant or -1 if it's not reported
+func gaugeValue(t *testing.T, name string, antID string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "Ant" && label.GetValue() == antID {
+					return m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return -1
+}
+
 func registerAnt(
 	queueClient queue.Client,
 	registrationTopic string,
@@ -36,6 +36,7 @@ type JobScheduler struct {
 	artifactManager                  *manager.ArtifactManager
 	userManager                      *manager.UserManager
 	retentionManager                 *manager.RetentionManager
+	maintenanceManager               *manager.MaintenanceManager
 	errorRepository                  repository.ErrorCodeRepository
 	resourceManager                  resource.Manager
 	approvalService                  *approval.Service
@@ -69,6 +70,7 @@ func New(
 	metricsRegistry *metrics.Registry,
 	approvalSvc *approval.Service,
 	retentionManager *manager.RetentionManager,
+	maintenanceManager *manager.MaintenanceManager,
 	triggerCh chan struct{},
 ) *JobScheduler {
 	return &JobScheduler{
@@ -81,6 +83,7 @@ func New(
 		userManager:                   userManager,
 		resourceManager:               resourceManager,
 		retentionManager:              retentionManager,
+		maintenanceManager:            maintenanceManager,
 		approvalService:               approvalSvc,
 		monitor:                       monitor,
 		metricsRegistry:               metricsRegistry,
@@ -111,6 +114,7 @@ func (js *JobScheduler) Start(ctx context.Context) (err error) {
 	if js.approvalService != nil {
 		js.tickers = append(js.tickers, js.startTickerToCheckApprovalSLAs(ctx))
 	}
+	js.tickers = append(js.tickers, js.startTickerToCheckSLAs(ctx))
 	if js.retentionManager != nil {
 		js.tickers = append(js.tickers, js.startTickerToRunRetention(ctx))
 	}
@@ -146,6 +150,13 @@ func (js *JobScheduler) Stop(ctx context.Context) error {
 }
 
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+// IsLeader returns true if this queen received a job scheduler leader event within three leader intervals
+func (js *JobScheduler) IsLeader() bool {
+	js.lock.RLock()
+	defer js.lock.RUnlock()
+	return time.Since(js.lastJobSchedulerLeaderEventAt) < js.serverCfg.Jobs.JobSchedulerLeaderInterval*3
+}
+
 func (js *JobScheduler) isStopped() bool {
 	js.lock.RLock()
 	defer js.lock.RUnlock()
@@ -169,6 +180,9 @@ func (js *JobScheduler) schedulePendingJobs(ctx context.Context) (err error) {
 		return fmt.Errorf("server shutting down so stopping scheduling")
 	}
 
+	// running jobs continue during maintenance but pending jobs wait until it ends
+	inMaintenance := js.maintenanceManager != nil && js.maintenanceManager.InMaintenance()
+
 	if err = js.monitor.HealthStatus(ctx); err != nil {
 		logrus.WithFields(logrus.Fields{
 			"Component": "JobScheduler",
@@ -197,6 +211,22 @@ func (js *JobScheduler) schedulePendingJobs(ctx context.Context) (err error) {
 		return err
 	}
 
+	if inMaintenance {
+		// jobs forked by running jobs are still scheduled, otherwise their parents would wait for them until
+		// the maintenance ends
+		requests = js.forkedByRunningJobs(requests)
+		if len(requests) == 0 {
+			if logrus.IsLevelEnabled(logrus.DebugLevel) {
+				logrus.WithFields(logrus.Fields{
+					"Component": "JobScheduler",
+					"ID":        js.serverCfg.Common.ID,
+				}).Debug("maintenance mode is enabled so skipping scheduling")
+			}
+			js.metricsRegistry.Incr("scheduler_maintenance_total", nil)
+			return fmt.Errorf("maintenance mode is enabled so skipping scheduling")
+		}
+	}
+
 	if len(requests) == 0 {
 		js.backoffUntil = time.Now().Add(js.scheduleBackoff.Duration())
 		if logrus.IsLevelEnabled(logrus.DebugLevel) {
@@ -239,6 +269,27 @@ func (js *JobScheduler) schedulePendingJobs(ctx context.Context) (err error) {
 	return nil
 }
 
+// forkedByRunningJobs returns requests whose parent request is running
+func (js *JobScheduler) forkedByRunningJobs(requests []*types.JobRequestInfo) []*types.JobRequestInfo {
+	running := make(map[string]bool)
+	forked := make([]*types.JobRequestInfo, 0)
+	for _, req := range requests {
+		if req.ParentID == "" {
+			continue
+		}
+		parentRunning, ok := running[req.ParentID]
+		if !ok {
+			parent, err := js.jobManager.GetJobRequest(common.NewQueryContext(nil, ""), req.ParentID)
+			parentRunning = err == nil && parent.JobState.Running()
+			running[req.ParentID] = parentRunning
+		}
+		if parentRunning {
+			forked = append(forked, req)
+		}
+	}
+	return forked
+}
+
 // scheduling the job for execution if resources are available to execute it
 func (js *JobScheduler) scheduleJob(
 	ctx context.Context,
@@ -299,55 +350,14 @@ func (js *JobScheduler) scheduleJob(
 
 	// Check to make sure we have ants connected to execute job for task methods/tags
 	if err = jobStateMachine.CheckAntResourcesAndConcurrencyForJob(); err != nil {
-		if request.ScheduleAttempts+1 > js.serverCfg.Jobs.MaxScheduleAttempts {
-			// changing state from PENDING to FAILED
-			return jobStateMachine.ScheduleFailed(
-				ctx,
-				fmt.Errorf("allocation failed due to %w, max schedule attempts exceeded %d", err, request.ScheduleAttempts),
-				common.ErrorAntResources)
-		}
-		decrPriority := 0
-		scheduleAttempts := request.ScheduleAttempts + 1
-		scheduleSecs := math.Min(int(js.serverCfg.Jobs.NotReadyJobsMaxWait.Seconds()), scheduleAttempts*5)
-		if scheduleAttempts >= 5 && scheduleAttempts%5 == 0 && request.JobPriority > 5 {
-			// decrement priority if we can't schedule it immediately -- every 5th attempt, decrement by 1
-			decrPriority = 1
-		}
-		request.ScheduledAt = request.ScheduledAt.Add(time.Duration(scheduleSecs) * time.Second)
-		request.JobPriority = request.JobPriority - decrPriority
-		_ = js.jobManager.IncrementScheduleAttemptsForJobRequest(
-			request,
-			time.Duration(scheduleSecs)*time.Second,
-			decrPriority,
-			err.Error())
-		// will try again
-		logrus.WithFields(logrus.Fields{
-			"Component":        "JobScheduler",
-			"ID":               js.serverCfg.Common.ID,
-			"RequestID":        request.ID,
-			"JobType":          request.JobType,
-			"Organization":     request.OrganizationID,
-			"User":             request.UserID,
-			"ScheduleAttempts": request.ScheduleAttempts,
-			"Error":            err,
-		}).Warnf("failed to schedule job")
-		return fmt.Errorf("ant resources are not available for id=%s, type=%s attempts=%d priority=%d due to %v",
-			request.ID, request.JobType, scheduleAttempts, request.JobPriority-decrPriority, err)
+		return js.rescheduleJob(ctx, jobStateMachine, request, err)
 	}
 
 	// Reserve resources for tasks
 	if err = jobStateMachine.ReserveJobResources(); err != nil {
-		if request.ScheduleAttempts+1 > js.serverCfg.Jobs.MaxScheduleAttempts {
-			// changing state from PENDING to FAILED
-			return jobStateMachine.ScheduleFailed(
-				ctx,
-				fmt.Errorf("max schedule attempts exceeded %d", request.ScheduleAttempts),
-				common.ErrorAntResources,
-			)
-		}
-		// will try again
-		return fmt.Errorf("ant resources cannot be allocated for ID=%s, Kind=%s State=%s due to %v",
-			request.ID, request.JobType, request.JobState, err)
+		// resources may have been taken by other jobs since they were checked, so schedule attempts
+		// are incremented so that the job doesn't remain pending forever
+		return js.rescheduleJob(ctx, jobStateMachine, request, err)
 	}
 
 	// Creating a new job-execution
@@ -388,9 +398,59 @@ func (js *JobScheduler) scheduleJob(
 		"Priority":         request.GetJobPriority(),
 		"ScheduleAttempts": request.ScheduleAttempts,
 	}).Info("scheduling job...")
+	js.metricsRegistry.Observe(
+		"job_queue_wait_secs",
+		request.QueueWaitTime(time.Now()).Seconds(),
+		metrics.DurationBuckets,
+		map[string]string{
+			"Org": request.OrganizationID,
+			"Job": request.JobType})
 	return nil
 }
 
+// rescheduleJob bumps schedule time and attempts of the job whose ant resources are not available
+// and fails it after max schedule attempts
+func (js *JobScheduler) rescheduleJob(
+	ctx context.Context,
+	jobStateMachine *fsm.JobExecutionStateMachine,
+	request *types.JobRequestInfo,
+	err error) error {
+	if request.ScheduleAttempts+1 > js.serverCfg.Jobs.MaxScheduleAttempts {
+		// changing state from PENDING to FAILED
+		return jobStateMachine.ScheduleFailed(
+			ctx,
+			fmt.Errorf("allocation failed due to %w, max schedule attempts exceeded %d", err, request.ScheduleAttempts),
+			common.ErrorAntResources)
+	}
+	decrPriority := 0
+	scheduleAttempts := request.ScheduleAttempts + 1
+	scheduleSecs := math.Min(int(js.serverCfg.Jobs.NotReadyJobsMaxWait.Seconds()), scheduleAttempts*5)
+	if scheduleAttempts >= 5 && scheduleAttempts%5 == 0 && request.JobPriority > 5 {
+		// decrement priority if we can't schedule it immediately -- every 5th attempt, decrement by 1
+		decrPriority = 1
+	}
+	request.ScheduledAt = request.ScheduledAt.Add(time.Duration(scheduleSecs) * time.Second)
+	request.JobPriority = request.JobPriority - decrPriority
+	_ = js.jobManager.IncrementScheduleAttemptsForJobRequest(
+		request,
+		time.Duration(scheduleSecs)*time.Second,
+		decrPriority,
+		err.Error())
+	// will try again
+	logrus.WithFields(logrus.Fields{
+		"Component":        "JobScheduler",
+		"ID":               js.serverCfg.Common.ID,
+		"RequestID":        request.ID,
+		"JobType":          request.JobType,
+		"Organization":     request.OrganizationID,
+		"User":             request.UserID,
+		"ScheduleAttempts": request.ScheduleAttempts,
+		"Error":            err,
+	}).Warnf("failed to schedule job")
+	return fmt.Errorf("ant resources are not available for id=%s, type=%s attempts=%d priority=%d due to %v",
+		request.ID, request.JobType, scheduleAttempts, request.JobPriority-decrPriority, err)
+}
+
 func (js *JobScheduler) unlockWithBusy() {
 	js.busy = false
 	js.lock.Unlock()
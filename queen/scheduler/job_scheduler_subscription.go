@@ -160,6 +160,39 @@ func (js *JobScheduler) startTickerToRunRetention(ctx context.Context) *time.Tic
 	return ticker
 }
 
+// startTickerToCheckSLAs records and notifies breached SLAs of jobs and tasks.
+func (js *JobScheduler) startTickerToCheckSLAs(ctx context.Context) *time.Ticker {
+	interval := js.serverCfg.Jobs.SLACheckInterval
+	ticker := time.NewTicker(interval)
+	go func() {
+		for !js.isStopped() {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-js.done:
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				// requests that finished between ticks are checked once more, breaches are only recorded once
+				total, err := js.jobManager.CheckSLABreaches(time.Now().Add(-2 * interval))
+				if err != nil {
+					logrus.WithFields(logrus.Fields{
+						"Component": "JobScheduler",
+						"Error":     err,
+					}).Warn("failed to check SLA breaches")
+				} else if total > 0 {
+					logrus.WithFields(logrus.Fields{
+						"Component": "JobScheduler",
+						"Total":     total,
+					}).Info("found SLA breaches")
+				}
+			}
+		}
+	}()
+	return ticker
+}
+
 // startTickerToCheckApprovalSLAs fires HandleSLABreach for each overdue approval deadline.
 func (js *JobScheduler) startTickerToCheckApprovalSLAs(ctx context.Context) *time.Ticker {
 	ticker := time.NewTicker(js.serverCfg.Jobs.ApprovalSLACheckInterval)
@@ -243,7 +276,9 @@ func (js *JobScheduler) subscribeToJobSchedulerLeader(ctx context.Context) (stri
 			}).Error("failed to unmarshal job scheduler leader event")
 		}
 
+		js.lock.Lock()
 		js.lastJobSchedulerLeaderEventAt = time.Now()
+		js.lock.Unlock()
 		if logrus.IsLevelEnabled(logrus.DebugLevel) {
 			logrus.WithFields(logrus.Fields{
 				"jobSchedulerLeaderEvent": jobSchedulerLeaderEvent,
@@ -369,5 +404,8 @@ func (js *JobScheduler) scheduleCronTriggeredJobs(_ context.Context) (err error)
 			}
 		}
 	}
+	if err = js.jobManager.ProcessCronBackfills(); err != nil {
+		return fmt.Errorf("failed to process cron backfills due to %w", err)
+	}
 	return nil
 }
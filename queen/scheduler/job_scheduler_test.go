@@ -4,13 +4,16 @@ import (
 	"context"
 	"time"
 
+	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
 	"plexobject.com/formicary/internal/health"
 	"plexobject.com/formicary/internal/metrics"
 	"plexobject.com/formicary/internal/queue"
+	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/config"
 	"plexobject.com/formicary/queen/manager"
 	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
 	"testing"
 )
 
@@ -29,6 +32,74 @@ func Test_ShouldStartAndStopJobScheduler(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldNotScheduleJobsInMaintenanceMode(t *testing.T) {
+	serverCfg := config.TestServerConfig()
+	// GIVEN job scheduler with maintenance mode enabled
+	scheduler := newTestJobScheduler(t, serverCfg)
+	sysConfigRepo, err := repository.NewTestSystemConfigRepository()
+	require.NoError(t, err)
+	scheduler.maintenanceManager, err = manager.NewMaintenanceManager(sysConfigRepo, metrics.New())
+	require.NoError(t, err)
+	_, err = scheduler.maintenanceManager.Update(common.NewQueryContextFromIDs("", "").WithAdmin(), true, "upgrade")
+	require.NoError(t, err)
+
+	// WHEN scheduling pending jobs
+	err = scheduler.schedulePendingJobs(context.Background())
+
+	// THEN scheduling should be skipped
+	require.ErrorContains(t, err, "maintenance mode")
+
+	// WHEN maintenance mode is disabled
+	_, err = scheduler.maintenanceManager.Update(common.NewQueryContextFromIDs("", "").WithAdmin(), false, "")
+	require.NoError(t, err)
+	err = scheduler.schedulePendingJobs(context.Background())
+
+	// THEN scheduling should not be skipped for maintenance
+	if err != nil {
+		require.NotContains(t, err.Error(), "maintenance mode")
+	}
+}
+
+func Test_ShouldScheduleJobsForkedByRunningJobsInMaintenanceMode(t *testing.T) {
+	serverCfg := config.TestServerConfig()
+	// GIVEN job scheduler
+	scheduler := newTestJobScheduler(t, serverCfg)
+	jobRequestRepo, err := repository.NewTestJobRequestRepository()
+	require.NoError(t, err)
+	qc, err := repository.NewTestQC()
+	require.NoError(t, err)
+	job, err := scheduler.jobManager.SaveJobDefinition(
+		qc, repository.NewTestJobDefinition(qc.User, "maintenance-fork-"+ulid.Make().String()))
+	require.NoError(t, err)
+	saveRequest := func(parentID string, state common.RequestState) *types.JobRequest {
+		request, err := types.NewJobRequestFromDefinition(job)
+		require.NoError(t, err)
+		request.UserKey = ulid.Make().String()
+		request.ParentID = parentID
+		saved, err := jobRequestRepo.Save(qc, request)
+		require.NoError(t, err)
+		require.NoError(t, jobRequestRepo.UpdateJobState(saved.ID, "", state, "", "", 0, 0, 0))
+		return saved
+	}
+	// AND pending requests forked by a running and a completed job along with a request without parent
+	running := saveRequest("", common.EXECUTING)
+	completed := saveRequest("", common.COMPLETED)
+	forkedByRunning := saveRequest(running.ID, common.PENDING)
+	_ = saveRequest(completed.ID, common.PENDING)
+	_ = saveRequest("", common.PENDING)
+	requests, err := scheduler.jobManager.NextSchedulableJobRequestsByType(
+		[]string{job.JobType}, []common.RequestState{common.PENDING}, 100)
+	require.NoError(t, err)
+	require.Len(t, requests, 3)
+
+	// WHEN filtering pending requests during maintenance
+	forked := scheduler.forkedByRunningJobs(requests)
+
+	// THEN only request forked by the running job should be scheduled
+	require.Len(t, forked, 1)
+	require.Equal(t, forkedByRunning.ID, forked[0].ID)
+}
+
 // Test_ShouldWakeSchedulerOnTriggerChannel verifies that a send on triggerCh causes the
 // scheduling loop to consume the signal before the next regular tick fires.
 // Strategy: we set a 30s tick so any drain must come from the triggerCh case.
@@ -58,6 +129,7 @@ func Test_ShouldWakeSchedulerOnTriggerChannel(t *testing.T) {
 		metrics.New(),
 		nil,
 		nil,
+		nil,
 		triggerCh,
 	)
 
@@ -98,6 +170,7 @@ func newTestJobScheduler(t *testing.T, serverCfg *config.ServerConfig) *JobSched
 		metrics.New(),
 		nil, // approvalSvc - not needed for unit tests
 		nil, // retentionManager - not needed for unit tests
+		nil, // maintenanceManager - not needed for unit tests
 		nil, // no external triggerCh in unit tests
 	)
 	return scheduler
@@ -31,6 +31,7 @@ import (
 	"plexobject.com/formicary/queen/repository"
 	"plexobject.com/formicary/queen/resource"
 	"plexobject.com/formicary/queen/security"
+	"plexobject.com/formicary/queen/slack"
 	queenService "plexobject.com/formicary/queen/service"
 	"plexobject.com/formicary/queen/stats"
 	"plexobject.com/formicary/queen/tasklet/wstask"
@@ -65,6 +66,7 @@ func StartWebServer(
 	userManager *manager.UserManager,
 	jobManager *manager.JobManager,
 	retentionManager *manager.RetentionManager,
+	maintenanceManager *manager.MaintenanceManager,
 	dashboardStats *manager.DashboardManager,
 	resourceManager resource.Manager,
 	requestRegistry tasklet.RequestRegistry,
@@ -98,7 +100,7 @@ func StartWebServer(
 		return err
 	}
 
-	startControllers(serverCfg, repoFactory, userManager, jobManager,
+	startControllers(serverCfg, repoFactory, userManager, jobManager, maintenanceManager,
 		resourceManager, artifactManager, statsRegistry, healthMonitor, webServer)
 	startAdminControllers(serverCfg, repoFactory, userManager, jobManager,
 		retentionManager, dashboardStats, resourceManager, artifactManager, statsRegistry,
@@ -181,6 +183,8 @@ func buildServices(
 ) *services {
 	triggerEvaluator := trigger.NewEvaluator(repoFactory.TriggerStateRepository)
 	triggerSubmitter := trigger.NewSubmitter(jobManager, repoFactory.TriggerStateRepository)
+	triggerDeadLetters := trigger.NewDeadLetters(
+		repoFactory.TriggerDeadLetterRepository, triggerEvaluator, triggerSubmitter)
 	return &services{
 		jobDef:    queenService.NewJobDefinitionService(jobManager),
 		jobExec:   queenService.NewJobExecutionService(jobManager),
@@ -194,7 +198,7 @@ func buildServices(
 		jobRes:    queenService.NewJobResourceService(repoFactory.JobResourceRepository),
 		health:    queenService.NewHealthService(dashboardStats),
 		admin:     queenService.NewAdminService(dashboardStats, userManager),
-		triggers:  queenService.NewTriggerService(jobManager, repoFactory.TriggerStateRepository, triggerEvaluator, triggerSubmitter),
+		triggers:  queenService.NewTriggerService(jobManager, repoFactory.TriggerStateRepository, triggerEvaluator, triggerSubmitter, triggerDeadLetters),
 	}
 }
 
@@ -336,10 +340,17 @@ func buildMethodPermissions() map[string]*acl.Permission {
 	} {
 		p[m] = acl.NewPermission(acl.JobRequest, acl.Execute)
 	}
-	p[svcpb.JobExecutionService_VoteOnApproval_FullMethodName] = acl.NewPermission(acl.JobRequest, acl.Approve)
+	for _, m := range []string{
+		svcpb.JobExecutionService_VoteOnApproval_FullMethodName,
+		svcpb.JobExecutionService_CreateApprovalDelegation_FullMethodName,
+		svcpb.JobExecutionService_RevokeApprovalDelegation_FullMethodName,
+	} {
+		p[m] = acl.NewPermission(acl.JobRequest, acl.Approve)
+	}
 	for _, m := range []string{
 		svcpb.JobExecutionService_GetApprovalStatus_FullMethodName,
 		svcpb.JobExecutionService_ListPendingApprovals_FullMethodName,
+		svcpb.JobExecutionService_ListApprovalDelegations_FullMethodName,
 	} {
 		p[m] = acl.NewPermission(acl.JobRequest, acl.View)
 	}
@@ -457,6 +468,9 @@ func buildMethodPermissions() map[string]*acl.Permission {
 	p[svcpb.TriggerService_ListTriggerStates_FullMethodName] = acl.NewPermission(acl.JobDefinition, acl.View)
 	p[svcpb.TriggerService_ResetTriggerState_FullMethodName] = acl.NewPermission(acl.JobDefinition, acl.Write)
 	p[svcpb.TriggerService_FireWebhookTrigger_FullMethodName] = acl.NewPermission(acl.JobRequest, acl.Execute)
+	p[svcpb.TriggerService_ListTriggerDeadLetters_FullMethodName] = acl.NewPermission(acl.JobDefinition, acl.View)
+	p[svcpb.TriggerService_ReplayTriggerDeadLetters_FullMethodName] = acl.NewPermission(acl.JobRequest, acl.Execute)
+	p[svcpb.TriggerService_DiscardTriggerDeadLetters_FullMethodName] = acl.NewPermission(acl.JobDefinition, acl.Write)
 
 	return p
 }
@@ -484,6 +498,7 @@ func startTriggerManager(
 	}
 	evaluator := trigger.NewEvaluator(repoFactory.TriggerStateRepository)
 	submitter := trigger.NewSubmitter(jobManager, repoFactory.TriggerStateRepository)
+	deadLetters := trigger.NewDeadLetters(repoFactory.TriggerDeadLetterRepository, evaluator, submitter)
 	webhookHandler := trigger.NewWebhookHandler(
 		jobManager, evaluator, submitter,
 		serverCfg.Jobs.TriggerWebhookBodyMaxBytes,
@@ -496,6 +511,7 @@ func startTriggerManager(
 		repoFactory.TriggerStateRepository,
 		evaluator,
 		submitter,
+		deadLetters,
 		webhookHandler,
 	)
 	return mgr, mgr.Start(ctx)
@@ -542,6 +558,7 @@ func startControllers(
 	repoFactory *repository.Locator,
 	userManager *manager.UserManager,
 	jobManager *manager.JobManager,
+	maintenanceManager *manager.MaintenanceManager,
 	resourceManager resource.Manager,
 	artifactManager *manager.ArtifactManager,
 	statsRegistry *stats.JobStatsRegistry,
@@ -562,7 +579,13 @@ func startControllers(
 	controller.NewSystemConfigController(repoFactory.SystemConfigRepository, webServer)
 	controller.NewErrorCodeController(repoFactory.ErrorCodeRepository, webServer)
 	controller.NewJobRequestController(jobManager, webServer)
+	controller.NewCronBackfillController(jobManager, webServer)
+	controller.NewSLAController(jobManager, webServer)
+	controller.NewFlakyTaskController(jobManager, webServer)
+	controller.NewTaskRightSizingController(jobManager, webServer)
+	controller.NewCostController(jobManager, webServer)
 	controller.NewAntRegistrationController(resourceManager, webServer)
+	controller.NewMaintenanceController(maintenanceManager, webServer)
 	controller.NewArtifactController(artifactManager, webServer)
 	controller.NewContainerExecutionController(resourceManager, webServer)
 	controller.NewHealthController(healthMonitor, webServer)
@@ -573,6 +596,7 @@ func startControllers(
 		repoFactory.AuditRecordRepository,
 		webServer)
 	controller.NewEmailVerificationController(userManager, webServer)
+	slack.NewInteractionHandler(cfg, jobManager, userManager, webServer)
 }
 
 func startAdminControllers(
@@ -616,5 +640,6 @@ func startAdminControllers(
 	admin.NewExecutionContainerAdminController(resourceManager, webServer)
 	admin.NewHealthAdminController(healthMonitor, webServer)
 	admin.NewEmailVerificationAdminController(userManager, webServer)
+	admin.NewApprovalActionAdminController(jobManager, webServer)
 	admin.NewRetentionAdminController(retentionManager, webServer)
 }
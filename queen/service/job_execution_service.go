@@ -166,12 +166,13 @@ func (s *JobExecutionService) VoteOnApproval(ctx context.Context, req *svcpb.Vot
 		return nil, status.Error(codes.InvalidArgument, "vote is required")
 	}
 	voteReq := &queenTypes.ApprovalVoteRequest{
-		RequestID: req.RequestId,
-		TaskType:  req.TaskType,
-		VoterID:   qc.GetUserID(),
-		VoterName: req.Vote.VoterName,
-		Decision:  queenTypes.ApprovalDecision(req.Vote.Decision),
-		Comments:  req.Vote.Comments,
+		RequestID:  req.RequestId,
+		TaskType:   req.TaskType,
+		VoterID:    qc.GetUserID(),
+		VoterName:  req.Vote.VoterName,
+		Decision:   queenTypes.ApprovalDecision(req.Vote.Decision),
+		Comments:   req.Vote.Comments,
+		OnBehalfOf: req.Vote.OnBehalfOf,
 	}
 	approvalStatus, err := s.jobManager.CastApprovalVote(ctx, qc, voteReq)
 	if err != nil {
@@ -221,6 +222,64 @@ func (s *JobExecutionService) ListPendingApprovals(ctx context.Context, req *svc
 	return resp, nil
 }
 
+func (s *JobExecutionService) ListApprovalDelegations(ctx context.Context, _ *svcpb.ListApprovalDelegationsRequest) (*svcpb.ListApprovalDelegationsResponse, error) {
+	qc := interceptors.QueryContextFromContext(ctx)
+	if qc == nil {
+		return nil, status.Error(codes.Unauthenticated, "no query context")
+	}
+	delegations, err := s.jobManager.ListApprovalDelegations(qc)
+	if err != nil {
+		return nil, interceptors.MapDomainError(err)
+	}
+	resp := &svcpb.ListApprovalDelegationsResponse{}
+	for _, d := range delegations {
+		resp.Delegations = append(resp.Delegations, toProtoApprovalDelegation(d))
+	}
+	return resp, nil
+}
+
+func (s *JobExecutionService) CreateApprovalDelegation(ctx context.Context, req *svcpb.CreateApprovalDelegationRequest) (*svcpb.CreateApprovalDelegationResponse, error) {
+	qc := interceptors.QueryContextFromContext(ctx)
+	if qc == nil {
+		return nil, status.Error(codes.Unauthenticated, "no query context")
+	}
+	delegation := &queenTypes.ApprovalDelegation{
+		DelegateID: req.DelegateId,
+		StartsAt:   time.Now(),
+		Reason:     req.Reason,
+	}
+	if req.StartsAt != "" {
+		t, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid starts_at: %v", err)
+		}
+		delegation.StartsAt = t
+	}
+	t, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ends_at: %v", err)
+	}
+	delegation.EndsAt = t
+	saved, err := s.jobManager.CreateApprovalDelegation(qc, delegation)
+	if err != nil {
+		return nil, interceptors.MapDomainError(err)
+	}
+	return &svcpb.CreateApprovalDelegationResponse{
+		Delegation: toProtoApprovalDelegation(saved),
+	}, nil
+}
+
+func (s *JobExecutionService) RevokeApprovalDelegation(ctx context.Context, req *svcpb.RevokeApprovalDelegationRequest) (*emptypb.Empty, error) {
+	qc := interceptors.QueryContextFromContext(ctx)
+	if qc == nil {
+		return nil, status.Error(codes.Unauthenticated, "no query context")
+	}
+	if err := s.jobManager.RevokeApprovalDelegation(qc, req.Id); err != nil {
+		return nil, interceptors.MapDomainError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *JobExecutionService) GetJobWaitTime(ctx context.Context, req *svcpb.GetJobRequestRequest) (*svcpb.JobWaitTimeResponse, error) {
 	qc := interceptors.QueryContextFromContext(ctx)
 	if qc == nil {
@@ -281,6 +340,7 @@ func toProtoApprovalStatus(s *queenTypes.ApprovalStatus) *protoQueen.ApprovalSta
 		QuorumReached:     s.QuorumReached,
 		Rejected:          s.Rejected,
 		SlaBreached:       s.SLABreached,
+		CurrentStage:      s.CurrentStage,
 	}
 	if s.Deadline != nil {
 		p.Deadline = timestamppb.New(*s.Deadline)
@@ -291,6 +351,15 @@ func toProtoApprovalStatus(s *queenTypes.ApprovalStatus) *protoQueen.ApprovalSta
 	if s.Policy != nil {
 		p.Policy = toProtoApprovalPolicy(s.Policy)
 	}
+	for _, st := range s.Stages {
+		p.Stages = append(p.Stages, &protoQueen.ApprovalStageStatus{
+			Name:                 st.Name,
+			ApprovalsReceived:    int32(st.ApprovalsReceived),
+			RejectionsReceived:   int32(st.RejectionsReceived),
+			MinApprovalsRequired: int32(st.MinApprovalsRequired),
+			Completed:            st.Completed,
+		})
+	}
 	return p
 }
 
@@ -307,6 +376,8 @@ func toProtoApprovalVote(v *queenTypes.ApprovalVote) *protoQueen.ApprovalVote {
 		Decision:        string(v.Decision),
 		Comments:        v.Comments,
 		VotedAt:         timestamppb.New(v.VotedAt),
+		OnBehalfOf:      v.OnBehalfOf,
+		Stage:           v.Stage,
 	}
 }
 
@@ -327,6 +398,39 @@ func toProtoApprovalPolicy(p *queenTypes.ApprovalPolicy) *protoQueen.ApprovalPol
 		EscalationMessage:    p.EscalationMessage,
 		CreatedAt:            timestamppb.New(p.CreatedAt),
 		UpdatedAt:            timestamppb.New(p.UpdatedAt),
+		EscalationVoting:     p.EscalationVoting,
+		Stages:               toProtoApprovalStages(p.Stages),
+	}
+}
+
+func toProtoApprovalStages(stages []*queenTypes.ApprovalStage) []*protoQueen.ApprovalStage {
+	var out []*protoQueen.ApprovalStage
+	for _, st := range stages {
+		out = append(out, &protoQueen.ApprovalStage{
+			Name:             st.Name,
+			MinApprovals:     int32(st.MinApprovals),
+			AllowedRoles:     st.AllowedRoles,
+			AllowedUsers:     st.AllowedUsers,
+			RequireUnanimous: st.RequireUnanimous,
+		})
+	}
+	return out
+}
+
+func toProtoApprovalDelegation(d *queenTypes.ApprovalDelegation) *protoQueen.ApprovalDelegation {
+	if d == nil {
+		return nil
+	}
+	return &protoQueen.ApprovalDelegation{
+		Id:             d.ID,
+		DelegatorId:    d.DelegatorID,
+		DelegatorName:  d.DelegatorName,
+		DelegateId:     d.DelegateID,
+		OrganizationId: d.OrganizationID,
+		StartsAt:       timestamppb.New(d.StartsAt),
+		EndsAt:         timestamppb.New(d.EndsAt),
+		Reason:         d.Reason,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
 	}
 }
 
@@ -27,6 +27,7 @@ type TriggerService struct {
 	triggerRepo repository.TriggerStateRepository
 	evaluator   *trigger.Evaluator
 	submitter   *trigger.Submitter
+	deadLetters *trigger.DeadLetters
 }
 
 // NewTriggerService constructs a TriggerService.
@@ -35,12 +36,14 @@ func NewTriggerService(
 	triggerRepo repository.TriggerStateRepository,
 	evaluator *trigger.Evaluator,
 	submitter *trigger.Submitter,
+	deadLetters *trigger.DeadLetters,
 ) *TriggerService {
 	return &TriggerService{
 		jobManager:  jobManager,
 		triggerRepo: triggerRepo,
 		evaluator:   evaluator,
 		submitter:   submitter,
+		deadLetters: deadLetters,
 	}
 }
 
@@ -149,6 +152,88 @@ func (s *TriggerService) FireWebhookTrigger(ctx context.Context, req *svcpb.Fire
 	return &svcpb.FireWebhookTriggerResponse{RequestId: reqID}, nil
 }
 
+// ListTriggerDeadLetters returns queue and S3 notification events that failed to submit a job request.
+func (s *TriggerService) ListTriggerDeadLetters(ctx context.Context, req *svcpb.ListTriggerDeadLettersRequest) (*svcpb.ListTriggerDeadLettersResponse, error) {
+	qc := interceptors.QueryContextFromContext(ctx)
+	if qc == nil {
+		return nil, status.Error(codes.Unauthenticated, "no query context")
+	}
+	def, err := s.jobManager.GetJobDefinitionByType(qc, req.GetJobType(), "")
+	if err != nil {
+		return nil, interceptors.MapDomainError(err)
+	}
+	dls, err := s.deadLetters.Query(def, req.GetTriggerName(), req.GetState(), int(req.GetLimit()))
+	if err != nil {
+		return nil, interceptors.MapDomainError(err)
+	}
+	return &svcpb.ListTriggerDeadLettersResponse{
+		DeadLetters: toProtoTriggerDeadLetters(dls),
+	}, nil
+}
+
+// ReplayTriggerDeadLetters submits job requests of failed events with the current trigger definition.
+// Events that fail again are reported in the response and stay in dead-letters.
+func (s *TriggerService) ReplayTriggerDeadLetters(ctx context.Context, req *svcpb.TriggerDeadLettersRequest) (*svcpb.ReplayTriggerDeadLettersResponse, error) {
+	def, dls, err := s.selectDeadLetters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &svcpb.ReplayTriggerDeadLettersResponse{
+		RequestIds: make([]string, 0),
+		Failed:     make(map[string]string),
+	}
+	for _, dl := range dls {
+		savedReq, err := s.deadLetters.Replay(ctx, def, dl)
+		if err != nil {
+			res.Failed[dl.ID] = err.Error()
+			continue
+		}
+		res.Replayed++
+		if savedReq != nil {
+			res.RequestIds = append(res.RequestIds, savedReq.ID)
+		}
+	}
+	return res, nil
+}
+
+// DiscardTriggerDeadLetters marks failed events as discarded.
+func (s *TriggerService) DiscardTriggerDeadLetters(ctx context.Context, req *svcpb.TriggerDeadLettersRequest) (*svcpb.DiscardTriggerDeadLettersResponse, error) {
+	_, dls, err := s.selectDeadLetters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &svcpb.DiscardTriggerDeadLettersResponse{}
+	for _, dl := range dls {
+		if err := s.deadLetters.Discard(dl); err != nil {
+			return nil, interceptors.MapDomainError(err)
+		}
+		res.Discarded++
+	}
+	return res, nil
+}
+
+// selectDeadLetters finds dead-letters by ids or all failed dead-letters of the job or trigger.
+func (s *TriggerService) selectDeadLetters(
+	ctx context.Context,
+	req *svcpb.TriggerDeadLettersRequest) (*types.JobDefinition, []*types.TriggerDeadLetter, error) {
+	qc := interceptors.QueryContextFromContext(ctx)
+	if qc == nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "no query context")
+	}
+	if len(req.GetIds()) == 0 && !req.GetAll() {
+		return nil, nil, status.Error(codes.InvalidArgument, "ids or all must be specified")
+	}
+	def, err := s.jobManager.GetJobDefinitionByType(qc, req.GetJobType(), "")
+	if err != nil {
+		return nil, nil, interceptors.MapDomainError(err)
+	}
+	dls, err := s.deadLetters.Select(def, req.GetIds(), req.GetTriggerName())
+	if err != nil {
+		return nil, nil, interceptors.MapDomainError(err)
+	}
+	return def, dls, nil
+}
+
 // toProtoTriggerStates maps internal GORM TriggerState rows to proto TriggerState messages.
 func toProtoTriggerStates(states []*types.TriggerState) []*queenpb.TriggerState {
 	out := make([]*queenpb.TriggerState, 0, len(states))
@@ -180,3 +265,37 @@ func toProtoTriggerState(s *types.TriggerState) *queenpb.TriggerState {
 	}
 	return ps
 }
+
+// toProtoTriggerDeadLetters maps internal GORM TriggerDeadLetter rows to proto TriggerDeadLetter messages.
+func toProtoTriggerDeadLetters(dls []*types.TriggerDeadLetter) []*queenpb.TriggerDeadLetter {
+	out := make([]*queenpb.TriggerDeadLetter, 0, len(dls))
+	for _, dl := range dls {
+		out = append(out, toProtoTriggerDeadLetter(dl))
+	}
+	return out
+}
+
+func toProtoTriggerDeadLetter(dl *types.TriggerDeadLetter) *queenpb.TriggerDeadLetter {
+	pdl := &queenpb.TriggerDeadLetter{
+		Id:              dl.ID,
+		JobDefinitionId: dl.JobDefinitionID,
+		JobType:         dl.JobType,
+		TriggerName:     dl.TriggerName,
+		TriggerType:     dl.TriggerType,
+		Payload:         dl.Payload,
+		ErrorMessage:    dl.ErrorMessage,
+		State:           dl.State,
+		Attempts:        int32(dl.Attempts),
+		JobRequestId:    dl.JobRequestID,
+	}
+	if !dl.ReplayedAt.IsZero() {
+		pdl.ReplayedAt = timestamppb.New(dl.ReplayedAt)
+	}
+	if !dl.CreatedAt.IsZero() {
+		pdl.CreatedAt = timestamppb.New(dl.CreatedAt)
+	}
+	if !dl.UpdatedAt.IsZero() {
+		pdl.UpdatedAt = timestamppb.New(dl.UpdatedAt)
+	}
+	return pdl
+}
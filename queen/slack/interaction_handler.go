@@ -0,0 +1,170 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/slack-go/slack"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/internal/web"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+const (
+	// ApprovalCallbackID identifies slack messages with approve/reject buttons
+	ApprovalCallbackID = "approval_vote"
+	// ApproveActionName name of the approve button
+	ApproveActionName = "approve"
+	// RejectActionName name of the reject button
+	RejectActionName = "reject"
+
+	interactionBodyMaxBytes = 64 * 1024
+)
+
+// EmailLookup returns the email of a slack user using the slack token of the organization
+type EmailLookup func(token string, slackUserID string) (string, error)
+
+// InteractionHandler handles clicks on approve/reject buttons of slack notifications. Slack signs
+// each request with the signing secret of the slack app, which is verified before the vote is cast
+// as the formicary user with the same email as the slack user who clicked.
+type InteractionHandler struct {
+	cfg         *config.ServerConfig
+	jobManager  *manager.JobManager
+	userManager *manager.UserManager
+	emailLookup EmailLookup
+}
+
+// NewInteractionHandler constructor that registers the slack interactivity endpoint
+func NewInteractionHandler(
+	cfg *config.ServerConfig,
+	jobManager *manager.JobManager,
+	userManager *manager.UserManager,
+	webServer web.Server,
+) *InteractionHandler {
+	h := &InteractionHandler{
+		cfg:         cfg,
+		jobManager:  jobManager,
+		userManager: userManager,
+		emailLookup: lookupEmail,
+	}
+	// no auth middleware, requests are verified with the slack signing secret
+	webServer.POST("/slack/interactions", h.handleInteraction, nil).Name = "slack_interactions"
+	return h
+}
+
+func (h *InteractionHandler) handleInteraction(c web.APIContext) error {
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, interactionBodyMaxBytes))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "failed to read request body"})
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid form body"})
+	}
+	var callback slack.InteractionCallback
+	if err = json.Unmarshal([]byte(values.Get("payload")), &callback); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid payload"})
+	}
+	if callback.CallbackID != ApprovalCallbackID || len(callback.ActionCallback.AttachmentActions) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "unsupported interaction"})
+	}
+	token := callback.ActionCallback.AttachmentActions[0].Value
+
+	// the signed token identifies the job and thus the organization whose slack app sent the request
+	claims, request, err := h.jobManager.GetApprovalAction(token)
+	if err != nil {
+		return c.JSON(http.StatusOK, ephemeral(fmt.Sprintf("Cannot vote: %s", err)))
+	}
+	var org *common.Organization
+	if request.OrganizationID != "" {
+		if org, err = h.userManager.GetOrganization(
+			common.NewQueryContextFromIDs("", "").WithAdmin(), request.OrganizationID); err != nil {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "organization not found"})
+		}
+	}
+	if err = h.verify(c.Request().Header, body, org); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"Component": "SlackInteractionHandler",
+			"RequestID": claims.RequestID,
+			"Error":     err,
+		}).Warn("failed to verify slack interaction")
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "authentication failed"})
+	}
+
+	var slackToken string
+	if org != nil {
+		slackToken = org.GetConfigString(types.SlackToken)
+	}
+	email, err := h.emailLookup(slackToken, callback.User.ID)
+	if err != nil {
+		return c.JSON(http.StatusOK, ephemeral(fmt.Sprintf("Cannot identify you in formicary: %s", err)))
+	}
+	status, _, err := h.jobManager.CastApprovalVoteFromAction(c.Request().Context(), token, email, "slack")
+	if err != nil {
+		return c.JSON(http.StatusOK, ephemeral(fmt.Sprintf("Cannot vote on %s: %s", claims.RequestID, err)))
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component": "SlackInteractionHandler",
+		"RequestID": claims.RequestID,
+		"TaskType":  claims.TaskType,
+		"Decision":  claims.Decision,
+		"Email":     email,
+	}).Info("approval vote cast from slack")
+	return c.JSON(http.StatusOK, ephemeral(voteSummary(claims, status)))
+}
+
+// verify checks the slack signature using the signing secret of the organization, falling back
+// to the signing secret of the server.
+func (h *InteractionHandler) verify(header http.Header, body []byte, org *common.Organization) error {
+	secret := h.cfg.Notify.SlackSigningSecret
+	if org != nil && org.GetConfigString(types.SlackSigningSecret) != "" {
+		secret = org.GetConfigString(types.SlackSigningSecret)
+	}
+	if secret == "" {
+		return fmt.Errorf("slack signing secret is not configured")
+	}
+	verifier, err := slack.NewSecretsVerifier(header, strings.TrimSpace(secret))
+	if err != nil {
+		return err
+	}
+	if _, err = verifier.Write(body); err != nil {
+		return err
+	}
+	return verifier.Ensure()
+}
+
+func voteSummary(claims *types.ApprovalActionClaims, status *types.ApprovalStatus) string {
+	if status.QuorumReached {
+		return fmt.Sprintf("Recorded your %s vote, %s of %s is approved.", claims.Decision, claims.TaskType, claims.RequestID)
+	}
+	if status.Rejected {
+		return fmt.Sprintf("Recorded your %s vote, %s of %s is rejected.", claims.Decision, claims.TaskType, claims.RequestID)
+	}
+	return fmt.Sprintf("Recorded your %s vote on %s of %s, %d of %d approvals received.",
+		claims.Decision, claims.TaskType, claims.RequestID, status.ApprovalsReceived, status.MinApprovalsRequired)
+}
+
+func ephemeral(text string) *slack.Msg {
+	return &slack.Msg{ResponseType: slack.ResponseTypeEphemeral, ReplaceOriginal: false, Text: text}
+}
+
+func lookupEmail(token string, slackUserID string) (string, error) {
+	if token == "" {
+		return "", fmt.Errorf("SlackToken is not found in organization config")
+	}
+	user, err := slack.New(strings.TrimSpace(token)).GetUserInfo(slackUserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find slack user %s due to %w", slackUserID, err)
+	}
+	if user.Profile.Email == "" {
+		return "", fmt.Errorf("slack user %s has no email", slackUserID)
+	}
+	return user.Profile.Email, nil
+}
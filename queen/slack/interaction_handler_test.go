@@ -0,0 +1,53 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/config"
+	"plexobject.com/formicary/queen/types"
+)
+
+func signSlackRequest(secret string, ts time.Time, body []byte) http.Header {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write([]byte(fmt.Sprintf("v0:%s:%s", timestamp, body)))
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", timestamp)
+	header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(h.Sum(nil)))
+	return header
+}
+
+func Test_ShouldVerifySlackInteractionSignature(t *testing.T) {
+	// GIVEN: a handler with a server signing secret
+	serverCfg := config.TestServerConfig()
+	serverCfg.Notify.SlackSigningSecret = "server-secret"
+	h := &InteractionHandler{cfg: serverCfg}
+	body := []byte("payload=%7B%7D")
+
+	// WHEN/THEN: request signed with the server secret is accepted
+	require.NoError(t, h.verify(signSlackRequest("server-secret", time.Now(), body), body, nil))
+	// AND: tampered body, wrong secret or replayed request are rejected
+	require.Error(t, h.verify(signSlackRequest("server-secret", time.Now(), body), []byte("payload=x"), nil))
+	require.Error(t, h.verify(signSlackRequest("other-secret", time.Now(), body), body, nil))
+	require.Error(t, h.verify(signSlackRequest("server-secret", time.Now().Add(-time.Hour), body), body, nil))
+
+	// AND: signing secret of the organization takes precedence
+	org := &common.Organization{}
+	_, err := org.AddConfig(types.SlackSigningSecret, "org-secret", true)
+	require.NoError(t, err)
+	require.NoError(t, h.verify(signSlackRequest("org-secret", time.Now(), body), body, org))
+	require.Error(t, h.verify(signSlackRequest("server-secret", time.Now(), body), body, org))
+
+	// AND: requests are rejected without a signing secret
+	serverCfg.Notify.SlackSigningSecret = ""
+	require.Error(t, h.verify(signSlackRequest("", time.Now(), body), body, nil))
+}
@@ -73,6 +73,30 @@ func (d *DefaultSlackSender) SendMessage(
 		},
 		}
 	}
+	if opts[types.ApproveAction] != nil && opts[types.RejectAction] != nil {
+		attachment.CallbackID = ApprovalCallbackID
+		attachment.Actions = append(attachment.Actions,
+			slack.AttachmentAction{
+				Name:  ApproveActionName,
+				Text:  "Approve",
+				Style: "primary",
+				Type:  "button",
+				Value: opts[types.ApproveAction].(string),
+			},
+			slack.AttachmentAction{
+				Name:  RejectActionName,
+				Text:  "Reject",
+				Style: "danger",
+				Type:  "button",
+				Value: opts[types.RejectAction].(string),
+				Confirm: &slack.ConfirmationField{
+					Title:       "Reject approval?",
+					Text:        "The job will fail if the approval is rejected.",
+					OkText:      "Reject",
+					DismissText: "Cancel",
+				},
+			})
+	}
 	msgOpts := []slack.MsgOption{
 		slack.MsgOptionText(subject, false),
 		slack.MsgOptionAttachments(attachment),
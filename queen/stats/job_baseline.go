@@ -0,0 +1,111 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// baselineWindow is number of recent runs kept for percentiles and failure rate of a job
+	baselineWindow = 50
+	// recentFailureWindow is number of latest runs whose failure rate is compared against older runs
+	recentFailureWindow = 10
+	// ewmaAlpha is weight of the latest duration in the moving average
+	ewmaAlpha = 0.2
+)
+
+// AnomalyThresholds defines when a run deviates significantly from the baseline of its job
+type AnomalyThresholds struct {
+	// SlowFactor flags a successful run that is slower than this multiple of the moving average and p95
+	SlowFactor float64
+	// FailureRateIncrease flags a spike when failure rate of recent runs exceeds failure rate of older runs
+	// by this fraction
+	FailureRateIncrease float64
+	// MinRuns is number of runs needed before the baseline is compared
+	MinRuns int
+}
+
+// DefaultAnomalyThresholds returns thresholds that are used unless configured otherwise
+func DefaultAnomalyThresholds() AnomalyThresholds {
+	return AnomalyThresholds{
+		SlowFactor:          2,
+		FailureRateIncrease: 0.3,
+		MinRuns:             10,
+	}
+}
+
+// JobBaseline keeps rolling baseline of durations and outcomes of recent runs of a job.
+// It's persisted with job stats so that it survives restarts of the server.
+type JobBaseline struct {
+	// Durations of recent successful runs in millis, oldest first
+	Durations []int64 `json:"durations"`
+	// Failures of recent runs, oldest first
+	Failures []bool `json:"failures"`
+	// EWMA is exponentially weighted moving average of durations of successful runs in millis
+	EWMA float64 `json:"ewma"`
+	// FailureSpike is set while failure rate stays above the baseline so that a spike is reported once
+	FailureSpike bool `json:"failure_spike"`
+}
+
+// Add adds a run to the baseline and returns whether the run was slow or started a failure spike
+// compared to the runs before it.
+func (b *JobBaseline) Add(latency int64, failed bool, t AnomalyThresholds) (slow bool, spike bool) {
+	if !failed {
+		if len(b.Durations) >= t.MinRuns && b.EWMA > 0 {
+			slow = float64(latency) > b.EWMA*t.SlowFactor && latency > b.Percentile(95)
+		}
+		if b.EWMA == 0 {
+			b.EWMA = float64(latency)
+		} else {
+			b.EWMA = ewmaAlpha*float64(latency) + (1-ewmaAlpha)*b.EWMA
+		}
+		b.Durations = append(b.Durations, latency)
+		if len(b.Durations) > baselineWindow {
+			b.Durations = b.Durations[len(b.Durations)-baselineWindow:]
+		}
+	}
+	b.Failures = append(b.Failures, failed)
+	if len(b.Failures) > baselineWindow {
+		b.Failures = b.Failures[len(b.Failures)-baselineWindow:]
+	}
+	recent, older, ok := b.FailureRates(t)
+	spiking := ok && recent-older >= t.FailureRateIncrease
+	spike = spiking && failed && !b.FailureSpike
+	b.FailureSpike = spiking
+	return
+}
+
+// Percentile returns duration of successful runs in the window at the given percentile using nearest rank
+func (b *JobBaseline) Percentile(p float64) int64 {
+	if len(b.Durations) == 0 {
+		return 0
+	}
+	sorted := make([]int64, len(b.Durations))
+	copy(sorted, b.Durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// FailureRates returns failure rate of recent runs and of older runs in the window, where ok is false
+// until there are enough older runs to compare with
+func (b *JobBaseline) FailureRates(t AnomalyThresholds) (recent float64, older float64, ok bool) {
+	if len(b.Failures) < t.MinRuns+recentFailureWindow {
+		return 0, 0, false
+	}
+	split := len(b.Failures) - recentFailureWindow
+	return failureRate(b.Failures[split:]), failureRate(b.Failures[:split]), true
+}
+
+func failureRate(failures []bool) float64 {
+	count := 0
+	for _, failed := range failures {
+		if failed {
+			count++
+		}
+	}
+	return float64(count) / float64(len(failures))
+}
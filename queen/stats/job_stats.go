@@ -55,6 +55,18 @@ type JobStats struct {
 	// JobDisabled disabled flag
 	JobDisabled bool `json:"job_disabled"`
 
+	// Baseline of recent runs for detecting anomalies
+	Baseline *JobBaseline `json:"baseline,omitempty"`
+
+	// BaselineLatency moving average of successful jobs
+	BaselineLatency float64 `json:"baseline_latency"`
+
+	// P95Latency percentile of recent successful jobs
+	P95Latency int64 `json:"p95_latency"`
+
+	// LastAnomaly detected for the job
+	LastAnomaly *types.JobAnomaly `json:"last_anomaly"`
+
 	// succeededJobsMinMax average latency
 	succeededJobsMinMax *math.RollingMinMax
 
@@ -127,6 +139,10 @@ func (j *JobStats) Calculate() {
 	j.FailedJobsAverage = j.failedJobsMinMax.Average()
 	j.FailedJobsMin = j.failedJobsMinMax.Min
 	j.FailedJobsMax = j.failedJobsMinMax.Max
+	if j.Baseline != nil {
+		j.BaselineLatency = j.Baseline.EWMA
+		j.P95Latency = j.Baseline.Percentile(95)
+	}
 	if j.SucceededJobs+j.FailedJobs > 0 {
 		j.SucceededJobsPercentages = j.SucceededJobs * 100 / (j.SucceededJobs + j.FailedJobs)
 	} else {
@@ -165,6 +181,53 @@ func (j *JobStats) SucceededJobsAverageString() string {
 	return (time.Duration(j.SucceededJobsAverage) * time.Millisecond).String()
 }
 
+// BaselineLatencyString elapsed in string format
+func (j *JobStats) BaselineLatencyString() string {
+	if j.BaselineLatency == 0 {
+		return ""
+	}
+	return (time.Duration(j.BaselineLatency) * time.Millisecond).Round(time.Millisecond).String()
+}
+
+// HasRecentAnomaly returns true if an anomaly was detected within last day
+func (j *JobStats) HasRecentAnomaly() bool {
+	return j.LastAnomaly != nil && time.Since(j.LastAnomaly.DetectedAt) < 24*time.Hour
+}
+
+// detectAnomaly adds the run to the baseline and returns anomaly if it deviates significantly from runs before it
+func (j *JobStats) detectAnomaly(
+	req types.IJobRequestSummary,
+	latency int64,
+	failed bool,
+	thresholds AnomalyThresholds) *types.JobAnomaly {
+	if j.Baseline == nil {
+		j.Baseline = &JobBaseline{}
+	}
+	ewma := j.Baseline.EWMA
+	p95 := j.Baseline.Percentile(95)
+	slow, spike := j.Baseline.Add(latency, failed, thresholds)
+	if !slow && !spike {
+		return nil
+	}
+	anomaly := &types.JobAnomaly{
+		JobRequestID:     req.GetID(),
+		JobType:          req.GetJobType(),
+		UserID:           req.GetUserID(),
+		OrganizationID:   req.GetOrganizationID(),
+		Kind:             types.AnomalySlowRun,
+		Duration:         time.Duration(latency) * time.Millisecond,
+		BaselineDuration: time.Duration(ewma) * time.Millisecond,
+		P95Duration:      time.Duration(p95) * time.Millisecond,
+		DetectedAt:       time.Now(),
+	}
+	if spike {
+		anomaly.Kind = types.AnomalyFailureSpike
+		anomaly.FailureRate, anomaly.BaselineFailureRate, _ = j.Baseline.FailureRates(thresholds)
+	}
+	j.LastAnomaly = anomaly
+	return anomaly
+}
+
 // FailedJobsAverageString elapsed in string format
 func (j *JobStats) FailedJobsAverageString() string {
 	if j.FailedJobsAverage == 0 {
@@ -23,6 +23,7 @@ type JobStatsRegistry struct {
 	countByOrg        map[string]map[string]bool
 	pendingJobsByType map[string]map[string]types.IJobRequestSummary
 	lastJobStatus     map[string]*RequestIDAndStatus
+	anomalyThresholds AnomalyThresholds
 	backend           statsBackend
 	lock              sync.RWMutex
 }
@@ -54,10 +55,18 @@ func newRegistry(b statsBackend) *JobStatsRegistry {
 		countByOrg:        make(map[string]map[string]bool),
 		pendingJobsByType: make(map[string]map[string]types.IJobRequestSummary),
 		lastJobStatus:     make(map[string]*RequestIDAndStatus),
+		anomalyThresholds: DefaultAnomalyThresholds(),
 		backend:           b,
 	}
 }
 
+// SetAnomalyThresholds overrides thresholds for detecting slow runs and failure spikes
+func (r *JobStatsRegistry) SetAnomalyThresholds(thresholds AnomalyThresholds) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.anomalyThresholds = thresholds
+}
+
 // Pending - adds pending job
 func (r *JobStatsRegistry) Pending(req types.IJobRequestSummary, reverted bool) {
 	r.lock.Lock()
@@ -130,28 +139,32 @@ func (r *JobStatsRegistry) Cancelled(req types.IJobRequestSummary) {
 	r.backend.save(req.GetUserJobTypeKey(), stats)
 }
 
-// Succeeded when job is succeeded
-func (r *JobStatsRegistry) Succeeded(req types.IJobRequestSummary, latency int64) {
+// Succeeded when job is succeeded, returns anomaly if the job was significantly slower than its baseline
+func (r *JobStatsRegistry) Succeeded(req types.IJobRequestSummary, latency int64) *types.JobAnomaly {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 	stats := r.createOrFindStat(req)
 	stats.Succeeded(latency)
+	anomaly := stats.detectAnomaly(req, latency, false, r.anomalyThresholds)
 	r.removePendingJob(req)
 	r.decrUserOrgCount(req)
 	r.lastJobStatus[req.GetUserJobTypeKey()] = &RequestIDAndStatus{requestID: req.GetID(), state: req.GetJobState()}
 	r.backend.save(req.GetUserJobTypeKey(), stats)
+	return anomaly
 }
 
-// Failed when job is failed
-func (r *JobStatsRegistry) Failed(req types.IJobRequestSummary, latency int64) {
+// Failed when job is failed, returns anomaly if failure rate of the job spiked above its baseline
+func (r *JobStatsRegistry) Failed(req types.IJobRequestSummary, latency int64) *types.JobAnomaly {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 	stats := r.createOrFindStat(req)
 	stats.Failed(latency)
+	anomaly := stats.detectAnomaly(req, latency, true, r.anomalyThresholds)
 	r.removePendingJob(req)
 	r.decrUserOrgCount(req)
 	r.lastJobStatus[req.GetUserJobTypeKey()] = &RequestIDAndStatus{requestID: req.GetID(), state: req.GetJobState()}
 	r.backend.save(req.GetUserJobTypeKey(), stats)
+	return anomaly
 }
 
 // Paused when job is paused
@@ -215,6 +228,23 @@ func (r *JobStatsRegistry) BuildWaitEstimate(key types.UserJobTypeKey) (q JobWai
 	return
 }
 
+// PendingJobs returns pending jobs of all job types that are due to be scheduled within a minute
+func (r *JobStatsRegistry) PendingJobs() (jobs []types.IJobRequestSummary) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	jobs = make([]types.IJobRequestSummary, 0)
+	maxTime := time.Now().Add(60 * time.Second)
+	for _, pendingJobs := range r.pendingJobsByType {
+		for _, job := range pendingJobs {
+			if job.GetScheduledAt().After(maxTime) {
+				continue // ignore future job
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	return
+}
+
 // GetExecutionCount return count of executing jobs
 func (r *JobStatsRegistry) GetExecutionCount(key types.UserJobTypeKey) int32 {
 	r.lock.RLock()
@@ -258,6 +288,9 @@ func (r *JobStatsRegistry) GetStats(qc *common.QueryContext, offset int, max int
 				AntsCapacity:         stat.AntsCapacity,
 				AntUnavailableError:  stat.AntUnavailableError,
 				JobDisabled:          stat.JobDisabled,
+				BaselineLatency:      stat.BaselineLatency,
+				P95Latency:           stat.P95Latency,
+				LastAnomaly:          stat.LastAnomaly,
 			})
 		}
 	}
@@ -1,12 +1,15 @@
 package stats
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	"plexobject.com/formicary/internal/cache"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/types"
 	"testing"
+	"time"
 )
 
 func Test_ShouldCountJobsAndGetStats(t *testing.T) {
@@ -62,3 +65,106 @@ func Test_ShouldCountJobsAndGetStats(t *testing.T) {
 	stats := jobStatsRegistry.GetStats(common.NewQueryContext(nil, "").WithAdmin(), 0, 500)
 	require.Equal(t, 10, len(stats))
 }
+
+func Test_ShouldDetectSlowJobAgainstBaseline(t *testing.T) {
+	// GIVEN stats-registry with 10 successful runs of a job taking around a second
+	jobStatsRegistry := NewJobStatsRegistry()
+	req := &types.JobRequestInfo{JobType: "slow-job", UserID: "user", OrganizationID: "org"}
+	for i := 0; i < 10; i++ {
+		req.ID = ulid.Make().String()
+		jobStatsRegistry.Started(req)
+		require.Nil(t, jobStatsRegistry.Succeeded(req, int64(1000+i*10)))
+	}
+
+	// WHEN a run takes slightly longer
+	req.ID = ulid.Make().String()
+	jobStatsRegistry.Started(req)
+	// THEN no anomaly is detected
+	require.Nil(t, jobStatsRegistry.Succeeded(req, 1500))
+
+	// WHEN a run takes three times longer
+	req.ID = ulid.Make().String()
+	jobStatsRegistry.Started(req)
+	anomaly := jobStatsRegistry.Succeeded(req, 3000)
+
+	// THEN slow run is detected
+	require.NotNil(t, anomaly)
+	require.Equal(t, types.AnomalySlowRun, anomaly.Kind)
+	require.Equal(t, req.ID, anomaly.JobRequestID)
+	require.Equal(t, int64(1500), anomaly.P95Duration.Milliseconds())
+	stats := jobStatsRegistry.GetStats(common.NewQueryContext(nil, "").WithAdmin(), 0, 500)
+	require.Len(t, stats, 1)
+	require.True(t, stats[0].HasRecentAnomaly())
+	require.True(t, stats[0].BaselineLatency > 1000)
+}
+
+func Test_ShouldDetectFailureSpikeOnce(t *testing.T) {
+	// GIVEN stats-registry with 20 runs of a job where one of them failed
+	jobStatsRegistry := NewJobStatsRegistry()
+	req := &types.JobRequestInfo{JobType: "flaky-job", UserID: "user", OrganizationID: "org"}
+	for i := 0; i < 20; i++ {
+		req.ID = ulid.Make().String()
+		jobStatsRegistry.Started(req)
+		if i == 5 {
+			require.Nil(t, jobStatsRegistry.Failed(req, 100))
+		} else {
+			require.Nil(t, jobStatsRegistry.Succeeded(req, 1000))
+		}
+	}
+
+	// WHEN the job fails repeatedly
+	anomalies := make([]*types.JobAnomaly, 0)
+	for i := 0; i < 6; i++ {
+		req.ID = ulid.Make().String()
+		jobStatsRegistry.Started(req)
+		if anomaly := jobStatsRegistry.Failed(req, 100); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	// THEN failure spike is reported once
+	require.Len(t, anomalies, 1)
+	require.Equal(t, types.AnomalyFailureSpike, anomalies[0].Kind)
+	require.True(t, anomalies[0].FailureRate > anomalies[0].BaselineFailureRate)
+}
+
+func Test_ShouldRehydrateBaselineFromCache(t *testing.T) {
+	// GIVEN stats-registry backed by cache with a baseline of a job
+	repo, err := cache.NewStub()
+	require.NoError(t, err)
+	jobStatsRegistry := NewJobStatsRegistryWithCache(repo)
+	req := &types.JobRequestInfo{ID: ulid.Make().String(), JobType: "cached-job", UserID: "user"}
+	jobStatsRegistry.Started(req)
+	jobStatsRegistry.Succeeded(req, 1000)
+	require.Eventually(t, func() bool {
+		all, _ := repo.GetAll(statsRedisGroup)
+		var s map[string]interface{}
+		return len(all) == 1 && json.Unmarshal(all[req.GetUserJobTypeKey()], &s) == nil && s["baseline"] != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// WHEN creating another registry from the cache
+	loaded := NewJobStatsRegistryWithCache(repo)
+	// THEN the baseline should be restored and can be updated
+	stats := loaded.GetStats(common.NewQueryContext(nil, "").WithAdmin(), 0, 500)
+	require.Len(t, stats, 1)
+	require.Equal(t, float64(1000), stats[0].BaselineLatency)
+	require.Equal(t, "cached-job", stats[0].JobKey.GetJobType())
+	loaded.Started(req)
+	require.Nil(t, loaded.Succeeded(req, 1000))
+}
+
+func Test_ShouldReturnDuePendingJobs(t *testing.T) {
+	// GIVEN stats-registry with a due and a future pending job
+	jobStatsRegistry := NewJobStatsRegistry()
+	due := &types.JobRequestInfo{ID: ulid.Make().String(), JobType: "due-job", ScheduledAt: time.Now()}
+	future := &types.JobRequestInfo{ID: ulid.Make().String(), JobType: "future-job", ScheduledAt: time.Now().Add(time.Hour)}
+	jobStatsRegistry.Pending(due, false)
+	jobStatsRegistry.Pending(future, false)
+
+	// WHEN fetching pending jobs
+	jobs := jobStatsRegistry.PendingJobs()
+
+	// THEN only the due job should be returned
+	require.Len(t, jobs, 1)
+	require.Equal(t, due.ID, jobs[0].GetID())
+}
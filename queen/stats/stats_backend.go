@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"plexobject.com/formicary/internal/cache"
+	"plexobject.com/formicary/internal/math"
+	"plexobject.com/formicary/queen/types"
 )
 
 const statsRedisGroup = "formicary:job_stats"
@@ -40,7 +42,13 @@ func (b *redisStatsBackend) loadAll() map[string]*JobStats {
 		return result
 	}
 	for key, data := range all {
-		var s JobStats
+		// job key is an interface so it's decoded into request info and rolling windows aren't persisted
+		s := JobStats{
+			JobKey:              &types.JobRequestInfo{},
+			succeededJobsMinMax: math.NewRollingMinMax(20),
+			failedJobsMinMax:    math.NewRollingMinMax(20),
+			pausedJobsMinMax:    math.NewRollingMinMax(20),
+		}
 		if json.Unmarshal(data, &s) == nil {
 			result[key] = &s
 		}
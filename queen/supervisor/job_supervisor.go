@@ -2,6 +2,7 @@ package supervisor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -60,10 +61,36 @@ func (js *JobSupervisor) AsyncExecute(
 }
 
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+// emitExecutionSpan exports root span of the job execution once the job finishes, a paused job
+// or a job waiting for approval keeps adding spans to the same root span when it's resumed
+func (js *JobSupervisor) emitExecutionSpan(ctx context.Context) {
+	state := js.jobStateMachine.Request.GetJobState()
+	if !state.IsTerminal() {
+		return
+	}
+	exec := js.jobStateMachine.JobExecution
+	_, span := tracing.StartExecutionSpan(ctx, "formicary.queen", exec.ID, exec.StartedAt,
+		trace.WithAttributes(
+			attribute.String("job.type", js.jobStateMachine.JobDefinition.JobType),
+			attribute.String("job.request_id", js.jobStateMachine.Request.GetID()),
+			attribute.String("job.execution_id", exec.ID),
+			attribute.String("job.state", string(state)),
+			attribute.Int("job.retried", js.jobStateMachine.Request.GetRetried()),
+		),
+		trace.WithLinks(js.jobStateMachine.ParentTraceLinks()...),
+	)
+	if state != common.COMPLETED {
+		span.SetStatus(codes.Error, exec.ErrorMessage)
+	}
+	span.End()
+}
+
 // executing job and all tasks within it
 func (js *JobSupervisor) tryExecuteJob(
 	ctx context.Context) (err error) {
-	ctx, span := tracing.Tracer("formicary.queen").Start(ctx, "job.supervise",
+	ctx, span := tracing.Tracer("formicary.queen").Start(
+		tracing.ExecutionContext(ctx, js.jobStateMachine.JobExecution.ID),
+		"job.supervise",
 		trace.WithAttributes(
 			attribute.String("job.type", js.jobStateMachine.JobDefinition.JobType),
 			attribute.String("job.request_id", js.jobStateMachine.Request.GetID()),
@@ -76,6 +103,7 @@ func (js *JobSupervisor) tryExecuteJob(
 			span.SetStatus(codes.Error, err.Error())
 		}
 		span.End()
+		js.emitExecutionSpan(ctx)
 	}()
 
 	logrus.WithFields(js.jobStateMachine.LogFields(
@@ -122,6 +150,15 @@ func (js *JobSupervisor) tryExecuteJob(
 	var task *types.TaskDefinition
 	var errorCode string
 
+	// tasks emitted at runtime before the execution was resumed are not part of the job definition
+	restored, err := js.jobStateMachine.RestoreDynamicTasks()
+	if err == nil {
+		err = js.reserveDynamicTasks(restored)
+	}
+	if err != nil {
+		return js.jobStateMachine.LaunchFailed(ctx, err)
+	}
+
 	// ONLY check for resume from manual approval at job start (not expensive per-task)
 	startTaskType := js.jobStateMachine.JobExecution.GetCurrentTask()
 	if startTaskType != "" {
@@ -139,18 +176,28 @@ func (js *JobSupervisor) tryExecuteJob(
 	for canExecute := true; canExecute; canExecute = js.jobStateMachine.Request.IncrRetried() > 0 &&
 		js.jobStateMachine.CanRetry() {
 
-		// Use determined start task or find first task for new execution
-		if startTaskType != "" {
-			task = js.jobStateMachine.JobDefinition.GetTask(startTaskType)
-		}
-		if task == nil {
-			// Find the first task to run or in case of restart, execute last task executing
-			task, err = js.jobStateMachine.JobDefinition.GetFirstTask()
-			if err != nil {
-				break
+		if js.jobStateMachine.JobDefinition.ScheduledByDependencies() {
+			// tasks run as soon as their dependencies are done and completed tasks are skipped upon resume
+			errorCode, err = js.executeTasksByDependencies(ctx)
+		} else {
+			// Use determined start task or find first task for new execution
+			nextTaskType := startTaskType
+			if startTaskType != "" {
+				task = js.jobStateMachine.JobDefinition.GetTask(startTaskType)
+				if task == nil && js.jobStateMachine.DynamicTasks.Get(startTaskType) == nil {
+					err = fmt.Errorf("task %s of the job execution is not defined", startTaskType)
+					break
+				}
+			} else {
+				// Find the first task to run or in case of restart, execute last task executing
+				task, err = js.jobStateMachine.JobDefinition.GetFirstTask()
+				if err != nil {
+					break
+				}
+				nextTaskType = task.TaskType
 			}
+			errorCode, err = js.executeNextTask(ctx, nextTaskType)
 		}
-		errorCode, err = js.executeNextTask(ctx, task.TaskType)
 		if err == nil {
 			// if task had on-failed to next task, we will try to find failed status of that task
 			var failedTask *types.TaskExecution
@@ -165,7 +212,7 @@ func (js *JobSupervisor) tryExecuteJob(
 						failedTask,
 						failedTask.Active)
 			}
-		} else {
+		} else if task != nil {
 			if logrus.IsLevelEnabled(logrus.DebugLevel) {
 				logrus.WithFields(js.jobStateMachine.LogFields("JobSupervisor")).
 					WithError(err).
@@ -415,6 +462,16 @@ func (js *JobSupervisor) executeNextTask(
 		return
 	}
 
+	// tasks emitted at runtime run between the task that emitted them and its next task
+	if nextTaskType, dynamic, dynErr := js.nextDynamicTask(taskStateMachine, nextTaskDef); dynErr != nil {
+		return common.ErrorValidation, dynErr
+	} else if dynamic {
+		if nextTaskType == "" {
+			return "", nil
+		}
+		return js.executeNextTask(ctx, nextTaskType)
+	}
+
 	// check if keep going
 	if nextTaskDef == nil {
 		if taskStateMachine.TaskExecution.TaskState == common.FAILED &&
@@ -431,29 +488,8 @@ func (js *JobSupervisor) executeNextTask(
 			// handled here so the job supervisor can act on them.
 			// Note: exit-code-based special actions are handled earlier via
 			// OverrideStatusAndErrorCode in task_supervisor.go — this covers the status-based gap.
-			statusTarget := common.NewRequestState(
-				taskStateMachine.TaskDefinition.OnExitCode[taskStateMachine.TaskExecution.TaskState])
-			switch statusTarget {
-			case common.RESTART_JOB:
-				return common.ErrorRestartJob,
-					fmt.Errorf("restarting job after task %s status=%s exit=%s",
-						taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
-			case common.PAUSE_JOB, common.PAUSED:
-				return common.ErrorPauseJob,
-					fmt.Errorf("pausing job after task %s status=%s exit=%s",
-						taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
-			case common.FATAL:
-				return common.ErrorFatal,
-					fmt.Errorf("fatal error in task %s status=%s exit=%s",
-						taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
-			case common.WAIT_FOR_APPROVAL:
-				return common.ErrorManualApprovalRequired,
-					fmt.Errorf("approval required after task %s status=%s exit=%s",
-						taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
-			case common.RESTART_TASK:
-				return common.ErrorRestartTask,
-					fmt.Errorf("restarting task %s status=%s exit=%s",
-						taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+			if errorCode, err = onExitCodeAction(taskStateMachine); err != nil {
+				return
 			}
 			return common.ErrorInvalidNextTask,
 				fmt.Errorf("cannot find next task after %s, unexpected task status=%s, exit-code: %s, error-code: %s, multiple exits=%v",
@@ -472,6 +508,256 @@ func (js *JobSupervisor) executeNextTask(
 	}
 }
 
+// onExitCodeAction returns error for status-based on_exit_code mapping of the task that resolves to a
+// special action such as RESTART_JOB, PAUSE_JOB or FATAL, and nil if the status does not map to an action.
+func onExitCodeAction(
+	taskStateMachine *fsm.TaskExecutionStateMachine) (errorCode string, err error) {
+	taskType := taskStateMachine.TaskDefinition.TaskType
+	statusTarget := common.NewRequestState(
+		taskStateMachine.TaskDefinition.OnExitCode[taskStateMachine.TaskExecution.TaskState])
+	switch statusTarget {
+	case common.RESTART_JOB:
+		return common.ErrorRestartJob,
+			fmt.Errorf("restarting job after task %s status=%s exit=%s",
+				taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+	case common.PAUSE_JOB, common.PAUSED:
+		return common.ErrorPauseJob,
+			fmt.Errorf("pausing job after task %s status=%s exit=%s",
+				taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+	case common.FATAL:
+		return common.ErrorFatal,
+			fmt.Errorf("fatal error in task %s status=%s exit=%s",
+				taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+	case common.WAIT_FOR_APPROVAL:
+		return common.ErrorManualApprovalRequired,
+			fmt.Errorf("approval required after task %s status=%s exit=%s",
+				taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+	case common.RESTART_TASK:
+		return common.ErrorRestartTask,
+			fmt.Errorf("restarting task %s status=%s exit=%s",
+				taskType, taskStateMachine.TaskExecution.TaskState, taskStateMachine.TaskExecution.ExitCode)
+	}
+	return "", nil
+}
+
+// taskResult is the outcome of a task that was run in parallel with other tasks
+type taskResult struct {
+	taskType         string
+	taskStateMachine *fsm.TaskExecutionStateMachine
+	err              error
+}
+
+// errSiblingTaskFailed is the cause of cancelling tasks that are running when another task of the job fails
+var errSiblingTaskFailed = errors.New("cancelled because another task of the job failed")
+
+// executeTasksByDependencies runs tasks of a job that are scheduled by dependencies. A task starts as soon
+// as its join is satisfied so that independent tasks run in parallel on ants. Upon a failure no more tasks
+// are started, the tasks that are running are cancelled and awaited before returning the error.
+func (js *JobSupervisor) executeTasksByDependencies(
+	ctx context.Context) (errorCode string, err error) {
+	taskCtx, cancelTasks := context.WithCancelCause(ctx)
+	defer cancelTasks(nil)
+	jobDef := js.jobStateMachine.JobDefinition
+	pending := make(map[string]bool)
+	for _, task := range jobDef.Tasks {
+		pending[task.TaskType] = true
+	}
+	finished := make(map[string]common.RequestState)
+	results := make(chan taskResult, len(jobDef.Tasks))
+	running := 0
+	for {
+		if err == nil && !js.jobStateMachine.JobExecution.JobState.IsTerminal() {
+			for _, task := range jobDef.Tasks {
+				if !pending[task.TaskType] {
+					continue
+				}
+				ready, satisfiable := dependenciesReady(task, finished)
+				if !satisfiable {
+					errorCode, err = common.ErrorInvalidNextTask,
+						fmt.Errorf("join of %s dependencies of task %s cannot be satisfied", task.Join, task.TaskType)
+					break
+				}
+				if !ready {
+					continue
+				}
+				delete(pending, task.TaskType)
+				running++
+				go func(taskType string) {
+					taskStateMachine, taskErr := js.submitTask(taskCtx, taskType)
+					results <- taskResult{taskType: taskType, taskStateMachine: taskStateMachine, err: taskErr}
+				}(task.TaskType)
+			}
+		}
+		if running == 0 {
+			break
+		}
+		if err != nil {
+			// the job fails regardless of the tasks that are still running
+			cancelTasks(fmt.Errorf("%w: %v", errSiblingTaskFailed, err))
+		}
+		res := <-results
+		running--
+		state, resErrorCode, resErr := js.taskResultState(res)
+		if resErr != nil {
+			logrus.WithFields(js.jobStateMachine.LogFields("JobSupervisor")).
+				WithError(resErr).
+				Warnf("[js] task %s of job='%s' failed with errorcode=%s, cancelling %d running tasks",
+					res.taskType, jobDef.JobType, resErrorCode, running)
+			// the first failure is reported
+			if err == nil {
+				errorCode, err = resErrorCode, resErr
+			}
+			continue
+		}
+		finished[res.taskType] = state
+	}
+	if err == nil && len(pending) > 0 && !js.jobStateMachine.JobExecution.JobState.IsTerminal() {
+		notRun := make([]string, 0, len(pending))
+		for taskType := range pending {
+			notRun = append(notRun, taskType)
+		}
+		return common.ErrorInvalidNextTask,
+			fmt.Errorf("tasks %v could not run because their dependencies did not finish", notRun)
+	}
+	return
+}
+
+// taskResultState returns state of a task that finished in parallel with other tasks or error if the
+// job cannot continue after the task.
+func (js *JobSupervisor) taskResultState(res taskResult) (state common.RequestState, errorCode string, err error) {
+	if res.taskStateMachine != nil {
+		errorCode = res.taskStateMachine.TaskExecution.ErrorCode
+	}
+	if res.err != nil {
+		return "", errorCode, res.err
+	}
+	taskExec := res.taskStateMachine.TaskExecution
+	taskDef := res.taskStateMachine.TaskDefinition
+	if taskExec.TaskState == common.MANUAL_APPROVAL_REQUIRED {
+		logrus.WithFields(js.jobStateMachine.LogFields("JobSupervisor")).
+			Infof("[js] Job paused for manual approval of task: %s", res.taskType)
+		if err = js.jobStateMachine.JobManager.SetJobRequestAndExecutingStatusToApprovalRequired(
+			js.jobStateMachine.JobExecution.ID, res.taskType); err != nil {
+			return "", errorCode, fmt.Errorf("failed to set job to manual approval state: %w", err)
+		}
+		return "", common.ErrorManualApprovalRequired,
+			fmt.Errorf("job paused for manual approval of task: %s", res.taskType)
+	}
+	if taskExec.TaskState == common.PAUSED {
+		return "", common.ErrorPauseJob, fmt.Errorf("%s", taskExec.ErrorMessage)
+	}
+	if len(taskDef.OnExitCode) > 0 {
+		if errorCode, err = onExitCodeAction(res.taskStateMachine); err != nil {
+			return "", errorCode, err
+		}
+	}
+	if taskExec.TaskState == common.COMPLETED || taskDef.AllowFailure {
+		return taskExec.TaskState, "", nil
+	}
+	return "", taskExec.ErrorCode,
+		fmt.Errorf("task %s failed with status=%s, exit=%s: %s",
+			res.taskType, taskExec.TaskState, taskExec.ExitCode, taskExec.ErrorMessage)
+}
+
+// dependenciesReady checks the join of the task against its finished dependencies
+func dependenciesReady(
+	task *types.TaskDefinition,
+	finished map[string]common.RequestState) (ready bool, satisfiable bool) {
+	completed, done := 0, 0
+	for _, dep := range task.Dependencies {
+		if state, ok := finished[dep]; ok {
+			done++
+			if state == common.COMPLETED {
+				completed++
+			}
+		}
+	}
+	return task.Join.Ready(completed, done, len(task.Dependencies))
+}
+
+// nextDynamicTask splices tasks emitted by a completed task with dynamic_tasks into the execution and
+// returns the first of them, or returns the next task of a task that was itself emitted at runtime.
+func (js *JobSupervisor) nextDynamicTask(
+	taskStateMachine *fsm.TaskExecutionStateMachine,
+	nextTaskDef *types.TaskDefinition) (nextTaskType string, dynamic bool, err error) {
+	taskDef := taskStateMachine.TaskDefinition
+	taskState := taskStateMachine.TaskExecution.TaskState
+	if nextTaskDef != nil {
+		nextTaskType = nextTaskDef.TaskType
+	} else if emitted := js.jobStateMachine.DynamicTasks.Get(taskDef.TaskType); emitted != nil &&
+		(taskState == common.COMPLETED || taskDef.AllowFailure) {
+		nextTaskType, dynamic = emitted.Next, true
+	}
+	if taskDef.DynamicTasks == nil || taskState != common.COMPLETED {
+		return nextTaskType, dynamic, nil
+	}
+
+	fragment := js.dynamicTasksOutput(taskStateMachine)
+	if fragment == nil {
+		return nextTaskType, dynamic, nil
+	}
+	emitted, err := js.jobStateMachine.DynamicTasks.Expand(
+		js.jobStateMachine.JobDefinition, taskDef, nextTaskType, fragment)
+	if err != nil {
+		return "", false, err
+	}
+	if err = js.jobStateMachine.SaveDynamicTasks(); err != nil {
+		return "", false, fmt.Errorf("failed to save tasks emitted by %s due to %w", taskDef.TaskType, err)
+	}
+	if len(emitted) == 0 {
+		return nextTaskType, dynamic, nil
+	}
+	if err = js.reserveDynamicTasks(emitted); err != nil {
+		return "", false, err
+	}
+	emittedTypes := make([]string, len(emitted))
+	for i, task := range emitted {
+		emittedTypes[i] = task.TaskType
+	}
+	logrus.WithFields(js.jobStateMachine.LogFields("JobSupervisor")).
+		Infof("[js] task %s emitted tasks %v that will run before '%s'",
+			taskDef.TaskType, emittedTypes, nextTaskType)
+	return emitted[0].TaskType, true, nil
+}
+
+// reserveDynamicTasks reserves ants for tasks emitted at runtime, which were not known when the job was scheduled
+func (js *JobSupervisor) reserveDynamicTasks(tasks []*types.DynamicTask) error {
+	for _, task := range tasks {
+		if js.jobStateMachine.Reservations[task.TaskType] != nil {
+			continue
+		}
+		reservation, err := js.jobStateMachine.ResourceManager.Reserve(
+			js.jobStateMachine.Request.GetID(),
+			task.TaskType,
+			task.Method,
+			task.Tags,
+			common.NewPlacementRequest(js.jobStateMachine.JobDefinition.JobType, task.Placement))
+		if err != nil {
+			return fmt.Errorf("failed to reserve ant for task %s emitted by %s due to %w",
+				task.TaskType, task.Generator, err)
+		}
+		js.jobStateMachine.Reservations[task.TaskType] = reservation
+	}
+	return nil
+}
+
+// dynamicTasksOutput returns the tasks emitted by the task from its context or the job context
+func (js *JobSupervisor) dynamicTasksOutput(
+	taskStateMachine *fsm.TaskExecutionStateMachine) interface{} {
+	name := taskStateMachine.TaskDefinition.DynamicTasks.Output
+	if c := taskStateMachine.TaskExecution.GetContext(name); c != nil {
+		if val, err := c.GetParsedValue(); err == nil {
+			return val
+		}
+	}
+	if c := js.jobStateMachine.JobExecution.GetContext(name); c != nil {
+		if val, err := c.GetParsedValue(); err == nil {
+			return val
+		}
+	}
+	return nil
+}
+
 func (js *JobSupervisor) submitTask(
 	ctx context.Context,
 	taskType string) (taskStateMachine *fsm.TaskExecutionStateMachine, err error) {
@@ -2,14 +2,21 @@ package supervisor
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
 	evbus "github.com/asaskevich/EventBus"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	"plexobject.com/formicary/internal/tracing"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/config"
 	"plexobject.com/formicary/queen/fsm"
+	"plexobject.com/formicary/queen/types"
 )
 
 func Test_ShouldNotExecuteJobWithoutWorkers(t *testing.T) {
@@ -40,6 +47,43 @@ func Test_ShouldExecuteJob(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_ShouldTraceJobExecutionUnderRootSpan(t *testing.T) {
+	// GIVEN tracer provider that records spans
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+	supervisor := newTestJobSupervisor(t)
+	err := supervisor.jobStateMachine.PrepareLaunch(supervisor.jobStateMachine.JobExecution.ID)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+	require.NoError(t, err)
+
+	// THEN spans of the job should be under root span of the job execution
+	root := tracing.ExecutionSpanContext(supervisor.jobStateMachine.JobExecution.ID)
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.NotNil(t, spans["job.supervise"])
+	require.Equal(t, root.TraceID(), spans["job.supervise"].SpanContext().TraceID())
+	require.Equal(t, root.SpanID(), spans["job.supervise"].Parent().SpanID())
+	require.NotNil(t, spans["task.supervise"])
+	require.Equal(t, root.TraceID(), spans["task.supervise"].SpanContext().TraceID())
+	require.NotNil(t, spans["task.dispatch"])
+	require.Equal(t, spans["task.supervise"].SpanContext().SpanID(), spans["task.dispatch"].Parent().SpanID())
+
+	// AND root span should be emitted once the job completes
+	require.NotNil(t, spans[tracing.ExecutionSpanName])
+	require.False(t, spans[tracing.ExecutionSpanName].Parent().IsValid())
+	require.Equal(t, supervisor.jobStateMachine.JobExecution.StartedAt.UnixNano(),
+		spans[tracing.ExecutionSpanName].StartTime().UnixNano())
+}
+
 // Test_ShouldPauseJobWhenTaskExitsWithPauseJobCode is the regression test for the bug
 // where a task exiting with code 3 (mapped via on_exit_code to PAUSE_JOB) caused the
 // job to be marked FAILED instead of PAUSED.
@@ -79,6 +123,427 @@ func Test_ShouldPauseJobWhenTaskExitsWithPauseJobCode(t *testing.T) {
 	require.Equal(t, 1, jsm.Request.GetPausedCount(), "PausedCount must be incremented")
 }
 
+func Test_ShouldExecuteTasksEmittedAtRuntime(t *testing.T) {
+	// GIVEN a job whose discover task emits a pipeline per partition before the report task
+	jobYaml := `
+job_type: io.formicary.test.dynamic-partitions
+tasks:
+- task_type: discover
+  method: KUBERNETES
+  script:
+    - ./discover-partitions
+  dynamic_tasks:
+    output: partitions
+    max_tasks: 5
+  on_completed: report
+- task_type: report
+  method: KUBERNETES
+  script:
+    - ./report
+`
+	emitted := `[
+{"task_type": "load-p1", "method": "KUBERNETES", "script": ["./load p1"], "dependencies": ["extract-p1"]},
+{"task_type": "extract-p1", "method": "KUBERNETES", "script": ["./extract p1"]},
+{"task_type": "extract-p2", "method": "KUBERNETES", "script": ["./extract p2"]}
+]`
+	var lock sync.Mutex
+	executed := make([]string, 0)
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		lock.Lock()
+		defer lock.Unlock()
+		executed = append(executed, req.TaskType)
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		if req.TaskType == "discover" {
+			res.AddJobContext("partitions", emitted)
+		}
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN emitted tasks run after their dependencies and before the next task of discover
+	require.NoError(t, err)
+	require.Equal(t, common.COMPLETED, jsm.JobExecution.JobState)
+	require.Equal(t, []string{"discover", "extract-p1", "load-p1", "extract-p2", "report"}, executed)
+	require.Len(t, jsm.JobExecution.Tasks, 5)
+}
+
+func Test_ShouldResumeJobWithTasksEmittedBeforeResume(t *testing.T) {
+	// GIVEN a job execution that is resumed at a task emitted by discover before it was paused
+	jobYaml := `
+job_type: io.formicary.test.dynamic-resume
+tasks:
+- task_type: discover
+  method: KUBERNETES
+  script:
+    - ./discover-partitions
+  dynamic_tasks:
+    output: partitions
+  on_completed: report
+- task_type: report
+  method: KUBERNETES
+  script:
+    - ./report
+`
+	var lock sync.Mutex
+	executed := make([]string, 0)
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		lock.Lock()
+		defer lock.Unlock()
+		executed = append(executed, req.TaskType)
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	discover := *jsm.JobDefinition.GetTask("discover")
+	discover.DynamicTasks = &types.DynamicTasksConfig{Output: "partitions", MaxTasks: 5, MaxDepth: 1}
+	graph := types.NewDynamicTaskGraph()
+	_, err = graph.Expand(jsm.JobDefinition, &discover, "report",
+		`[{"task_type": "extract-p1", "method": "KUBERNETES", "script": ["./extract p1"]},
+{"task_type": "extract-p2", "method": "KUBERNETES", "script": ["./extract p2"]}]`)
+	require.NoError(t, err)
+	serialized, err := graph.Marshal()
+	require.NoError(t, err)
+	_, err = jsm.JobExecution.AddContext(types.DynamicTasksContext, serialized)
+	require.NoError(t, err)
+	jsm.JobExecution.CurrentTask = "extract-p2"
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN resuming the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN the emitted task and the tasks after it should run
+	require.NoError(t, err)
+	require.Equal(t, common.COMPLETED, jsm.JobExecution.JobState)
+	require.Equal(t, []string{"extract-p2", "report"}, executed)
+}
+
+func Test_ShouldFailJobWhenResumedTaskIsNotDefined(t *testing.T) {
+	// GIVEN a job execution that is resumed at a task that is neither defined nor emitted
+	jsm, err := fsm.NewTestJobStateMachine()
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	jsm.JobExecution.CurrentTask = "missing-task"
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN resuming the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN the job should fail
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "task missing-task of the job execution is not defined")
+	require.Equal(t, common.FAILED, jsm.JobExecution.JobState)
+}
+
+func Test_ShouldFailJobWhenTaskEmitsTooManyTasks(t *testing.T) {
+	// GIVEN a job whose discover task emits more tasks than max_tasks
+	jobYaml := `
+job_type: io.formicary.test.dynamic-limit
+tasks:
+- task_type: discover
+  method: KUBERNETES
+  script:
+    - ./discover-partitions
+  dynamic_tasks:
+    output: partitions
+    max_tasks: 1
+`
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		res.AddJobContext("partitions", `[{"task_type": "p1"}, {"task_type": "p2"}]`)
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN the job fails without running emitted tasks
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max_tasks is 1")
+	require.Equal(t, common.FAILED, jsm.JobExecution.JobState)
+	require.Len(t, jsm.JobExecution.Tasks, 1)
+}
+
+func Test_ShouldExecuteIndependentTasksInParallelAndJoinTheirOutputs(t *testing.T) {
+	// GIVEN a job whose extract tasks only depend on the prepare task and are joined by the merge task
+	jobYaml := `
+job_type: io.formicary.test.parallel-join
+tasks:
+- task_type: prepare
+  method: KUBERNETES
+  script:
+    - ./prepare
+- task_type: extract-a
+  method: KUBERNETES
+  script:
+    - ./extract a
+  dependencies: [prepare]
+- task_type: extract-b
+  method: KUBERNETES
+  script:
+    - ./extract b
+  dependencies: [prepare]
+- task_type: merge
+  method: KUBERNETES
+  script:
+    - ./merge
+  dependencies: [extract-a, extract-b]
+`
+	var lock sync.Mutex
+	executed := make([]string, 0)
+	var mergeReq *common.TaskRequest
+	extracting := make(chan string, 2)
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		switch req.TaskType {
+		case "extract-a", "extract-b":
+			// both extract tasks must be running at the same time to finish
+			extracting <- req.TaskType
+			for len(extracting) < 2 {
+				time.Sleep(10 * time.Millisecond)
+			}
+			res.AddJobContext("rows", req.TaskType)
+		case "merge":
+			mergeReq = req
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		executed = append(executed, req.TaskType)
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	require.True(t, jsm.JobDefinition.ScheduledByDependencies())
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN extract tasks run in parallel between prepare and merge
+	require.NoError(t, err)
+	require.Equal(t, common.COMPLETED, jsm.JobExecution.JobState)
+	require.Len(t, executed, 4)
+	require.Equal(t, "prepare", executed[0])
+	require.ElementsMatch(t, []string{"extract-a", "extract-b"}, executed[1:3])
+	require.Equal(t, "merge", executed[3])
+
+	// AND merge task receives outputs of both extract tasks
+	require.NotNil(t, mergeReq)
+	require.Equal(t, "extract-a", mergeReq.Variables["extract_a_rows"].Value)
+	require.Equal(t, "extract-b", mergeReq.Variables["extract_b_rows"].Value)
+	require.Equal(t, "extract-a,extract-b", mergeReq.Variables["CompletedDependencies"].Value)
+}
+
+func Test_ShouldJoinAnyOfOptionalDependencies(t *testing.T) {
+	// GIVEN a job whose report task runs when any of mirror tasks completes
+	jobYaml := `
+job_type: io.formicary.test.parallel-join-any
+tasks:
+- task_type: mirror-a
+  method: KUBERNETES
+  allow_failure: true
+  script:
+    - ./fetch a
+- task_type: mirror-b
+  method: KUBERNETES
+  allow_failure: true
+  script:
+    - ./fetch b
+- task_type: report
+  method: KUBERNETES
+  script:
+    - ./report
+  dependencies: [mirror-a, mirror-b]
+  join:
+    mode: any
+`
+	var reportReq *common.TaskRequest
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		if req.TaskType == "mirror-a" {
+			res.Status = common.FAILED
+			res.ErrorCode = "ERR_MIRROR"
+			res.ErrorMessage = "mirror is down"
+		} else if req.TaskType == "report" {
+			reportReq = req
+		}
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN report runs with the mirror that completed
+	require.NoError(t, err)
+	require.Equal(t, common.COMPLETED, jsm.JobExecution.JobState)
+	require.NotNil(t, reportReq)
+	require.Equal(t, "mirror-b", reportReq.Variables["CompletedDependencies"].Value)
+}
+
+func Test_ShouldFailJobWhenJoinCannotBeSatisfied(t *testing.T) {
+	// GIVEN a job whose report task requires both optional mirror tasks to complete
+	jobYaml := `
+job_type: io.formicary.test.parallel-join-n-of
+tasks:
+- task_type: mirror-a
+  method: KUBERNETES
+  allow_failure: true
+  script:
+    - ./fetch a
+- task_type: mirror-b
+  method: KUBERNETES
+  allow_failure: true
+  script:
+    - ./fetch b
+- task_type: report
+  method: KUBERNETES
+  script:
+    - ./report
+  dependencies: [mirror-a, mirror-b]
+  join:
+    mode: n_of
+    count: 2
+`
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		if req.TaskType == "mirror-a" {
+			res.Status = common.FAILED
+			res.ErrorCode = "ERR_MIRROR"
+			res.ErrorMessage = "mirror is down"
+		}
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN the job fails without running report
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be satisfied")
+	require.Equal(t, common.FAILED, jsm.JobExecution.JobState)
+	require.Len(t, jsm.JobExecution.Tasks, 2)
+}
+
+func Test_ShouldCancelRunningTasksWhenSiblingFails(t *testing.T) {
+	// GIVEN a job whose merge task joins a task that fails fast and a task that runs for long
+	jobYaml := `
+job_type: io.formicary.test.parallel-cancel
+tasks:
+- task_type: fail-fast
+  method: KUBERNETES
+  script:
+    - ./fail
+- task_type: long-running
+  method: KUBERNETES
+  script:
+    - ./sleep
+- task_type: merge
+  method: KUBERNETES
+  script:
+    - ./merge
+  dependencies: [fail-fast, long-running]
+`
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	jsm, err := fsm.NewTestJobStateMachineFromYaml(jobYaml, func(req *common.TaskRequest) *common.TaskResponse {
+		res := common.NewTaskResponse(req)
+		res.AntID = "test-ant"
+		res.Host = "test-host"
+		res.Status = common.COMPLETED
+		switch req.TaskType {
+		case "fail-fast":
+			// fails once the long-running task is running
+			<-started
+			res.Status = common.FAILED
+			res.ErrorCode = "ERR_FAIL_FAST"
+			res.ErrorMessage = "failed fast"
+		case "long-running":
+			close(started)
+			select {
+			case <-release:
+			case <-time.After(10 * time.Second):
+			}
+		}
+		return res
+	})
+	require.NoError(t, err)
+	require.NoError(t, jsm.PrepareLaunch(jsm.JobExecution.ID))
+	supervisor := NewJobSupervisor(config.TestServerConfig(), jsm, evbus.New())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	// WHEN executing the job
+	began := time.Now()
+	_, err = supervisor.AsyncExecute(ctx).Await(ctx)
+
+	// THEN the job fails without waiting for the long-running task
+	require.Error(t, err)
+	require.Less(t, time.Since(began), 5*time.Second)
+	require.Equal(t, common.FAILED, jsm.JobExecution.JobState)
+	// AND the long-running task is cancelled and merge never runs
+	require.Len(t, jsm.JobExecution.Tasks, 2)
+	states := make(map[string]common.RequestState)
+	for _, task := range jsm.JobExecution.Tasks {
+		states[task.TaskType] = task.TaskState
+	}
+	require.Equal(t, common.FAILED, states["fail-fast"])
+	require.Equal(t, common.CANCELLED, states["long-running"])
+}
 
 func newTestJobSupervisor(t *testing.T) *JobSupervisor {
 	// Initializing dependent objects
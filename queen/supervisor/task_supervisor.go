@@ -2,6 +2,7 @@ package supervisor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -47,6 +48,28 @@ func (ts *TaskSupervisor) execute(
 	ctx context.Context) (err error) {
 	started := time.Now()
 
+	// span of task covers all of its dispatches to ants and the finalization of its state
+	ctx, span := tracing.Tracer("formicary.queen").Start(ctx, "task.supervise",
+		trace.WithAttributes(
+			attribute.String("task.type", ts.taskStateMachine.TaskDefinition.TaskType),
+			attribute.String("task.method", string(ts.taskStateMachine.TaskDefinition.Method)),
+			attribute.String("job.request_id", ts.taskStateMachine.Request.GetID()),
+		),
+	)
+	defer func() {
+		if ts.taskStateMachine.Reservation != nil {
+			span.SetAttributes(attribute.String("ant.id", ts.taskStateMachine.Reservation.AntID))
+		}
+		span.SetAttributes(
+			attribute.String("task.state", string(ts.taskStateMachine.TaskExecution.TaskState)),
+			attribute.String("task.exit_code", ts.taskStateMachine.TaskExecution.ExitCode),
+		)
+		if ts.taskStateMachine.TaskExecution.Failed() {
+			span.SetStatus(codes.Error, ts.taskStateMachine.TaskExecution.ErrorMessage)
+		}
+		span.End()
+	}()
+
 	timeout := ts.taskStateMachine.TaskDefinition.Timeout
 	if timeout == 0 && ts.serverCfg.Common.MaxTaskTimeout > 0 {
 		timeout = ts.serverCfg.Common.MaxTaskTimeout
@@ -78,7 +101,12 @@ func (ts *TaskSupervisor) execute(
 
 	// we will save task state in the end
 	defer func() {
-		if !ts.taskStateMachine.TaskExecution.TaskState.CanFinalize() { // changed from IsTerminal for manual
+		if cause := context.Cause(ctx); !ts.taskStateMachine.TaskExecution.TaskState.CanFinalize() &&
+			errors.Is(cause, errSiblingTaskFailed) {
+			// ant is notified to stop the task by the lifecycle event of the cancelled task
+			err = cause
+			ts.taskStateMachine.SetCancelled(err)
+		} else if !ts.taskStateMachine.TaskExecution.TaskState.CanFinalize() { // changed from IsTerminal for manual
 			if err == nil {
 				if ctx.Err() != nil {
 					err = fmt.Errorf("%v (timeout=%s/%s)",
@@ -271,6 +299,7 @@ func (ts *TaskSupervisor) invoke(
 		Timeout:  taskReq.Timeout,
 		Props:    props,
 	}
+	dispatchedAt := time.Now()
 	res, err := ts.taskStateMachine.QueueClient.SendReceive(ctx, req)
 	if err != nil {
 		span.RecordError(err)
@@ -305,6 +334,18 @@ func (ts *TaskSupervisor) invoke(
 		return taskReq.ErrorResponse(err), nil
 	}
 
+	// latency between sending the request and the ant receiving it, clocks of queen and ant may differ slightly
+	if taskResp.Timings.ReceivedAt.After(dispatchedAt) {
+		ts.taskStateMachine.MetricsRegistry.Observe(
+			"task_dispatch_latency_secs",
+			taskResp.Timings.ReceivedAt.Sub(dispatchedAt).Seconds(),
+			nil,
+			map[string]string{
+				"Job":    taskReq.JobType,
+				"Task":   taskReq.TaskType,
+				"Method": string(ts.taskStateMachine.TaskDefinition.Method)})
+	}
+
 	newState, newErrorCode := ts.taskStateMachine.TaskDefinition.OverrideStatusAndErrorCode(taskResp.ExitCode)
 
 	logrus.WithFields(logrus.Fields{
@@ -363,7 +363,23 @@ func (t *FanOutTasklet) spawnChildJob(
 	fanOut *common.FanOutConfig,
 	itemVal string,
 	idx int,
-) (string, error) {
+) (childID string, err error) {
+	ctx, span := tracing.Tracer("formicary.queen").Start(ctx, "job.fork",
+		trace.WithAttributes(
+			attribute.String("job.request_id", parentReq.JobRequestID),
+			attribute.String("fork.job_type", fanOut.ForkJobType),
+			attribute.Int("fan_out.index", idx),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else {
+			span.SetAttributes(attribute.String("fork.request_id", childID))
+		}
+		span.End()
+	}()
 	qc := common.NewQueryContextFromIDs(parentReq.UserID, parentReq.OrganizationID)
 	jobDef, err := t.jobManager.GetJobDefinitionByType(
 		qc,
@@ -382,6 +398,9 @@ func (t *FanOutTasklet) spawnChildJob(
 	req.ParentID = parentReq.JobRequestID
 	req.CascadeCancel = true
 	_, _ = req.AddParam(common.ForkedJob, true)
+	if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+		_, _ = req.AddParam(common.ParentTraceParent, traceParent)
+	}
 
 	// Inject the item variable so child tasks can reference it.
 	_, _ = req.AddParam(fanOut.ItemVar, itemVal)
@@ -485,6 +504,7 @@ func (t *FanOutTasklet) dispatchSingleTask(
 		parentReq.TaskType,
 		method,
 		parentReq.Tags,
+		common.NewPlacementRequest(parentReq.JobType, nil),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("fan_out[%d]: failed to reserve ant for method %s: %w", idx, method, err)
@@ -8,9 +8,13 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"plexobject.com/formicary/internal/events"
 	"plexobject.com/formicary/internal/queue"
 	"plexobject.com/formicary/internal/tasklet"
+	"plexobject.com/formicary/internal/tracing"
 	common "plexobject.com/formicary/internal/types"
 	"plexobject.com/formicary/queen/config"
 	"plexobject.com/formicary/queen/manager"
@@ -96,6 +100,19 @@ func (t *JobForkTasklet) PreExecute(
 func (t *JobForkTasklet) Execute(
 	ctx context.Context,
 	taskReq *common.TaskRequest) (taskResp *common.TaskResponse, err error) {
+	ctx, span := tracing.Tracer("formicary.queen").Start(ctx, "job.fork",
+		trace.WithAttributes(
+			attribute.String("job.request_id", taskReq.JobRequestID),
+			attribute.String("task.type", taskReq.TaskType),
+			attribute.String("fork.job_type", taskReq.ExecutorOpts.ForkJobType),
+		),
+	)
+	defer func() {
+		if taskResp != nil && taskResp.Status == common.FAILED {
+			span.SetStatus(codes.Error, taskResp.ErrorMessage)
+		}
+		span.End()
+	}()
 	queryContext := common.NewQueryContextFromIDs(taskReq.UserID, taskReq.OrganizationID)
 	if taskReq.ExecutorOpts.ForkJobType == "" {
 		return taskReq.ErrorResponse(fmt.Errorf("fork_job_type is not specified for job %s and request %s",
@@ -155,6 +172,10 @@ func (t *JobForkTasklet) Execute(
 	}
 
 	_, _ = req.AddParam(common.ForkedJob, true)
+	if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+		// root span of the forked job links to this span
+		_, _ = req.AddParam(common.ParentTraceParent, traceParent)
+	}
 	req.ParentID = taskReq.JobRequestID
 	_, _ = req.AddParam(fmt.Sprintf("%s_%s", types.ParentJobTypePrefix, req.ParentID), taskReq.JobType)
 
@@ -167,6 +188,7 @@ func (t *JobForkTasklet) Execute(
 	if err != nil {
 		return taskReq.ErrorResponse(err), nil
 	}
+	span.SetAttributes(attribute.String("fork.request_id", saved.ID))
 
 	logrus.WithFields(logrus.Fields{
 		"Component":       "JobForkTasklet",
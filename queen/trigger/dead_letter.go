@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+// maxDeadLetterBatch limits number of dead-letters that are replayed or discarded in a single call.
+const maxDeadLetterBatch = 1000
+
+// DeadLetters persists queue and S3 notification events whose job request couldn't be submitted
+// and replays them with the current definition of the trigger.
+type DeadLetters struct {
+	repo      repository.TriggerDeadLetterRepository
+	evaluator *Evaluator
+	submitter jobSubmitter
+}
+
+// NewDeadLetters creates DeadLetters backed by the given repository.
+func NewDeadLetters(
+	repo repository.TriggerDeadLetterRepository,
+	evaluator *Evaluator,
+	submitter *Submitter,
+) *DeadLetters {
+	return newDeadLetters(repo, evaluator, submitter)
+}
+
+func newDeadLetters(
+	repo repository.TriggerDeadLetterRepository,
+	evaluator *Evaluator,
+	submitter jobSubmitter,
+) *DeadLetters {
+	return &DeadLetters{repo: repo, evaluator: evaluator, submitter: submitter}
+}
+
+// Record saves the event with the error that prevented submission of its job request.
+func (d *DeadLetters) Record(event *TriggerEvent, cause error) (*types.TriggerDeadLetter, error) {
+	if d == nil {
+		return nil, fmt.Errorf("dead-letters are not configured")
+	}
+	payload, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize trigger event due to %w", err)
+	}
+	dl, err := d.repo.Save(&types.TriggerDeadLetter{
+		JobDefinitionID: event.JobDefinition.ID,
+		JobType:         event.JobDefinition.JobType,
+		OrganizationID:  event.JobDefinition.OrganizationID,
+		UserID:          event.JobDefinition.UserID,
+		TriggerName:     event.Trigger.Name,
+		TriggerType:     event.Trigger.Type,
+		Payload:         string(payload),
+		ErrorMessage:    cause.Error(),
+		State:           types.DeadLetterFailed,
+		Attempts:        1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save dead-letter due to %w", err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"Component":    "TriggerDeadLetters",
+		"JobType":      dl.JobType,
+		"TriggerName":  dl.TriggerName,
+		"DeadLetterID": dl.ID,
+		"Error":        cause,
+	}).Warnf("trigger event moved to dead-letters")
+	return dl, nil
+}
+
+// Query returns newest dead-letters of all versions of the job definition.
+func (d *DeadLetters) Query(
+	jobDef *types.JobDefinition,
+	triggerName string,
+	state string,
+	limit int) ([]*types.TriggerDeadLetter, error) {
+	if limit <= 0 || limit > maxDeadLetterBatch {
+		limit = maxDeadLetterBatch
+	}
+	return d.repo.Query(jobDef, triggerName, state, limit)
+}
+
+// Select returns failed dead-letters of the job definition by ids, or all failed dead-letters of the
+// trigger when ids are empty, where empty trigger name matches all triggers of the job.
+func (d *DeadLetters) Select(
+	jobDef *types.JobDefinition,
+	ids []string,
+	triggerName string) ([]*types.TriggerDeadLetter, error) {
+	if len(ids) == 0 {
+		return d.repo.Query(jobDef, triggerName, types.DeadLetterFailed, maxDeadLetterBatch)
+	}
+	if len(ids) > maxDeadLetterBatch {
+		return nil, common.NewValidationError(
+			fmt.Errorf("cannot select more than %d dead-letters", maxDeadLetterBatch))
+	}
+	dls := make([]*types.TriggerDeadLetter, 0, len(ids))
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		dl, err := d.repo.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		// dead-letters of other jobs are hidden from the caller
+		if !dl.BelongsTo(jobDef) {
+			return nil, common.NewNotFoundError(fmt.Errorf("dead-letter %s is not found", id))
+		}
+		if !dl.Failed() {
+			return nil, common.NewValidationError(
+				fmt.Errorf("dead-letter %s is already %s", id, dl.State))
+		}
+		dls = append(dls, dl)
+	}
+	return dls, nil
+}
+
+// Replay evaluates the event again with current definition of the trigger and submits its job request.
+// The dead-letter is claimed before submission so that concurrent replays don't submit it twice. It's
+// marked as replayed on success, otherwise its error is updated.
+func (d *DeadLetters) Replay(
+	ctx context.Context,
+	jobDef *types.JobDefinition,
+	dl *types.TriggerDeadLetter) (*types.JobRequest, error) {
+	if !dl.Failed() {
+		return nil, common.NewValidationError(fmt.Errorf("dead-letter %s is already %s", dl.ID, dl.State))
+	}
+	claimed, err := d.repo.Claim(dl)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, common.NewConflictError(fmt.Errorf("dead-letter %s is already being replayed", dl.ID))
+	}
+	req, err := d.replay(ctx, jobDef, dl)
+	if err != nil {
+		dl.ErrorMessage = err.Error()
+		if _, saveErr := d.repo.Save(dl); saveErr != nil {
+			return nil, saveErr
+		}
+		return nil, err
+	}
+	dl.State = types.DeadLetterReplayed
+	dl.ReplayedAt = time.Now()
+	if req != nil {
+		dl.JobRequestID = req.ID
+	}
+	if _, err = d.repo.Save(dl); err != nil {
+		return req, err
+	}
+	return req, nil
+}
+
+// Discard marks the dead-letter as discarded so that it is no longer replayed.
+func (d *DeadLetters) Discard(dl *types.TriggerDeadLetter) error {
+	if !dl.Failed() {
+		return common.NewValidationError(fmt.Errorf("dead-letter %s is already %s", dl.ID, dl.State))
+	}
+	dl.State = types.DeadLetterDiscarded
+	_, err := d.repo.Save(dl)
+	return err
+}
+
+func (d *DeadLetters) replay(
+	ctx context.Context,
+	jobDef *types.JobDefinition,
+	dl *types.TriggerDeadLetter) (*types.JobRequest, error) {
+	var trigger *types.TriggerDefinition
+	for _, t := range jobDef.Triggers {
+		if t.Name == dl.TriggerName {
+			trigger = t
+			break
+		}
+	}
+	if trigger == nil {
+		return nil, fmt.Errorf("trigger %q no longer exists on job %s", dl.TriggerName, jobDef.JobType)
+	}
+	if trigger.Type != dl.TriggerType {
+		return nil, fmt.Errorf("trigger %q changed type from %s to %s", dl.TriggerName, dl.TriggerType, trigger.Type)
+	}
+	// numbers are decoded as json.Number so that integer fields such as object size keep their format
+	data := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader([]byte(dl.Payload)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to parse dead-letter payload due to %w", err)
+	}
+	return fireTrigger(ctx, d.evaluator, d.submitter, &TriggerEvent{
+		JobDefinition: jobDef,
+		Trigger:       trigger,
+		Data:          data,
+	})
+}
+
+// fireTrigger evaluates the event and submits its job request. It returns nil request without error
+// when the event is filtered or deduped, and an error when the rate limit of the trigger is exceeded.
+func fireTrigger(
+	ctx context.Context,
+	evaluator *Evaluator,
+	submitter jobSubmitter,
+	event *TriggerEvent) (*types.JobRequest, error) {
+	result, err := evaluator.Evaluate(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if result.RateLimited {
+		return nil, fmt.Errorf("trigger %q exceeded rate limit of %d per %s",
+			event.Trigger.Name, event.Trigger.RateLimit.Max, event.Trigger.RateLimit.Window)
+	}
+	if !result.Passed {
+		return nil, nil
+	}
+	return submitter.Submit(ctx, event.JobDefinition, event.Trigger.Name, result)
+}
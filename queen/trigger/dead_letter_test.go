@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/queue"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+// memDeadLetterRepo is an in-memory TriggerDeadLetterRepository for dead-letter unit tests.
+type memDeadLetterRepo struct {
+	dls map[string]*types.TriggerDeadLetter
+}
+
+func newMemDeadLetterRepo() *memDeadLetterRepo {
+	return &memDeadLetterRepo{dls: make(map[string]*types.TriggerDeadLetter)}
+}
+
+func (m *memDeadLetterRepo) Get(id string) (*types.TriggerDeadLetter, error) {
+	if dl, ok := m.dls[id]; ok {
+		cp := *dl
+		return &cp, nil
+	}
+	return nil, common.NewNotFoundError(fmt.Errorf("dead-letter %s is not found", id))
+}
+
+func (m *memDeadLetterRepo) Query(jobDef *types.JobDefinition, triggerName string, state string, limit int) ([]*types.TriggerDeadLetter, error) {
+	out := make([]*types.TriggerDeadLetter, 0)
+	for _, dl := range m.dls {
+		if dl.BelongsTo(jobDef) &&
+			(triggerName == "" || dl.TriggerName == triggerName) &&
+			(state == "" || dl.State == state) {
+			cp := *dl
+			out = append(out, &cp)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID > out[j].ID })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m *memDeadLetterRepo) Save(dl *types.TriggerDeadLetter) (*types.TriggerDeadLetter, error) {
+	if err := dl.Validate(); err != nil {
+		return nil, err
+	}
+	if dl.ID == "" {
+		dl.ID = ulid.Make().String()
+		dl.CreatedAt = time.Now()
+	}
+	dl.UpdatedAt = time.Now()
+	cp := *dl
+	m.dls[dl.ID] = &cp
+	return dl, nil
+}
+
+func (m *memDeadLetterRepo) Claim(dl *types.TriggerDeadLetter) (bool, error) {
+	saved, ok := m.dls[dl.ID]
+	if !ok || !saved.Failed() || saved.Attempts != dl.Attempts {
+		return false, nil
+	}
+	saved.Attempts++
+	dl.Attempts++
+	return true, nil
+}
+
+func newDeadLetterQueueSubscriber(
+	repo *memDeadLetterRepo,
+	submitter jobSubmitter,
+	trigger *types.TriggerDefinition) *QueueSubscriber {
+	ev := NewEvaluator(newMemTriggerRepo())
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "order-job", OrganizationID: "org-1",
+		Triggers: []*types.TriggerDefinition{trigger}}
+	return &QueueSubscriber{
+		evaluator:   ev,
+		submitter:   submitter,
+		deadLetters: newDeadLetters(repo, ev, submitter),
+		jobDef:      jobDef,
+		trigger:     trigger,
+	}
+}
+
+func handleOrderMessage(t *testing.T, qs *QueueSubscriber, orderID string, total int) *ackRecorder {
+	payload, err := json.Marshal(map[string]interface{}{"order_id": orderID, "total": total})
+	require.NoError(t, err)
+	rec := &ackRecorder{}
+	err = qs.handleMessage(
+		context.Background(),
+		&queue.MessageEvent{Payload: payload, Properties: map[string]string{"source": "shop"}},
+		rec.ack,
+		rec.nack)
+	require.NoError(t, err)
+	return rec
+}
+
+// Test_ShouldMoveFailedQueueEventToDeadLettersAndReplay verifies that a message with a bad template is
+// acknowledged and kept in dead-letters, and that it can be replayed after fixing the trigger.
+func Test_ShouldMoveFailedQueueEventToDeadLettersAndReplay(t *testing.T) {
+	// GIVEN a queue trigger with a param template that fails at runtime
+	repo := newMemDeadLetterRepo()
+	submitter := &capturingJobSubmitter{}
+	trigger := &types.TriggerDefinition{
+		Type:  "queue",
+		Name:  "orders",
+		Topic: "orders",
+		Params: map[string]string{
+			"order_id": `{{ index .Message.missing "id" }}`,
+		},
+	}
+	qs := newDeadLetterQueueSubscriber(repo, submitter, trigger)
+
+	// WHEN a message arrives
+	rec := handleOrderMessage(t, qs, "ord-1", 2000)
+
+	// THEN message is acknowledged and kept in dead-letters without submitting a job
+	require.True(t, rec.acked)
+	require.False(t, rec.nacked)
+	require.Len(t, submitter.submitted(), 0)
+	dls, err := qs.deadLetters.Query(qs.jobDef, "", types.DeadLetterFailed, 0)
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	require.Equal(t, "orders", dls[0].TriggerName)
+	require.Equal(t, "queue", dls[0].TriggerType)
+	require.Equal(t, 1, dls[0].Attempts)
+	require.Contains(t, dls[0].ErrorMessage, "order_id")
+	require.Contains(t, dls[0].Payload, "ord-1")
+
+	// WHEN replaying before the trigger is fixed
+	_, err = qs.deadLetters.Replay(context.Background(), qs.jobDef, dls[0])
+
+	// THEN it stays in dead-letters with another attempt
+	require.Error(t, err)
+	dl, err := repo.Get(dls[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, types.DeadLetterFailed, dl.State)
+	require.Equal(t, 2, dl.Attempts)
+
+	// WHEN replaying after fixing the trigger, which saves a new version of the job definition
+	fixedTrigger := *trigger
+	fixedTrigger.Params = map[string]string{
+		"order_id": "{{ .Message.order_id }}",
+		"total":    `{{ printf "%v" .Message.total }}`,
+		"source":   "{{ .Properties.source }}",
+	}
+	fixedJobDef := &types.JobDefinition{ID: "def-1-v2", JobType: "order-job", OrganizationID: "org-1",
+		Triggers: []*types.TriggerDefinition{&fixedTrigger}}
+	dls, err = qs.deadLetters.Select(fixedJobDef, []string{dl.ID}, "")
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	concurrent, err := qs.deadLetters.Select(fixedJobDef, []string{dl.ID}, "")
+	require.NoError(t, err)
+	_, err = qs.deadLetters.Replay(context.Background(), fixedJobDef, dls[0])
+
+	// THEN job is submitted with params of the original message
+	require.NoError(t, err)
+	require.Len(t, submitter.submitted(), 1)
+	require.Equal(t, "ord-1", submitter.submitted()[0].Params["order_id"])
+	require.Equal(t, "2000", submitter.submitted()[0].Params["total"])
+	require.Equal(t, "shop", submitter.submitted()[0].Params["source"])
+	dl, err = repo.Get(dl.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.DeadLetterReplayed, dl.State)
+	require.False(t, dl.ReplayedAt.IsZero())
+
+	// AND replayed dead-letter cannot be replayed again, even by a replay that loaded it concurrently
+	_, err = qs.deadLetters.Select(qs.jobDef, []string{dl.ID}, "")
+	require.Error(t, err)
+	_, err = qs.deadLetters.Replay(context.Background(), fixedJobDef, concurrent[0])
+	require.ErrorContains(t, err, "already being replayed")
+	require.Len(t, submitter.submitted(), 1)
+}
+
+// Test_ShouldMoveRateLimitedQueueEventsToDeadLettersAndDiscard verifies that rate-limited messages are
+// kept in dead-letters and can be discarded in bulk.
+func Test_ShouldMoveRateLimitedQueueEventsToDeadLettersAndDiscard(t *testing.T) {
+	// GIVEN a queue trigger that allows a single job per hour
+	repo := newMemDeadLetterRepo()
+	submitter := &capturingJobSubmitter{}
+	trigger := &types.TriggerDefinition{
+		Type:      "queue",
+		Name:      "orders",
+		Topic:     "orders",
+		Filter:    `{{ if gt (atoi (printf "%v" .Message.total)) 1000 }}true{{ end }}`,
+		RateLimit: &types.TriggerRateLimit{Max: 1, Window: time.Hour},
+	}
+	qs := newDeadLetterQueueSubscriber(repo, submitter, trigger)
+
+	// WHEN messages arrive, where one of them is filtered
+	for i, total := range []int{2000, 500, 3000, 4000} {
+		rec := handleOrderMessage(t, qs, fmt.Sprintf("ord-%d", i), total)
+		require.True(t, rec.acked)
+	}
+
+	// THEN first message submits a job, filtered message is dropped and others are rate limited
+	require.Len(t, submitter.submitted(), 1)
+	dls, err := qs.deadLetters.Select(qs.jobDef, nil, "orders")
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+	require.Contains(t, dls[0].ErrorMessage, "rate limit")
+
+	// WHEN discarding all failed dead-letters of the trigger
+	for _, dl := range dls {
+		require.NoError(t, qs.deadLetters.Discard(dl))
+	}
+
+	// THEN nothing is left to replay, but discarded events are still listed
+	dls, err = qs.deadLetters.Select(qs.jobDef, nil, "")
+	require.NoError(t, err)
+	require.Len(t, dls, 0)
+	dls, err = qs.deadLetters.Query(qs.jobDef, "orders", types.DeadLetterDiscarded, 10)
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+
+	// AND dead-letters of other jobs or orgs cannot be selected
+	_, err = qs.deadLetters.Select(&types.JobDefinition{ID: "def-2", JobType: "other-job", OrganizationID: "org-1"},
+		[]string{dls[0].ID}, "")
+	require.Error(t, err)
+	_, err = qs.deadLetters.Select(&types.JobDefinition{ID: "def-3", JobType: "order-job", OrganizationID: "org-2"},
+		[]string{dls[0].ID}, "")
+	require.Error(t, err)
+}
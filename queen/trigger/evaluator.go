@@ -26,6 +26,8 @@ type EvalResult struct {
 	Params   map[string]string
 	// DedupKey is the evaluated dedup_key template result (used as JobRequest.UserKey).
 	DedupKey string
+	// RateLimited is true when the filter matched but the rate limit of the trigger was exceeded.
+	RateLimited bool
 }
 
 // TriggerEvent carries the event data passed to the evaluator.
@@ -105,7 +107,7 @@ func (e *Evaluator) Evaluate(ctx context.Context, event *TriggerEvent) (*EvalRes
 		}
 		if !allowed {
 			span.SetAttributes(attribute.Bool("trigger.rate_limited", true))
-			return &EvalResult{Passed: false}, nil
+			return &EvalResult{Passed: false, RateLimited: true}, nil
 		}
 	}
 
@@ -0,0 +1,399 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"plexobject.com/formicary/internal/tracing"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+const (
+	defaultFileSettleTime = 2 * time.Second
+	// firedFileRetention is how long fired files are remembered to ignore events that don't change them
+	firedFileRetention = time.Hour
+)
+
+// WatchedFile is the settled file exposed to file trigger templates as `.File`.
+type WatchedFile struct {
+	// Path is the absolute path of the file.
+	Path string
+	// Name is the slash separated path relative to the watched directory.
+	Name      string
+	Directory string
+	Size      int64
+	ModTime   time.Time
+}
+
+// fileCursor is saved in trigger state so that files that were already processed don't refire after
+// restart; files are ordered by modification time and then name.
+type fileCursor struct {
+	ModTime time.Time `json:"mod_time"`
+	Name    string    `json:"name"`
+}
+
+// after returns true if the file comes after the cursor and hasn't been processed
+func (c *fileCursor) after(modTime time.Time, name string) bool {
+	return modTime.After(c.ModTime) || (modTime.Equal(c.ModTime) && name > c.Name)
+}
+
+// pendingFile is a file that waits for the settle time
+type pendingFile struct {
+	timer   *time.Timer
+	modTime time.Time
+}
+
+// firedFile is modification time of a file when it fired
+type firedFile struct {
+	modTime time.Time
+	firedAt time.Time
+}
+
+// FileWatcher watches a directory with fsnotify and fires a trigger for each matching file once it
+// stops changing for the settle time.
+type FileWatcher struct {
+	watcher          *fsnotify.Watcher
+	evaluator        *Evaluator
+	submitter        jobSubmitter
+	deadLetters      *DeadLetters
+	triggerStateRepo repository.TriggerStateRepository
+	jobDef           *types.JobDefinition
+	trigger          *types.TriggerDefinition
+	directory        string
+	settle           time.Duration
+	cursor           fileCursor
+	lastFired        fileCursor
+	pending          map[string]*pendingFile
+	fired            map[string]firedFile
+	ready            chan string
+	stopCh           chan struct{}
+	wg               sync.WaitGroup
+}
+
+// NewFileWatcher creates and starts a FileWatcher.
+func NewFileWatcher(
+	ctx context.Context,
+	evaluator *Evaluator,
+	submitter *Submitter,
+	deadLetters *DeadLetters,
+	triggerStateRepo repository.TriggerStateRepository,
+	jobDef *types.JobDefinition,
+	trigger *types.TriggerDefinition,
+) (*FileWatcher, error) {
+	w, err := newFileWatcher(evaluator, submitter, deadLetters, triggerStateRepo, jobDef, trigger)
+	if err != nil {
+		return nil, err
+	}
+	w.wg.Add(1)
+	go w.run(ctx)
+	return w, nil
+}
+
+func newFileWatcher(
+	evaluator *Evaluator,
+	submitter jobSubmitter,
+	deadLetters *DeadLetters,
+	triggerStateRepo repository.TriggerStateRepository,
+	jobDef *types.JobDefinition,
+	trigger *types.TriggerDefinition,
+) (*FileWatcher, error) {
+	// symlinks are resolved so that the directory can't point outside the watch roots
+	dir, err := types.ResolveFileTriggerDirectory(trigger.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("file trigger %q: %w", trigger.Name, err)
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("file trigger %q: directory %s is not accessible", trigger.Name, dir)
+	}
+	settle := trigger.SettleTime
+	if settle <= 0 {
+		settle = defaultFileSettleTime
+	}
+	w := &FileWatcher{
+		evaluator:        evaluator,
+		submitter:        submitter,
+		deadLetters:      deadLetters,
+		triggerStateRepo: triggerStateRepo,
+		jobDef:           jobDef,
+		trigger:          trigger,
+		directory:        dir,
+		settle:           settle,
+		pending:          make(map[string]*pendingFile),
+		fired:            make(map[string]firedFile),
+		ready:            make(chan string),
+		stopCh:           make(chan struct{}),
+	}
+	found, err := loadSensorCursor(triggerStateRepo, jobDef.ID, trigger.Name, &w.cursor)
+	if err != nil {
+		return nil, fmt.Errorf("file trigger %q: failed to load state: %w", trigger.Name, err)
+	}
+	if !found {
+		// Like S3 polling, initialize cursor to now so that existing files are not backfilled.
+		w.cursor = fileCursor{ModTime: time.Now()}
+		if err = saveSensorCursor(triggerStateRepo, jobDef.ID, trigger.Name, &w.cursor); err != nil {
+			return nil, fmt.Errorf("file trigger %q: failed to initialize state: %w", trigger.Name, err)
+		}
+	}
+	w.lastFired = w.cursor
+	if w.watcher, err = fsnotify.NewWatcher(); err != nil {
+		return nil, err
+	}
+	if err = w.watchDirectory(dir); err != nil {
+		_ = w.watcher.Close()
+		return nil, fmt.Errorf("file trigger %q: failed to watch %s: %w", trigger.Name, dir, err)
+	}
+	return w, nil
+}
+
+// Stop halts the watcher and discards files that haven't settled yet.
+func (w *FileWatcher) Stop() {
+	close(w.stopCh)
+	w.wg.Wait()
+}
+
+func (w *FileWatcher) run(ctx context.Context) {
+	defer w.wg.Done()
+	defer func() {
+		for _, pending := range w.pending {
+			pending.timer.Stop()
+		}
+		_ = w.watcher.Close()
+	}()
+	// files that changed while the queen wasn't watching
+	w.scan(w.directory, true)
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			w.handleEvent(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			logrus.WithFields(logrus.Fields{
+				"Component":   "FileWatcher",
+				"JobType":     w.jobDef.JobType,
+				"TriggerName": w.trigger.Name,
+			}).Warnf("file watch error: %v", err)
+		case path := <-w.ready:
+			delete(w.pending, path)
+			w.fire(ctx, path)
+		}
+	}
+}
+
+func (w *FileWatcher) handleEvent(event fsnotify.Event) {
+	if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+		if pending := w.pending[event.Name]; pending != nil {
+			pending.timer.Stop()
+			delete(w.pending, event.Name)
+		}
+		delete(w.fired, event.Name)
+		return
+	}
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+		return
+	}
+	if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+		if w.trigger.Recursive && event.Has(fsnotify.Create) {
+			_ = w.watchDirectory(event.Name)
+			// files may be created before the watch is added
+			w.scan(event.Name, false)
+		}
+		return
+	}
+	if w.matches(event.Name) {
+		w.schedule(event.Name)
+	}
+}
+
+// schedule (re)starts settle timer of the file
+func (w *FileWatcher) schedule(path string) {
+	pending := w.pending[path]
+	if pending != nil {
+		pending.timer.Stop()
+	} else {
+		pending = &pendingFile{}
+		w.pending[path] = pending
+	}
+	if info, err := os.Lstat(path); err == nil {
+		pending.modTime = info.ModTime()
+	}
+	pending.timer = time.AfterFunc(w.settle, func() {
+		select {
+		case w.ready <- path:
+		case <-w.stopCh:
+		}
+	})
+}
+
+// scan schedules matching files under dir, optionally only those after the cursor
+func (w *FileWatcher) scan(dir string, afterCursor bool) {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != dir && !w.trigger.Recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !w.matches(path) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if !afterCursor || w.cursor.after(info.ModTime(), w.relativeName(path)) {
+			w.schedule(path)
+		}
+		return nil
+	})
+}
+
+func (w *FileWatcher) fire(ctx context.Context, path string) {
+	// symlinks don't fire because they may point to files outside the watched directory
+	info, err := os.Lstat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return
+	}
+	if wait := w.settle - time.Since(info.ModTime()); wait > 0 {
+		// still being written without events, e.g. on network volumes
+		w.schedule(path)
+		return
+	}
+	if fired, ok := w.fired[path]; ok && fired.modTime.Equal(info.ModTime()) {
+		return
+	}
+	ctx, span := tracing.Tracer("formicary.trigger").Start(ctx, "trigger.file",
+		trace.WithAttributes(
+			attribute.String("trigger.name", w.trigger.Name),
+			attribute.String("job.type", w.jobDef.JobType),
+			attribute.String("file.path", path),
+		),
+	)
+	defer func() { span.End() }()
+	logger := logrus.WithFields(logrus.Fields{
+		"Component":   "FileWatcher",
+		"JobType":     w.jobDef.JobType,
+		"TriggerName": w.trigger.Name,
+		"Path":        path,
+	})
+
+	name := w.relativeName(path)
+	event := &TriggerEvent{
+		JobDefinition: w.jobDef,
+		Trigger:       w.trigger,
+		Data: map[string]interface{}{
+			"File": &WatchedFile{
+				Path:      path,
+				Name:      name,
+				Directory: w.directory,
+				Size:      info.Size(),
+				ModTime:   info.ModTime(),
+			},
+		},
+	}
+	if _, err = fireTrigger(ctx, w.evaluator, w.submitter, event); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.Errorf("failed to fire trigger: %v", err)
+		// the file is done once the event is kept in dead-letters, where it can be replayed
+		if _, dlErr := w.deadLetters.Record(event, err); dlErr != nil {
+			// retry the file after the settle time
+			logger.Warnf("failed to record dead-letter: %v", dlErr)
+			w.schedule(path)
+			return
+		}
+	}
+	w.markFired(path, info.ModTime())
+	if w.lastFired.after(info.ModTime(), name) {
+		w.lastFired = fileCursor{ModTime: info.ModTime(), Name: name}
+	}
+	if cursor := w.nextCursor(); !cursor.ModTime.Equal(w.cursor.ModTime) || cursor.Name != w.cursor.Name {
+		w.cursor = cursor
+		if err = saveSensorCursor(w.triggerStateRepo, w.jobDef.ID, w.trigger.Name, &w.cursor); err != nil {
+			logger.Warnf("failed to update trigger state: %v", err)
+		}
+	}
+}
+
+// nextCursor returns the cursor to save, which is the latest fired file unless an earlier file is still
+// pending, e.g. because it's still being written or its event failed. The cursor then stays before the
+// pending file so that it's scanned again after restart, and fired files with the same modification
+// time may fire again.
+func (w *FileWatcher) nextCursor() fileCursor {
+	cursor := w.lastFired
+	for _, pending := range w.pending {
+		if !cursor.after(pending.modTime, "") {
+			cursor = fileCursor{ModTime: pending.modTime}
+		}
+	}
+	return cursor
+}
+
+// markFired remembers modification time of the fired file and forgets files that were fired long ago
+// so that the watcher doesn't grow with the number of files in the directory
+func (w *FileWatcher) markFired(path string, modTime time.Time) {
+	now := time.Now()
+	w.fired[path] = firedFile{modTime: modTime, firedAt: now}
+	for p, fired := range w.fired {
+		if now.Sub(fired.firedAt) > firedFileRetention {
+			delete(w.fired, p)
+		}
+	}
+}
+
+func (w *FileWatcher) watchDirectory(dir string) error {
+	if !w.trigger.Recursive {
+		return w.watcher.Add(dir)
+	}
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return w.watcher.Add(path)
+		}
+		return nil
+	})
+}
+
+func (w *FileWatcher) matches(path string) bool {
+	name := w.relativeName(path)
+	if name == "" || strings.HasPrefix(name, "../") || (!w.trigger.Recursive && strings.Contains(name, "/")) {
+		return false
+	}
+	return matchGlobFilter(w.trigger.Paths, name)
+}
+
+func (w *FileWatcher) relativeName(path string) string {
+	rel, err := filepath.Rel(w.directory, path)
+	if err != nil {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
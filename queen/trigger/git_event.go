@@ -0,0 +1,453 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	gitEventPush        = "push"
+	gitEventTag         = "tag"
+	gitEventPullRequest = "pull_request"
+)
+
+// GitEvent is the provider-neutral form of a push, tag or pull-request webhook from GitHub, GitLab,
+// Gitea or Bitbucket. It is exposed to filter/param templates as `.Git`.
+type GitEvent struct {
+	// Provider is one of github, gitlab, gitea or bitbucket.
+	Provider string
+	// Event is one of push, tag or pull_request.
+	Event string
+	// Action is the pull-request action reported by the provider such as opened or synchronize.
+	Action     string
+	Repository string
+	CloneURL   string
+	Ref        string
+	// Branch is the pushed branch or the source branch of a pull request.
+	Branch string
+	Tag    string
+	// BaseBranch is the target branch of a pull request.
+	BaseBranch    string
+	CommitID      string
+	CommitMessage string
+	Author        string
+	PullRequest   int
+	Title         string
+	// ChangedFiles is the sorted union of added, modified and removed files of pushed commits.
+	ChangedFiles []string
+	// ChangedFilesKnown is false when the provider doesn't report files, e.g. pull requests,
+	// Bitbucket pushes or GitLab pushes with truncated commit lists, where paths filters of the
+	// trigger match or skip the event based on its changed_files_unknown.
+	ChangedFilesKnown bool
+	// Deleted is true when the branch or tag was deleted.
+	Deleted bool
+}
+
+// Params returns job params that are added to requests created by git triggers
+func (e *GitEvent) Params() map[string]string {
+	params := map[string]string{
+		"GitProvider":      e.Provider,
+		"GitEvent":         e.Event,
+		"GitRepository":    e.Repository,
+		"GitRef":           e.Ref,
+		"GitBranch":        e.Branch,
+		"GitCommitID":      e.CommitID,
+		"GitCommitMessage": e.CommitMessage,
+		"GitCommitAuthor":  e.Author,
+		"GitChangedFiles":  strings.Join(e.ChangedFiles, "\n"),
+	}
+	if e.CloneURL != "" {
+		params["GitCloneURL"] = e.CloneURL
+	}
+	if e.Tag != "" {
+		params["GitTag"] = e.Tag
+	}
+	if e.Event == gitEventPullRequest {
+		params["GitPullRequest"] = strconv.Itoa(e.PullRequest)
+		params["GitPullRequestAction"] = e.Action
+		params["GitBaseBranch"] = e.BaseBranch
+	}
+	return params
+}
+
+// ParseGitEvent normalizes webhook payload of a git provider. The provider is detected from event
+// headers unless it's given. It returns nil event for event types that aren't push, tag or pull
+// request such as ping.
+func ParseGitEvent(provider string, header http.Header, body []byte) (*GitEvent, error) {
+	if provider == "" {
+		provider = detectGitProvider(header)
+	}
+	var eventType string
+	switch provider {
+	case "github":
+		eventType = header.Get("X-GitHub-Event")
+	case "gitea":
+		eventType = header.Get("X-Gitea-Event")
+		if eventType == "" {
+			eventType = header.Get("X-Gogs-Event")
+		}
+	case "gitlab":
+		eventType = header.Get("X-Gitlab-Event")
+	case "bitbucket":
+		eventType = header.Get("X-Event-Key")
+	default:
+		return nil, fmt.Errorf("failed to detect git provider from event headers")
+	}
+	if eventType == "" {
+		return nil, fmt.Errorf("missing event header for git provider %s", provider)
+	}
+
+	var event *GitEvent
+	var err error
+	switch provider {
+	case "github", "gitea":
+		event, err = parseGithubEvent(eventType, body)
+	case "gitlab":
+		event, err = parseGitlabEvent(eventType, body)
+	case "bitbucket":
+		event, err = parseBitbucketEvent(eventType, body)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s event %s due to %w", provider, eventType, err)
+	}
+	if event != nil {
+		event.Provider = provider
+	}
+	return event, nil
+}
+
+// detectGitProvider checks Gitea headers first because Gitea also sends GitHub compatible headers
+func detectGitProvider(header http.Header) string {
+	switch {
+	case header.Get("X-Gitea-Event") != "" || header.Get("X-Gogs-Event") != "":
+		return "gitea"
+	case header.Get("X-GitHub-Event") != "":
+		return "github"
+	case header.Get("X-Gitlab-Event") != "":
+		return "gitlab"
+	case header.Get("X-Event-Key") != "":
+		return "bitbucket"
+	}
+	return ""
+}
+
+type githubCommit struct {
+	ID       string   `json:"id"`
+	Message  string   `json:"message"`
+	Added    []string `json:"added"`
+	Modified []string `json:"modified"`
+	Removed  []string `json:"removed"`
+	Author   struct {
+		Name string `json:"name"`
+	} `json:"author"`
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+	CloneURL string `json:"clone_url"`
+}
+
+type githubPush struct {
+	Ref        string           `json:"ref"`
+	After      string           `json:"after"`
+	Deleted    bool             `json:"deleted"`
+	HeadCommit *githubCommit    `json:"head_commit"`
+	Commits    []githubCommit   `json:"commits"`
+	Repository githubRepository `json:"repository"`
+}
+
+type githubPullRequest struct {
+	Action      string           `json:"action"`
+	Number      int              `json:"number"`
+	Repository  githubRepository `json:"repository"`
+	PullRequest struct {
+		Title string `json:"title"`
+		Head  struct {
+			Ref string `json:"ref"`
+			Sha string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+}
+
+// parseGithubEvent parses GitHub payloads, which are also sent by Gitea
+func parseGithubEvent(eventType string, body []byte) (*GitEvent, error) {
+	switch eventType {
+	case "push":
+		var push githubPush
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		event := newGitRefEvent(push.Ref)
+		event.Repository = push.Repository.FullName
+		event.CloneURL = push.Repository.CloneURL
+		event.CommitID = push.After
+		event.Deleted = push.Deleted || isZeroCommit(push.After)
+		files := make([][]string, 0)
+		for _, c := range push.Commits {
+			files = append(files, c.Added, c.Modified, c.Removed)
+		}
+		head := push.HeadCommit
+		if head == nil && len(push.Commits) > 0 {
+			head = &push.Commits[len(push.Commits)-1]
+		}
+		if head != nil {
+			event.CommitID = head.ID
+			event.CommitMessage = head.Message
+			event.Author = head.Author.Name
+		}
+		event.ChangedFiles = mergeChangedFiles(files...)
+		event.ChangedFilesKnown = true
+		return event, nil
+	case "pull_request":
+		var pr githubPullRequest
+		if err := json.Unmarshal(body, &pr); err != nil {
+			return nil, err
+		}
+		return &GitEvent{
+			Event:       gitEventPullRequest,
+			Action:      pr.Action,
+			Repository:  pr.Repository.FullName,
+			CloneURL:    pr.Repository.CloneURL,
+			Ref:         "refs/heads/" + pr.PullRequest.Head.Ref,
+			Branch:      pr.PullRequest.Head.Ref,
+			BaseBranch:  pr.PullRequest.Base.Ref,
+			CommitID:    pr.PullRequest.Head.Sha,
+			Author:      pr.PullRequest.User.Login,
+			PullRequest: pr.Number,
+			Title:       pr.PullRequest.Title,
+		}, nil
+	}
+	return nil, nil
+}
+
+type gitlabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+	GitHTTPURL        string `json:"git_http_url"`
+}
+
+type gitlabPush struct {
+	Ref               string         `json:"ref"`
+	After             string         `json:"after"`
+	CheckoutSha       string         `json:"checkout_sha"`
+	UserName          string         `json:"user_name"`
+	TotalCommitsCount int            `json:"total_commits_count"`
+	Commits           []githubCommit `json:"commits"`
+	Project           gitlabProject  `json:"project"`
+}
+
+type gitlabMergeRequest struct {
+	Project gitlabProject `json:"project"`
+	User    struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Action       string `json:"action"`
+		Title        string `json:"title"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		LastCommit   struct {
+			ID      string `json:"id"`
+			Message string `json:"message"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+func parseGitlabEvent(eventType string, body []byte) (*GitEvent, error) {
+	switch eventType {
+	case "Push Hook", "Tag Push Hook":
+		var push gitlabPush
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		event := newGitRefEvent(push.Ref)
+		event.Repository = push.Project.PathWithNamespace
+		event.CloneURL = push.Project.GitHTTPURL
+		event.CommitID = push.CheckoutSha
+		event.Deleted = isZeroCommit(push.After)
+		event.Author = push.UserName
+		files := make([][]string, 0)
+		for _, c := range push.Commits {
+			files = append(files, c.Added, c.Modified, c.Removed)
+			if c.ID == push.CheckoutSha {
+				event.CommitMessage = c.Message
+				event.Author = c.Author.Name
+			}
+		}
+		event.ChangedFiles = mergeChangedFiles(files...)
+		// gitlab only sends first 20 commits of a push
+		event.ChangedFilesKnown = push.TotalCommitsCount <= len(push.Commits)
+		return event, nil
+	case "Merge Request Hook":
+		var mr gitlabMergeRequest
+		if err := json.Unmarshal(body, &mr); err != nil {
+			return nil, err
+		}
+		attrs := mr.ObjectAttributes
+		return &GitEvent{
+			Event:         gitEventPullRequest,
+			Action:        attrs.Action,
+			Repository:    mr.Project.PathWithNamespace,
+			CloneURL:      mr.Project.GitHTTPURL,
+			Ref:           "refs/heads/" + attrs.SourceBranch,
+			Branch:        attrs.SourceBranch,
+			BaseBranch:    attrs.TargetBranch,
+			CommitID:      attrs.LastCommit.ID,
+			CommitMessage: attrs.LastCommit.Message,
+			Author:        mr.User.Username,
+			PullRequest:   attrs.IID,
+			Title:         attrs.Title,
+		}, nil
+	}
+	return nil, nil
+}
+
+type bitbucketRepository struct {
+	FullName string `json:"full_name"`
+	Links    struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+type bitbucketActor struct {
+	DisplayName string `json:"display_name"`
+}
+
+type bitbucketRef struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target struct {
+		Hash    string `json:"hash"`
+		Message string `json:"message"`
+	} `json:"target"`
+}
+
+type bitbucketPush struct {
+	Actor      bitbucketActor      `json:"actor"`
+	Repository bitbucketRepository `json:"repository"`
+	Push       struct {
+		Changes []struct {
+			New *bitbucketRef `json:"new"`
+			Old *bitbucketRef `json:"old"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+type bitbucketPullRequest struct {
+	Repository  bitbucketRepository `json:"repository"`
+	PullRequest struct {
+		ID     int            `json:"id"`
+		Title  string         `json:"title"`
+		Author bitbucketActor `json:"author"`
+		Source struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+			Commit struct {
+				Hash string `json:"hash"`
+			} `json:"commit"`
+		} `json:"source"`
+		Destination struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+		} `json:"destination"`
+	} `json:"pullrequest"`
+}
+
+// parseBitbucketEvent parses Bitbucket Cloud payloads, which don't include changed files
+func parseBitbucketEvent(eventType string, body []byte) (*GitEvent, error) {
+	if eventType == "repo:push" {
+		var push bitbucketPush
+		if err := json.Unmarshal(body, &push); err != nil {
+			return nil, err
+		}
+		if len(push.Push.Changes) == 0 {
+			return nil, nil
+		}
+		change := push.Push.Changes[len(push.Push.Changes)-1]
+		ref := change.New
+		deleted := ref == nil
+		if deleted {
+			ref = change.Old
+		}
+		if ref == nil {
+			return nil, nil
+		}
+		prefix := "refs/heads/"
+		if ref.Type == "tag" {
+			prefix = "refs/tags/"
+		}
+		event := newGitRefEvent(prefix + ref.Name)
+		event.Repository = push.Repository.FullName
+		event.CloneURL = push.Repository.Links.HTML.Href
+		event.Author = push.Actor.DisplayName
+		event.Deleted = deleted
+		if !deleted {
+			event.CommitID = ref.Target.Hash
+			event.CommitMessage = ref.Target.Message
+		}
+		return event, nil
+	}
+	if strings.HasPrefix(eventType, "pullrequest:") {
+		var pr bitbucketPullRequest
+		if err := json.Unmarshal(body, &pr); err != nil {
+			return nil, err
+		}
+		return &GitEvent{
+			Event:       gitEventPullRequest,
+			Action:      strings.TrimPrefix(eventType, "pullrequest:"),
+			Repository:  pr.Repository.FullName,
+			CloneURL:    pr.Repository.Links.HTML.Href,
+			Ref:         "refs/heads/" + pr.PullRequest.Source.Branch.Name,
+			Branch:      pr.PullRequest.Source.Branch.Name,
+			BaseBranch:  pr.PullRequest.Destination.Branch.Name,
+			CommitID:    pr.PullRequest.Source.Commit.Hash,
+			Author:      pr.PullRequest.Author.DisplayName,
+			PullRequest: pr.PullRequest.ID,
+			Title:       pr.PullRequest.Title,
+		}, nil
+	}
+	return nil, nil
+}
+
+// newGitRefEvent creates push or tag event from a git ref such as refs/heads/main or refs/tags/v1
+func newGitRefEvent(ref string) *GitEvent {
+	if tag := strings.TrimPrefix(ref, "refs/tags/"); tag != ref {
+		return &GitEvent{Event: gitEventTag, Ref: ref, Tag: tag}
+	}
+	return &GitEvent{Event: gitEventPush, Ref: ref, Branch: strings.TrimPrefix(ref, "refs/heads/")}
+}
+
+func isZeroCommit(sha string) bool {
+	return sha != "" && strings.Trim(sha, "0") == ""
+}
+
+func mergeChangedFiles(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	files := make([]string, 0)
+	for _, list := range lists {
+		for _, f := range list {
+			if f != "" && !seen[f] {
+				seen[f] = true
+				files = append(files, f)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files
+}
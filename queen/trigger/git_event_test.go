@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+const githubPushPayload = `{
+  "ref": "refs/heads/main",
+  "after": "c2",
+  "repository": {"full_name": "acme/mono", "clone_url": "https://github.com/acme/mono.git"},
+  "head_commit": {"id": "c2", "message": "fix api", "author": {"name": "dev"}},
+  "commits": [
+    {"id": "c1", "added": ["services/api/new.go"], "modified": ["libs/util.go"], "removed": []},
+    {"id": "c2", "added": [], "modified": ["services/api/new.go"], "removed": ["docs/old.md"]}
+  ]
+}`
+
+func Test_ShouldParseGithubPush(t *testing.T) {
+	// GIVEN a GitHub push payload
+	header := http.Header{"X-Github-Event": []string{"push"}}
+
+	// WHEN it's parsed
+	event, err := ParseGitEvent("", header, []byte(githubPushPayload))
+
+	// THEN it should be normalized with changed files
+	require.NoError(t, err)
+	require.Equal(t, "github", event.Provider)
+	require.Equal(t, "push", event.Event)
+	require.Equal(t, "main", event.Branch)
+	require.Equal(t, "acme/mono", event.Repository)
+	require.Equal(t, "c2", event.CommitID)
+	require.Equal(t, "fix api", event.CommitMessage)
+	require.Equal(t, "dev", event.Author)
+	require.True(t, event.ChangedFilesKnown)
+	require.Equal(t, []string{"docs/old.md", "libs/util.go", "services/api/new.go"}, event.ChangedFiles)
+
+	// AND params should expose changed files
+	params := event.Params()
+	require.Equal(t, "main", params["GitBranch"])
+	require.Equal(t, "docs/old.md\nlibs/util.go\nservices/api/new.go", params["GitChangedFiles"])
+	require.Equal(t, "", params["GitTag"])
+}
+
+func Test_ShouldParseGiteaTagAndIgnoreOtherEvents(t *testing.T) {
+	// GIVEN a Gitea tag push which also sends GitHub headers
+	header := http.Header{"X-Github-Event": []string{"push"}, "X-Gitea-Event": []string{"push"}}
+
+	// WHEN it's parsed
+	event, err := ParseGitEvent("", header, []byte(`{"ref": "refs/tags/v1.0", "after": "abc", "commits": []}`))
+
+	// THEN it should be detected as gitea tag
+	require.NoError(t, err)
+	require.Equal(t, "gitea", event.Provider)
+	require.Equal(t, "tag", event.Event)
+	require.Equal(t, "v1.0", event.Tag)
+	require.Equal(t, "v1.0", event.Params()["GitTag"])
+
+	// AND ping events should be ignored
+	event, err = ParseGitEvent("github", http.Header{"X-Github-Event": []string{"ping"}}, []byte(`{}`))
+	require.NoError(t, err)
+	require.Nil(t, event)
+
+	// AND missing headers should fail
+	_, err = ParseGitEvent("", http.Header{}, []byte(`{}`))
+	require.Error(t, err)
+	_, err = ParseGitEvent("gitlab", http.Header{"X-Github-Event": []string{"push"}}, []byte(`{}`))
+	require.Error(t, err)
+}
+
+func Test_ShouldParseGitlabPushAndMergeRequest(t *testing.T) {
+	// GIVEN a GitLab push with truncated commits
+	header := http.Header{"X-Gitlab-Event": []string{"Push Hook"}}
+	body := `{"ref": "refs/heads/dev", "after": "b2", "checkout_sha": "b2", "user_name": "pusher",
+		"total_commits_count": 30, "project": {"path_with_namespace": "acme/mono"},
+		"commits": [{"id": "b2", "message": "msg", "author": {"name": "author"}, "added": ["a.go"]}]}`
+
+	// WHEN it's parsed
+	event, err := ParseGitEvent("", header, []byte(body))
+
+	// THEN changed files should be marked incomplete
+	require.NoError(t, err)
+	require.Equal(t, "gitlab", event.Provider)
+	require.Equal(t, "dev", event.Branch)
+	require.Equal(t, "msg", event.CommitMessage)
+	require.Equal(t, "author", event.Author)
+	require.Equal(t, []string{"a.go"}, event.ChangedFiles)
+	require.False(t, event.ChangedFilesKnown)
+
+	// WHEN a merge request is parsed
+	header = http.Header{"X-Gitlab-Event": []string{"Merge Request Hook"}}
+	body = `{"project": {"path_with_namespace": "acme/mono"}, "user": {"username": "dev"},
+		"object_attributes": {"iid": 7, "action": "open", "source_branch": "feature", "target_branch": "main",
+		"last_commit": {"id": "f1", "message": "feat"}}}`
+	event, err = ParseGitEvent("", header, []byte(body))
+
+	// THEN it should be a pull request
+	require.NoError(t, err)
+	require.Equal(t, "pull_request", event.Event)
+	require.Equal(t, "feature", event.Branch)
+	require.Equal(t, "main", event.BaseBranch)
+	require.Equal(t, "7", event.Params()["GitPullRequest"])
+	require.Equal(t, "main", event.Params()["GitBaseBranch"])
+}
+
+func Test_ShouldParseBitbucketEvents(t *testing.T) {
+	// GIVEN a Bitbucket branch deletion
+	header := http.Header{"X-Event-Key": []string{"repo:push"}}
+	body := `{"repository": {"full_name": "acme/mono"}, "actor": {"display_name": "dev"},
+		"push": {"changes": [{"new": null, "old": {"type": "branch", "name": "old", "target": {"hash": "x"}}}]}}`
+
+	// WHEN it's parsed
+	event, err := ParseGitEvent("", header, []byte(body))
+
+	// THEN it should be marked deleted without changed files
+	require.NoError(t, err)
+	require.Equal(t, "bitbucket", event.Provider)
+	require.Equal(t, "old", event.Branch)
+	require.True(t, event.Deleted)
+	require.False(t, event.ChangedFilesKnown)
+
+	// WHEN a pull request is parsed
+	header = http.Header{"X-Event-Key": []string{"pullrequest:created"}}
+	body = `{"repository": {"full_name": "acme/mono"}, "pullrequest": {"id": 3, "title": "t",
+		"source": {"branch": {"name": "feature"}, "commit": {"hash": "h"}}, "destination": {"branch": {"name": "main"}}}}`
+	event, err = ParseGitEvent("", header, []byte(body))
+
+	// THEN it should be a pull request
+	require.NoError(t, err)
+	require.Equal(t, "pull_request", event.Event)
+	require.Equal(t, "created", event.Action)
+	require.Equal(t, "h", event.CommitID)
+	require.Equal(t, 3, event.PullRequest)
+}
+
+func Test_GitTriggerFixture_FullPath(t *testing.T) {
+	// GIVEN git trigger fixture and a GitHub push of api service
+	raw, err := os.ReadFile(filepath.Join(fixtureDir(t), "git_trigger_job.yaml"))
+	require.NoError(t, err)
+	job, err := types.NewJobDefinitionFromYaml(raw)
+	require.NoError(t, err)
+	require.Len(t, job.Triggers, 2)
+	trig := job.Triggers[0]
+	require.Equal(t, "git", trig.Type)
+	event, err := ParseGitEvent(trig.Provider, http.Header{"X-Github-Event": []string{"push"}}, []byte(githubPushPayload))
+	require.NoError(t, err)
+
+	// WHEN event is matched and evaluated
+	require.True(t, MatchGitEvent(trig, event))
+	result, err := NewEvaluator(newMemTriggerRepo()).Evaluate(context.Background(), &TriggerEvent{
+		JobDefinition: job,
+		Trigger:       trig,
+		Data:          map[string]interface{}{"Git": event},
+	})
+
+	// THEN dedup key should use normalized event
+	require.NoError(t, err)
+	require.True(t, result.Passed)
+	require.Equal(t, "api-c2", result.DedupKey)
+
+	// AND release trigger should not match branch pushes
+	require.False(t, MatchGitEvent(job.Triggers[1], event))
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/karlseguin/ccache/v3"
+	"plexobject.com/formicary/queen/types"
+)
+
+const (
+	globCacheSize = 1000
+	globCacheTTL  = time.Hour
+)
+
+// globCache keeps compiled globs of triggers, patterns aren't evicted when a trigger is removed but the cache is
+// bounded so that least recently used patterns are evicted once it's full
+var globCache = ccache.New(ccache.Configure[*regexp.Regexp]().MaxSize(globCacheSize))
+
+// MatchGitEvent checks whether the git event passes events, branches, tags and paths filters of the
+// trigger. Like other CI systems, a trigger with only tag filters ignores branch pushes and a trigger
+// with only branch filters ignores tags. Branch filters of pull requests match the target branch and
+// path filters match or skip events whose provider doesn't report changed files based on the trigger.
+func MatchGitEvent(t *types.TriggerDefinition, event *GitEvent) bool {
+	if event.Deleted {
+		return false
+	}
+	if len(t.Events) > 0 && !containsString(t.Events, event.Event) {
+		return false
+	}
+	switch event.Event {
+	case gitEventPush:
+		if t.Branches == nil && t.Tags != nil {
+			return false
+		}
+		if !matchGlobFilter(t.Branches, event.Branch) {
+			return false
+		}
+	case gitEventTag:
+		if t.Tags == nil && t.Branches != nil {
+			return false
+		}
+		return matchGlobFilter(t.Tags, event.Tag)
+	case gitEventPullRequest:
+		if !matchGlobFilter(t.Branches, event.BaseBranch) {
+			return false
+		}
+	}
+	if t.Paths == nil {
+		return true
+	}
+	if !event.ChangedFilesKnown {
+		return t.ChangedFilesUnknown != types.TriggerSkipUnknownChanges
+	}
+	for _, f := range event.ChangedFiles {
+		if matchGlobFilter(t.Paths, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlobFilter returns true if value matches any include pattern (or there are none) and
+// doesn't match any exclude pattern.
+func matchGlobFilter(filter *types.TriggerGlobFilter, value string) bool {
+	if filter == nil {
+		return true
+	}
+	included := len(filter.Include) == 0
+	for _, pattern := range filter.Include {
+		if matchGlob(pattern, value) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, pattern := range filter.Exclude {
+		if matchGlob(pattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchGlob matches value against glob where `*` and `?` don't cross `/`, `**` matches anything
+// and `**/` matches zero or more directories.
+func matchGlob(pattern string, value string) bool {
+	item, _ := globCache.Fetch(pattern, globCacheTTL, func() (*regexp.Regexp, error) {
+		return regexp.MustCompile(globToRegex(pattern)), nil
+	})
+	return item.Value().MatchString(value)
+}
+
+func globToRegex(pattern string) string {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					sb.WriteString("(.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	sb.WriteString("$")
+	return sb.String()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+func Test_ShouldMatchGlobs(t *testing.T) {
+	require.True(t, matchGlob("main", "main"))
+	require.False(t, matchGlob("main", "main2"))
+	require.True(t, matchGlob("release/*", "release/1.0"))
+	require.False(t, matchGlob("release/*", "release/1.0/hotfix"))
+	require.True(t, matchGlob("release/**", "release/1.0/hotfix"))
+	require.True(t, matchGlob("services/api/**", "services/api/cmd/main.go"))
+	require.False(t, matchGlob("services/api/**", "services/web/main.go"))
+	require.True(t, matchGlob("**/*.md", "README.md"))
+	require.True(t, matchGlob("**/*.md", "services/api/README.md"))
+	require.True(t, matchGlob("v?.*", "v1.2"))
+	require.False(t, matchGlob("v?.*", "v12.2"))
+	require.True(t, matchGlob("a+b(c)", "a+b(c)"))
+}
+
+func Test_ShouldMatchGitEventWithBranchAndPathFilters(t *testing.T) {
+	// GIVEN a monorepo trigger for api service on main branch
+	trig := &types.TriggerDefinition{
+		Type:     "git",
+		Name:     "api",
+		Branches: &types.TriggerGlobFilter{Include: []string{"main"}},
+		Paths: &types.TriggerGlobFilter{
+			Include: []string{"services/api/**"},
+			Exclude: []string{"**/*.md"},
+		},
+	}
+	push := &GitEvent{
+		Event:             gitEventPush,
+		Branch:            "main",
+		ChangedFiles:      []string{"services/api/main.go", "services/web/index.js"},
+		ChangedFilesKnown: true,
+	}
+
+	// WHEN/THEN matching push on main with api change should fire
+	require.True(t, MatchGitEvent(trig, push))
+
+	// AND docs-only or other service changes should not fire
+	push.ChangedFiles = []string{"services/api/README.md", "services/web/index.js"}
+	require.False(t, MatchGitEvent(trig, push))
+
+	// AND other branches should not fire
+	push.ChangedFiles = []string{"services/api/main.go"}
+	push.Branch = "feature"
+	require.False(t, MatchGitEvent(trig, push))
+
+	// AND unknown changed files should not filter
+	push.Branch = "main"
+	push.ChangedFiles = nil
+	push.ChangedFilesKnown = false
+	require.True(t, MatchGitEvent(trig, push))
+
+	// AND unknown changed files should not fire when trigger skips them
+	trig.ChangedFilesUnknown = types.TriggerSkipUnknownChanges
+	require.False(t, MatchGitEvent(trig, push))
+	trig.ChangedFilesUnknown = ""
+
+	// AND deleted branches should not fire
+	push.Deleted = true
+	require.False(t, MatchGitEvent(trig, push))
+
+	// AND pull requests should match target branch
+	pr := &GitEvent{Event: gitEventPullRequest, Branch: "feature", BaseBranch: "main"}
+	require.True(t, MatchGitEvent(trig, pr))
+	pr.BaseBranch = "develop"
+	require.False(t, MatchGitEvent(trig, pr))
+
+	// AND tags should be ignored by branch-only trigger
+	require.False(t, MatchGitEvent(trig, &GitEvent{Event: gitEventTag, Tag: "v1.0"}))
+}
+
+func Test_ShouldMatchGitEventWithTagAndEventFilters(t *testing.T) {
+	// GIVEN a release trigger for tags
+	trig := &types.TriggerDefinition{
+		Type: "git",
+		Name: "release",
+		Tags: &types.TriggerGlobFilter{Include: []string{"v*"}, Exclude: []string{"*-rc*"}},
+	}
+
+	// WHEN/THEN only matching tags should fire
+	require.True(t, MatchGitEvent(trig, &GitEvent{Event: gitEventTag, Tag: "v1.2.0"}))
+	require.False(t, MatchGitEvent(trig, &GitEvent{Event: gitEventTag, Tag: "v1.2.0-rc1"}))
+	require.False(t, MatchGitEvent(trig, &GitEvent{Event: gitEventPush, Branch: "main"}))
+
+	// AND events filter should restrict event types
+	trig = &types.TriggerDefinition{Type: "git", Name: "prs", Events: []string{"pull_request"}}
+	require.True(t, MatchGitEvent(trig, &GitEvent{Event: gitEventPullRequest, BaseBranch: "main"}))
+	require.False(t, MatchGitEvent(trig, &GitEvent{Event: gitEventPush, Branch: "main"}))
+}
+
+func Test_ShouldValidateGitTrigger(t *testing.T) {
+	trig := &types.TriggerDefinition{Type: "git", Name: "git", Events: []string{"push", "tag"}}
+	require.NoError(t, trig.Validate())
+	trig.Events = []string{"merge"}
+	require.Error(t, trig.Validate())
+	trig.Events = nil
+	trig.Provider = "svn"
+	require.Error(t, trig.Validate())
+	trig.Provider = "gitlab"
+	trig.ChangedFilesUnknown = "ignore"
+	require.Error(t, trig.Validate())
+	trig.ChangedFilesUnknown = types.TriggerSkipUnknownChanges
+	require.NoError(t, trig.Validate())
+	trig.Paths = &types.TriggerGlobFilter{Include: []string{""}}
+	require.Error(t, trig.Validate())
+}
@@ -0,0 +1,390 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"plexobject.com/formicary/internal/tracing"
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+const maxHTTPPollTimeout = 30 * time.Second
+
+// HTTPResponse is the polled response exposed to http trigger templates as `.Response`.
+type HTTPResponse struct {
+	Status  int
+	Headers map[string]string
+	// Body is parsed JSON body or raw string for other content.
+	Body interface{}
+	// Value is the value at json_path of the trigger.
+	Value        interface{}
+	ETag         string
+	LastModified string
+}
+
+// httpCursor is saved in trigger state so that restarts don't refire a condition that already holds.
+type httpCursor struct {
+	Condition    bool   `json:"condition"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// HTTPPoller periodically polls an HTTP endpoint and fires a trigger when its condition flips to true.
+type HTTPPoller struct {
+	client           *http.Client
+	evaluator        *Evaluator
+	submitter        jobSubmitter
+	deadLetters      *DeadLetters
+	triggerStateRepo repository.TriggerStateRepository
+	jobDef           *types.JobDefinition
+	trigger          *types.TriggerDefinition
+	interval         time.Duration
+	stopCh           chan struct{}
+	wg               sync.WaitGroup
+}
+
+// NewHTTPPoller creates and starts an HTTPPoller.
+func NewHTTPPoller(
+	ctx context.Context,
+	evaluator *Evaluator,
+	submitter *Submitter,
+	deadLetters *DeadLetters,
+	triggerStateRepo repository.TriggerStateRepository,
+	jobDef *types.JobDefinition,
+	trigger *types.TriggerDefinition,
+	defaultInterval time.Duration,
+) (*HTTPPoller, error) {
+	p, err := newHTTPPoller(evaluator, submitter, deadLetters, triggerStateRepo, jobDef, trigger, defaultInterval)
+	if err != nil {
+		return nil, err
+	}
+	p.start(ctx)
+	return p, nil
+}
+
+func newHTTPPoller(
+	evaluator *Evaluator,
+	submitter jobSubmitter,
+	deadLetters *DeadLetters,
+	triggerStateRepo repository.TriggerStateRepository,
+	jobDef *types.JobDefinition,
+	trigger *types.TriggerDefinition,
+	defaultInterval time.Duration,
+) (*HTTPPoller, error) {
+	if err := types.CheckHTTPTriggerURL(trigger.URL); err != nil {
+		return nil, fmt.Errorf("http trigger %q: %w", trigger.Name, err)
+	}
+	interval := trigger.PollInterval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if interval <= 0 {
+		interval = 60 * time.Second
+	}
+	timeout := interval
+	if timeout > maxHTTPPollTimeout {
+		timeout = maxHTTPPollTimeout
+	}
+	return &HTTPPoller{
+		client:           newHTTPTriggerClient(timeout),
+		evaluator:        evaluator,
+		submitter:        submitter,
+		deadLetters:      deadLetters,
+		triggerStateRepo: triggerStateRepo,
+		jobDef:           jobDef,
+		trigger:          trigger,
+		interval:         interval,
+		stopCh:           make(chan struct{}),
+	}, nil
+}
+
+// newHTTPTriggerClient checks addresses that hosts resolve to when connecting and urls of redirects so
+// that triggers can't reach internal services of the queen server through DNS or redirects. Proxies of
+// the environment are not used because the address of the proxy would be checked instead.
+func newHTTPTriggerClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("address %s is invalid", address)
+			}
+			return types.CheckHTTPTriggerAddress(ip)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return fmt.Errorf("stopped after 10 redirects")
+			}
+			return types.CheckHTTPTriggerURL(req.URL.String())
+		},
+	}
+}
+
+// Stop halts the polling goroutine.
+func (p *HTTPPoller) Stop() {
+	close(p.stopCh)
+	p.wg.Wait()
+}
+
+func (p *HTTPPoller) start(ctx context.Context) {
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		p.poll(ctx)
+		for {
+			select {
+			case <-p.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.poll(ctx)
+			}
+		}
+	}()
+}
+
+func (p *HTTPPoller) poll(ctx context.Context) {
+	ctx, span := tracing.Tracer("formicary.trigger").Start(ctx, "trigger.http_poll",
+		trace.WithAttributes(
+			attribute.String("trigger.name", p.trigger.Name),
+			attribute.String("job.type", p.jobDef.JobType),
+			attribute.String("http.url", p.trigger.URL),
+		),
+	)
+	defer func() { span.End() }()
+	logger := logrus.WithFields(logrus.Fields{
+		"Component":   "HTTPPoller",
+		"JobType":     p.jobDef.JobType,
+		"TriggerName": p.trigger.Name,
+		"URL":         p.trigger.URL,
+	})
+
+	var cursor httpCursor
+	if _, err := loadSensorCursor(p.triggerStateRepo, p.jobDef.ID, p.trigger.Name, &cursor); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.Warnf("failed to load trigger state: %v", err)
+		return
+	}
+	resp, err := p.fetch(ctx, &cursor)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		logger.Warnf("http poll failed: %v", err)
+		return
+	}
+	if resp == nil {
+		// not modified since last poll
+		return
+	}
+	condition := p.matches(resp)
+	span.SetAttributes(attribute.Bool("trigger.condition", condition))
+	if condition && !cursor.Condition {
+		event := &TriggerEvent{
+			JobDefinition: p.jobDef,
+			Trigger:       p.trigger,
+			Data:          map[string]interface{}{"Response": resp},
+		}
+		if _, err = fireTrigger(ctx, p.evaluator, p.submitter, event); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			logger.Errorf("failed to fire trigger: %v", err)
+			// the condition is saved once the event is kept in dead-letters, where it can be replayed
+			if _, dlErr := p.deadLetters.Record(event, err); dlErr != nil {
+				// Don't save the condition so that it's retried on the next poll.
+				logger.Warnf("failed to record dead-letter: %v", dlErr)
+				return
+			}
+		}
+	}
+	if err = saveSensorCursor(p.triggerStateRepo, p.jobDef.ID, p.trigger.Name, &httpCursor{
+		Condition:    condition,
+		ETag:         resp.ETag,
+		LastModified: resp.LastModified,
+	}); err != nil {
+		logger.Warnf("failed to update trigger state: %v", err)
+	}
+}
+
+// fetch polls the endpoint with conditional headers and returns nil response when it's not modified
+func (p *HTTPPoller) fetch(ctx context.Context, cursor *httpCursor) (*HTTPResponse, error) {
+	method := p.trigger.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), p.trigger.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.trigger.Headers {
+		req.Header.Set(k, v)
+	}
+	if p.trigger.Auth != nil {
+		secret := p.jobDef.GetConfigString(p.trigger.Auth.SecretConfig)
+		if secret == "" {
+			return nil, fmt.Errorf("auth secret %s is not configured", p.trigger.Auth.SecretConfig)
+		}
+		if p.trigger.Auth.Method == "bearer_token" {
+			req.Header.Set("Authorization", "Bearer "+secret)
+		} else {
+			req.Header.Set(p.trigger.Auth.Header, secret)
+		}
+	}
+	if cursor.ETag != "" {
+		req.Header.Set("If-None-Match", cursor.ETag)
+	}
+	if cursor.LastModified != "" {
+		req.Header.Set("If-Modified-Since", cursor.LastModified)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, defaultWebhookBodyMaxBytes))
+	if err != nil {
+		return nil, err
+	}
+	resp := &HTTPResponse{
+		Status:       res.StatusCode,
+		Headers:      make(map[string]string),
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}
+	// servers that ignore conditional requests still return same validators for unchanged content
+	if (resp.ETag != "" && resp.ETag == cursor.ETag) ||
+		(resp.ETag == "" && resp.LastModified != "" && resp.LastModified == cursor.LastModified) {
+		return nil, nil
+	}
+	for k := range res.Header {
+		resp.Headers[k] = res.Header.Get(k)
+	}
+	if err = json.Unmarshal(body, &resp.Body); err != nil {
+		resp.Body = string(body)
+	}
+	if p.trigger.JSONPath != "" {
+		resp.Value, _ = lookupJSONPath(resp.Body, p.trigger.JSONPath)
+	}
+	return resp, nil
+}
+
+// matches checks expected status and json_path condition of the trigger
+func (p *HTTPPoller) matches(resp *HTTPResponse) bool {
+	if len(p.trigger.ExpectedStatus) == 0 {
+		if resp.Status < 200 || resp.Status > 299 {
+			return false
+		}
+	} else {
+		matched := false
+		for _, status := range p.trigger.ExpectedStatus {
+			if status == resp.Status {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if p.trigger.JSONPath == "" {
+		return true
+	}
+	value, found := lookupJSONPath(resp.Body, p.trigger.JSONPath)
+	if !found || value == nil {
+		return false
+	}
+	if p.trigger.JSONValue != "" {
+		return fmt.Sprintf("%v", value) == p.trigger.JSONValue
+	}
+	return value != false && value != ""
+}
+
+// lookupJSONPath finds value of a simple JSONPath such as $.status.phase, $.items[0].name or
+// $['key with space'] in parsed JSON.
+func lookupJSONPath(data interface{}, path string) (interface{}, bool) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	current := data
+	for len(path) > 0 {
+		var key string
+		index := -1
+		switch {
+		case path[0] == '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			key, path = path[:end], path[end:]
+		case strings.HasPrefix(path, "['") || strings.HasPrefix(path, `["`):
+			end := strings.Index(path[2:], string(path[1])+"]")
+			if end < 0 {
+				return nil, false
+			}
+			key, path = path[2:2+end], path[end+4:]
+		case path[0] == '[':
+			end := strings.Index(path, "]")
+			if end < 0 {
+				return nil, false
+			}
+			n, err := strconv.Atoi(path[1:end])
+			if err != nil {
+				return nil, false
+			}
+			index, path = n, path[end+1:]
+		default:
+			return nil, false
+		}
+		if index >= 0 {
+			arr, ok := current.([]interface{})
+			if !ok || index >= len(arr) {
+				return nil, false
+			}
+			current = arr[index]
+			continue
+		}
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
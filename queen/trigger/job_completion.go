@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"plexobject.com/formicary/internal/events"
+	"plexobject.com/formicary/internal/queue"
+	"plexobject.com/formicary/internal/tracing"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/manager"
+	"plexobject.com/formicary/queen/types"
+)
+
+// upstreamChainParam holds comma separated job types that led to a request created by job triggers
+// and is used to detect loops such as A -> B -> A.
+const upstreamChainParam = "UpstreamJobChain"
+
+// UpstreamJob is the finished upstream job that is exposed to job trigger templates.
+type UpstreamJob struct {
+	JobType        string
+	JobRequestID   string
+	JobExecutionID string
+	State          string
+	// Params holds non-secret params of the upstream request.
+	Params map[string]interface{}
+	// Contexts holds non-secret context variables of the upstream execution.
+	Contexts map[string]interface{}
+	// Artifacts maps task type to comma separated artifact IDs of the task.
+	Artifacts map[string]string
+	// ArtifactIDs holds IDs of all artifacts of the upstream execution.
+	ArtifactIDs []string
+	// Chain holds job types that led to the downstream request including the upstream job.
+	Chain []string
+}
+
+// JobCompletionSubscriber fires a trigger when an upstream job finishes by subscribing to job
+// execution lifecycle events.
+type JobCompletionSubscriber struct {
+	queueClient    queue.Client
+	jobManager     *manager.JobManager
+	evaluator      *Evaluator
+	submitter      *Submitter
+	jobDef         *types.JobDefinition
+	trigger        *types.TriggerDefinition
+	topic          string
+	subscriptionID string
+}
+
+// NewJobCompletionSubscriber creates and starts a JobCompletionSubscriber.
+func NewJobCompletionSubscriber(
+	ctx context.Context,
+	queueClient queue.Client,
+	jobManager *manager.JobManager,
+	topic string,
+	evaluator *Evaluator,
+	submitter *Submitter,
+	jobDef *types.JobDefinition,
+	trigger *types.TriggerDefinition,
+) (*JobCompletionSubscriber, error) {
+	js := &JobCompletionSubscriber{
+		queueClient: queueClient,
+		jobManager:  jobManager,
+		evaluator:   evaluator,
+		submitter:   submitter,
+		jobDef:      jobDef,
+		trigger:     trigger,
+		topic:       topic,
+	}
+	id, err := queueClient.Subscribe(ctx, queue.SubscribeOptions{
+		Topic:    topic,
+		Shared:   false,
+		Callback: js.handleEvent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("job trigger %q: failed to subscribe to topic %q: %w", trigger.Name, topic, err)
+	}
+	js.subscriptionID = id
+	logrus.WithFields(logrus.Fields{
+		"Component":   "JobCompletionSubscriber",
+		"JobType":     jobDef.JobType,
+		"TriggerName": trigger.Name,
+		"Upstream":    trigger.Upstream,
+	}).Infof("subscribed to job lifecycle events for trigger")
+	return js, nil
+}
+
+// Stop unsubscribes from job lifecycle events.
+func (js *JobCompletionSubscriber) Stop(ctx context.Context) {
+	if js.subscriptionID != "" {
+		if err := js.queueClient.UnSubscribe(ctx, js.topic, js.subscriptionID); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component":   "JobCompletionSubscriber",
+				"JobType":     js.jobDef.JobType,
+				"TriggerName": js.trigger.Name,
+			}).Warnf("failed to unsubscribe: %v", err)
+		}
+		js.subscriptionID = ""
+	}
+}
+
+func (js *JobCompletionSubscriber) handleEvent(
+	ctx context.Context,
+	msg *queue.MessageEvent,
+	ack queue.AckHandler,
+	_ queue.AckHandler) error {
+	if ack != nil {
+		defer ack()
+	}
+	var event events.JobExecutionLifecycleEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return nil
+	}
+	if !matchUpstreamEvent(js.trigger, &event) {
+		return nil
+	}
+
+	ctx, span := tracing.Tracer("formicary.trigger").Start(ctx, "trigger.job_completion",
+		trace.WithAttributes(
+			attribute.String("trigger.name", js.trigger.Name),
+			attribute.String("job.type", js.jobDef.JobType),
+			attribute.String("upstream.job_type", event.JobType),
+			attribute.String("upstream.request_id", event.JobRequestID),
+		),
+	)
+	defer func() { span.End() }()
+
+	qc := common.NewQueryContextFromIDs("", "").WithAdmin()
+	req, err := js.jobManager.GetJobRequest(qc, event.JobRequestID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("job trigger %q: failed to load upstream request %s: %w", js.trigger.Name, event.JobRequestID, err)
+	}
+	// upstream jobs of other users or organizations can't trigger this job
+	if req.OrganizationID != js.jobDef.OrganizationID ||
+		(js.jobDef.OrganizationID == "" && req.UserID != js.jobDef.UserID) {
+		return nil
+	}
+	upstream := NewUpstreamJob(&event, req)
+	if !matchUpstreamParams(js.trigger, upstream) {
+		return nil
+	}
+	if upstream.HasLoop(js.jobDef.JobType) {
+		logrus.WithFields(logrus.Fields{
+			"Component":   "JobCompletionSubscriber",
+			"JobType":     js.jobDef.JobType,
+			"TriggerName": js.trigger.Name,
+			"Chain":       upstream.Chain,
+		}).Warnf("skipping job trigger because it would create a loop")
+		return nil
+	}
+
+	result, err := js.evaluator.Evaluate(ctx, &TriggerEvent{
+		JobDefinition: js.jobDef,
+		Trigger:       js.trigger,
+		Data:          upstream.templateData(),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if !result.Passed {
+		return nil
+	}
+	upstream.addParams(js.trigger, result)
+	if _, err = js.submitter.Submit(ctx, js.jobDef, js.trigger.Name, result); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// NewUpstreamJob builds upstream job from lifecycle event and the upstream request with its execution
+func NewUpstreamJob(event *events.JobExecutionLifecycleEvent, req *types.JobRequest) *UpstreamJob {
+	upstream := &UpstreamJob{
+		JobType:        event.JobType,
+		JobRequestID:   event.JobRequestID,
+		JobExecutionID: event.JobExecutionID,
+		State:          string(event.JobState),
+		Params:         make(map[string]interface{}),
+		Contexts:       make(map[string]interface{}),
+		Artifacts:      make(map[string]string),
+		ArtifactIDs:    make([]string, 0),
+	}
+	for _, p := range req.Params {
+		if p.Secret {
+			continue
+		}
+		if v, err := p.GetParsedValue(); err == nil {
+			upstream.Params[p.Name] = v
+		}
+	}
+	if chain, ok := upstream.Params[upstreamChainParam].(string); ok && chain != "" {
+		upstream.Chain = strings.Split(chain, ",")
+	}
+	upstream.Chain = append(upstream.Chain, event.JobType)
+
+	if req.Execution == nil {
+		for k, v := range event.Contexts {
+			upstream.Contexts[k] = v
+		}
+		return upstream
+	}
+	for _, c := range req.Execution.Contexts {
+		if c.Secret {
+			continue
+		}
+		if v, err := c.GetParsedValue(); err == nil {
+			upstream.Contexts[c.Name] = v
+		}
+	}
+	for _, task := range req.Execution.Tasks {
+		ids := make([]string, 0)
+		for _, art := range task.Artifacts {
+			ids = append(ids, art.ID)
+		}
+		if len(ids) > 0 {
+			upstream.Artifacts[task.TaskType] = strings.Join(ids, ",")
+			upstream.ArtifactIDs = append(upstream.ArtifactIDs, ids...)
+		}
+	}
+	return upstream
+}
+
+// HasLoop returns true if the downstream job is already part of the upstream chain
+func (u *UpstreamJob) HasLoop(jobType string) bool {
+	return containsString(u.Chain, jobType)
+}
+
+func (u *UpstreamJob) templateData() map[string]interface{} {
+	return map[string]interface{}{
+		"Upstream":  u,
+		"Params":    u.Params,
+		"Contexts":  u.Contexts,
+		"Artifacts": u.Artifacts,
+	}
+}
+
+// addParams adds upstream params that aren't defined by the trigger, the chain for loop detection
+// and a default dedup key so that an upstream request fires the trigger only once.
+func (u *UpstreamJob) addParams(t *types.TriggerDefinition, result *EvalResult) {
+	defaults := map[string]string{
+		"UpstreamJobType":      u.JobType,
+		"UpstreamJobRequestID": u.JobRequestID,
+		"UpstreamJobState":     u.State,
+		"UpstreamArtifactIDs":  strings.Join(u.ArtifactIDs, ","),
+	}
+	for k, v := range defaults {
+		if _, ok := result.Params[k]; !ok {
+			result.Params[k] = v
+		}
+	}
+	result.Params[upstreamChainParam] = strings.Join(u.Chain, ",")
+	if result.DedupKey == "" {
+		result.DedupKey = fmt.Sprintf("%s-%s", t.Name, u.JobRequestID)
+	}
+}
+
+// matchUpstreamEvent checks upstream job type and final state before the upstream request is loaded
+func matchUpstreamEvent(t *types.TriggerDefinition, event *events.JobExecutionLifecycleEvent) bool {
+	if !containsString(t.Upstream, event.JobType) {
+		return false
+	}
+	if len(t.States) == 0 {
+		return event.JobState == common.COMPLETED
+	}
+	return containsString(t.States, string(event.JobState))
+}
+
+func matchUpstreamParams(t *types.TriggerDefinition, upstream *UpstreamJob) bool {
+	for k, expected := range t.UpstreamParams {
+		if v, ok := upstream.Params[k]; !ok || fmt.Sprintf("%v", v) != expected {
+			return false
+		}
+	}
+	return true
+}
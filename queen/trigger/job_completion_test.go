@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/internal/events"
+	common "plexobject.com/formicary/internal/types"
+	"plexobject.com/formicary/queen/types"
+)
+
+func newUpstreamRequest(t *testing.T, chain string) *types.JobRequest {
+	req := &types.JobRequest{ID: "req-1", JobType: "build"}
+	for name, value := range map[string]interface{}{"env": "prod", "token": "s3cr3t", upstreamChainParam: chain} {
+		p, err := types.NewJobRequestParam(name, value, name == "token")
+		require.NoError(t, err)
+		req.Params = append(req.Params, p)
+	}
+	req.Execution = types.NewJobExecution(req)
+	_, err := req.Execution.AddContext("Version", "1.2.3")
+	require.NoError(t, err)
+	secret, err := types.NewJobExecutionContext("Password", "pass", true)
+	require.NoError(t, err)
+	req.Execution.Contexts = append(req.Execution.Contexts, secret)
+	task := types.NewTaskExecution(&types.TaskDefinition{TaskType: "package"})
+	task.Artifacts = []*common.Artifact{{ID: "art-1"}, {ID: "art-2"}}
+	req.Execution.Tasks = append(req.Execution.Tasks, task)
+	return req
+}
+
+func Test_ShouldMatchUpstreamEvent(t *testing.T) {
+	// GIVEN a job trigger on build job
+	trig := &types.TriggerDefinition{Type: "job", Name: "after-build", Upstream: []string{"build"}}
+	event := &events.JobExecutionLifecycleEvent{JobType: "build", JobState: common.COMPLETED}
+
+	// WHEN/THEN only completed build should match by default
+	require.True(t, matchUpstreamEvent(trig, event))
+	event.JobState = common.FAILED
+	require.False(t, matchUpstreamEvent(trig, event))
+	event.JobType = "test"
+	event.JobState = common.COMPLETED
+	require.False(t, matchUpstreamEvent(trig, event))
+
+	// AND explicit states should be matched
+	trig.States = []string{"FAILED", "CANCELLED"}
+	event.JobType = "build"
+	require.False(t, matchUpstreamEvent(trig, event))
+	event.JobState = common.CANCELLED
+	require.True(t, matchUpstreamEvent(trig, event))
+
+	// AND states must be final
+	require.NoError(t, trig.Validate())
+	trig.States = []string{"EXECUTING"}
+	require.Error(t, trig.Validate())
+	trig.States = nil
+	trig.Upstream = nil
+	require.Error(t, trig.Validate())
+}
+
+func Test_ShouldMapUpstreamJobIntoParams(t *testing.T) {
+	// GIVEN a completed upstream request with params, contexts and artifacts
+	event := &events.JobExecutionLifecycleEvent{
+		JobType: "build", JobRequestID: "req-1", JobExecutionID: "exec-1", JobState: common.COMPLETED}
+	upstream := NewUpstreamJob(event, newUpstreamRequest(t, ""))
+	trig := &types.TriggerDefinition{
+		Type:           "job",
+		Name:           "deploy-after-build",
+		Upstream:       []string{"build"},
+		UpstreamParams: map[string]string{"env": "prod"},
+		Filter:         `{{ if eq .Upstream.State "COMPLETED" }}true{{ end }}`,
+		Params: map[string]string{
+			"version":  "{{ .Contexts.Version }}",
+			"package":  `{{ index .Artifacts "package" }}`,
+			"password": `{{ default "none" .Contexts.Password }}`,
+		},
+	}
+
+	// WHEN trigger is evaluated
+	require.True(t, matchUpstreamParams(trig, upstream))
+	require.False(t, upstream.HasLoop("deploy"))
+	result, err := NewEvaluator(newMemTriggerRepo()).Evaluate(context.Background(), &TriggerEvent{
+		JobDefinition: &types.JobDefinition{ID: "def-1", JobType: "deploy"},
+		Trigger:       trig,
+		Data:          upstream.templateData(),
+	})
+	require.NoError(t, err)
+	require.True(t, result.Passed)
+	upstream.addParams(trig, result)
+
+	// THEN upstream contexts and artifacts should be mapped without secrets
+	require.Equal(t, "1.2.3", result.Params["version"])
+	require.Equal(t, "art-1,art-2", result.Params["package"])
+	require.Equal(t, "none", result.Params["password"])
+	require.NotContains(t, upstream.Params, "token")
+	require.Equal(t, "req-1", result.Params["UpstreamJobRequestID"])
+	require.Equal(t, "art-1,art-2", result.Params["UpstreamArtifactIDs"])
+	require.Equal(t, "build", result.Params[upstreamChainParam])
+	require.Equal(t, "deploy-after-build-req-1", result.DedupKey)
+
+	// AND mismatched upstream params should not match
+	trig.UpstreamParams["env"] = "dev"
+	require.False(t, matchUpstreamParams(trig, upstream))
+}
+
+func Test_ShouldDetectJobTriggerLoops(t *testing.T) {
+	// GIVEN upstream build that was triggered by deploy which was triggered by build
+	event := &events.JobExecutionLifecycleEvent{JobType: "build", JobRequestID: "req-1", JobState: common.COMPLETED}
+	upstream := NewUpstreamJob(event, newUpstreamRequest(t, "build,deploy"))
+
+	// WHEN/THEN jobs in the chain should be detected as loops
+	require.Equal(t, []string{"build", "deploy", "build"}, upstream.Chain)
+	require.True(t, upstream.HasLoop("deploy"))
+	require.True(t, upstream.HasLoop("build"))
+	require.False(t, upstream.HasLoop("notify"))
+}
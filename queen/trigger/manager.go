@@ -29,7 +29,7 @@ type triggerKey struct {
 }
 
 // Manager orchestrates all event-driven triggers. It is leader-aware:
-// S3 pollers and queue subscribers are only active on the scheduler leader.
+// S3/http pollers, file watchers, queue subscribers and job triggers are only active on the scheduler leader.
 // Webhook routes are registered on all instances.
 type Manager struct {
 	serverCfg        *config.ServerConfig
@@ -38,6 +38,7 @@ type Manager struct {
 	triggerStateRepo repository.TriggerStateRepository
 	evaluator        *Evaluator
 	submitter        *Submitter
+	deadLetters      *DeadLetters
 	webhookHandler   *WebhookHandler
 
 	// active holds stop functions for running pollers/subscribers.
@@ -62,6 +63,7 @@ func New(
 	triggerStateRepo repository.TriggerStateRepository,
 	evaluator *Evaluator,
 	submitter *Submitter,
+	deadLetters *DeadLetters,
 	webhookHandler *WebhookHandler,
 ) *Manager {
 	return &Manager{
@@ -71,6 +73,7 @@ func New(
 		triggerStateRepo: triggerStateRepo,
 		evaluator:        evaluator,
 		submitter:        submitter,
+		deadLetters:      deadLetters,
 		webhookHandler:   webhookHandler,
 		active:           make(map[triggerKey]stopFn),
 		stopCh:           make(chan struct{}),
@@ -264,7 +267,7 @@ func (m *Manager) startTriggersForJobDef(ctx context.Context, def *types.JobDefi
 		key := triggerKey{jobDefID: def.ID, triggerName: t.Name}
 		switch t.Type {
 		case "queue":
-			qs, err := NewQueueSubscriber(ctx, m.queueClient, m.evaluator, m.submitter, def, t)
+			qs, err := NewQueueSubscriber(ctx, m.queueClient, m.evaluator, m.submitter, m.deadLetters, def, t)
 			if err != nil {
 				logrus.WithFields(logrus.Fields{
 					"Component":   "TriggerManager",
@@ -277,9 +280,69 @@ func (m *Manager) startTriggersForJobDef(ctx context.Context, def *types.JobDefi
 			m.mu.Lock()
 			m.active[key] = func(c context.Context) { qs.Stop(c) }
 			m.mu.Unlock()
+		case "job":
+			js, err := NewJobCompletionSubscriber(
+				ctx,
+				m.queueClient,
+				m.jobManager,
+				m.serverCfg.Common.GetJobExecutionLifecycleTopic(),
+				m.evaluator,
+				m.submitter,
+				def,
+				t,
+			)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":   "TriggerManager",
+					"JobType":     def.JobType,
+					"TriggerName": t.Name,
+					"Error":       err,
+				}).Errorf("failed to start job trigger")
+				continue
+			}
+			m.mu.Lock()
+			m.active[key] = func(c context.Context) { js.Stop(c) }
+			m.mu.Unlock()
+		case "http":
+			poller, err := NewHTTPPoller(
+				ctx,
+				m.evaluator,
+				m.submitter,
+				m.deadLetters,
+				m.triggerStateRepo,
+				def,
+				t,
+				m.serverCfg.Jobs.TriggerPollDefaultInterval,
+			)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":   "TriggerManager",
+					"JobType":     def.JobType,
+					"TriggerName": t.Name,
+					"Error":       err,
+				}).Errorf("failed to start http trigger")
+				continue
+			}
+			m.mu.Lock()
+			m.active[key] = func(_ context.Context) { poller.Stop() }
+			m.mu.Unlock()
+		case "file":
+			watcher, err := NewFileWatcher(ctx, m.evaluator, m.submitter, m.deadLetters, m.triggerStateRepo, def, t)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":   "TriggerManager",
+					"JobType":     def.JobType,
+					"TriggerName": t.Name,
+					"Error":       err,
+				}).Errorf("failed to start file trigger")
+				continue
+			}
+			m.mu.Lock()
+			m.active[key] = func(_ context.Context) { watcher.Stop() }
+			m.mu.Unlock()
 		case "s3":
 			if t.Mode == "notification" {
-				sns, err := NewS3NotificationSubscriber(ctx, m.queueClient, m.evaluator, m.submitter, def, t)
+				sns, err := NewS3NotificationSubscriber(ctx, m.queueClient, m.evaluator, m.submitter, m.deadLetters, def, t)
 				if err != nil {
 					logrus.WithFields(logrus.Fields{
 						"Component":   "TriggerManager",
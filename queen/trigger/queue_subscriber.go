@@ -21,7 +21,8 @@ import (
 type QueueSubscriber struct {
 	queueClient   queue.Client
 	evaluator     *Evaluator
-	submitter     *Submitter
+	submitter     jobSubmitter
+	deadLetters   *DeadLetters
 	jobDef        *types.JobDefinition
 	trigger       *types.TriggerDefinition
 	subscriptionID string
@@ -33,6 +34,7 @@ func NewQueueSubscriber(
 	queueClient queue.Client,
 	evaluator *Evaluator,
 	submitter *Submitter,
+	deadLetters *DeadLetters,
 	jobDef *types.JobDefinition,
 	trigger *types.TriggerDefinition,
 ) (*QueueSubscriber, error) {
@@ -40,6 +42,7 @@ func NewQueueSubscriber(
 		queueClient: queueClient,
 		evaluator:   evaluator,
 		submitter:   submitter,
+		deadLetters: deadLetters,
 		jobDef:      jobDef,
 		trigger:     trigger,
 	}
@@ -119,27 +122,25 @@ func (qs *QueueSubscriber) handleMessage(ctx context.Context, event *queue.Messa
 		"Properties": props,
 	}
 
-	result, err := qs.evaluator.Evaluate(ctx, &TriggerEvent{
+	triggerEvent := &TriggerEvent{
 		JobDefinition: qs.jobDef,
 		Trigger:       qs.trigger,
 		Data:          data,
-	})
-	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		nack()
-		return err
-	}
-	if !result.Passed {
-		ack()
-		return nil
 	}
-
-	if _, err = qs.submitter.Submit(ctx, qs.jobDef, qs.trigger.Name, result); err != nil {
+	if _, err := fireTrigger(ctx, qs.evaluator, qs.submitter, triggerEvent); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		nack()
-		return err
+		// the message is acknowledged once it's kept in dead-letters, where it can be replayed
+		if _, dlErr := qs.deadLetters.Record(triggerEvent, err); dlErr != nil {
+			logrus.WithFields(logrus.Fields{
+				"Component":   "QueueSubscriber",
+				"JobType":     qs.jobDef.JobType,
+				"TriggerName": qs.trigger.Name,
+				"Topic":       qs.trigger.Topic,
+			}).Warnf("failed to record dead-letter: %v", dlErr)
+			nack()
+			return err
+		}
 	}
 	ack()
 	return nil
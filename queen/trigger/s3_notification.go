@@ -21,7 +21,8 @@ import (
 type S3NotificationSubscriber struct {
 	queueClient    queue.Client
 	evaluator      *Evaluator
-	submitter      *Submitter
+	submitter      jobSubmitter
+	deadLetters    *DeadLetters
 	jobDef         *types.JobDefinition
 	trigger        *types.TriggerDefinition
 	subscriptionID string
@@ -33,6 +34,7 @@ func NewS3NotificationSubscriber(
 	queueClient queue.Client,
 	evaluator *Evaluator,
 	submitter *Submitter,
+	deadLetters *DeadLetters,
 	jobDef *types.JobDefinition,
 	trigger *types.TriggerDefinition,
 ) (*S3NotificationSubscriber, error) {
@@ -40,6 +42,7 @@ func NewS3NotificationSubscriber(
 		queueClient: queueClient,
 		evaluator:   evaluator,
 		submitter:   submitter,
+		deadLetters: deadLetters,
 		jobDef:      jobDef,
 		trigger:     trigger,
 	}
@@ -132,8 +135,9 @@ func (s *S3NotificationSubscriber) handleNotification(ctx context.Context, event
 	}
 
 	// Process all records and always ACK. NACKing a partially-processed batch would cause
-	// already-submitted records to be re-delivered and double-fired. Errors are logged for
-	// alerting; operators should configure dedup_key on the trigger for idempotent re-delivery.
+	// already-submitted records to be re-delivered and double-fired. Failed records are kept in
+	// dead-letters so that they can be replayed; operators should configure dedup_key on the
+	// trigger for idempotent replays.
 	var firstErr error
 	for _, rec := range notif.Records {
 		objData := map[string]interface{}{
@@ -142,41 +146,26 @@ func (s *S3NotificationSubscriber) handleNotification(ctx context.Context, event
 			"Size":   rec.S3.Object.Size,
 			"ETag":   rec.S3.Object.ETag,
 		}
-		data := map[string]interface{}{
-			"Object": objData,
-		}
-		result, err := s.evaluator.Evaluate(ctx, &TriggerEvent{
+		triggerEvent := &TriggerEvent{
 			JobDefinition: s.jobDef,
 			Trigger:       s.trigger,
-			Data:          data,
-		})
-		if err != nil {
-			span.RecordError(err)
-			logrus.WithFields(logrus.Fields{
-				"Component":   "S3NotificationSubscriber",
-				"JobType":     s.jobDef.JobType,
-				"TriggerName": s.trigger.Name,
-				"Key":         rec.S3.Object.Key,
-			}).Errorf("evaluator error (record skipped): %v", err)
-			if firstErr == nil {
-				firstErr = err
-			}
-			continue
-		}
-		if !result.Passed {
-			continue
+			Data: map[string]interface{}{
+				"Object": objData,
+			},
 		}
-		if _, err = s.submitter.Submit(ctx, s.jobDef, s.trigger.Name, result); err != nil {
+		if _, err := fireTrigger(ctx, s.evaluator, s.submitter, triggerEvent); err != nil {
 			span.RecordError(err)
-			logrus.WithFields(logrus.Fields{
-				"Component":   "S3NotificationSubscriber",
-				"JobType":     s.jobDef.JobType,
-				"TriggerName": s.trigger.Name,
-				"Key":         rec.S3.Object.Key,
-			}).Errorf("submit error (record skipped): %v", err)
 			if firstErr == nil {
 				firstErr = err
 			}
+			if _, dlErr := s.deadLetters.Record(triggerEvent, err); dlErr != nil {
+				logrus.WithFields(logrus.Fields{
+					"Component":   "S3NotificationSubscriber",
+					"JobType":     s.jobDef.JobType,
+					"TriggerName": s.trigger.Name,
+					"Key":         rec.S3.Object.Key,
+				}).Errorf("trigger error (record skipped): %v, failed to record dead-letter: %v", err, dlErr)
+			}
 		}
 	}
 	ack()
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"plexobject.com/formicary/queen/repository"
+	"plexobject.com/formicary/queen/types"
+)
+
+// jobSubmitter submits job requests for sensors so that they can be tested without a job manager.
+type jobSubmitter interface {
+	Submit(ctx context.Context, jobDef *types.JobDefinition, triggerName string, result *EvalResult) (*types.JobRequest, error)
+}
+
+// loadSensorCursor decodes JSON cursor of http and file triggers from LastSeenKey of trigger state.
+// It returns false when the trigger has no state yet.
+func loadSensorCursor(
+	repo repository.TriggerStateRepository,
+	jobDefID string,
+	triggerName string,
+	cursor interface{}) (bool, error) {
+	state, err := repo.FindByJobAndTrigger(jobDefID, triggerName)
+	if err != nil || state == nil || state.LastSeenKey == "" {
+		return false, err
+	}
+	return true, json.Unmarshal([]byte(state.LastSeenKey), cursor)
+}
+
+// saveSensorCursor reloads trigger state before saving the cursor so that rate-limit counters
+// updated by the evaluator are not overwritten.
+func saveSensorCursor(
+	repo repository.TriggerStateRepository,
+	jobDefID string,
+	triggerName string,
+	cursor interface{}) error {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	state, err := repo.FindByJobAndTrigger(jobDefID, triggerName)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = &types.TriggerState{JobDefinitionID: jobDefID, TriggerName: triggerName}
+	}
+	state.LastSeenKey = string(b)
+	state.LastSeenTime = time.Now()
+	_, err = repo.Upsert(state)
+	return err
+}
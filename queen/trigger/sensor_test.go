@@ -0,0 +1,415 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"plexobject.com/formicary/queen/types"
+)
+
+// capturingJobSubmitter records submitted results instead of saving job requests, or fails with err.
+type capturingJobSubmitter struct {
+	lock    sync.Mutex
+	results []*EvalResult
+	err     error
+}
+
+func (s *capturingJobSubmitter) Submit(_ context.Context, _ *types.JobDefinition, _ string, result *EvalResult) (*types.JobRequest, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.results = append(s.results, result)
+	return &types.JobRequest{}, nil
+}
+
+func (s *capturingJobSubmitter) submitted() []*EvalResult {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*EvalResult{}, s.results...)
+}
+
+// allowTriggerLimits lets triggers of the test poll the local test server and watch dir
+func allowTriggerLimits(t *testing.T, dir string) {
+	types.SetTriggerLimits(types.TriggerLimits{AllowedHosts: []string{"127.0.0.1"}, WatchRoots: []string{dir}})
+	t.Cleanup(func() { types.SetTriggerLimits(types.TriggerLimits{}) })
+}
+
+func Test_ShouldLookupJSONPath(t *testing.T) {
+	data := map[string]interface{}{
+		"status": map[string]interface{}{"phase": "Ready"},
+		"items":  []interface{}{map[string]interface{}{"name": "a"}},
+		"a b":    true,
+	}
+	v, ok := lookupJSONPath(data, "$.status.phase")
+	require.True(t, ok)
+	require.Equal(t, "Ready", v)
+	v, ok = lookupJSONPath(data, "$.items[0].name")
+	require.True(t, ok)
+	require.Equal(t, "a", v)
+	v, ok = lookupJSONPath(data, "$['a b']")
+	require.True(t, ok)
+	require.Equal(t, true, v)
+	_, ok = lookupJSONPath(data, "$.items[1].name")
+	require.False(t, ok)
+	_, ok = lookupJSONPath(data, "$.status.missing")
+	require.False(t, ok)
+}
+
+func Test_ShouldFireHTTPTriggerWhenConditionFlips(t *testing.T) {
+	allowTriggerLimits(t, "")
+	// GIVEN an endpoint whose status changes and supports ETag
+	var lock sync.Mutex
+	phase, etag := "Pending", "v1"
+	requests, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		requests++
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(`{"status": {"phase": "` + phase + `"}}`))
+	}))
+	defer server.Close()
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "sensor"}
+	_, _ = jobDef.AddConfig("Token", "token", true)
+	trig := &types.TriggerDefinition{
+		Type:      "http",
+		Name:      "ready",
+		URL:       server.URL,
+		Auth:      &types.TriggerAuth{Method: "bearer_token", SecretConfig: "Token"},
+		JSONPath:  "$.status.phase",
+		JSONValue: "Ready",
+		Params:    map[string]string{"phase": "{{ .Response.Value }}"},
+	}
+	require.NoError(t, trig.Validate())
+	repo := newMemTriggerRepo()
+	submitter := &capturingJobSubmitter{}
+	poller, err := newHTTPPoller(NewEvaluator(repo), submitter, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// WHEN condition doesn't hold and content is unchanged
+	poller.poll(ctx)
+	poller.poll(ctx)
+
+	// THEN trigger should not fire and second poll should be conditional
+	require.Len(t, submitter.submitted(), 0)
+	require.Equal(t, 1, notModified)
+
+	// WHEN condition flips to true
+	lock.Lock()
+	phase, etag = "Ready", "v2"
+	lock.Unlock()
+	poller.poll(ctx)
+
+	// THEN trigger should fire with params
+	require.Len(t, submitter.submitted(), 1)
+	require.Equal(t, "Ready", submitter.submitted()[0].Params["phase"])
+
+	// WHEN content changes but condition still holds, even after restart
+	lock.Lock()
+	etag = "v3"
+	lock.Unlock()
+	restarted, err := newHTTPPoller(NewEvaluator(repo), submitter, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	restarted.poll(ctx)
+
+	// THEN it should not refire
+	require.Len(t, submitter.submitted(), 1)
+
+	// WHEN condition flips to false and back to true
+	for _, p := range []string{"Failed", "Ready"} {
+		lock.Lock()
+		phase, etag = p, "etag-"+p
+		lock.Unlock()
+		poller.poll(ctx)
+	}
+
+	// THEN it should fire again
+	require.Len(t, submitter.submitted(), 2)
+}
+
+func Test_ShouldRetryOrDeadLetterFailedHTTPTrigger(t *testing.T) {
+	allowTriggerLimits(t, "")
+	// GIVEN an endpoint whose condition holds and a submitter that fails
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ready": true}`))
+	}))
+	defer server.Close()
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "sensor"}
+	trig := &types.TriggerDefinition{Type: "http", Name: "ready", URL: server.URL, JSONPath: "$.ready"}
+	require.NoError(t, trig.Validate())
+	jobDef.Triggers = []*types.TriggerDefinition{trig}
+	repo := newMemTriggerRepo()
+	submitter := &capturingJobSubmitter{err: fmt.Errorf("database is down")}
+	ctx := context.Background()
+
+	// WHEN submit fails and dead-letters are not available
+	poller, err := newHTTPPoller(NewEvaluator(repo), submitter, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	poller.poll(ctx)
+	submitter.err = nil
+	poller, err = newHTTPPoller(NewEvaluator(repo), submitter, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	poller.poll(ctx)
+
+	// THEN it should be retried on the next poll
+	require.Len(t, submitter.submitted(), 1)
+
+	// WHEN condition flips again and submit fails with dead-letters
+	dlRepo := newMemDeadLetterRepo()
+	deadLetters := newDeadLetters(dlRepo, NewEvaluator(repo), submitter)
+	require.NoError(t, saveSensorCursor(repo, jobDef.ID, trig.Name, &httpCursor{}))
+	submitter.err = fmt.Errorf("database is down")
+	poller, err = newHTTPPoller(NewEvaluator(repo), submitter, deadLetters, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	poller.poll(ctx)
+	submitter.err = nil
+	poller.poll(ctx)
+
+	// THEN event should be kept in dead-letters instead of being retried
+	require.Len(t, submitter.submitted(), 1)
+	dls, err := dlRepo.Query(jobDef, trig.Name, types.DeadLetterFailed, 10)
+	require.NoError(t, err)
+	require.Len(t, dls, 1)
+	require.Equal(t, "database is down", dls[0].ErrorMessage)
+
+	// AND it should be submitted when replayed
+	_, err = deadLetters.Replay(ctx, jobDef, dls[0])
+	require.NoError(t, err)
+	require.Len(t, submitter.submitted(), 2)
+}
+
+func Test_ShouldStopHTTPPollerWhenContextIsDone(t *testing.T) {
+	allowTriggerLimits(t, "")
+	// GIVEN a running http poller
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "sensor"}
+	trig := &types.TriggerDefinition{Type: "http", Name: "ready", URL: server.URL}
+	require.NoError(t, trig.Validate())
+	repo := newMemTriggerRepo()
+	poller, err := newHTTPPoller(NewEvaluator(repo), &capturingJobSubmitter{}, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	poller.start(ctx)
+
+	// WHEN context is cancelled
+	cancel()
+
+	// THEN polling goroutine should exit
+	done := make(chan struct{})
+	go func() {
+		poller.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("http poller didn't stop after context was cancelled")
+	}
+}
+
+func Test_ShouldNotPollInternalAddressesOfHTTPTrigger(t *testing.T) {
+	// GIVEN a local endpoint and an endpoint that redirects to it
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ready": true}`))
+	}))
+	defer server.Close()
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+	}))
+	defer redirect.Close()
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "sensor"}
+	repo := newMemTriggerRepo()
+	trig := &types.TriggerDefinition{Type: "http", Name: "ready", URL: server.URL}
+
+	// WHEN allowed hosts are not configured
+	types.SetTriggerLimits(types.TriggerLimits{})
+	defer types.SetTriggerLimits(types.TriggerLimits{})
+
+	// THEN local endpoint should be rejected when creating the poller and when connecting
+	_, err := newHTTPPoller(NewEvaluator(repo), &capturingJobSubmitter{}, nil, repo, jobDef, trig, time.Minute)
+	require.Error(t, err)
+	_, err = newHTTPTriggerClient(time.Second).Get(server.URL)
+	require.ErrorContains(t, err, "internal")
+
+	// WHEN only the local host is allowed
+	allowTriggerLimits(t, "")
+	trig.URL = redirect.URL
+	poller, err := newHTTPPoller(NewEvaluator(repo), &capturingJobSubmitter{}, nil, repo, jobDef, trig, time.Minute)
+	require.NoError(t, err)
+
+	// THEN redirects to other hosts should not be followed
+	_, err = poller.fetch(context.Background(), &httpCursor{})
+	require.ErrorContains(t, err, "169.254.169.254")
+}
+
+func Test_ShouldForgetFilesFiredLongAgo(t *testing.T) {
+	// GIVEN a watcher with a file that fired long ago
+	w := &FileWatcher{fired: map[string]firedFile{
+		"old.csv": {modTime: time.Now().Add(-2 * time.Hour), firedAt: time.Now().Add(-2 * time.Hour)},
+	}}
+
+	// WHEN another file fires
+	modTime := time.Now()
+	w.markFired("new.csv", modTime)
+
+	// THEN only the recent file should be remembered
+	require.Len(t, w.fired, 1)
+	require.True(t, w.fired["new.csv"].modTime.Equal(modTime))
+}
+
+func Test_ShouldKeepFileCursorBeforePendingFiles(t *testing.T) {
+	// GIVEN a file that is still pending and a later file that settled
+	dir := t.TempDir()
+	early, later := filepath.Join(dir, "early.csv"), filepath.Join(dir, "later.csv")
+	require.NoError(t, os.WriteFile(early, []byte("early"), 0644))
+	require.NoError(t, os.WriteFile(later, []byte("later"), 0644))
+	now := time.Now()
+	require.NoError(t, os.Chtimes(early, now, now.Add(-2*time.Minute)))
+	require.NoError(t, os.Chtimes(later, now, now.Add(-time.Minute)))
+	repo := newMemTriggerRepo()
+	submitter := &capturingJobSubmitter{}
+	newWatcher := func(cursor fileCursor) *FileWatcher {
+		w := &FileWatcher{
+			evaluator:        NewEvaluator(repo),
+			submitter:        submitter,
+			triggerStateRepo: repo,
+			jobDef:           &types.JobDefinition{ID: "def-1", JobType: "loader"},
+			trigger:          &types.TriggerDefinition{Type: "file", Name: "csv", Directory: dir},
+			directory:        dir,
+			settle:           time.Hour,
+			cursor:           cursor,
+			lastFired:        cursor,
+			pending:          make(map[string]*pendingFile),
+			fired:            make(map[string]firedFile),
+			ready:            make(chan string),
+			stopCh:           make(chan struct{}),
+		}
+		t.Cleanup(func() {
+			for _, pending := range w.pending {
+				pending.timer.Stop()
+			}
+			close(w.stopCh)
+		})
+		return w
+	}
+	watcher := newWatcher(fileCursor{ModTime: now.Add(-time.Hour)})
+	watcher.schedule(early)
+
+	// WHEN the later file fires before the pending file
+	watcher.settle = time.Millisecond
+	watcher.fire(context.Background(), later)
+
+	// THEN the saved cursor should stay before the pending file
+	require.Len(t, submitter.submitted(), 1)
+	var cursor fileCursor
+	_, err := loadSensorCursor(repo, "def-1", "csv", &cursor)
+	require.NoError(t, err)
+	require.True(t, cursor.after(now.Add(-2*time.Minute), "early.csv"))
+
+	// AND the pending file should be scanned again after restart
+	restarted := newWatcher(cursor)
+	restarted.scan(dir, true)
+	require.Contains(t, restarted.pending, early)
+
+	// WHEN the pending file fires
+	delete(watcher.pending, early)
+	watcher.fire(context.Background(), early)
+
+	// THEN the cursor should move to the later file
+	_, err = loadSensorCursor(repo, "def-1", "csv", &cursor)
+	require.NoError(t, err)
+	require.Equal(t, "later.csv", cursor.Name)
+	require.False(t, cursor.after(now.Add(-time.Minute), "later.csv"))
+}
+
+func Test_ShouldFireFileTriggerAfterSettleTime(t *testing.T) {
+	// GIVEN a directory with an existing file and a file trigger for csv files
+	dir := t.TempDir()
+	allowTriggerLimits(t, dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "old.csv"), []byte("old"), 0644))
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "loader"}
+	trig := &types.TriggerDefinition{
+		Type:       "file",
+		Name:       "csv",
+		Directory:  dir,
+		Paths:      &types.TriggerGlobFilter{Include: []string{"*.csv"}},
+		SettleTime: 100 * time.Millisecond,
+		Params:     map[string]string{"file": "{{ .File.Name }}"},
+	}
+	require.NoError(t, trig.Validate())
+	repo := newMemTriggerRepo()
+	submitter := &capturingJobSubmitter{}
+	watcher, err := newFileWatcher(NewEvaluator(repo), submitter, nil, repo, jobDef, trig)
+	require.NoError(t, err)
+	watcher.wg.Add(1)
+	go watcher.run(context.Background())
+
+	// WHEN matching and other files are written
+	time.Sleep(20 * time.Millisecond)
+	f, err := os.Create(filepath.Join(dir, "new.csv"))
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, _ = f.WriteString("data\n")
+		time.Sleep(30 * time.Millisecond)
+	}
+	require.NoError(t, f.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "skip.txt"), []byte("x"), 0644))
+
+	// THEN only the new matching file should fire once
+	require.Eventually(t, func() bool { return len(submitter.submitted()) == 1 }, 3*time.Second, 20*time.Millisecond)
+	time.Sleep(300 * time.Millisecond)
+	watcher.Stop()
+	require.Len(t, submitter.submitted(), 1)
+	require.Equal(t, "new.csv", submitter.submitted()[0].Params["file"])
+
+	// WHEN watcher restarts after another file was added
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "later.csv"), []byte("later"), 0644))
+	watcher, err = newFileWatcher(NewEvaluator(repo), submitter, nil, repo, jobDef, trig)
+	require.NoError(t, err)
+	watcher.wg.Add(1)
+	go watcher.run(context.Background())
+
+	// THEN only the file added while stopped should fire
+	require.Eventually(t, func() bool { return len(submitter.submitted()) == 2 }, 3*time.Second, 20*time.Millisecond)
+	time.Sleep(300 * time.Millisecond)
+	watcher.Stop()
+	require.Len(t, submitter.submitted(), 2)
+	require.Equal(t, "later.csv", submitter.submitted()[1].Params["file"])
+}
+
+func Test_ShouldNotWatchDirectoryOutsideWatchRoots(t *testing.T) {
+	// GIVEN a watch root and a file trigger for another directory
+	allowTriggerLimits(t, t.TempDir())
+	repo := newMemTriggerRepo()
+	jobDef := &types.JobDefinition{ID: "def-1", JobType: "loader"}
+	trig := &types.TriggerDefinition{Type: "file", Name: "csv", Directory: t.TempDir()}
+	require.NoError(t, trig.Validate())
+
+	// WHEN the watcher is created
+	_, err := newFileWatcher(NewEvaluator(repo), &capturingJobSubmitter{}, nil, repo, jobDef, trig)
+
+	// THEN it should be rejected
+	require.ErrorContains(t, err, "file_trigger_watch_roots")
+}
@@ -229,3 +229,19 @@ func Test_IsDuplicateKeyError(t *testing.T) {
 	require.False(t, isDuplicateKeyError(fmt.Errorf("some other db error")))
 	require.False(t, isDuplicateKeyError(nil))
 }
+
+// Test_TriggerParse_JobFixture verifies job completion fixture trigger parsing.
+func Test_TriggerParse_JobFixture(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join(fixtureDir(t), "job_trigger_job.yaml"))
+	require.NoError(t, err)
+
+	job, err := types.NewJobDefinitionFromYaml(raw)
+	require.NoError(t, err)
+	require.Len(t, job.Triggers, 1)
+
+	trig := job.Triggers[0]
+	require.Equal(t, "job", trig.Type)
+	require.Equal(t, []string{"webhook-triggered-pipeline"}, trig.Upstream)
+	require.Equal(t, []string{"COMPLETED"}, trig.States)
+	require.NotEmpty(t, trig.Params["artifacts"])
+}
@@ -80,8 +80,8 @@ func (h *WebhookHandler) handleWebhook(c web.APIContext) error {
 	if triggerDef == nil {
 		return c.JSON(http.StatusNotFound, map[string]string{"error": fmt.Sprintf("trigger %q not found on job %q", triggerName, jobType)})
 	}
-	if triggerDef.Type != "webhook" {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "trigger is not of type webhook"})
+	if triggerDef.Type != "webhook" && triggerDef.Type != "git" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "trigger is not of type webhook or git"})
 	}
 
 	// Read body with size limit. LimitReader is capped at maxBytes so we can detect overflow
@@ -139,6 +139,22 @@ func (h *WebhookHandler) handleWebhook(c web.APIContext) error {
 		"Query":   query,
 	}
 
+	// Git triggers normalize provider payloads and match branches, tags and paths before templates.
+	var gitEvent *GitEvent
+	if triggerDef.Type == "git" {
+		if gitEvent, err = ParseGitEvent(triggerDef.Provider, c.Request().Header, body); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		if gitEvent == nil || !MatchGitEvent(triggerDef, gitEvent) {
+			return c.JSON(http.StatusAccepted, map[string]interface{}{"filtered": true, "request_id": ""})
+		}
+		span.SetAttributes(
+			attribute.String("git.event", gitEvent.Event),
+			attribute.String("git.ref", gitEvent.Ref),
+		)
+		data["Git"] = gitEvent
+	}
+
 	result, err := h.evaluator.Evaluate(ctx, &TriggerEvent{
 		JobDefinition: jobDef,
 		Trigger:       triggerDef,
@@ -154,6 +170,14 @@ func (h *WebhookHandler) handleWebhook(c web.APIContext) error {
 		// Not an error — the webhook was received and processed correctly.
 		return c.JSON(http.StatusAccepted, map[string]interface{}{"filtered": true, "request_id": ""})
 	}
+	if gitEvent != nil {
+		// params of the trigger take precedence over normalized git params
+		for k, v := range gitEvent.Params() {
+			if _, ok := result.Params[k]; !ok {
+				result.Params[k] = v
+			}
+		}
+	}
 
 	saved, err := h.submitter.Submit(ctx, jobDef, triggerName, result)
 	if err != nil {
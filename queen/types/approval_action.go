@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultApprovalActionLinkTTL is how long approve/reject links in notifications remain valid.
+const DefaultApprovalActionLinkTTL = 72 * time.Hour
+
+// ApprovalActionClaims are the signed contents of an approve/reject link sent in an email or a
+// Slack button. The approve and reject links of a notification share the same ID so that the
+// recipient can only use one of them.
+type ApprovalActionClaims struct {
+	// ID random nonce shared by the approve and reject links of a notification
+	ID string `json:"id"`
+	// RequestID of the job awaiting approval
+	RequestID string `json:"request_id"`
+	// TaskType of the task awaiting approval
+	TaskType string `json:"task_type"`
+	// Recipient email the link was sent to; empty for Slack buttons where Slack identifies the user
+	Recipient string `json:"recipient,omitempty"`
+	// Decision cast when the link is used
+	Decision ApprovalDecision `json:"decision"`
+	// ExpiresAt unix time after which the link is rejected
+	ExpiresAt int64 `json:"exp"`
+}
+
+// Validate checks required fields of the claims.
+func (c *ApprovalActionClaims) Validate() error {
+	if c.ID == "" {
+		return errors.New("id is required")
+	}
+	if c.RequestID == "" {
+		return errors.New("request_id is required")
+	}
+	if c.TaskType == "" {
+		return errors.New("task_type is required")
+	}
+	if c.Decision != ApprovalDecisionApproved && c.Decision != ApprovalDecisionRejected {
+		return errors.New("decision must be APPROVED or REJECTED")
+	}
+	if c.ExpiresAt == 0 {
+		return errors.New("exp is required")
+	}
+	return nil
+}
+
+// Expired returns true if the link can no longer be used at the given time.
+func (c *ApprovalActionClaims) Expired(now time.Time) bool {
+	return now.Unix() >= c.ExpiresAt
+}
+
+// ApprovalActionRedemption records that a user used an approve/reject link so that
+// the link cannot be replayed. There is one row per link id and user.
+type ApprovalActionRedemption struct {
+	// ID primary key
+	ID string `json:"id" gorm:"primary_key"`
+	// ActionID is the id of the redeemed link
+	ActionID string `json:"action_id" gorm:"uniqueIndex:formicary_approval_action_redemptions_ndx;not null"`
+	// UserID of the user who used the link
+	UserID string `json:"user_id" gorm:"uniqueIndex:formicary_approval_action_redemptions_ndx;not null"`
+	// RequestID of the job awaiting approval
+	RequestID string `json:"request_id"`
+	// TaskType of the task awaiting approval
+	TaskType string `json:"task_type"`
+	// Decision cast with the link
+	Decision ApprovalDecision `json:"decision"`
+	// Channel through which the link was used, e.g. email or slack
+	Channel string `json:"channel"`
+	// RedeemedAt redemption time
+	RedeemedAt time.Time `json:"redeemed_at"`
+}
+
+// TableName overrides the default GORM table name.
+func (ApprovalActionRedemption) TableName() string {
+	return "formicary_approval_action_redemptions"
+}
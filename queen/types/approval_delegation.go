@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxApprovalDelegationPeriod limits how long an approver may delegate votes while away.
+const MaxApprovalDelegationPeriod = 90 * 24 * time.Hour
+
+// ApprovalDelegation lets a delegate vote on behalf of an approver while the approver is
+// out of office. Delegated votes count as the delegator's vote and are only honoured
+// between StartsAt and EndsAt.
+type ApprovalDelegation struct {
+	// ID primary key
+	ID string `json:"id" gorm:"primary_key"`
+	// DelegatorID is the user-id of the approver who is away
+	DelegatorID string `json:"delegator_id" gorm:"index;not null"`
+	// DelegatorName display name of the approver
+	DelegatorName string `json:"delegator_name"`
+	// DelegateID is the user-id of the user who may vote on behalf of the approver
+	DelegateID string `json:"delegate_id" gorm:"index;not null"`
+	// OrganizationID of the approver
+	OrganizationID string `json:"organization_id"`
+	// StartsAt is when the approver goes away
+	StartsAt time.Time `json:"starts_at"`
+	// EndsAt is when the approver returns
+	EndsAt time.Time `json:"ends_at"`
+	// Reason optional note such as "vacation"
+	Reason string `json:"reason"`
+	// CreatedAt creation time
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName overrides the default GORM table name.
+func (ApprovalDelegation) TableName() string {
+	return "formicary_approval_delegations"
+}
+
+// Validate checks required fields and the delegation period.
+func (d *ApprovalDelegation) Validate() error {
+	if d.DelegatorID == "" {
+		return errors.New("delegator_id is required")
+	}
+	if d.DelegateID == "" {
+		return errors.New("delegate_id is required")
+	}
+	if d.DelegatorID == d.DelegateID {
+		return errors.New("approver cannot delegate to themselves")
+	}
+	if d.StartsAt.IsZero() || d.EndsAt.IsZero() {
+		return errors.New("starts_at and ends_at are required")
+	}
+	if !d.EndsAt.After(d.StartsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+	if d.EndsAt.Sub(d.StartsAt) > MaxApprovalDelegationPeriod {
+		return errors.New("delegation period cannot exceed 90 days")
+	}
+	return nil
+}
+
+// ActiveAt returns true if the delegation is in effect at the given time.
+func (d *ApprovalDelegation) ActiveAt(now time.Time) bool {
+	return !now.Before(d.StartsAt) && now.Before(d.EndsAt)
+}
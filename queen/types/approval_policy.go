@@ -3,6 +3,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -19,6 +20,31 @@ const (
 	TimeoutActionEscalate TimeoutAction = "ESCALATE"
 )
 
+// ApprovalStage defines quorum rules of one step in an ordered multi-stage approval,
+// e.g. team lead followed by change-advisory board.
+type ApprovalStage struct {
+	// Name identifies the stage in votes and status, e.g. "team-lead" or "cab".
+	Name string `yaml:"name" json:"name"`
+	// MinApprovals is the quorum threshold of the stage.
+	MinApprovals int `yaml:"min_approvals" json:"min_approvals"`
+	// AllowedRoles comma-separated roles permitted to vote in the stage (empty = no role restriction).
+	AllowedRoles string `yaml:"allowed_roles,omitempty" json:"allowed_roles"`
+	// AllowedUsers comma-separated user IDs permitted to vote in the stage (empty = no user restriction).
+	AllowedUsers string `yaml:"allowed_users,omitempty" json:"allowed_users"`
+	// RequireUnanimous when true, any single rejection in the stage immediately fails the task.
+	RequireUnanimous bool `yaml:"require_unanimous,omitempty" json:"require_unanimous"`
+}
+
+// AllowedRoleList returns the split list of allowed roles (trimmed, non-empty).
+func (s *ApprovalStage) AllowedRoleList() []string {
+	return splitTrimmed(s.AllowedRoles)
+}
+
+// AllowedUserList returns the split list of allowed user IDs (trimmed, non-empty).
+func (s *ApprovalStage) AllowedUserList() []string {
+	return splitTrimmed(s.AllowedUsers)
+}
+
 // ApprovalPolicy defines quorum rules and SLA config for a MANUAL task.
 type ApprovalPolicy struct {
 	// ID primary key
@@ -41,6 +67,12 @@ type ApprovalPolicy struct {
 	EscalationRecipients string `yaml:"escalation_recipients,omitempty" json:"escalation_recipients"`
 	// EscalationMessage custom message for escalation notifications.
 	EscalationMessage string `yaml:"escalation_message,omitempty" json:"escalation_message"`
+	// EscalationVoting when true, escalation recipients may vote on the current stage once
+	// the SLA is breached and escalated.
+	EscalationVoting bool `yaml:"escalation_voting,omitempty" json:"escalation_voting"`
+	// Stages ordered approval stages that must reach quorum one after another. When empty, the
+	// quorum rules above define a single stage.
+	Stages []*ApprovalStage `yaml:"stages,omitempty" json:"stages" gorm:"-"`
 	// CreatedAt creation time
 	CreatedAt time.Time `json:"created_at"`
 	// UpdatedAt update time
@@ -54,9 +86,22 @@ func (ApprovalPolicy) TableName() string {
 
 // Validate checks required fields and logical consistency.
 func (p *ApprovalPolicy) Validate() error {
-	if p.MinApprovals < 1 {
+	if len(p.Stages) == 0 && p.MinApprovals < 1 {
 		return errors.New("min_approvals must be at least 1")
 	}
+	names := make(map[string]bool)
+	for _, stage := range p.Stages {
+		if stage == nil || strings.TrimSpace(stage.Name) == "" {
+			return errors.New("approval stage name is required")
+		}
+		if names[stage.Name] {
+			return fmt.Errorf("approval stage %s is defined more than once", stage.Name)
+		}
+		names[stage.Name] = true
+		if stage.MinApprovals < 1 {
+			return fmt.Errorf("min_approvals of approval stage %s must be at least 1", stage.Name)
+		}
+	}
 	if p.SLADeadline < 0 {
 		return errors.New("sla_deadline cannot be negative")
 	}
@@ -84,6 +129,20 @@ func (p *ApprovalPolicy) EscalationRecipientList() []string {
 	return splitTrimmed(p.EscalationRecipients)
 }
 
+// EffectiveStages returns the ordered approval stages, where a policy without stages has a
+// single unnamed stage built from its top-level quorum rules.
+func (p *ApprovalPolicy) EffectiveStages() []*ApprovalStage {
+	if len(p.Stages) > 0 {
+		return p.Stages
+	}
+	return []*ApprovalStage{{
+		MinApprovals:     p.MinApprovals,
+		AllowedRoles:     p.AllowedRoles,
+		AllowedUsers:     p.AllowedUsers,
+		RequireUnanimous: p.RequireUnanimous,
+	}}
+}
+
 // EffectiveTimeoutAction returns ESCALATE when timeout_action is not set.
 func (p *ApprovalPolicy) EffectiveTimeoutAction() TimeoutAction {
 	if p.TimeoutAction == "" {
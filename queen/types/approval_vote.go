@@ -31,6 +31,10 @@ type ApprovalVote struct {
 	VoterID string `json:"voter_id" gorm:"uniqueIndex:uq_approval_vote_voter_task;not null"`
 	// VoterName display name of the approver
 	VoterName string `json:"voter_name"`
+	// OnBehalfOf is the user-id of the away approver when the vote was cast by their delegate.
+	OnBehalfOf string `json:"on_behalf_of"`
+	// Stage is the name of the approval stage the vote counts toward (empty for single-stage policies).
+	Stage string `json:"stage"`
 	// Decision is APPROVED or REJECTED
 	Decision ApprovalDecision `json:"decision"`
 	// Comments optional rationale
@@ -66,6 +70,23 @@ func (v *ApprovalVote) IsApproved() bool {
 	return v.Decision == ApprovalDecisionApproved
 }
 
+// Principal returns the approver the vote counts for, i.e. the delegator of a delegated vote.
+func (v *ApprovalVote) Principal() string {
+	if v.OnBehalfOf != "" {
+		return v.OnBehalfOf
+	}
+	return v.VoterID
+}
+
+// ApprovalStageStatus is the vote tally of a single approval stage.
+type ApprovalStageStatus struct {
+	Name                 string `json:"name"`
+	ApprovalsReceived    int    `json:"approvals_received"`
+	RejectionsReceived   int    `json:"rejections_received"`
+	MinApprovalsRequired int    `json:"min_approvals_required"`
+	Completed            bool   `json:"completed"`
+}
+
 // ApprovalStatus is the aggregate approval state for a single task execution.
 type ApprovalStatus struct {
 	TaskExecutionID      string          `json:"task_execution_id"`
@@ -79,6 +100,9 @@ type ApprovalStatus struct {
 	Deadline             *time.Time      `json:"deadline,omitempty"`
 	Votes                []*ApprovalVote `json:"votes"`
 	Policy               *ApprovalPolicy `json:"policy,omitempty"`
+	// CurrentStage is the stage awaiting votes, or the last stage once the approval is resolved.
+	CurrentStage string                 `json:"current_stage"`
+	Stages       []*ApprovalStageStatus `json:"stages"`
 }
 
 // PendingApproval represents a task awaiting approval votes.
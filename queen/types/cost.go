@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	common "plexobject.com/formicary/internal/types"
+)
+
+// CostKind defines the resource that was charged
+type CostKind string
+
+const (
+	// ComputeCost charges cpu and memory used by containers of a task
+	ComputeCost CostKind = "COMPUTE"
+	// StorageCost charges storage of artifacts of a task until they expire
+	StorageCost CostKind = "STORAGE"
+	// EgressCost charges download of an artifact
+	EgressCost CostKind = "EGRESS"
+)
+
+// CostGroupBy defines dimension by which costs are aggregated in a report
+type CostGroupBy string
+
+const (
+	// CostByOrganization aggregates costs per organization
+	CostByOrganization CostGroupBy = "org"
+	// CostByUser aggregates costs per user
+	CostByUser CostGroupBy = "user"
+	// CostByJobType aggregates costs per job type
+	CostByJobType CostGroupBy = "job_type"
+	// CostByTag aggregates costs per tag of tasks, where cost of a task is split evenly among its tags
+	CostByTag CostGroupBy = "tag"
+)
+
+// Column returns column of cost records for the dimension
+func (g CostGroupBy) Column() (string, error) {
+	switch g {
+	case CostByOrganization:
+		return "organization_id", nil
+	case CostByUser:
+		return "user_id", nil
+	case CostByJobType:
+		return "job_type", nil
+	case CostByTag:
+		return "tags", nil
+	default:
+		return "", fmt.Errorf("group_by %s must be org, user, job_type or tag", g)
+	}
+}
+
+// CostRecord records cost of resources used by a task execution for chargeback
+type CostRecord struct {
+	// ID is a 26-char ULID string.
+	ID              string   `json:"id" gorm:"primaryKey;size:128"`
+	Kind            CostKind `json:"kind" gorm:"not null;size:64"`
+	JobRequestID    string   `json:"job_request_id" gorm:"size:128"`
+	JobExecutionID  string   `json:"job_execution_id" gorm:"size:128"`
+	TaskExecutionID string   `json:"task_execution_id" gorm:"size:128;index"`
+	JobType         string   `json:"job_type" gorm:"size:255"`
+	TaskType        string   `json:"task_type" gorm:"size:100"`
+	Method          string   `json:"method" gorm:"size:64"`
+	// Tags are comma separated tags of the task
+	Tags           string `json:"tags"`
+	UserID         string `json:"user_id" gorm:"size:128"`
+	OrganizationID string `json:"organization_id" gorm:"size:128"`
+	// CPUSecs is number of cores multiplied by seconds the task ran
+	CPUSecs float64 `json:"cpu_secs"`
+	// MemoryGBSecs is memory in GB multiplied by seconds the task ran
+	MemoryGBSecs float64 `json:"memory_gb_secs"`
+	// StorageGBDays is size of artifacts in GB multiplied by days until they expire
+	StorageGBDays float64 `json:"storage_gb_days"`
+	// EgressGB is size of downloaded artifact in GB
+	EgressGB  float64   `json:"egress_gb"`
+	Cost      float64   `json:"cost"`
+	Currency  string    `json:"currency" gorm:"size:16"`
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName overrides the GORM table name.
+func (CostRecord) TableName() string {
+	return "formicary_cost_records"
+}
+
+// Validate validates cost record
+func (r *CostRecord) Validate() error {
+	if r.Kind == "" {
+		return fmt.Errorf("kind is not specified")
+	}
+	if r.Cost < 0 {
+		return fmt.Errorf("cost cannot be negative")
+	}
+	return nil
+}
+
+// GetTags returns tags of the task
+func (r *CostRecord) GetTags() []string {
+	if r.Tags == "" {
+		return nil
+	}
+	return strings.Split(r.Tags, ",")
+}
+
+func (r *CostRecord) String() string {
+	return fmt.Sprintf("Kind=%s JobType=%s TaskType=%s TaskExecutionID=%s Cost=%.4f %s",
+		r.Kind, r.JobType, r.TaskType, r.TaskExecutionID, r.Cost, r.Currency)
+}
+
+// CostBudgetAlert records a budget that was notified in its period so that recipients are notified once
+// even after the server restarts.
+type CostBudgetAlert struct {
+	// ID is a 26-char ULID string.
+	ID          string    `json:"id" gorm:"primaryKey;size:128"`
+	Budget      string    `json:"budget" gorm:"not null;size:255;uniqueIndex:formicary_cost_budget_alerts_period_ndx"`
+	PeriodStart time.Time `json:"period_start" gorm:"not null;uniqueIndex:formicary_cost_budget_alerts_period_ndx"`
+	Spent       float64   `json:"spent"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName overrides the GORM table name.
+func (CostBudgetAlert) TableName() string {
+	return "formicary_cost_budget_alerts"
+}
+
+// CostSummary aggregates costs of an organization, user, job type or tag over a date range
+type CostSummary struct {
+	// Key is the organization, user, job type or tag
+	Key           string  `json:"key"`
+	Tasks         int64   `json:"tasks"`
+	CPUSecs       float64 `json:"cpu_secs"`
+	MemoryGBSecs  float64 `json:"memory_gb_secs"`
+	StorageGBDays float64 `json:"storage_gb_days"`
+	EgressGB      float64 `json:"egress_gb"`
+	ComputeCost   float64 `json:"compute_cost"`
+	StorageCost   float64 `json:"storage_cost"`
+	EgressCost    float64 `json:"egress_cost"`
+	Cost          float64 `json:"cost"`
+}
+
+// add adds fraction of other summary
+func (s *CostSummary) add(other *CostSummary, fraction float64) {
+	s.Tasks += other.Tasks
+	s.CPUSecs += other.CPUSecs * fraction
+	s.MemoryGBSecs += other.MemoryGBSecs * fraction
+	s.StorageGBDays += other.StorageGBDays * fraction
+	s.EgressGB += other.EgressGB * fraction
+	s.ComputeCost += other.ComputeCost * fraction
+	s.StorageCost += other.StorageCost * fraction
+	s.EgressCost += other.EgressCost * fraction
+	s.Cost += other.Cost * fraction
+}
+
+// CostReport aggregates costs by a dimension over a date range
+type CostReport struct {
+	GroupBy CostGroupBy `json:"group_by"`
+	From    time.Time   `json:"from"`
+	// To is exclusive end of the range
+	To        time.Time      `json:"to"`
+	Currency  string         `json:"currency"`
+	Total     float64        `json:"total"`
+	Summaries []*CostSummary `json:"summaries"`
+}
+
+// NewCostReport creates report from summaries of the dimension, where summaries grouped by tags of tasks are
+// split evenly among the tags. Summaries are ordered by cost.
+func NewCostReport(
+	groupBy CostGroupBy,
+	from time.Time,
+	to time.Time,
+	currency string,
+	summaries []*CostSummary) *CostReport {
+	report := &CostReport{
+		GroupBy:   groupBy,
+		From:      from,
+		To:        to,
+		Currency:  currency,
+		Summaries: make([]*CostSummary, 0),
+	}
+	byKey := make(map[string]*CostSummary)
+	add := func(key string, summary *CostSummary, fraction float64) {
+		total := byKey[key]
+		if total == nil {
+			total = &CostSummary{Key: key}
+			byKey[key] = total
+			report.Summaries = append(report.Summaries, total)
+		}
+		total.add(summary, fraction)
+	}
+	for _, summary := range summaries {
+		report.Total += summary.Cost
+		if groupBy != CostByTag || summary.Key == "" {
+			add(summary.Key, summary, 1)
+			continue
+		}
+		tags := strings.Split(summary.Key, ",")
+		for _, tag := range tags {
+			add(strings.TrimSpace(tag), summary, 1/float64(len(tags)))
+		}
+	}
+	sort.Slice(report.Summaries, func(i, j int) bool {
+		return report.Summaries[i].Cost > report.Summaries[j].Cost
+	})
+	return report
+}
+
+// WriteCSV writes summaries of the report as CSV
+func (r *CostReport) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{string(r.GroupBy), "from", "until", "tasks", "cpu_secs", "memory_gb_secs",
+		"storage_gb_days", "egress_gb", "compute_cost", "storage_cost", "egress_cost", "cost", "currency"}); err != nil {
+		return err
+	}
+	number := func(v float64) string {
+		return fmt.Sprintf("%.4f", v)
+	}
+	for _, s := range r.Summaries {
+		if err := out.Write([]string{s.Key, r.From.Format("2006-01-02"), r.To.Format("2006-01-02"),
+			fmt.Sprintf("%d", s.Tasks), number(s.CPUSecs), number(s.MemoryGBSecs), number(s.StorageGBDays),
+			number(s.EgressGB), number(s.ComputeCost), number(s.StorageCost), number(s.EgressCost),
+			number(s.Cost), r.Currency}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// TaskResources returns cores and memory in GB requested by the main container and services of a task,
+// where the defaults are used for containers that don't request cpu or memory.
+func TaskResources(
+	opts *common.ExecutorOptions,
+	defaultCPU float64,
+	defaultMemoryGB float64) (cpu float64, memoryGB float64) {
+	add := func(cpuRequest, cpuLimit, memoryRequest, memoryLimit string, instances int) {
+		if instances < 1 {
+			instances = 1
+		}
+		cpu += parseResource(cpuRequest, cpuLimit, 1, defaultCPU) * float64(instances)
+		memoryGB += parseResource(memoryRequest, memoryLimit, 1024*1024*1024, defaultMemoryGB) * float64(instances)
+	}
+	if opts == nil || opts.MainContainer == nil {
+		add("", "", "", "", 1)
+	} else {
+		add(opts.MainContainer.CPURequest, opts.MainContainer.CPULimit,
+			opts.MainContainer.MemoryRequest, opts.MainContainer.MemoryLimit, 1)
+	}
+	if opts != nil {
+		for _, svc := range opts.Services {
+			add(svc.CPURequest, svc.CPULimit, svc.MemoryRequest, svc.MemoryLimit, svc.Instances)
+		}
+	}
+	return
+}
+
+// parseResource parses kubernetes quantity of request or else limit in given unit
+func parseResource(request string, limit string, unit float64, def float64) float64 {
+	for _, val := range []string{request, limit} {
+		if val == "" {
+			continue
+		}
+		if q, err := resource.ParseQuantity(val); err == nil {
+			return q.AsApproximateFloat64() / unit
+		}
+	}
+	return def
+}
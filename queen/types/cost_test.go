@@ -0,0 +1,64 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	common "plexobject.com/formicary/internal/types"
+)
+
+func Test_ShouldFindTaskResources(t *testing.T) {
+	// GIVEN a task whose main container requests half core and two services that only limit cpu
+	opts := common.NewExecutorOptions("build", common.Kubernetes)
+	opts.MainContainer.CPURequest = "500m"
+	opts.MainContainer.MemoryLimit = "2Gi"
+	opts.Services = []common.Service{{Name: "redis", CPULimit: "2", Instances: 2}}
+
+	// WHEN finding resources of the task
+	cpu, memoryGB := TaskResources(opts, 1, 1)
+
+	// THEN requests and limits are used and defaults for memory of services
+	require.InDelta(t, 4.5, cpu, 0.001)
+	require.InDelta(t, 4.0, memoryGB, 0.001)
+
+	// WHEN finding resources of a task without containers
+	cpu, memoryGB = TaskResources(nil, 1, 0.5)
+	// THEN defaults are used
+	require.Equal(t, 1.0, cpu)
+	require.Equal(t, 0.5, memoryGB)
+}
+
+func Test_ShouldBuildCostReportByTags(t *testing.T) {
+	// GIVEN costs of tasks grouped by their tags
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	summaries := []*CostSummary{
+		{Key: "gpu,linux", Tasks: 2, ComputeCost: 8, Cost: 8},
+		{Key: "linux", Tasks: 1, ComputeCost: 3, Cost: 3},
+		{Key: "", Tasks: 1, EgressCost: 1, Cost: 1},
+	}
+
+	// WHEN building report by tag
+	report := NewCostReport(CostByTag, from, from.AddDate(0, 0, 7), "USD", summaries)
+
+	// THEN cost of tasks with several tags is split among the tags and ordered by cost
+	require.Equal(t, 12.0, report.Total)
+	require.Len(t, report.Summaries, 3)
+	require.Equal(t, "linux", report.Summaries[0].Key)
+	require.Equal(t, 7.0, report.Summaries[0].Cost)
+	require.Equal(t, int64(3), report.Summaries[0].Tasks)
+	require.Equal(t, "gpu", report.Summaries[1].Key)
+	require.Equal(t, 4.0, report.Summaries[1].Cost)
+	require.Equal(t, "", report.Summaries[2].Key)
+
+	// WHEN exporting the report as CSV
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteCSV(&buf))
+	// THEN it should have a header and a row per tag
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	require.True(t, strings.HasPrefix(lines[0], "tag,from,until,tasks"))
+	require.Equal(t, "linux,2026-10-01,2026-10-08,3,0.0000,0.0000,0.0000,0.0000,7.0000,0.0000,0.0000,7.0000,USD", lines[1])
+}
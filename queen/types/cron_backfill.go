@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	common "plexobject.com/formicary/internal/types"
+)
+
+const (
+	// LogicalDateParam is the cron tick of a backfill or catch-up request in RFC3339 format
+	LogicalDateParam = "LogicalDate"
+	// BackfillIDParam identifies the backfill that submitted a request
+	BackfillIDParam = "BackfillID"
+	// MaxCronBackfillTicks limits number of ticks in a backfill
+	MaxCronBackfillTicks = 1000
+)
+
+// CronBackfill runs a cron job for every tick in [StartTime, EndTime), where at most MaxParallel
+// requests of the backfill run at a time. Ticks that already ran are skipped by matching logical date
+// of cron requests.
+type CronBackfill struct {
+	// ID is a 26-char ULID string.
+	ID             string `json:"id" gorm:"primaryKey;size:128"`
+	JobType        string `json:"job_type" gorm:"not null;size:255;index"`
+	UserID         string `json:"user_id" gorm:"size:128"`
+	OrganizationID string `json:"organization_id" gorm:"size:128"`
+	// StartTime of the range, inclusive
+	StartTime time.Time `json:"start_time"`
+	// EndTime of the range, exclusive
+	EndTime     time.Time `json:"end_time"`
+	MaxParallel int       `json:"max_parallel"`
+	// Catchup is set for backfills that are created automatically for missed ticks after downtime
+	Catchup bool `json:"catchup"`
+	// State is EXECUTING while ticks are submitted and COMPLETED, CANCELLED or FAILED at the end
+	State common.RequestState `json:"state" gorm:"not null;size:64;index"`
+	// TotalTicks is number of cron ticks in the range
+	TotalTicks int `json:"total_ticks"`
+	// SkippedTicks is number of ticks that had already run before the backfill
+	SkippedTicks int `json:"skipped_ticks"`
+	// SubmittedTicks is number of requests submitted by the backfill
+	SubmittedTicks int       `json:"submitted_ticks"`
+	ErrorMessage   string    `json:"error_message"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// CronBackfillRequest is the body of API request to backfill a cron job for a date range
+type CronBackfillRequest struct {
+	JobType string `json:"job_type"`
+	// From is the inclusive start of the range in RFC3339 format
+	From time.Time `json:"from"`
+	// To is the exclusive end of the range in RFC3339 format, which is capped to the current time
+	To          time.Time `json:"to"`
+	MaxParallel int       `json:"max_parallel"`
+}
+
+// NewCronBackfill creates a backfill for the job-definition
+func NewCronBackfill(
+	jd *JobDefinition,
+	start time.Time,
+	end time.Time,
+	maxParallel int) *CronBackfill {
+	if maxParallel <= 0 {
+		maxParallel = 1
+	}
+	return &CronBackfill{
+		JobType:        jd.JobType,
+		UserID:         jd.UserID,
+		OrganizationID: jd.OrganizationID,
+		StartTime:      start,
+		EndTime:        end,
+		MaxParallel:    maxParallel,
+		State:          common.EXECUTING,
+	}
+}
+
+// TableName overrides the GORM table name.
+func (CronBackfill) TableName() string {
+	return "formicary_cron_backfills"
+}
+
+// Validate validates backfill
+func (bf *CronBackfill) Validate() error {
+	if bf.JobType == "" {
+		return fmt.Errorf("job_type is not specified")
+	}
+	if bf.StartTime.IsZero() || bf.EndTime.IsZero() {
+		return fmt.Errorf("from and to dates must be specified")
+	}
+	if !bf.EndTime.After(bf.StartTime) {
+		return fmt.Errorf("to date %s must be after from date %s",
+			bf.EndTime.Format(time.RFC3339), bf.StartTime.Format(time.RFC3339))
+	}
+	if bf.MaxParallel <= 0 {
+		return fmt.Errorf("max_parallel must be positive")
+	}
+	return nil
+}
+
+// OrganizationOrUserID returns org-id or user-id that prefixes user-key of cron ticks
+func (bf *CronBackfill) OrganizationOrUserID() string {
+	if bf.OrganizationID != "" {
+		return bf.OrganizationID
+	}
+	return bf.UserID
+}
+
+// Done returns true if backfill is no longer submitting requests
+func (bf *CronBackfill) Done() bool {
+	return bf.State.IsTerminal()
+}
+
+func (bf *CronBackfill) String() string {
+	return fmt.Sprintf("ID=%s JobType=%s Start=%s End=%s MaxParallel=%d State=%s",
+		bf.ID, bf.JobType, bf.StartTime.Format(time.RFC3339), bf.EndTime.Format(time.RFC3339), bf.MaxParallel, bf.State)
+}
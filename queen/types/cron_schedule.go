@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	// embed zone database so that job timezones work in minimal containers
+	_ "time/tzdata"
+
+	"github.com/gorhill/cronexpr"
+)
+
+const (
+	// BlackoutPolicySkip drops cron runs that fall into a blackout window or holiday
+	BlackoutPolicySkip = "skip"
+	// BlackoutPolicyDefer runs the job when the blackout window or holiday ends
+	BlackoutPolicyDefer = "defer"
+
+	maxCronScheduleIterations = 1000
+)
+
+var blackoutTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// BlackoutWindow defines a period such as change-freeze when cron runs of a job are skipped or deferred.
+// A window is either fixed using start/end or recurring using cron/duration.
+type BlackoutWindow struct {
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Start is date or date-time in timezone of the job such as 2026-12-20 or 2026-12-20T18:00
+	Start string `yaml:"start,omitempty" json:"start,omitempty"`
+	// End is exclusive date-time or inclusive date so that a freeze ending 2027-01-04 includes whole day
+	End string `yaml:"end,omitempty" json:"end,omitempty"`
+	// Cron defines start of recurring window such as `0 0 18 * * FRI *`
+	Cron string `yaml:"cron,omitempty" json:"cron,omitempty"`
+	// Duration of recurring window
+	Duration time.Duration `yaml:"duration,omitempty" json:"duration,omitempty"`
+}
+
+// Validate validates blackout window
+func (bw *BlackoutWindow) Validate() error {
+	if bw.Cron != "" {
+		if bw.Start != "" || bw.End != "" {
+			return fmt.Errorf("blackout window %s cannot define both cron and start/end", bw.Name)
+		}
+		if bw.Duration <= 0 {
+			return fmt.Errorf("blackout window %s must define duration with cron", bw.Name)
+		}
+		if _, err := cronexpr.Parse(bw.Cron); err != nil {
+			return fmt.Errorf("blackout window %s has invalid cron %s due to %w", bw.Name, bw.Cron, err)
+		}
+		return nil
+	}
+	start, end, err := bw.fixedRange(time.UTC)
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return fmt.Errorf("blackout window %s must end after %s", bw.Name, bw.Start)
+	}
+	return nil
+}
+
+// blockedUntil returns end of the window if t falls in it
+func (bw *BlackoutWindow) blockedUntil(t time.Time, loc *time.Location) (time.Time, bool) {
+	if bw.Cron != "" {
+		expr, err := cronexpr.Parse(bw.Cron)
+		if err != nil {
+			return time.Time{}, false
+		}
+		// the first window start after t-duration covers t if it isn't after t
+		start := expr.Next(t.In(loc).Add(-bw.Duration))
+		if start.IsZero() || start.After(t) {
+			return time.Time{}, false
+		}
+		return start.Add(bw.Duration), true
+	}
+	start, end, err := bw.fixedRange(loc)
+	if err != nil || t.Before(start) || !t.Before(end) {
+		return time.Time{}, false
+	}
+	return end, true
+}
+
+func (bw *BlackoutWindow) fixedRange(loc *time.Location) (start time.Time, end time.Time, err error) {
+	if bw.Start == "" || bw.End == "" {
+		return start, end, fmt.Errorf("blackout window %s must define start and end or cron and duration", bw.Name)
+	}
+	if start, _, err = parseBlackoutTime(bw.Start, loc); err != nil {
+		return start, end, fmt.Errorf("blackout window %s has invalid start %s", bw.Name, bw.Start)
+	}
+	var dateOnly bool
+	if end, dateOnly, err = parseBlackoutTime(bw.End, loc); err != nil {
+		return start, end, fmt.Errorf("blackout window %s has invalid end %s", bw.Name, bw.End)
+	}
+	if dateOnly {
+		end = end.AddDate(0, 0, 1)
+	}
+	return start, end, nil
+}
+
+func parseBlackoutTime(value string, loc *time.Location) (time.Time, bool, error) {
+	for _, layout := range blackoutTimeLayouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(value), loc); err == nil {
+			return t, layout == "2006-01-02", nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("unsupported time format %s", value)
+}
+
+// CronSchedule defines calendar-aware schedule of a job with one or more cron expressions that are
+// evaluated in the timezone of the job and can be blocked by blackout windows and holidays.
+type CronSchedule struct {
+	CronTriggers     []string          `json:"cron_triggers"`
+	Timezone         string            `json:"timezone,omitempty"`
+	BlackoutWindows  []*BlackoutWindow `json:"blackout_windows,omitempty"`
+	HolidayCalendars []string          `json:"holiday_calendars,omitempty"`
+	BlackoutPolicy   string            `json:"blackout_policy,omitempty"`
+	Catchup          bool              `json:"catchup,omitempty"`
+}
+
+// NewCronSchedule creates schedule for a plain cron expression in server time
+func NewCronSchedule(cronTrigger string) *CronSchedule {
+	cs := &CronSchedule{CronTriggers: make([]string, 0)}
+	if cronTrigger != "" {
+		cs.CronTriggers = append(cs.CronTriggers, cronTrigger)
+	}
+	return cs
+}
+
+// ParseCronSchedule parses serialized schedule and falls back to plain cron expression
+func ParseCronSchedule(cronTrigger string, serialized string) *CronSchedule {
+	if serialized == "" {
+		return NewCronSchedule(cronTrigger)
+	}
+	cs := &CronSchedule{}
+	if err := json.Unmarshal([]byte(serialized), cs); err != nil {
+		return NewCronSchedule(cronTrigger)
+	}
+	return cs
+}
+
+// Calendar returns true if schedule uses more than a single cron expression in server time
+func (cs *CronSchedule) Calendar() bool {
+	return cs.Timezone != "" || len(cs.CronTriggers) > 1 || len(cs.BlackoutWindows) > 0 ||
+		len(cs.HolidayCalendars) > 0 || cs.Catchup
+}
+
+// Location returns timezone of the schedule or server time
+func (cs *CronSchedule) Location() (*time.Location, error) {
+	if cs.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(cs.Timezone)
+}
+
+// Validate checks cron expressions, timezone, policy and blackout windows. Holiday calendars are
+// only checked by ValidateCalendars because they are loaded from the holiday calendar directory of the server.
+func (cs *CronSchedule) Validate() error {
+	for _, cron := range cs.CronTriggers {
+		if _, err := cronexpr.Parse(cron); err != nil {
+			return fmt.Errorf("cron expression %s is invalid due to %w", cron, err)
+		}
+	}
+	if _, err := cs.Location(); err != nil {
+		return fmt.Errorf("timezone %s is invalid due to %w", cs.Timezone, err)
+	}
+	if cs.BlackoutPolicy != "" && cs.BlackoutPolicy != BlackoutPolicySkip && cs.BlackoutPolicy != BlackoutPolicyDefer {
+		return fmt.Errorf("blackout_policy %s is invalid, it must be %s or %s",
+			cs.BlackoutPolicy, BlackoutPolicySkip, BlackoutPolicyDefer)
+	}
+	for _, bw := range cs.BlackoutWindows {
+		if err := bw.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCalendars loads holiday calendars of the schedule
+func (cs *CronSchedule) ValidateCalendars() error {
+	_, err := cs.loadCalendars()
+	return err
+}
+
+// Next returns the earliest cron tick after given time that isn't blocked by a blackout window or
+// holiday, or the end of the blackout when blackout policy is defer. It returns zero time when there
+// is no such tick.
+func (cs *CronSchedule) Next(from time.Time) (time.Time, error) {
+	loc, err := cs.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	calendars, err := cs.loadCalendars()
+	if err != nil {
+		return time.Time{}, err
+	}
+	exprs := make([]*cronexpr.Expression, len(cs.CronTriggers))
+	for i, cron := range cs.CronTriggers {
+		if exprs[i], err = cronexpr.Parse(cron); err != nil {
+			return time.Time{}, err
+		}
+	}
+	t := from.In(loc)
+	for i := 0; i < maxCronScheduleIterations; i++ {
+		var next time.Time
+		for _, expr := range exprs {
+			if n := expr.Next(t); !n.IsZero() && (next.IsZero() || n.Before(next)) {
+				next = n
+			}
+		}
+		if next.IsZero() {
+			return next, nil
+		}
+		end, blocked := cs.blockedUntil(next, loc, calendars)
+		if !blocked {
+			return next, nil
+		}
+		if cs.BlackoutPolicy == BlackoutPolicyDefer {
+			return cs.endOfBlackout(end, loc, calendars), nil
+		}
+		// skip ticks in the blackout, the first tick after t is at or after the end
+		t = end.Add(-time.Nanosecond)
+	}
+	return time.Time{}, nil
+}
+
+// Ticks returns up to limit ticks in [start, end) that aren't blocked by blackout windows or holidays.
+// Deferred runs are included if they fall in the range.
+func (cs *CronSchedule) Ticks(start time.Time, end time.Time, limit int) ([]time.Time, error) {
+	ticks := make([]time.Time, 0)
+	t := start.Add(-time.Nanosecond)
+	for len(ticks) < limit {
+		next, err := cs.Next(t)
+		if err != nil {
+			return nil, err
+		}
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+		// deferred ticks of a blackout collapse into a single run
+		if len(ticks) == 0 || !ticks[len(ticks)-1].Equal(next) {
+			ticks = append(ticks, next)
+		}
+		t = next
+	}
+	return ticks, nil
+}
+
+// endOfBlackout follows adjacent or overlapping blackouts
+func (cs *CronSchedule) endOfBlackout(end time.Time, loc *time.Location, calendars []*HolidayCalendar) time.Time {
+	for i := 0; i < maxCronScheduleIterations; i++ {
+		next, blocked := cs.blockedUntil(end, loc, calendars)
+		if !blocked {
+			return end
+		}
+		end = next
+	}
+	return time.Time{}
+}
+
+func (cs *CronSchedule) blockedUntil(t time.Time, loc *time.Location, calendars []*HolidayCalendar) (time.Time, bool) {
+	for _, bw := range cs.BlackoutWindows {
+		if end, ok := bw.blockedUntil(t, loc); ok {
+			return end, true
+		}
+	}
+	for _, cal := range calendars {
+		if _, end, ok := cal.Find(t, loc); ok {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (cs *CronSchedule) loadCalendars() ([]*HolidayCalendar, error) {
+	calendars := make([]*HolidayCalendar, len(cs.HolidayCalendars))
+	for i, name := range cs.HolidayCalendars {
+		cal, err := LoadHolidayCalendar(name)
+		if err != nil {
+			return nil, err
+		}
+		calendars[i] = cal
+	}
+	return calendars, nil
+}
+
+// ScheduleTimeAndUserKey returns next schedule time and user-key that is unique for the job and tick
+func (cs *CronSchedule) ScheduleTimeAndUserKey(orgIDOrUserID string, jobType string) (*time.Time, string, error) {
+	if len(cs.CronTriggers) == 0 {
+		return nil, "", nil
+	}
+	nextTime, err := cs.Next(time.Now())
+	if err != nil || nextTime.IsZero() {
+		return nil, "", err
+	}
+	return &nextTime, CronUserKey(orgIDOrUserID, jobType, nextTime), nil
+}
+
+// CronUserKey returns user-key of the cron tick, which is used to prevent duplicate requests of a tick
+func CronUserKey(orgIDOrUserID string, jobType string, tick time.Time) string {
+	prefix := orgIDOrUserID
+	if prefix == "" {
+		prefix = "default"
+	}
+	return fmt.Sprintf("%s-%s-%s", prefix, jobType, tick.Format(time.RFC3339))
+}
@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func loadCalendarJob(t *testing.T) *JobDefinition {
+	SetHolidayCalendarDir("../../fixtures")
+	b, err := os.ReadFile("../../fixtures/finance_etl_calendar.yaml")
+	require.NoError(t, err)
+	job, err := NewJobDefinitionFromYaml(b)
+	require.NoError(t, err)
+	return job
+}
+
+func newYork(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	return loc
+}
+
+func Test_ShouldParseCalendarCronJobDefinition(t *testing.T) {
+	// GIVEN a job with timezone, multiple cron entries, blackout windows and holidays
+	job := loadCalendarJob(t)
+
+	// THEN calendar options should be parsed and cron_trigger should hold first expression
+	require.Equal(t, "0 30 9 * * MON-FRI *", job.CronTrigger)
+	cs := job.GetCronSchedule()
+	require.Equal(t, []string{"0 30 9 * * MON-FRI *", "0 15 16 * * MON-FRI *"}, cs.CronTriggers)
+	require.Equal(t, "America/New_York", cs.Timezone)
+	require.Len(t, cs.BlackoutWindows, 2)
+	require.Equal(t, 10*time.Hour, cs.BlackoutWindows[1].Duration)
+	require.True(t, cs.Calendar())
+	require.Contains(t, job.CronAndScheduleTime(), "America/New_York")
+
+	// WHEN saving and loading the job
+	require.NoError(t, job.ValidateBeforeSave(nil))
+	require.NotEqual(t, "", job.CronScheduleSerialized)
+	loaded := &JobDefinition{
+		JobType:                job.JobType,
+		RawYaml:                job.RawYaml,
+		Tasks:                  job.Tasks,
+		CronTrigger:            job.CronTrigger,
+		CronScheduleSerialized: job.CronScheduleSerialized,
+	}
+	require.NoError(t, loaded.AfterLoad(nil))
+
+	// THEN schedule and user key should match the cron entry used by the scheduler
+	require.Equal(t, cs, loaded.GetCronSchedule())
+	date, userKey := loaded.GetCronScheduleTimeAndUserKey()
+	require.NotNil(t, date)
+	_, cronUserKey := NewJobTypeCronTrigger(loaded).GetCronScheduleTimeAndUserKey()
+	require.Equal(t, userKey, cronUserKey)
+}
+
+func Test_ShouldFollowTimezoneAcrossDSTForCronSchedule(t *testing.T) {
+	// GIVEN a weekday schedule in New York time
+	cs := &CronSchedule{CronTriggers: []string{"0 30 9 * * MON-FRI *"}, Timezone: "America/New_York"}
+	require.NoError(t, cs.Validate())
+
+	// WHEN finding next tick before and after DST starts on 2026-03-08
+	next, err := cs.Next(time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	// THEN it should run at 9:30 EST
+	require.Equal(t, time.Date(2026, 3, 6, 14, 30, 0, 0, time.UTC), next.UTC())
+
+	next, err = cs.Next(next)
+	require.NoError(t, err)
+	// THEN it should run at 9:30 EDT on monday
+	require.Equal(t, time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC), next.UTC())
+	require.Equal(t, "2026-03-09T09:30:00-04:00", next.Format(time.RFC3339))
+}
+
+func Test_ShouldUseEarliestOfMultipleCronTriggers(t *testing.T) {
+	// GIVEN a schedule with two cron entries
+	cs := &CronSchedule{
+		CronTriggers: []string{"0 15 16 * * MON-FRI *", "0 30 9 * * MON-FRI *"},
+		Timezone:     "America/New_York",
+	}
+	loc := newYork(t)
+
+	// WHEN finding next ticks
+	next, err := cs.Next(time.Date(2026, 10, 19, 8, 0, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 19, 9, 30, 0, 0, loc), next)
+	next, err = cs.Next(next)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 19, 16, 15, 0, 0, loc), next)
+}
+
+func Test_ShouldSkipHolidaysForCronSchedule(t *testing.T) {
+	// GIVEN a schedule with holiday calendar
+	job := loadCalendarJob(t)
+	cs := job.GetCronSchedule()
+	cs.BlackoutWindows = nil
+	loc := newYork(t)
+
+	// WHEN finding next tick before thanksgiving
+	next, err := cs.Next(time.Date(2026, 11, 25, 17, 0, 0, 0, loc))
+	require.NoError(t, err)
+	// THEN thanksgiving and the afternoon of early close should be skipped
+	require.Equal(t, time.Date(2026, 11, 27, 9, 30, 0, 0, loc), next)
+	next, err = cs.Next(next)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 11, 30, 9, 30, 0, 0, loc), next)
+
+	// WHEN finding next tick before christmas of a later year
+	next, err = cs.Next(time.Date(2028, 12, 24, 17, 0, 0, 0, loc))
+	require.NoError(t, err)
+	// THEN yearly holiday should be skipped
+	require.Equal(t, time.Date(2028, 12, 26, 9, 30, 0, 0, loc), next)
+}
+
+func Test_ShouldDeferRunsInBlackoutForCronSchedule(t *testing.T) {
+	// GIVEN a daily schedule with holidays and defer policy
+	cs := &CronSchedule{
+		CronTriggers:     []string{"0 0 6 * * * *"},
+		Timezone:         "America/New_York",
+		HolidayCalendars: []string{"nyse_holidays.ics"},
+		BlackoutWindows:  []*BlackoutWindow{{Name: "boxing-day", Start: "2026-12-26", End: "2026-12-26T12:00"}},
+		BlackoutPolicy:   BlackoutPolicyDefer,
+	}
+	SetHolidayCalendarDir("../../fixtures")
+	require.NoError(t, cs.Validate())
+	require.NoError(t, cs.ValidateCalendars())
+	loc := newYork(t)
+
+	// WHEN finding next tick on christmas eve
+	next, err := cs.Next(time.Date(2026, 12, 24, 7, 0, 0, 0, loc))
+	require.NoError(t, err)
+	// THEN the run should be deferred past christmas and the adjacent blackout window
+	require.Equal(t, time.Date(2026, 12, 26, 12, 0, 0, 0, loc), next)
+}
+
+func Test_ShouldSkipFixedAndRecurringBlackoutWindows(t *testing.T) {
+	loc := newYork(t)
+	// GIVEN an hourly schedule with a freeze whose date-only end is inclusive
+	cs := &CronSchedule{
+		CronTriggers:    []string{"0 0 * * * * *"},
+		Timezone:        "America/New_York",
+		BlackoutWindows: []*BlackoutWindow{{Name: "freeze", Start: "2026-12-21", End: "2027-01-04"}},
+	}
+	require.NoError(t, cs.Validate())
+	next, err := cs.Next(time.Date(2026, 12, 20, 23, 30, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2027, 1, 5, 0, 0, 0, 0, loc), next)
+
+	// GIVEN a recurring weekend window
+	cs.BlackoutWindows = []*BlackoutWindow{{Name: "weekend", Cron: "0 0 20 * * FRI *", Duration: 58 * time.Hour}}
+	require.NoError(t, cs.Validate())
+	next, err = cs.Next(time.Date(2026, 10, 23, 19, 30, 0, 0, loc))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 26, 6, 0, 0, 0, loc), next)
+}
+
+func Test_ShouldValidateCronSchedule(t *testing.T) {
+	require.Error(t, (&CronSchedule{CronTriggers: []string{"bad"}}).Validate())
+	require.Error(t, (&CronSchedule{Timezone: "Mars/Olympus"}).Validate())
+	require.Error(t, (&CronSchedule{BlackoutPolicy: "ignore"}).Validate())
+	require.Error(t, (&CronSchedule{BlackoutWindows: []*BlackoutWindow{{Start: "2026-12-21"}}}).Validate())
+	require.Error(t, (&CronSchedule{BlackoutWindows: []*BlackoutWindow{{Start: "2026-12-21", End: "2026-12-20"}}}).Validate())
+	require.Error(t, (&CronSchedule{BlackoutWindows: []*BlackoutWindow{{Cron: "0 0 20 * * FRI *"}}}).Validate())
+	require.Error(t, (&CronSchedule{HolidayCalendars: []string{"missing.ics"}}).ValidateCalendars())
+
+	// a job with invalid timezone should fail validation
+	job := loadCalendarJob(t)
+	job.Timezone = "Mars/Olympus"
+	require.Error(t, job.Validate())
+
+	// a job with missing holiday calendar can't be saved or scheduled
+	job = loadCalendarJob(t)
+	job.HolidayCalendars = []string{"missing.ics"}
+	require.Error(t, job.ValidateBeforeSave(nil))
+	date, userKey := job.GetCronScheduleTimeAndUserKey()
+	require.Nil(t, date)
+	require.Equal(t, "", userKey)
+}
+
+func Test_ShouldParseHolidayCalendar(t *testing.T) {
+	// GIVEN an iCal file
+	SetHolidayCalendarDir("../../fixtures")
+	cal, err := LoadHolidayCalendar("nyse_holidays.ics")
+	require.NoError(t, err)
+
+	// THEN events should be parsed with folded lines and escaped text
+	require.Len(t, cal.Events, 4)
+	require.Equal(t, "Martin Luther King, Jr. Day", cal.Events[2].Summary)
+	require.Equal(t, "Early close after Thanksgiving", cal.Events[3].Summary)
+	require.True(t, cal.Events[1].Yearly)
+
+	// calendars outside the holiday calendar directory should fail
+	for _, name := range []string{"../fixtures/nyse_holidays.ics", "/etc/passwd", "..", ""} {
+		_, err = LoadHolidayCalendar(name)
+		require.Error(t, err)
+	}
+	SetHolidayCalendarDir("")
+	_, err = LoadHolidayCalendar("nyse_holidays.ics")
+	require.Error(t, err)
+	SetHolidayCalendarDir("../../fixtures")
+
+	// unsupported recurrence should fail
+	_, err = ParseHolidayCalendar(strings.NewReader(
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20260101\nRRULE:FREQ=WEEKLY\nEND:VEVENT\n"))
+	require.Error(t, err)
+}
+
+func Test_ShouldEnumerateCronTicksForBackfill(t *testing.T) {
+	loc := newYork(t)
+	// GIVEN a weekday schedule with a holiday calendar
+	job := loadCalendarJob(t)
+	cs := job.GetCronSchedule()
+	cs.BlackoutWindows = nil
+
+	// WHEN enumerating ticks of thanksgiving week
+	ticks, err := cs.Ticks(time.Date(2026, 11, 25, 0, 0, 0, 0, loc), time.Date(2026, 11, 28, 0, 0, 0, 0, loc), 100)
+
+	// THEN holiday and early close should be skipped and end should be exclusive
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		time.Date(2026, 11, 25, 9, 30, 0, 0, loc),
+		time.Date(2026, 11, 25, 16, 15, 0, 0, loc),
+		time.Date(2026, 11, 27, 9, 30, 0, 0, loc),
+	}, ticks)
+	// AND start should be inclusive and number of ticks limited
+	ticks, err = cs.Ticks(time.Date(2026, 11, 25, 9, 30, 0, 0, loc), time.Date(2026, 12, 31, 0, 0, 0, 0, loc), 2)
+	require.NoError(t, err)
+	require.Len(t, ticks, 2)
+	require.Equal(t, time.Date(2026, 11, 25, 9, 30, 0, 0, loc), ticks[0])
+
+	// WHEN deferring hourly ticks of a blackout window
+	cs = &CronSchedule{
+		CronTriggers:    []string{"0 0 * * * * *"},
+		Timezone:        "America/New_York",
+		BlackoutWindows: []*BlackoutWindow{{Name: "patch", Start: "2026-10-19T01:30", End: "2026-10-19T04:30"}},
+		BlackoutPolicy:  BlackoutPolicyDefer,
+	}
+	ticks, err = cs.Ticks(time.Date(2026, 10, 19, 0, 0, 0, 0, loc), time.Date(2026, 10, 19, 6, 0, 0, 0, loc), 100)
+	// THEN blocked ticks should collapse into a single run at the end of the window
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+		time.Date(2026, 10, 19, 1, 0, 0, 0, loc),
+		time.Date(2026, 10, 19, 4, 30, 0, 0, loc),
+		time.Date(2026, 10, 19, 5, 0, 0, 0, loc),
+	}, ticks)
+	require.Equal(t, "org-job-2026-10-19T04:30:00-04:00", CronUserKey("org", "job", ticks[2]))
+	require.Equal(t, "default-job-2026-10-19T04:30:00-04:00", CronUserKey("", "job", ticks[2]))
+}
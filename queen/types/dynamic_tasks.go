@@ -0,0 +1,379 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	yaml "gopkg.in/yaml.v3"
+
+	common "plexobject.com/formicary/internal/types"
+)
+
+const (
+	// DefaultMaxDynamicTasks is the default number of tasks a task can emit at runtime
+	DefaultMaxDynamicTasks = 20
+	// DefaultMaxDynamicTaskDepth is the default nesting of emitted tasks, 1 means emitted tasks cannot emit tasks
+	DefaultMaxDynamicTaskDepth = 1
+	// maxDynamicTaskDepth upper bound of max_depth
+	maxDynamicTaskDepth = 5
+	// DynamicTasksContext is the job context that keeps tasks emitted at runtime so that an execution
+	// that is resumed, e.g. after approval, can run them
+	DynamicTasksContext = "DynamicTasks"
+)
+
+// DynamicTasksConfig lets a task emit a YAML or JSON fragment of new tasks as an output, which are
+// spliced into the running job execution after the task completes. The fragment is either a list of
+// tasks or an object with a `tasks` list; emitted tasks are ordered by their `dependencies` and run
+// before the next task of the generating task.
+type DynamicTasksConfig struct {
+	// Output is the name of the task or job context holding the fragment, e.g. set by `::set-output name=<Output>::<json>`
+	Output string `yaml:"output" json:"output"`
+	// MaxTasks limits number of tasks emitted by the task
+	MaxTasks int `yaml:"max_tasks,omitempty" json:"max_tasks"`
+	// MaxDepth limits how deep emitted tasks can emit further tasks
+	MaxDepth int `yaml:"max_depth,omitempty" json:"max_depth"`
+}
+
+// Validate validates config and sets defaults
+func (c *DynamicTasksConfig) Validate() error {
+	if c.Output == "" {
+		return errors.New("output is not specified")
+	}
+	if c.MaxTasks < 0 || c.MaxTasks > maxTasksPerJob {
+		return fmt.Errorf("max_tasks must be between 1 and %d", maxTasksPerJob)
+	}
+	if c.MaxTasks == 0 {
+		c.MaxTasks = DefaultMaxDynamicTasks
+	}
+	if c.MaxDepth < 0 || c.MaxDepth > maxDynamicTaskDepth {
+		return fmt.Errorf("max_depth must be between 1 and %d", maxDynamicTaskDepth)
+	}
+	if c.MaxDepth == 0 {
+		c.MaxDepth = DefaultMaxDynamicTaskDepth
+	}
+	return nil
+}
+
+// DynamicTask is a task spliced into a running job execution
+type DynamicTask struct {
+	// TaskType of the emitted task
+	TaskType string `json:"task_type"`
+	// Generator is the task that emitted this task
+	Generator string `json:"generator"`
+	// Next task to run after this task, empty if the job ends after it
+	Next string `json:"next,omitempty"`
+	// Depth is 1 for tasks emitted by tasks of the job definition and grows for each nested generation
+	Depth int `json:"depth"`
+	// MaxDepth is the lowest max_depth of the generators of this task
+	MaxDepth int `json:"max_depth"`
+	// Method of the task for reserving an ant
+	Method common.TaskMethod `json:"method"`
+	// Tags of the task for reserving an ant
+	Tags []string `json:"tags,omitempty"`
+	// Placement of the task for reserving an ant
+	Placement *common.PlacementPolicy `json:"placement,omitempty"`
+	// Yaml of the task, which is parsed like task definitions of the job when the task is run
+	Yaml string `json:"yaml"`
+}
+
+// DynamicTaskGraph keeps tasks spliced into the DAG of a job execution. The job definition is shared
+// by all executions so emitted tasks are kept per execution instead of being added to the definition.
+type DynamicTaskGraph struct {
+	tasks map[string]*DynamicTask
+	lock  sync.RWMutex
+}
+
+// NewDynamicTaskGraph constructor
+func NewDynamicTaskGraph() *DynamicTaskGraph {
+	return &DynamicTaskGraph{tasks: make(map[string]*DynamicTask)}
+}
+
+// Get returns the emitted task or nil if task was not emitted at runtime
+func (g *DynamicTaskGraph) Get(taskType string) *DynamicTask {
+	if g == nil {
+		return nil
+	}
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.tasks[taskType]
+}
+
+// Len returns number of emitted tasks
+func (g *DynamicTaskGraph) Len() int {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return len(g.tasks)
+}
+
+// Marshal serializes emitted tasks for the job context
+func (g *DynamicTaskGraph) Marshal() (string, error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	tasks := make([]*DynamicTask, 0, len(g.tasks))
+	for _, t := range g.tasks {
+		tasks = append(tasks, t)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskType < tasks[j].TaskType })
+	b, err := json.Marshal(tasks)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Restore replaces emitted tasks with tasks serialized by Marshal and returns them
+func (g *DynamicTaskGraph) Restore(serialized string) ([]*DynamicTask, error) {
+	tasks := make([]*DynamicTask, 0)
+	if err := json.Unmarshal([]byte(serialized), &tasks); err != nil {
+		return nil, fmt.Errorf("failed to parse tasks emitted at runtime due to %w", err)
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.tasks = make(map[string]*DynamicTask)
+	for _, t := range tasks {
+		if t == nil || t.TaskType == "" {
+			return nil, fmt.Errorf("found task emitted at runtime without task_type")
+		}
+		g.tasks[t.TaskType] = t
+	}
+	return tasks, nil
+}
+
+// Expand validates tasks emitted by the generator and splices them between the generator and the
+// next task. Tasks are returned in the order they run, which follows their dependencies. A generator
+// that runs again, e.g. on retry, replaces the tasks it emitted before.
+func (g *DynamicTaskGraph) Expand(
+	jd *JobDefinition,
+	generator *TaskDefinition,
+	next string,
+	fragment interface{}) ([]*DynamicTask, error) {
+	if generator.DynamicTasks == nil {
+		return nil, fmt.Errorf("task %s does not define dynamic_tasks", generator.TaskType)
+	}
+	cfg := generator.DynamicTasks
+	rawTasks, err := parseDynamicTasksFragment(fragment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tasks emitted by %s due to %w", generator.TaskType, err)
+	}
+	if len(rawTasks) > cfg.MaxTasks {
+		return nil, fmt.Errorf("task %s emitted %d tasks but max_tasks is %d",
+			generator.TaskType, len(rawTasks), cfg.MaxTasks)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if len(rawTasks) == 0 {
+		g.removeEmittedBy(generator.TaskType)
+		return nil, nil
+	}
+
+	depth, maxDepth := 1, cfg.MaxDepth
+	if parent := g.tasks[generator.TaskType]; parent != nil {
+		depth = parent.Depth + 1
+		if parent.MaxDepth < maxDepth {
+			maxDepth = parent.MaxDepth
+		}
+	}
+	if depth > maxDepth {
+		return nil, fmt.Errorf("task %s cannot emit tasks at depth %d because max_depth is %d",
+			generator.TaskType, depth, maxDepth)
+	}
+
+	g.removeEmittedBy(generator.TaskType)
+	if total := len(jd.Tasks) + len(g.tasks) + len(rawTasks); total > maxTasksPerJob {
+		return nil, fmt.Errorf("task %s emitted %d tasks but number of tasks cannot exceed %d",
+			generator.TaskType, len(rawTasks), maxTasksPerJob)
+	}
+
+	defs := make(map[string]*TaskDefinition)
+	order := make([]string, 0, len(rawTasks))
+	for i, raw := range rawTasks {
+		task, err := g.parseEmittedTask(jd, raw)
+		if err != nil {
+			return nil, fmt.Errorf("task #%d emitted by %s is invalid due to %w", i+1, generator.TaskType, err)
+		}
+		if defs[task.TaskType] != nil {
+			return nil, fmt.Errorf("task %s emitted by %s is duplicated", task.TaskType, generator.TaskType)
+		}
+		defs[task.TaskType] = task
+		order = append(order, task.TaskType)
+	}
+	for _, taskType := range order {
+		for _, dep := range defs[taskType].Dependencies {
+			if defs[dep] == nil && jd.GetTask(dep) == nil && g.tasks[dep] == nil {
+				return nil, fmt.Errorf("task %s emitted by %s depends on unknown task %s",
+					taskType, generator.TaskType, dep)
+			}
+		}
+	}
+	sorted, err := sortDynamicTasks(order, defs)
+	if err != nil {
+		return nil, fmt.Errorf("tasks emitted by %s are invalid due to %w", generator.TaskType, err)
+	}
+
+	res := make([]*DynamicTask, len(sorted))
+	for i, taskType := range sorted {
+		taskYaml, err := emittedTaskYaml(taskType, rawTasks[indexOf(order, taskType)])
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &DynamicTask{
+			TaskType:  taskType,
+			Generator: generator.TaskType,
+			Next:      next,
+			Depth:     depth,
+			MaxDepth:  maxDepth,
+			Method:    defs[taskType].Method,
+			Tags:      defs[taskType].Tags,
+			Placement: defs[taskType].Placement,
+			Yaml:      taskYaml,
+		}
+		if i > 0 {
+			res[i-1].Next = taskType
+		}
+	}
+	for _, t := range res {
+		g.tasks[t.TaskType] = t
+	}
+	return res, nil
+}
+
+// removeEmittedBy removes tasks emitted by the generator and their descendants
+func (g *DynamicTaskGraph) removeEmittedBy(generator string) {
+	for taskType, t := range g.tasks {
+		if t.Generator == generator {
+			delete(g.tasks, taskType)
+			g.removeEmittedBy(taskType)
+		}
+	}
+}
+
+func (g *DynamicTaskGraph) parseEmittedTask(
+	jd *JobDefinition,
+	raw map[string]interface{}) (*TaskDefinition, error) {
+	b, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	task := NewTaskDefinition("", "")
+	if err = yaml.Unmarshal(b, task); err != nil {
+		return nil, err
+	}
+	if err = task.Validate(); err != nil {
+		return nil, err
+	}
+	if jd.GetTask(task.TaskType) != nil || g.tasks[task.TaskType] != nil {
+		return nil, fmt.Errorf("task %s is already defined", task.TaskType)
+	}
+	if len(task.OnExitCode) > 0 || task.OnCompleted != "" || task.OnFailed != "" {
+		return nil, fmt.Errorf("task %s cannot define on_exit_code, on_completed or on_failed, "+
+			"use dependencies to order emitted tasks", task.TaskType)
+	}
+	return task, nil
+}
+
+// emittedTaskYaml serializes the task with task_type as the first property as in the job definition
+// because executor options are parsed from task_type onward.
+func emittedTaskYaml(taskType string, raw map[string]interface{}) (string, error) {
+	props := make(map[string]interface{})
+	for k, v := range raw {
+		if k != "task_type" {
+			props[k] = v
+		}
+	}
+	head, err := yaml.Marshal(map[string]string{"task_type": taskType})
+	if err != nil {
+		return "", err
+	}
+	if len(props) == 0 {
+		return string(head), nil
+	}
+	body, err := yaml.Marshal(props)
+	if err != nil {
+		return "", err
+	}
+	return string(head) + string(body), nil
+}
+
+// parseDynamicTasksFragment accepts YAML/JSON text or an already parsed list of tasks or object with tasks
+func parseDynamicTasksFragment(fragment interface{}) (res []map[string]interface{}, err error) {
+	var text string
+	switch val := fragment.(type) {
+	case string:
+		text = val
+	case []byte:
+		text = string(val)
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		text = string(b)
+	}
+	var parsed interface{}
+	if err = yaml.Unmarshal([]byte(text), &parsed); err != nil {
+		return nil, err
+	}
+	if obj, ok := parsed.(map[string]interface{}); ok {
+		parsed = obj["tasks"]
+	}
+	if parsed == nil {
+		return nil, nil
+	}
+	list, ok := parsed.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of tasks but found %v", parsed)
+	}
+	for _, next := range list {
+		task, ok := next.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected task object but found %v", next)
+		}
+		res = append(res, task)
+	}
+	return res, nil
+}
+
+// sortDynamicTasks orders tasks so that each task runs after its dependencies. It picks the first
+// emitted task whose dependencies are done so that a task runs right after its dependencies.
+func sortDynamicTasks(order []string, defs map[string]*TaskDefinition) ([]string, error) {
+	sorted := make([]string, 0, len(order))
+	done := make(map[string]bool)
+	for len(sorted) < len(order) {
+		progressed := false
+		for _, taskType := range order {
+			if done[taskType] {
+				continue
+			}
+			ready := true
+			for _, dep := range defs[taskType].Dependencies {
+				if defs[dep] != nil && !done[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				done[taskType] = true
+				sorted = append(sorted, taskType)
+				progressed = true
+				break
+			}
+		}
+		if !progressed {
+			return nil, errors.New("dependencies of emitted tasks have a cycle")
+		}
+	}
+	return sorted, nil
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const dynamicTasksJobYaml = `
+job_type: dynamic-job
+tasks:
+- task_type: discover
+  method: KUBERNETES
+  script:
+    - ./discover
+  dynamic_tasks:
+    output: partitions
+    max_tasks: 3
+    max_depth: 2
+  on_completed: report
+- task_type: report
+  method: KUBERNETES
+  script:
+    - ./report
+`
+
+func Test_ShouldValidateDynamicTasksConfig(t *testing.T) {
+	// GIVEN config without output
+	cfg := &DynamicTasksConfig{}
+	// WHEN validating
+	// THEN it should fail
+	require.Error(t, cfg.Validate())
+
+	// WHEN validating config with output
+	cfg.Output = "partitions"
+	// THEN it should set default limits
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, DefaultMaxDynamicTasks, cfg.MaxTasks)
+	require.Equal(t, DefaultMaxDynamicTaskDepth, cfg.MaxDepth)
+
+	// WHEN validating config with depth above the upper bound
+	cfg.MaxDepth = maxDynamicTaskDepth + 1
+	// THEN it should fail
+	require.Error(t, cfg.Validate())
+}
+
+func Test_ShouldExpandDynamicTasksInDependencyOrder(t *testing.T) {
+	// GIVEN a job whose discover task can emit tasks
+	job, err := NewJobDefinitionFromYaml([]byte(dynamicTasksJobYaml))
+	require.NoError(t, err)
+	graph := NewDynamicTaskGraph()
+
+	// WHEN discover emits tasks with dependencies
+	emitted, err := graph.Expand(job, job.GetTask("discover"), "report", `
+tasks:
+- task_type: load-p1
+  dependencies: [extract-p1]
+  script: ["./load p1"]
+- task_type: extract-p1
+  script: ["./extract p1"]
+  container:
+    image: alpine
+`)
+
+	// THEN tasks are chained after their dependencies and before the next task
+	require.NoError(t, err)
+	require.Len(t, emitted, 2)
+	require.Equal(t, "extract-p1", emitted[0].TaskType)
+	require.Equal(t, "load-p1", emitted[0].Next)
+	require.Equal(t, "load-p1", emitted[1].TaskType)
+	require.Equal(t, "report", emitted[1].Next)
+	require.Equal(t, 1, emitted[0].Depth)
+	require.Equal(t, "discover", graph.Get("load-p1").Generator)
+
+	// AND emitted task can be parsed like task definitions of the job
+	task, opts, err := job.GetDynamicTaskFromYaml("extract-p1", emitted[0].Yaml, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"./extract p1"}, task.Script)
+	require.Equal(t, "alpine", opts.MainContainer.Image)
+}
+
+func Test_ShouldExpandDynamicTasksFromParsedJSON(t *testing.T) {
+	// GIVEN a job whose discover task can emit tasks
+	job, err := NewJobDefinitionFromYaml([]byte(dynamicTasksJobYaml))
+	require.NoError(t, err)
+	graph := NewDynamicTaskGraph()
+
+	// WHEN discover emits tasks as parsed JSON
+	emitted, err := graph.Expand(job, job.GetTask("discover"), "report", []interface{}{
+		map[string]interface{}{"task_type": "p1", "script": []interface{}{"./p1"}},
+	})
+
+	// THEN task is spliced
+	require.NoError(t, err)
+	require.Len(t, emitted, 1)
+	require.Equal(t, 1, graph.Len())
+
+	// WHEN discover runs again and emits no tasks
+	emitted, err = graph.Expand(job, job.GetTask("discover"), "report", "[]")
+
+	// THEN previously emitted tasks are removed
+	require.NoError(t, err)
+	require.Len(t, emitted, 0)
+	require.Equal(t, 0, graph.Len())
+}
+
+func Test_ShouldNotExpandInvalidDynamicTasks(t *testing.T) {
+	// GIVEN a job whose discover task can emit tasks
+	job, err := NewJobDefinitionFromYaml([]byte(dynamicTasksJobYaml))
+	require.NoError(t, err)
+	discover := job.GetTask("discover")
+
+	for name, fragment := range map[string]string{
+		"max_tasks":         `[{task_type: a}, {task_type: b}, {task_type: c}, {task_type: d}]`,
+		"already defined":   `[{task_type: report}]`,
+		"duplicated":        `[{task_type: a}, {task_type: a}]`,
+		"unknown task":      `[{task_type: a, dependencies: [missing]}]`,
+		"cycle":             `[{task_type: a, dependencies: [b]}, {task_type: b, dependencies: [a]}]`,
+		"cannot define":     `[{task_type: a, on_completed: report}]`,
+		"expected a list":   `{tasks: a}`,
+		"taskType":          `[{method: KUBERNETES}]`,
+		"expected task obj": `[a]`,
+	} {
+		// WHEN discover emits invalid tasks
+		_, err = NewDynamicTaskGraph().Expand(job, discover, "report", fragment)
+		// THEN it should fail
+		require.Error(t, err, name)
+	}
+}
+
+func Test_ShouldLimitDepthOfDynamicTasks(t *testing.T) {
+	// GIVEN a job whose discover task can emit tasks up to depth 2
+	job, err := NewJobDefinitionFromYaml([]byte(dynamicTasksJobYaml))
+	require.NoError(t, err)
+	graph := NewDynamicTaskGraph()
+	_, err = graph.Expand(job, job.GetTask("discover"), "report",
+		`[{task_type: level1, dynamic_tasks: {output: level1-tasks, max_depth: 5}}]`)
+	require.NoError(t, err)
+
+	// WHEN emitted task emits tasks
+	level1, _, err := job.GetDynamicTaskFromYaml("level1", graph.Get("level1").Yaml, nil, nil)
+	require.NoError(t, err)
+	emitted, err := graph.Expand(job, level1, "report",
+		`[{task_type: level2, dynamic_tasks: {output: level2-tasks, max_depth: 5}}]`)
+
+	// THEN tasks are spliced at depth 2
+	require.NoError(t, err)
+	require.Equal(t, 2, emitted[0].Depth)
+
+	// WHEN tasks at depth 2 emit tasks
+	level2, _, err := job.GetDynamicTaskFromYaml("level2", graph.Get("level2").Yaml, nil, nil)
+	require.NoError(t, err)
+	_, err = graph.Expand(job, level2, "report", `[{task_type: level3}]`)
+
+	// THEN it should fail because max_depth of discover is 2
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max_depth is 2")
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+)
+
+// QuarantinedFlakyTaskContext is added to context of a task execution that was allowed to fail because the task
+// is known to be flaky
+const QuarantinedFlakyTaskContext = "QuarantinedFlakyTask"
+
+// FlakyTask summarizes how often a task failed and then passed without any change, either when the task was
+// retried or when the same job request was re-run
+type FlakyTask struct {
+	JobType  string `json:"job_type"`
+	TaskType string `json:"task_type"`
+	// Runs is number of task executions
+	Runs int64 `json:"runs"`
+	// Failed is number of task executions that failed after all retries
+	Failed int64 `json:"failed"`
+	// PassedOnRetry is number of task executions that failed and then passed on retry
+	PassedOnRetry int64 `json:"passed_on_retry"`
+	// PassedOnRerun is number of job requests where the task failed in one execution and passed in another
+	PassedOnRerun int64 `json:"passed_on_rerun"`
+	// Score is fraction of runs that were flaky
+	Score float64 `json:"score"`
+	// Quarantined is true if failures of the task are allowed because the job quarantines flaky tasks
+	Quarantined bool `json:"quarantined"`
+}
+
+// CalculateScore calculates flakiness score from runs
+func (f *FlakyTask) CalculateScore() float64 {
+	if f.Runs == 0 {
+		f.Score = 0
+	} else {
+		f.Score = float64(f.PassedOnRetry+f.PassedOnRerun) / float64(f.Runs)
+		if f.Score > 1 {
+			f.Score = 1
+		}
+	}
+	return f.Score
+}
+
+// IsFlaky returns true if the task ran at least min runs and its score is at or above the threshold
+func (f *FlakyTask) IsFlaky(minRuns int, threshold float64) bool {
+	return f.Runs >= int64(minRuns) && f.Score >= threshold
+}
+
+// Warning returns annotation of a quarantined task
+func (f *FlakyTask) Warning() string {
+	return fmt.Sprintf("task %s is quarantined because %d of its last %d runs were flaky (score %.2f), "+
+		"its failure won't fail the job", f.TaskType, f.PassedOnRetry+f.PassedOnRerun, f.Runs, f.Score)
+}
+
+func (f *FlakyTask) String() string {
+	return fmt.Sprintf("JobType=%s TaskType=%s Runs=%d Failed=%d PassedOnRetry=%d PassedOnRerun=%d Score=%.2f",
+		f.JobType, f.TaskType, f.Runs, f.Failed, f.PassedOnRetry, f.PassedOnRerun, f.Score)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldCalculateFlakyTaskScore(t *testing.T) {
+	// GIVEN a task that passed on retry twice and on re-run once in 10 runs
+	task := &FlakyTask{TaskType: "integ-test", Runs: 10, PassedOnRetry: 2, PassedOnRerun: 1}
+	// WHEN calculating score
+	// THEN it should be fraction of flaky runs
+	require.InDelta(t, 0.3, task.CalculateScore(), 0.001)
+	require.True(t, task.IsFlaky(10, 0.2))
+	require.False(t, task.IsFlaky(20, 0.2))
+	require.False(t, task.IsFlaky(10, 0.5))
+	require.Contains(t, task.Warning(), "quarantined")
+}
+
+func Test_ShouldSaveAndLoadQuarantineFlakyTasks(t *testing.T) {
+	// GIVEN a job that quarantines flaky tasks
+	job, err := NewJobDefinitionFromYaml([]byte(`
+job_type: flaky-job
+quarantine_flaky_tasks: true
+tasks:
+- task_type: integ-test
+  script:
+    - ./test
+`))
+	require.NoError(t, err)
+	require.True(t, job.QuarantineFlakyTasks)
+
+	// WHEN saving and loading the job
+	require.NoError(t, job.ValidateBeforeSave(nil))
+	loaded := NewJobDefinition("flaky-job")
+	loaded.RawYaml = job.RawYaml
+	loaded.Variables = job.Variables
+	loaded.Tasks = job.Tasks
+	require.NoError(t, loaded.AfterLoad(nil))
+
+	// THEN quarantine is preserved and not exposed as a job variable
+	require.True(t, loaded.QuarantineFlakyTasks)
+	require.Nil(t, loaded.GetVariable(keyQuarantineFlakyTasks))
+}
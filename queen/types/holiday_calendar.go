@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var holidayCalendarCache sync.Map
+
+// holidayCalendarDir is the directory of the queen server that holiday calendars are loaded from
+var holidayCalendarDir atomic.Value
+
+// HolidayCalendar holds events of an iCal (RFC 5545) file that block cron runs of a job.
+type HolidayCalendar struct {
+	Name   string
+	Events []*HolidayEvent
+}
+
+// HolidayEvent is a VEVENT of a holiday calendar. All-day events and events without time zone are
+// evaluated in the time zone of the job so that a holiday blocks the whole local day.
+type HolidayEvent struct {
+	Summary string
+	Start   time.Time
+	// End is exclusive
+	End    time.Time
+	AllDay bool
+	// Location is nil for all-day and floating events
+	Location *time.Location
+	// Yearly is set for events with `RRULE:FREQ=YEARLY` such as fixed date holidays
+	Yearly bool
+	Until  time.Time
+	Count  int
+}
+
+type cachedHolidayCalendar struct {
+	modTime  time.Time
+	size     int64
+	calendar *HolidayCalendar
+}
+
+// SetHolidayCalendarDir sets the directory that holiday calendars of job definitions are loaded from.
+// Holiday calendars can't be used when it's empty.
+func SetHolidayCalendarDir(dir string) {
+	holidayCalendarDir.Store(dir)
+}
+
+// LoadHolidayCalendar parses iCal file with given name in the holiday calendar directory and caches it
+// until the file is modified. Names can't refer to files outside the directory because job definitions
+// are defined by users.
+func LoadHolidayCalendar(name string) (*HolidayCalendar, error) {
+	dir, _ := holidayCalendarDir.Load().(string)
+	if dir == "" {
+		return nil, fmt.Errorf("holiday calendar %s can't be loaded because holiday calendar directory is not configured", name)
+	}
+	if name == "" || name == "." || name == ".." || name != filepath.Base(name) {
+		return nil, fmt.Errorf("holiday calendar %s must be name of a file in holiday calendar directory", name)
+	}
+	path := filepath.Join(dir, name)
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("failed to find holiday calendar %s", name)
+	}
+	if cached, ok := holidayCalendarCache.Load(path); ok {
+		c := cached.(*cachedHolidayCalendar)
+		if c.modTime.Equal(info.ModTime()) && c.size == info.Size() {
+			return c.calendar, nil
+		}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open holiday calendar %s", name)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	cal, err := ParseHolidayCalendar(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse holiday calendar %s due to %w", name, err)
+	}
+	cal.Name = name
+	holidayCalendarCache.Store(path, &cachedHolidayCalendar{
+		modTime:  info.ModTime(),
+		size:     info.Size(),
+		calendar: cal,
+	})
+	return cal, nil
+}
+
+// ParseHolidayCalendar parses VEVENT entries of iCal data. Only DTSTART, DTEND, SUMMARY and yearly
+// RRULE are used, other properties and components are ignored.
+func ParseHolidayCalendar(r io.Reader) (*HolidayCalendar, error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+	cal := &HolidayCalendar{Events: make([]*HolidayEvent, 0)}
+	var event *HolidayEvent
+	var hasEnd bool
+	for i, line := range lines {
+		name, params, value := parseICalProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = &HolidayEvent{}
+			hasEnd = false
+		case name == "END" && value == "VEVENT":
+			if event == nil || event.Start.IsZero() {
+				return nil, fmt.Errorf("event ending at line %d doesn't define DTSTART", i+1)
+			}
+			if !hasEnd || !event.End.After(event.Start) {
+				// a holiday without end blocks the day of its start
+				y, m, d := event.Start.Date()
+				event.End = time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+			}
+			cal.Events = append(cal.Events, event)
+			event = nil
+		case event == nil:
+			continue
+		case name == "SUMMARY":
+			event.Summary = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\\`, `\`).Replace(value)
+		case name == "DTSTART":
+			if event.Start, event.Location, event.AllDay, err = parseICalTime(params, value); err != nil {
+				return nil, fmt.Errorf("invalid DTSTART at line %d: %w", i+1, err)
+			}
+		case name == "DTEND":
+			if event.End, _, _, err = parseICalTime(params, value); err != nil {
+				return nil, fmt.Errorf("invalid DTEND at line %d: %w", i+1, err)
+			}
+			hasEnd = true
+		case name == "RRULE":
+			if err = parseICalYearlyRule(event, value); err != nil {
+				return nil, fmt.Errorf("invalid RRULE at line %d: %w", i+1, err)
+			}
+		}
+	}
+	return cal, nil
+}
+
+// Find returns the event that covers given time, where all-day and floating events are evaluated
+// in the given location.
+func (hc *HolidayCalendar) Find(t time.Time, loc *time.Location) (*HolidayEvent, time.Time, bool) {
+	for _, e := range hc.Events {
+		if end, ok := e.covers(t, loc); ok {
+			return e, end, true
+		}
+	}
+	return nil, time.Time{}, false
+}
+
+// covers checks if t falls in the event or its yearly recurrence and returns end of the occurrence
+func (e *HolidayEvent) covers(t time.Time, loc *time.Location) (time.Time, bool) {
+	if e.Location != nil {
+		loc = e.Location
+	}
+	local := t.In(loc)
+	years := []int{0}
+	if e.Yearly {
+		// occurrences of previous year may span new year
+		years = []int{local.Year() - e.Start.Year() - 1, local.Year() - e.Start.Year()}
+	}
+	for _, n := range years {
+		if n < 0 || (n > 0 && !e.Yearly) || (e.Count > 0 && n >= e.Count) {
+			continue
+		}
+		start := e.wallTime(e.Start, n, loc)
+		if !e.Until.IsZero() && start.After(e.wallTime(e.Until, 0, loc)) {
+			continue
+		}
+		end := e.wallTime(e.End, n, loc)
+		if !t.Before(start) && t.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// wallTime converts wall clock of the event to given location after shifting it by years
+func (e *HolidayEvent) wallTime(wall time.Time, years int, loc *time.Location) time.Time {
+	return time.Date(wall.Year()+years, wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+}
+
+func unfoldICalLines(r io.Reader) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseICalProperty splits `NAME;PARAM=VALUE:value` line
+func parseICalProperty(line string) (string, map[string]string, string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+	parts := strings.Split(line[:colon], ";")
+	params := make(map[string]string)
+	for _, p := range parts[1:] {
+		if kv := strings.SplitN(p, "=", 2); len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(line[colon+1:])
+}
+
+// parseICalTime parses DATE or DATE-TIME value and returns its wall clock in UTC with location of
+// the value, which is nil for dates and floating times.
+func parseICalTime(params map[string]string, value string) (time.Time, *time.Location, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		return t, nil, true, err
+	}
+	var loc *time.Location
+	if strings.HasSuffix(value, "Z") {
+		loc = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	} else if tzid := params["TZID"]; tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, nil, false, err
+		}
+	}
+	t, err := time.Parse("20060102T150405", value)
+	return t, loc, false, err
+}
+
+func parseICalYearlyRule(event *HolidayEvent, value string) error {
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToUpper(kv[0]) {
+		case "FREQ":
+			if strings.ToUpper(kv[1]) != "YEARLY" {
+				return fmt.Errorf("only yearly recurrence is supported but found %s", kv[1])
+			}
+			event.Yearly = true
+		case "UNTIL":
+			until, _, _, err := parseICalTime(nil, kv[1])
+			if err != nil {
+				return err
+			}
+			event.Until = until
+		case "COUNT":
+			count, err := strconv.Atoi(kv[1])
+			if err != nil {
+				return err
+			}
+			event.Count = count
+		case "INTERVAL":
+			if kv[1] != "1" {
+				return fmt.Errorf("yearly interval %s is not supported", kv[1])
+			}
+		default:
+			return fmt.Errorf("recurrence rule %s is not supported", kv[0])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// JobAnomalyKind defines how a job deviated from its baseline
+type JobAnomalyKind string
+
+const (
+	// AnomalySlowRun is detected when a successful run takes significantly longer than recent runs
+	AnomalySlowRun JobAnomalyKind = "SLOW_RUN"
+	// AnomalyFailureSpike is detected when failure rate of recent runs rises significantly above the baseline
+	AnomalyFailureSpike JobAnomalyKind = "FAILURE_SPIKE"
+)
+
+// JobAnomaly describes a run of a job that deviated from the rolling baseline of its previous runs
+type JobAnomaly struct {
+	JobRequestID   string         `json:"job_request_id"`
+	JobType        string         `json:"job_type"`
+	UserID         string         `json:"user_id"`
+	OrganizationID string         `json:"organization_id"`
+	Kind           JobAnomalyKind `json:"kind"`
+	// Duration of the slow run
+	Duration time.Duration `json:"duration"`
+	// BaselineDuration is exponentially weighted moving average of successful runs
+	BaselineDuration time.Duration `json:"baseline_duration"`
+	// P95Duration is 95th percentile of successful runs in the baseline window
+	P95Duration time.Duration `json:"p95_duration"`
+	// FailureRate of recent runs
+	FailureRate float64 `json:"failure_rate"`
+	// BaselineFailureRate of runs before the recent runs
+	BaselineFailureRate float64   `json:"baseline_failure_rate"`
+	DetectedAt          time.Time `json:"detected_at"`
+}
+
+// Description returns human-readable description of the anomaly
+func (a *JobAnomaly) Description() string {
+	if a.Kind == AnomalySlowRun {
+		return fmt.Sprintf("Job %s took %s, its baseline is %s and p95 is %s",
+			a.JobType, a.Duration.Round(time.Second), a.BaselineDuration.Round(time.Second),
+			a.P95Duration.Round(time.Second))
+	}
+	return fmt.Sprintf("Job %s failed %.0f%% of recent runs compared to %.0f%% before",
+		a.JobType, a.FailureRate*100, a.BaselineFailureRate*100)
+}
+
+func (a *JobAnomaly) String() string {
+	return fmt.Sprintf("JobRequestID=%s JobType=%s Kind=%s Duration=%s Baseline=%s FailureRate=%.2f BaselineFailureRate=%.2f",
+		a.JobRequestID, a.JobType, a.Kind, a.Duration, a.BaselineDuration, a.FailureRate, a.BaselineFailureRate)
+}
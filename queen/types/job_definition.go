@@ -60,6 +60,8 @@ type JobTypeCronTrigger struct {
 	JobType string
 	// CronTrigger can be used to run the job periodically
 	CronTrigger string
+	// CronScheduleSerialized serialized calendar-aware cron schedule
+	CronScheduleSerialized string
 	// User key
 	UserKey string
 }
@@ -67,11 +69,12 @@ type JobTypeCronTrigger struct {
 // NewJobTypeCronTrigger constructor
 func NewJobTypeCronTrigger(job *JobDefinition) JobTypeCronTrigger {
 	return JobTypeCronTrigger{
-		UserID:         job.UserID,
-		OrganizationID: job.OrganizationID,
-		JobType:        job.JobType,
-		CronTrigger:    job.CronTrigger,
-		UserKey:        job.GetUserJobTypeKey(),
+		UserID:                 job.UserID,
+		OrganizationID:         job.OrganizationID,
+		JobType:                job.JobType,
+		CronTrigger:            job.CronTrigger,
+		CronScheduleSerialized: job.CronScheduleSerialized,
+		UserKey:                job.GetUserJobTypeKey(),
 	}
 }
 
@@ -87,6 +90,12 @@ func (jtc JobTypeCronTrigger) OrganizationOrUserID() string {
 	return jtc.UserID
 }
 
+// GetCronScheduleTimeAndUserKey returns next schedule time and user-key of the cron schedule
+func (jtc JobTypeCronTrigger) GetCronScheduleTimeAndUserKey() (*time.Time, string) {
+	return cronScheduleTimeAndUserKey(
+		ParseCronSchedule(jtc.CronTrigger, jtc.CronScheduleSerialized), jtc.OrganizationOrUserID(), jtc.JobType)
+}
+
 // JobDefinition outlines a set of tasks arranged in a Directed Acyclic Graph (DAG), executed by worker entities.
 // The workflow progresses based on the exit codes of tasks, determining the subsequent task to execute.
 // Each task definition encapsulates a job's specifics, and upon receiving a new job request, an instance of
@@ -116,8 +125,14 @@ type JobDefinition struct {
 	NotifySerialized string `yaml:"-,omitempty" json:"-" gorm:"notify_serialized"`
 	// CronTrigger can be used to run the job periodically
 	CronTrigger string `yaml:"cron_trigger,omitempty" json:"cron_trigger"`
+	// CronScheduleSerialized serialized calendar-aware cron schedule
+	CronScheduleSerialized string `yaml:"-" json:"-" gorm:"cron_schedule_serialized"`
 	// Timeout defines max time a job should take, otherwise the job is aborted
 	Timeout time.Duration `yaml:"timeout,omitempty" json:"timeout"`
+	// SLA defines max queue wait, max run time or finish_by time of the job, which are checked by the scheduler
+	SLA *SLAConfig `yaml:"sla,omitempty" json:"sla,omitempty" gorm:"-"`
+	// QuarantineFlakyTasks allows failure of tasks that are known to be flaky from their execution history
+	QuarantineFlakyTasks bool `yaml:"quarantine_flaky_tasks,omitempty" json:"quarantine_flaky_tasks" gorm:"-"`
 	// PauseTime defines pause time when a job is paused.
 	PauseTime time.Duration `yaml:"pause_time,omitempty" json:"pause_time"`
 	// Retry defines max number of tries a job can be retried where it re-runs failed job
@@ -175,6 +190,18 @@ type JobDefinition struct {
 	NameValueVariables interface{}                                     `yaml:"job_variables,omitempty" json:"job_variables" gorm:"-"`
 	Notify             map[common.NotifyChannel]common.JobNotifyConfig `yaml:"notify,omitempty" json:"notify" gorm:"-"`
 	Resources          BasicResource                                   `yaml:"resources,omitempty" json:"resources" gorm:"-"`
+	// CronTriggers defines additional cron expressions, the earliest tick of all expressions is scheduled.
+	CronTriggers []string `yaml:"cron_triggers,omitempty" json:"cron_triggers" gorm:"-"`
+	// Timezone is IANA timezone such as America/New_York for evaluating cron expressions, default is server time.
+	Timezone string `yaml:"timezone,omitempty" json:"timezone" gorm:"-"`
+	// BlackoutWindows defines periods such as change-freeze when cron runs are skipped or deferred.
+	BlackoutWindows []*BlackoutWindow `yaml:"blackout_windows,omitempty" json:"blackout_windows" gorm:"-"`
+	// HolidayCalendars defines names of iCal files in the holiday calendar directory whose events block cron runs.
+	HolidayCalendars []string `yaml:"holiday_calendars,omitempty" json:"holiday_calendars" gorm:"-"`
+	// BlackoutPolicy is skip (default) or defer for cron runs that fall into blackout windows or holidays.
+	BlackoutPolicy string `yaml:"blackout_policy,omitempty" json:"blackout_policy" gorm:"-"`
+	// Catchup runs cron ticks that were missed while the queen was down, when the previous cron request finishes.
+	Catchup bool `yaml:"catchup,omitempty" json:"catchup" gorm:"-"`
 	// Triggers defines event-driven trigger configurations (transient, parsed from raw_yaml).
 	Triggers           []*TriggerDefinition                            `yaml:"triggers,omitempty" json:"triggers" gorm:"-"`
 	Errors             map[string]string                               `yaml:"-" json:"-" gorm:"-"`
@@ -369,6 +396,41 @@ func (jd *JobDefinition) GetDynamicTaskWithQuerier(
 	taskType string,
 	vars map[string]common.VariableValue,
 	querier utils.JobTemplateHelper) (task *TaskDefinition, opts *common.ExecutorOptions, err error) {
+	data := dynamicTemplateData(vars)
+	task = jd.GetTask(taskType)
+	if task == nil {
+		return nil, nil, fmt.Errorf("failed to find task %s", taskType)
+	}
+	if task.Method == "" {
+		task.Method = common.Kubernetes
+	}
+	for _, v := range task.Variables {
+		if parsed, err := v.GetParsedValue(); err == nil {
+			data[v.Name] = parsed
+		} else {
+			return nil, nil, fmt.Errorf("failed to parse value for %v due to %w", v, err)
+		}
+	}
+
+	// parse task-type
+	serData := utils.ParseYamlTag(jd.RawYaml, fmt.Sprintf("task_type: %s", taskType))
+	if serData == "" {
+		return nil, nil, fmt.Errorf("failed to find %s from Yaml definition", taskType)
+	}
+	return jd.parseDynamicTask(task, serData, data, vars, querier)
+}
+
+// GetDynamicTaskFromYaml is like GetDynamicTaskWithQuerier but parses the task from the given YAML
+// instead of the job definition, which is used for tasks emitted by other tasks at runtime.
+func (jd *JobDefinition) GetDynamicTaskFromYaml(
+	taskType string,
+	taskYaml string,
+	vars map[string]common.VariableValue,
+	querier utils.JobTemplateHelper) (task *TaskDefinition, opts *common.ExecutorOptions, err error) {
+	return jd.parseDynamicTask(NewTaskDefinition(taskType, ""), taskYaml, dynamicTemplateData(vars), vars, querier)
+}
+
+func dynamicTemplateData(vars map[string]common.VariableValue) map[string]interface{} {
 	data := make(map[string]interface{})
 	for k, v := range vars {
 		// Template functions like SubmitJobsFromJSON expect string arguments.
@@ -394,27 +456,17 @@ func (jd *JobDefinition) GetDynamicTaskWithQuerier(
 	data["YearDay"] = time.Now().YearDay()
 	data["FullDate"] = time.Now().Format("2006-01-02")
 	data["EpochSecs"] = time.Now().Unix()
-	task = jd.GetTask(taskType)
-	if task == nil {
-		return nil, nil, fmt.Errorf("failed to find task %s", taskType)
-	}
-	if task.Method == "" {
-		task.Method = common.Kubernetes
-	}
-	for _, v := range task.Variables {
-		if parsed, err := v.GetParsedValue(); err == nil {
-			data[v.Name] = parsed
-		} else {
-			return nil, nil, fmt.Errorf("failed to parse value for %v due to %w", v, err)
-		}
-	}
-
-	// parse task-type
-	serData := utils.ParseYamlTag(jd.RawYaml, fmt.Sprintf("task_type: %s", taskType))
-	if serData == "" {
-		return nil, nil, fmt.Errorf("failed to find %s from Yaml definition", taskType)
-	}
+	return data
+}
 
+// parseDynamicTask renders and parses YAML of the defined task using job variables
+func (jd *JobDefinition) parseDynamicTask(
+	defined *TaskDefinition,
+	serData string,
+	data map[string]interface{},
+	vars map[string]common.VariableValue,
+	querier utils.JobTemplateHelper) (task *TaskDefinition, opts *common.ExecutorOptions, err error) {
+	taskType := defined.TaskType
 	// For fan-out tasks, extract raw scripts BEFORE template rendering so that
 	// per-item placeholders ({{.region}}) survive for later per-item rendering
 	// by FanOutTasklet. Queen-side rendering only has job-level variables, not
@@ -442,7 +494,7 @@ func (jd *JobDefinition) GetDynamicTaskWithQuerier(
 				"Version":   jd.SemVersion,
 				"TaskType":  taskType,
 				"DataVars":  common.MaskVariableValues(vars),
-				"DataTask":  task.MaskTaskVariables(),
+				"DataTask":  defined.MaskTaskVariables(),
 				"Error":     err,
 			}).Error("failed to parse yaml task")
 			return nil, nil, fmt.Errorf("failed to parse task yaml for '%s' task due to %w", taskType, err)
@@ -701,6 +753,10 @@ func (jd *JobDefinition) AddVariable(
 		jd.Resources = value.(BasicResource)
 	} else if name == keyRequiredParams {
 		jd.RequiredParams = value.([]string)
+	} else if name == keySLA {
+		jd.SLA = value.(*SLAConfig)
+	} else if name == keyQuarantineFlakyTasks {
+		jd.QuarantineFlakyTasks = value.(bool)
 	} else {
 		jd.lock.Lock()
 		defer jd.lock.Unlock()
@@ -730,6 +786,10 @@ func (jd *JobDefinition) RemoveVariable(name string) bool {
 		jd.Resources = BasicResource{}
 	} else if name == keyRequiredParams {
 		jd.RequiredParams = []string{}
+	} else if name == keySLA {
+		jd.SLA = nil
+	} else if name == keyQuarantineFlakyTasks {
+		jd.QuarantineFlakyTasks = false
 	} else {
 		jd.lock.Lock()
 		defer jd.lock.Unlock()
@@ -897,6 +957,14 @@ func (jd *JobDefinition) AfterLoad(key []byte) (err error) {
 			if err != nil {
 				return err
 			}
+		} else if c.Name == keySLA {
+			jd.SLA = &SLAConfig{}
+			err = json.Unmarshal([]byte(c.Value), jd.SLA)
+			if err != nil {
+				return err
+			}
+		} else if c.Name == keyQuarantineFlakyTasks {
+			jd.QuarantineFlakyTasks = v == true
 		} else {
 			nameValueVariables[c.Name] = v
 		}
@@ -918,6 +986,18 @@ func (jd *JobDefinition) AfterLoad(key []byte) (err error) {
 			return err
 		}
 	}
+	if jd.CronScheduleSerialized != "" {
+		var cs CronSchedule
+		if err = json.Unmarshal([]byte(jd.CronScheduleSerialized), &cs); err != nil {
+			return err
+		}
+		jd.CronTriggers = cs.CronTriggers
+		jd.Timezone = cs.Timezone
+		jd.BlackoutWindows = cs.BlackoutWindows
+		jd.HolidayCalendars = cs.HolidayCalendars
+		jd.BlackoutPolicy = cs.BlackoutPolicy
+		jd.Catchup = cs.Catchup
+	}
 	// Triggers are not stored in the DB (gorm:"-"); re-parse them from RawYaml on every load.
 	if jd.RawYaml != "" {
 		jd.Triggers = parseTriggerDefinitions(jd.RawYaml)
@@ -1046,6 +1126,18 @@ func (jd *JobDefinition) Validate() (err error) {
 		jd.Errors["CronTrigger"] = err.Error()
 		return err
 	}
+	// cron_trigger column is used to find cron jobs so it holds the first expression
+	if jd.CronTrigger == "" && len(jd.CronTriggers) > 0 {
+		jd.CronTrigger = jd.CronTriggers[0]
+	}
+	if err = jd.GetCronSchedule().Validate(); err != nil {
+		jd.Errors["CronTrigger"] = err.Error()
+		return err
+	}
+	if err = jd.validateSLA(); err != nil {
+		jd.Errors["SLA"] = err.Error()
+		return err
+	}
 	if len(jd.Tasks) == 0 {
 		err = fmt.Errorf("tasks are not specified for %v", jd.JobType)
 		jd.Errors["Tasks"] = err.Error()
@@ -1096,6 +1188,18 @@ func (jd *JobDefinition) Validate() (err error) {
 		jd.Errors["Tasks"] = err.Error()
 		return err
 	}
+	scheduledByDependencies := jd.ScheduledByDependencies()
+	for _, t := range jd.Tasks {
+		if t.Join != nil && !scheduledByDependencies {
+			err = fmt.Errorf("join of task %s requires tasks scheduled by dependencies without on_completed, on_failed or on_exit_code", t.TaskType)
+		} else if t.DynamicTasks != nil && scheduledByDependencies {
+			err = fmt.Errorf("dynamic_tasks of task %s requires tasks chained with on_completed", t.TaskType)
+		}
+		if err != nil {
+			jd.Errors["Tasks"] = err.Error()
+			return err
+		}
+	}
 	// Validate triggers: check per-type required fields and no duplicate names.
 	triggerNames := make(map[string]struct{})
 	for _, t := range jd.Triggers {
@@ -1155,6 +1259,9 @@ func (jd *JobDefinition) GetLastTask() (last *TaskDefinition) {
 
 // GetFirstTask returns first task
 func (jd *JobDefinition) GetFirstTask() (*TaskDefinition, error) {
+	if jd.ScheduledByDependencies() {
+		return jd.validateDependencies()
+	}
 	onExitTypes, err := jd.validateReachableTasks()
 	if err != nil {
 		return nil, err
@@ -1162,6 +1269,34 @@ func (jd *JobDefinition) GetFirstTask() (*TaskDefinition, error) {
 	return jd.validateFirstTask(onExitTypes)
 }
 
+// ScheduledByDependencies returns true if tasks of the job are not chained with on_completed, on_failed or
+// on_exit_code and instead define dependencies, in which case tasks run as soon as their dependencies are
+// done and tasks without pending dependencies run in parallel.
+func (jd *JobDefinition) ScheduledByDependencies() bool {
+	if len(jd.Tasks) < 2 {
+		return false
+	}
+	taskTypes := make(map[string]bool)
+	for _, t := range jd.Tasks {
+		taskTypes[t.TaskType] = true
+	}
+	hasDependencies := false
+	for _, t := range jd.Tasks {
+		if t.OnCompleted != "" || t.OnFailed != "" {
+			return false
+		}
+		for _, next := range t.OnExitCode {
+			if taskTypes[next] {
+				return false
+			}
+		}
+		if len(t.Dependencies) > 0 {
+			hasDependencies = true
+		}
+	}
+	return hasDependencies
+}
+
 // CronAndScheduleTime returns next schedule time when using cron expression
 func (jd *JobDefinition) CronAndScheduleTime() string {
 	if jd.CronTrigger == "" {
@@ -1171,7 +1306,28 @@ func (jd *JobDefinition) CronAndScheduleTime() string {
 	if nextTime == nil {
 		return ""
 	}
-	return fmt.Sprintf("%s (Next: %s)", jd.CronTrigger, nextTime.Format(time.RFC3339))
+	cron := strings.Join(jd.GetCronSchedule().CronTriggers, ", ")
+	if jd.Timezone != "" {
+		cron += " " + jd.Timezone
+	}
+	return fmt.Sprintf("%s (Next: %s)", cron, nextTime.Format(time.RFC3339))
+}
+
+// GetCronSchedule returns calendar-aware schedule of cron_trigger, cron_triggers, timezone, blackout windows
+// and holiday calendars
+func (jd *JobDefinition) GetCronSchedule() *CronSchedule {
+	cs := NewCronSchedule(jd.CronTrigger)
+	for _, cron := range jd.CronTriggers {
+		if cron != jd.CronTrigger {
+			cs.CronTriggers = append(cs.CronTriggers, cron)
+		}
+	}
+	cs.Timezone = jd.Timezone
+	cs.BlackoutWindows = jd.BlackoutWindows
+	cs.HolidayCalendars = jd.HolidayCalendars
+	cs.BlackoutPolicy = jd.BlackoutPolicy
+	cs.Catchup = jd.Catchup
+	return cs
 }
 
 // GetCronScheduleTimeAndUserKey returns next schedule time when using cron expression
@@ -1185,23 +1341,26 @@ func (jd *JobDefinition) GetCronScheduleTimeAndUserKey() (*time.Time, string) {
 	} else {
 		orgIDOrUser = jd.UserID
 	}
-	return GetCronScheduleTimeAndUserKey(orgIDOrUser, jd.JobType, jd.CronTrigger)
+	return cronScheduleTimeAndUserKey(jd.GetCronSchedule(), orgIDOrUser, jd.JobType)
 }
 
 // GetCronScheduleTimeAndUserKey returns next schedule time when using cron expression
 func GetCronScheduleTimeAndUserKey(orgIDOrUserID string, jobType string, cronTrigger string) (*time.Time, string) {
-	if cronTrigger == "" {
-		return nil, ""
-	}
-	nextTime := cronexpr.MustParse(cronTrigger).Next(time.Now())
-	if nextTime.IsZero() {
+	return cronScheduleTimeAndUserKey(NewCronSchedule(cronTrigger), orgIDOrUserID, jobType)
+}
+
+func cronScheduleTimeAndUserKey(cs *CronSchedule, orgIDOrUserID string, jobType string) (*time.Time, string) {
+	nextTime, userKey, err := cs.ScheduleTimeAndUserKey(orgIDOrUserID, jobType)
+	if err != nil {
+		// e.g. holiday calendar is missing so don't risk running on a holiday
+		logrus.WithFields(logrus.Fields{
+			"Component": "JobDefinition",
+			"JobType":   jobType,
+			"Error":     err,
+		}).Warnf("failed to find next cron schedule")
 		return nil, ""
 	}
-	prefix := orgIDOrUserID
-	if prefix == "" {
-		prefix = "default"
-	}
-	return &nextTime, fmt.Sprintf("%s-%s-%s", prefix, jobType, nextTime.Format(time.RFC3339))
+	return nextTime, userKey
 }
 
 // ValidateBeforeSave validates job-definition
@@ -1219,6 +1378,16 @@ func (jd *JobDefinition) ValidateBeforeSave(key []byte) error {
 			return err
 		}
 	}
+	if jd.SLA != nil {
+		if _, err := jd.AddVariable(keySLA, jd.SLA); err != nil {
+			return err
+		}
+	}
+	if jd.QuarantineFlakyTasks {
+		if _, err := jd.AddVariable(keyQuarantineFlakyTasks, true); err != nil {
+			return err
+		}
+	}
 
 	// Update configs
 	if err := jd.addVariablesFromNameValueVariables(); err != nil {
@@ -1241,6 +1410,22 @@ func (jd *JobDefinition) ValidateBeforeSave(key []byte) error {
 			return err
 		}
 	}
+	jd.CronScheduleSerialized = ""
+	if cs := jd.GetCronSchedule(); cs.Calendar() {
+		if err := cs.ValidateCalendars(); err != nil {
+			return common.NewValidationError(err)
+		}
+		if b, err := json.Marshal(cs); err == nil {
+			jd.CronScheduleSerialized = string(b)
+		} else {
+			return err
+		}
+	}
+	for _, t := range jd.Triggers {
+		if err := t.ValidateLimits(); err != nil {
+			return common.NewValidationError(err)
+		}
+	}
 
 	return nil
 }
@@ -1265,6 +1450,26 @@ func (jd *JobDefinition) addVariablesFromNameValueVariables() error {
 }
 
 // ///////////////////////////////////////// PRIVATE METHODS ////////////////////////////////////////////
+// validateSLA validates sla of the job and its tasks, where finish_by is only supported for cron jobs
+func (jd *JobDefinition) validateSLA() error {
+	finishBy := false
+	if jd.SLA != nil {
+		if err := jd.SLA.Validate(false); err != nil {
+			return fmt.Errorf("sla is invalid due to %w", err)
+		}
+		finishBy = jd.SLA.FinishBy != ""
+	}
+	for _, t := range jd.Tasks {
+		if t.SLA != nil && t.SLA.FinishBy != "" {
+			finishBy = true
+		}
+	}
+	if finishBy && jd.CronTrigger == "" {
+		return fmt.Errorf("sla finish_by requires cron_trigger")
+	}
+	return nil
+}
+
 func (jd *JobDefinition) tasksString() string {
 	var b strings.Builder
 	for _, t := range jd.Tasks {
@@ -1291,6 +1496,55 @@ func (jd *JobDefinition) validateFirstTask(
 	return
 }
 
+// validateDependencies checks that dependencies refer to tasks of the job without cycles and returns
+// the first task without dependencies.
+func (jd *JobDefinition) validateDependencies() (firstTask *TaskDefinition, err error) {
+	tasks := make(map[string]*TaskDefinition)
+	for _, t := range jd.Tasks {
+		tasks[t.TaskType] = t
+	}
+	for _, t := range jd.Tasks {
+		for _, dep := range t.Dependencies {
+			if tasks[dep] == nil {
+				return nil, fmt.Errorf("task '%s' depends on '%s' but it's not defined", t.TaskType, dep)
+			}
+		}
+		if len(t.Dependencies) == 0 && firstTask == nil {
+			firstTask = t
+		}
+	}
+	if firstTask == nil {
+		return nil, fmt.Errorf("no task without dependencies found")
+	}
+	// a task is visited when all of its dependencies are visited, any task left belongs to a cycle
+	visited := make(map[string]bool)
+	for progressed := true; progressed; {
+		progressed = false
+		for _, t := range jd.Tasks {
+			if visited[t.TaskType] {
+				continue
+			}
+			ready := true
+			for _, dep := range t.Dependencies {
+				if !visited[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				visited[t.TaskType] = true
+				progressed = true
+			}
+		}
+	}
+	for _, t := range jd.Tasks {
+		if !visited[t.TaskType] {
+			return nil, fmt.Errorf("dependencies of task '%s' have a cycle", t.TaskType)
+		}
+	}
+	return firstTask, nil
+}
+
 func (jd *JobDefinition) validateReachableTasks() (map[string]bool, error) {
 	onExitTypes := make(map[string]bool)
 	reservedExitCodes := map[string]bool{
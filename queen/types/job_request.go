@@ -24,6 +24,8 @@ type ApprovalVoteRequest struct {
 	VoterName  string          `json:"voter_name" form:"voter_name"`
 	Decision   ApprovalDecision `json:"decision" form:"decision"` // "APPROVED" or "REJECTED"
 	Comments   string          `json:"comments" form:"comments"`
+	// OnBehalfOf is the user-id of an away approver who delegated their vote to the voter.
+	OnBehalfOf string          `json:"on_behalf_of" form:"on_behalf_of"`
 }
 
 // UserJobTypeKey defines key for job-type by user/org
@@ -94,6 +96,8 @@ type IJobRequest interface {
 	IncrPausedCount() int
 	// GetCronTriggered is true if request was triggered by cron
 	GetCronTriggered() bool
+	// GetParentID returns id of the request that forked this request
+	GetParentID() string
 	// GetHardRestart returns true if all tasks should re-run from scratch
 	GetHardRestart() bool
 	// GetScheduledAt defines schedule time
@@ -127,9 +131,9 @@ type JobRequest struct {
 	// LastJobExecutionID defines foreign key for JobExecution
 	LastJobExecutionID string `json:"last_job_execution_id"`
 	// OrganizationID defines org who submitted the job
-	OrganizationID string `json:"organization_id"`
+	OrganizationID string `json:"organization_id" gorm:"uniqueIndex:formicary_job_requests_logical_date_ndx,priority:2"`
 	// UserID defines user who submitted the job
-	UserID string `json:"user_id"`
+	UserID string `json:"user_id" gorm:"uniqueIndex:formicary_job_requests_logical_date_ndx,priority:3"`
 	// Permissions provides who can access this request 0 - all, 1 - Org must match, 2 - UserID must match from authentication
 	Permissions int `json:"permissions"`
 	// Description of the request
@@ -137,7 +141,7 @@ type JobRequest struct {
 	// Platform overrides platform property for targeting job to a specific follower
 	Platform string `json:"platform"`
 	// JobType defines type for the job
-	JobType    string `json:"job_type"`
+	JobType    string `json:"job_type" gorm:"uniqueIndex:formicary_job_requests_logical_date_ndx,priority:1"`
 	JobVersion string `json:"job_version"`
 	// JobState defines state of job that is maintained throughout the lifecycle of a job
 	JobState types.RequestState `json:"job_state"`
@@ -155,6 +159,12 @@ type JobRequest struct {
 	PausedCount int `json:"paused_count" gorm:"paused_count"`
 	// CronTriggered is true if request was triggered by cron
 	CronTriggered bool `json:"cron_triggered"`
+	// LogicalDate is the cron tick that the request runs, which is earlier than ScheduledAt for backfills.
+	// A tick of the job runs at most once because of the unique index on job-type, owner and logical-date.
+	LogicalDate *time.Time `json:"logical_date,omitempty" gorm:"logical_date;uniqueIndex:formicary_job_requests_logical_date_ndx,priority:4"`
+	// BackfillID identifies the backfill or catch-up that submitted the request, which is set by the
+	// server only and cannot be passed in a submitted request
+	BackfillID string `json:"-" gorm:"size:128"`
 	// QuickSearch provides quick search to search a request by params
 	QuickSearch string `json:"quick_search"`
 	// ErrorCode captures error code at the end of job execution if it fails
@@ -242,9 +252,21 @@ func (jr *JobRequest) UpdateUserKeyFromScheduleIfCronJob(job *JobDefinition) {
 		jr.ScheduledAt = *scheduledAt
 		jr.UserKey = userKey
 		jr.CronTriggered = true
+		jr.SetLogicalDate(*scheduledAt)
 	}
 }
 
+// SetLogicalDate sets cron tick of the request in UTC so that ticks can be compared in the database
+func (jr *JobRequest) SetLogicalDate(tick time.Time) {
+	utc := tick.UTC()
+	jr.LogicalDate = &utc
+}
+
+// IsBackfill returns true if request runs a past cron tick for a backfill or catch-up
+func (jr *JobRequest) IsBackfill() bool {
+	return jr.BackfillID != ""
+}
+
 // ElapsedDuration time duration of job execution
 func (jr *JobRequest) ElapsedDuration() string {
 	if jr.NotTerminal() {
@@ -458,6 +480,11 @@ func (jr *JobRequest) GetCronTriggered() bool {
 	return jr.CronTriggered
 }
 
+// GetParentID returns id of the request that forked this request
+func (jr *JobRequest) GetParentID() string {
+	return jr.ParentID
+}
+
 // GetHardRestart returns true if all tasks should re-run from scratch on restart
 func (jr *JobRequest) GetHardRestart() bool {
 	return jr.HardRestart
@@ -735,6 +762,7 @@ func (jr *JobRequest) UpdateScheduledAtFromCronTrigger(jd *JobDefinition) bool {
 	if date != nil {
 		jr.ScheduledAt = *date
 		jr.UserKey = userKey
+		jr.SetLogicalDate(*date)
 		return true
 	}
 	return false
@@ -36,6 +36,10 @@ type JobRequestInfo struct {
 	UserID string `json:"user_id"`
 	// CronTriggered is true if request was triggered by cron
 	CronTriggered bool `json:"cron_triggered"`
+	// LogicalDate is the cron tick that the request runs
+	LogicalDate *time.Time `json:"logical_date,omitempty"`
+	// ParentID defines id of the request that forked this request
+	ParentID string `json:"parent_id,omitempty"`
 	// HardRestart forces all tasks to re-run from scratch on next restart
 	HardRestart bool   `json:"hard_restart"`
 	CurrentTask string `json:"current_task"`
@@ -66,6 +70,23 @@ func (jri *JobRequestInfo) Editable(userID string, organizationID string) bool {
 	return jri.UserID == userID
 }
 
+// QueueWaitTime returns how long the request waited to be scheduled since it was due, i.e., the latest of its
+// creation, its cron tick and its scheduled time for requests submitted to run later. The scheduled time is
+// only used before the first schedule attempt because the scheduler pushes it back when ants are not available.
+func (jri *JobRequestInfo) QueueWaitTime(now time.Time) time.Duration {
+	waitingSince := jri.CreatedAt
+	if jri.LogicalDate != nil && jri.LogicalDate.After(waitingSince) {
+		waitingSince = *jri.LogicalDate
+	}
+	if jri.ScheduleAttempts == 0 && jri.ScheduledAt.After(waitingSince) {
+		waitingSince = jri.ScheduledAt
+	}
+	if waitingSince.IsZero() || now.Before(waitingSince) {
+		return 0
+	}
+	return now.Sub(waitingSince)
+}
+
 // GetUserJobTypeKey defines key
 func (jri *JobRequestInfo) GetUserJobTypeKey() string {
 	return getUserJobTypeKey(jri.OrganizationID, jri.UserID, jri.JobType, jri.JobVersion)
@@ -181,6 +202,11 @@ func (jri *JobRequestInfo) GetCronTriggered() bool {
 	return jri.CronTriggered
 }
 
+// GetParentID returns id of the request that forked this request
+func (jri *JobRequestInfo) GetParentID() string {
+	return jri.ParentID
+}
+
 // GetHardRestart returns true if all tasks should re-run from scratch on restart
 func (jri *JobRequestInfo) GetHardRestart() bool {
 	return jri.HardRestart
@@ -221,6 +247,7 @@ func NewJobRequestInfo(req IJobRequest) *JobRequestInfo {
 		UserID:             req.GetUserID(),
 		OrganizationID:     req.GetOrganizationID(),
 		CronTriggered:      req.GetCronTriggered(),
+		ParentID:           req.GetParentID(),
 		HardRestart:        req.GetHardRestart(),
 		Params:             req.GetParams(),
 		ScheduledAt:        req.GetScheduledAt(),
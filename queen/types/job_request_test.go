@@ -276,6 +276,37 @@ func Test_ShouldNotValidateJobRequestWithoutState(t *testing.T) {
 	require.Contains(t, job.ToInfo().Validate().Error(), "jobState is not specified")
 }
 
+// QueueWaitTime should measure from creation, from cron tick or from scheduled time
+func Test_ShouldCalculateQueueWaitTimeOfJobRequest(t *testing.T) {
+	// Given job request info created a minute ago
+	now := time.Now()
+	info := &JobRequestInfo{CreatedAt: now.Add(-time.Minute)}
+	// WHEN calculating queue wait
+	// THEN it should be measured from creation
+	require.Equal(t, time.Minute, info.QueueWaitTime(now))
+
+	// WHEN request was created ahead of its cron tick
+	tick := now.Add(-10 * time.Second)
+	info.LogicalDate = &tick
+	// THEN it should be measured from the tick
+	require.Equal(t, 10*time.Second, info.QueueWaitTime(now))
+
+	// WHEN request is not due yet
+	// THEN it should not wait
+	require.Equal(t, time.Duration(0), info.QueueWaitTime(now.Add(-time.Hour)))
+
+	// WHEN request was submitted to run later
+	info.ScheduledAt = now.Add(-5 * time.Second)
+	// THEN it should be measured from the scheduled time
+	require.Equal(t, 5*time.Second, info.QueueWaitTime(now))
+
+	// WHEN scheduled time was pushed back because ants were not available
+	info.ScheduledAt = now.Add(time.Minute)
+	info.ScheduleAttempts = 1
+	// THEN it should still be measured from the tick
+	require.Equal(t, 10*time.Second, info.QueueWaitTime(now))
+}
+
 func newTestJobRequest(name string) *JobRequest {
 	// Given job request
 	job := newTestJobDefinition(name)
@@ -286,3 +317,18 @@ func newTestJobRequest(name string) *JobRequest {
 	_, _ = req.AddParam("k2", "jv2")
 	return req
 }
+
+// A request is a backfill only if the server marked it, not if it was submitted with backfill param
+func Test_ShouldNotTreatBackfillParamOfSubmittedRequestAsBackfill(t *testing.T) {
+	// GIVEN a submitted request with backfill-id param and field
+	job := newTestJobRequest("test-job")
+	err := json.Unmarshal([]byte(`{"job_type": "test-job", "backfill_id": "bf", "params": {"BackfillID": "bf"}}`), job)
+	require.NoError(t, err)
+	_, _ = job.AddParam(BackfillIDParam, "bf")
+
+	// WHEN checking if it's a backfill
+	// THEN it should not be treated as a backfill
+	require.False(t, job.IsBackfill())
+	job.BackfillID = "bf"
+	require.True(t, job.IsBackfill())
+}
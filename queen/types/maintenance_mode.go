@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// MaintenanceMode pauses scheduling of new jobs by all queen servers while running jobs are allowed to complete.
+type MaintenanceMode struct {
+	Enabled bool `json:"enabled"`
+	// Reason is shown in the wait estimate of pending jobs while they wait for the maintenance to end
+	Reason    string    `json:"reason"`
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UnmarshalMaintenanceMode unmarshal
+func UnmarshalMaintenanceMode(b []byte) (*MaintenanceMode, error) {
+	var mode MaintenanceMode
+	if err := json.Unmarshal(b, &mode); err != nil {
+		return nil, err
+	}
+	return &mode, nil
+}
+
+// Marshal marshals
+func (m *MaintenanceMode) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
@@ -31,4 +31,11 @@ const (
 	LongReport = "LongReport"
 	// Thread option
 	Thread = "Thread"
+	// ApproveAction option with the signed token of the approve button
+	ApproveAction = "ApproveAction"
+	// RejectAction option with the signed token of the reject button
+	RejectAction = "RejectAction"
 )
+
+// SlackSigningSecret verifies requests from slack interactive buttons
+const SlackSigningSecret = "SlackSigningSecret"
@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	common "plexobject.com/formicary/internal/types"
+)
+
+// SLAKind defines the expectation of an SLA that a job or task can breach
+type SLAKind string
+
+const (
+	// SLAQueueWait is breached when a job waits longer than max_queue_wait to start
+	SLAQueueWait SLAKind = "QUEUE_WAIT"
+	// SLARunTime is breached when a job or task runs longer than max_run_time
+	SLARunTime SLAKind = "RUN_TIME"
+	// SLAFinishBy is breached when a cron job or task hasn't finished by the finish_by time of its tick
+	SLAFinishBy SLAKind = "FINISH_BY"
+)
+
+// SLAConfig defines expected duration of a job or task. Unlike timeout, an SLA doesn't abort the job but
+// the scheduler records a breach and notifies recipients of the job.
+type SLAConfig struct {
+	// MaxQueueWait is max time a job should wait before it starts, only supported for jobs
+	MaxQueueWait time.Duration `yaml:"max_queue_wait,omitempty" json:"max_queue_wait"`
+	// MaxRunTime is max time a job or task should run
+	MaxRunTime time.Duration `yaml:"max_run_time,omitempty" json:"max_run_time"`
+	// FinishBy is wall-clock time in HH:MM format in timezone of the cron job by which a cron tick should finish
+	FinishBy string `yaml:"finish_by,omitempty" json:"finish_by"`
+}
+
+// Validate validates sla, where task is true for SLA of tasks
+func (c *SLAConfig) Validate(task bool) error {
+	if c.MaxQueueWait < 0 || c.MaxRunTime < 0 {
+		return fmt.Errorf("max_queue_wait and max_run_time cannot be negative")
+	}
+	if task && c.MaxQueueWait > 0 {
+		return fmt.Errorf("max_queue_wait is only supported for jobs")
+	}
+	if c.FinishBy != "" {
+		if _, err := time.Parse("15:04", c.FinishBy); err != nil {
+			return fmt.Errorf("finish_by %s must be in HH:MM format", c.FinishBy)
+		}
+	}
+	if c.MaxQueueWait == 0 && c.MaxRunTime == 0 && c.FinishBy == "" {
+		return fmt.Errorf("max_queue_wait, max_run_time or finish_by must be specified")
+	}
+	return nil
+}
+
+// FinishByDeadline returns the first finish_by time at or after the cron tick in the location of the cron job
+func (c *SLAConfig) FinishByDeadline(tick time.Time, loc *time.Location) (time.Time, error) {
+	finishBy, err := time.Parse("15:04", c.FinishBy)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tick = tick.In(loc)
+	deadline := time.Date(tick.Year(), tick.Month(), tick.Day(), finishBy.Hour(), finishBy.Minute(), 0, 0, loc)
+	if deadline.Before(tick) {
+		deadline = deadline.AddDate(0, 0, 1)
+	}
+	return deadline, nil
+}
+
+// String defines description of sla
+func (c *SLAConfig) String() string {
+	return fmt.Sprintf("MaxQueueWait=%s MaxRunTime=%s FinishBy=%s", c.MaxQueueWait, c.MaxRunTime, c.FinishBy)
+}
+
+// HasSLA returns true if the job or any of its tasks defines an SLA
+func (jd *JobDefinition) HasSLA() bool {
+	if jd.SLA != nil {
+		return true
+	}
+	for _, t := range jd.Tasks {
+		if t.SLA != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// SLABreach records a job request or task that didn't meet the SLA of its job definition. A request is
+// recorded at most once per task and kind so that recipients are notified once.
+type SLABreach struct {
+	// ID is a 26-char ULID string.
+	ID             string `json:"id" gorm:"primaryKey;size:128"`
+	JobRequestID   string `json:"job_request_id" gorm:"not null;size:128;uniqueIndex:formicary_sla_breaches_request_ndx"`
+	JobType        string `json:"job_type" gorm:"not null;size:255;index"`
+	UserID         string `json:"user_id" gorm:"size:128"`
+	OrganizationID string `json:"organization_id" gorm:"size:128"`
+	// TaskType is empty for SLA of the job
+	TaskType string  `json:"task_type" gorm:"size:100;uniqueIndex:formicary_sla_breaches_request_ndx"`
+	Kind     SLAKind `json:"kind" gorm:"not null;size:64;uniqueIndex:formicary_sla_breaches_request_ndx"`
+	// Expected is the max duration or finish_by time of the SLA
+	Expected string `json:"expected"`
+	// Deadline is when the SLA was breached
+	Deadline time.Time `json:"deadline"`
+	// JobState is state of the request when the breach was detected
+	JobState  common.RequestState `json:"job_state" gorm:"size:64"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// TableName overrides the GORM table name.
+func (SLABreach) TableName() string {
+	return "formicary_sla_breaches"
+}
+
+// Validate validates breach
+func (b *SLABreach) Validate() error {
+	if b.JobRequestID == "" {
+		return fmt.Errorf("job_request_id is not specified")
+	}
+	if b.JobType == "" {
+		return fmt.Errorf("job_type is not specified")
+	}
+	if b.Kind == "" {
+		return fmt.Errorf("kind is not specified")
+	}
+	return nil
+}
+
+// Description returns human-readable description of the breach
+func (b *SLABreach) Description() string {
+	name := "Job " + b.JobType
+	if b.TaskType != "" {
+		name = fmt.Sprintf("Task %s of job %s", b.TaskType, b.JobType)
+	}
+	switch b.Kind {
+	case SLAQueueWait:
+		return fmt.Sprintf("%s waited longer than %s to start", name, b.Expected)
+	case SLARunTime:
+		return fmt.Sprintf("%s ran longer than %s", name, b.Expected)
+	default:
+		return fmt.Sprintf("%s didn't finish by %s", name, b.Expected)
+	}
+}
+
+func (b *SLABreach) String() string {
+	return fmt.Sprintf("JobRequestID=%s JobType=%s TaskType=%s Kind=%s Expected=%s Deadline=%s",
+		b.JobRequestID, b.JobType, b.TaskType, b.Kind, b.Expected, b.Deadline.Format(time.RFC3339))
+}
+
+// SLACompliance summarizes requests of a job type that finished on a day and how many of them breached SLA
+type SLACompliance struct {
+	JobType  string `json:"job_type"`
+	Day      string `json:"day"`
+	Total    int64  `json:"total"`
+	Breached int64  `json:"breached"`
+	// Compliance is percentage of requests that met their SLA
+	Compliance float64 `json:"compliance"`
+}
+
+// NewSLACompliance creates compliance of requests of a job type that finished on the day
+func NewSLACompliance(jobType string, day string, total int64, breached int64) *SLACompliance {
+	compliance := 100.0
+	if total > 0 {
+		compliance = float64(total-breached) * 100 / float64(total)
+	}
+	return &SLACompliance{
+		JobType:    jobType,
+		Day:        day,
+		Total:      total,
+		Breached:   breached,
+		Compliance: compliance,
+	}
+}
+
+// EvaluateSLA returns SLA breaches of the request, where exec is nil if the request hasn't started yet
+func EvaluateSLA(
+	jd *JobDefinition,
+	req *JobRequestInfo,
+	exec *JobExecution,
+	now time.Time) []*SLABreach {
+	breaches := make([]*SLABreach, 0)
+	add := func(taskType string, kind SLAKind, expected string, deadline time.Time) {
+		breaches = append(breaches, &SLABreach{
+			JobRequestID:   req.ID,
+			JobType:        req.JobType,
+			UserID:         req.UserID,
+			OrganizationID: req.OrganizationID,
+			TaskType:       taskType,
+			Kind:           kind,
+			Expected:       expected,
+			Deadline:       deadline,
+			JobState:       req.JobState,
+		})
+	}
+	finishByDeadline := func(sla *SLAConfig) (time.Time, bool) {
+		if sla.FinishBy == "" || req.LogicalDate == nil {
+			return time.Time{}, false
+		}
+		loc, err := jd.GetCronSchedule().Location()
+		if err != nil {
+			return time.Time{}, false
+		}
+		deadline, err := sla.FinishByDeadline(*req.LogicalDate, loc)
+		return deadline, err == nil
+	}
+
+	// requests cancelled before they started are not measured
+	if exec == nil && req.JobState.IsTerminal() {
+		return breaches
+	}
+	if sla := jd.SLA; sla != nil {
+		if sla.MaxQueueWait > 0 {
+			startedAt := now
+			if exec != nil {
+				startedAt = exec.StartedAt
+			}
+			if waited := req.QueueWaitTime(startedAt); waited > sla.MaxQueueWait {
+				add("", SLAQueueWait, sla.MaxQueueWait.String(), startedAt.Add(sla.MaxQueueWait-waited))
+			}
+		}
+		end := now
+		if exec != nil {
+			end, _ = executionEnd(req.JobState, exec.EndedAt, exec.UpdatedAt, now)
+		}
+		if sla.MaxRunTime > 0 && exec != nil && end.Sub(exec.StartedAt) > sla.MaxRunTime {
+			add("", SLARunTime, sla.MaxRunTime.String(), exec.StartedAt.Add(sla.MaxRunTime))
+		}
+		if deadline, ok := finishByDeadline(sla); ok && end.After(deadline) {
+			add("", SLAFinishBy, sla.FinishBy, deadline)
+		}
+	}
+
+	if exec == nil {
+		return breaches
+	}
+	for _, task := range exec.Tasks {
+		td := jd.GetTask(task.TaskType)
+		if td == nil || td.SLA == nil || task.StartedAt.IsZero() {
+			continue
+		}
+		// tasks that never finished because the job ended are not measured
+		if !task.TaskState.IsTerminal() && req.JobState.IsTerminal() {
+			continue
+		}
+		end, ok := executionEnd(task.TaskState, task.EndedAt, exec.UpdatedAt, now)
+		if !ok {
+			continue
+		}
+		if td.SLA.MaxRunTime > 0 && end.Sub(task.StartedAt) > td.SLA.MaxRunTime {
+			add(task.TaskType, SLARunTime, td.SLA.MaxRunTime.String(), task.StartedAt.Add(td.SLA.MaxRunTime))
+		}
+		if deadline, ok := finishByDeadline(td.SLA); ok && end.After(deadline) {
+			add(task.TaskType, SLAFinishBy, td.SLA.FinishBy, deadline)
+		}
+	}
+	return breaches
+}
+
+// executionEnd returns when a job or task ended or now if it's still running
+func executionEnd(
+	state common.RequestState,
+	endedAt *time.Time,
+	updatedAt time.Time,
+	now time.Time) (time.Time, bool) {
+	if endedAt != nil {
+		return *endedAt, true
+	}
+	if !state.IsTerminal() {
+		return now, true
+	}
+	return updatedAt, !updatedAt.IsZero()
+}
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	common "plexobject.com/formicary/internal/types"
+)
+
+const slaJobYaml = `
+job_type: nightly-etl
+cron_trigger: 0 0 1 * * * *
+timezone: UTC
+sla:
+  max_queue_wait: 10m
+  max_run_time: 2h
+  finish_by: "06:00"
+tasks:
+- task_type: extract
+  script:
+    - ./extract
+  on_completed: load
+- task_type: load
+  script:
+    - ./load
+  sla:
+    max_run_time: 30m
+`
+
+func Test_ShouldValidateSLA(t *testing.T) {
+	// GIVEN empty sla
+	// WHEN validating
+	// THEN it should fail
+	require.Error(t, (&SLAConfig{}).Validate(false))
+	// WHEN validating invalid finish_by
+	// THEN it should fail
+	require.Error(t, (&SLAConfig{FinishBy: "6am"}).Validate(false))
+	// WHEN validating max_queue_wait of a task
+	// THEN it should fail
+	require.Error(t, (&SLAConfig{MaxQueueWait: time.Minute}).Validate(true))
+	// WHEN validating valid sla
+	// THEN it should not fail
+	require.NoError(t, (&SLAConfig{MaxRunTime: time.Minute, FinishBy: "06:00"}).Validate(true))
+}
+
+func Test_ShouldRequireCronTriggerForFinishBy(t *testing.T) {
+	// GIVEN a job with finish_by but without cron trigger
+	job, err := NewJobDefinitionFromYaml([]byte(slaJobYaml))
+	require.NoError(t, err)
+	job.CronTrigger = ""
+	// WHEN validating
+	// THEN it should fail
+	require.Error(t, job.Validate())
+}
+
+func Test_ShouldFindFinishByDeadline(t *testing.T) {
+	sla := &SLAConfig{FinishBy: "06:00"}
+	// WHEN tick is before finish_by time
+	deadline, err := sla.FinishByDeadline(time.Date(2026, 10, 1, 1, 0, 0, 0, time.UTC), time.UTC)
+	require.NoError(t, err)
+	// THEN deadline is on the same day
+	require.Equal(t, time.Date(2026, 10, 1, 6, 0, 0, 0, time.UTC), deadline)
+	// WHEN tick is after finish_by time
+	deadline, err = sla.FinishByDeadline(time.Date(2026, 10, 1, 23, 0, 0, 0, time.UTC), time.UTC)
+	require.NoError(t, err)
+	// THEN deadline is on the next day
+	require.Equal(t, time.Date(2026, 10, 2, 6, 0, 0, 0, time.UTC), deadline)
+}
+
+func Test_ShouldSaveAndLoadSLA(t *testing.T) {
+	// GIVEN a job with sla
+	job, err := NewJobDefinitionFromYaml([]byte(slaJobYaml))
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, job.SLA.MaxRunTime)
+	require.Equal(t, 30*time.Minute, job.GetTask("load").SLA.MaxRunTime)
+
+	// WHEN saving and loading the job and task
+	require.NoError(t, job.ValidateBeforeSave(nil))
+	loaded := NewJobDefinition("nightly-etl")
+	loaded.RawYaml = job.RawYaml
+	loaded.CronTrigger = job.CronTrigger
+	loaded.Variables = job.Variables
+	loaded.Tasks = job.Tasks
+	for _, task := range job.Tasks {
+		task.SLA = nil
+	}
+	require.NoError(t, loaded.AfterLoad(nil))
+
+	// THEN sla is preserved and not exposed as a job variable
+	require.Equal(t, "06:00", loaded.SLA.FinishBy)
+	require.Equal(t, 30*time.Minute, loaded.GetTask("load").SLA.MaxRunTime)
+	require.Nil(t, loaded.GetVariable(keySLA))
+}
+
+func Test_ShouldEvaluateSLA(t *testing.T) {
+	// GIVEN a nightly job whose tick is at 1am
+	job, err := NewJobDefinitionFromYaml([]byte(slaJobYaml))
+	require.NoError(t, err)
+	tick := time.Date(2026, 10, 1, 1, 0, 0, 0, time.UTC)
+	req := &JobRequestInfo{
+		ID:          "req-1",
+		JobType:     job.JobType,
+		JobState:    common.PENDING,
+		LogicalDate: &tick,
+		CreatedAt:   tick.Add(-time.Hour),
+	}
+
+	// WHEN the request is still pending 5 minutes after the tick
+	// THEN no SLA is breached
+	require.Len(t, EvaluateSLA(job, req, nil, tick.Add(5*time.Minute)), 0)
+
+	// WHEN the request is still pending 15 minutes after the tick
+	breaches := EvaluateSLA(job, req, nil, tick.Add(15*time.Minute))
+	// THEN queue wait is breached
+	require.Len(t, breaches, 1)
+	require.Equal(t, SLAQueueWait, breaches[0].Kind)
+	require.Equal(t, tick.Add(10*time.Minute), breaches[0].Deadline)
+
+	// WHEN the job started after 5 minutes and its load task is running for an hour at 6:05am
+	req.JobState = common.EXECUTING
+	exec := NewJobExecution(&JobRequest{ID: req.ID, JobType: req.JobType})
+	exec.StartedAt = tick.Add(5 * time.Minute)
+	load := exec.AddTask(job.GetTask("load"))
+	load.TaskState = common.EXECUTING
+	load.StartedAt = tick.Add(4 * time.Hour)
+	breaches = EvaluateSLA(job, req, exec, tick.Add(5*time.Hour+5*time.Minute))
+
+	// THEN run time of job and task and finish_by of job are breached
+	kinds := make(map[string]bool)
+	for _, b := range breaches {
+		kinds[b.TaskType+":"+string(b.Kind)] = true
+	}
+	require.Equal(t, map[string]bool{
+		":" + string(SLARunTime):     true,
+		":" + string(SLAFinishBy):    true,
+		"load:" + string(SLARunTime): true,
+	}, kinds)
+
+	// WHEN the job completed within an hour
+	req.JobState = common.COMPLETED
+	ended := exec.StartedAt.Add(time.Hour)
+	exec.EndedAt = &ended
+	load.TaskState = common.COMPLETED
+	load.StartedAt = ended.Add(-20 * time.Minute)
+	load.EndedAt = &ended
+	// THEN no SLA is breached even if it's checked later
+	require.Len(t, EvaluateSLA(job, req, exec, tick.Add(10*time.Hour)), 0)
+}
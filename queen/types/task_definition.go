@@ -29,6 +29,10 @@ const keyExcept = "except"
 const keyJobVersion = "job_version"
 const keyDeps = "dependencies"
 const keyArtifacts = "artifact_ids"
+const keyJoin = "join"
+const keySLA = "sla"
+const keyPlacement = "placement"
+const keyQuarantineFlakyTasks = "quarantine_flaky_tasks"
 
 // TaskDefinition outlines the work performed by worker entities. It specifies the task's parameters and,
 // upon a new job request, a TaskExecution instance is initiated to carry out the task. The task details,
@@ -103,8 +107,15 @@ type TaskDefinition struct {
 	Except string `yaml:"except,omitempty" json:"except" gorm:"-"`
 	// JobVersion defines job version
 	JobVersion string `yaml:"job_version,omitempty" json:"job_version" gorm:"-"`
-	// Dependencies defines dependent tasks for downloading artifacts
+	// Dependencies defines dependent tasks for downloading artifacts. When tasks of a job are not chained with
+	// on_completed/on_failed/on_exit_code, dependencies define the order and independent tasks run in parallel.
 	Dependencies []string `json:"dependencies,omitempty" yaml:"dependencies,omitempty" gorm:"-"`
+	// Join defines how many dependencies must complete before the task runs, default is all
+	Join *JoinConfig `json:"join,omitempty" yaml:"join,omitempty" gorm:"-"`
+	// SLA defines max run time or finish_by time of the task
+	SLA *SLAConfig `json:"sla,omitempty" yaml:"sla,omitempty" gorm:"-"`
+	// Placement defines strategy, affinity and stickiness for choosing ants of the task
+	Placement *common.PlacementPolicy `json:"placement,omitempty" yaml:"placement,omitempty" gorm:"-"`
 	// ArtifactIDs defines id of artifacts that are automatically downloaded for job-execution
 	ArtifactIDs []string `json:"artifact_ids,omitempty" yaml:"artifact_ids,omitempty" gorm:"-"`
 	// ForkJobType defines type of job to work
@@ -120,9 +131,11 @@ type TaskDefinition struct {
 	// FanOut configures dynamic fan-out expansion for this task (transient, from YAML).
 	// When set, the engine spawns one child job per item in the source array.
 	FanOut *common.FanOutConfig `json:"fan_out,omitempty" yaml:"fan_out,omitempty" gorm:"-"`
-	unknownKeys           map[string]interface{}
-	lookupVariables       *cutils.SafeMap
-	lock                  sync.RWMutex
+	// DynamicTasks lets the task emit new tasks as an output that are spliced into the running execution (transient, from YAML).
+	DynamicTasks    *DynamicTasksConfig `json:"dynamic_tasks,omitempty" yaml:"dynamic_tasks,omitempty" gorm:"-"`
+	unknownKeys     map[string]interface{}
+	lookupVariables *cutils.SafeMap
+	lock            sync.RWMutex
 }
 
 // NewTaskDefinition creates new instance of task-definition
@@ -233,6 +246,12 @@ func (td *TaskDefinition) AddVariable(
 		td.JobVersion = fmt.Sprintf("%s", value)
 	} else if name == keyDeps {
 		td.Dependencies = value.([]string)
+	} else if name == keyJoin {
+		td.Join = value.(*JoinConfig)
+	} else if name == keySLA {
+		td.SLA = value.(*SLAConfig)
+	} else if name == keyPlacement {
+		td.Placement = value.(*common.PlacementPolicy)
 	} else if name == keyArtifacts {
 		switch value.(type) {
 		case []string:
@@ -367,6 +386,24 @@ func (td *TaskDefinition) AfterLoad() error {
 			if err != nil {
 				return err
 			}
+		} else if c.Name == keyJoin {
+			td.Join = &JoinConfig{}
+			err = json.Unmarshal([]byte(c.Value), td.Join)
+			if err != nil {
+				return err
+			}
+		} else if c.Name == keySLA {
+			td.SLA = &SLAConfig{}
+			err = json.Unmarshal([]byte(c.Value), td.SLA)
+			if err != nil {
+				return err
+			}
+		} else if c.Name == keyPlacement {
+			td.Placement = &common.PlacementPolicy{}
+			err = json.Unmarshal([]byte(c.Value), td.Placement)
+			if err != nil {
+				return err
+			}
 		} else if c.Name == keyArtifacts {
 			err = json.Unmarshal([]byte(c.Value), &td.ArtifactIDs)
 			if err != nil {
@@ -425,6 +462,31 @@ func (td *TaskDefinition) Validate() error {
 		// Route fan-out tasks through the FanOutTasklet.
 		td.Method = common.FanOutJob
 	}
+	if td.ApprovalPolicy != nil {
+		if err := td.ApprovalPolicy.Validate(); err != nil {
+			return fmt.Errorf("approval_policy of %s is invalid due to %w", td.TaskType, err)
+		}
+	}
+	if td.Join != nil {
+		if err := td.Join.Validate(len(td.Dependencies)); err != nil {
+			return fmt.Errorf("join of %s is invalid due to %w", td.TaskType, err)
+		}
+	}
+	if td.SLA != nil {
+		if err := td.SLA.Validate(true); err != nil {
+			return fmt.Errorf("sla of %s is invalid due to %w", td.TaskType, err)
+		}
+	}
+	if td.Placement != nil {
+		if err := td.Placement.Validate(); err != nil {
+			return fmt.Errorf("placement of %s is invalid due to %w", td.TaskType, err)
+		}
+	}
+	if td.DynamicTasks != nil {
+		if err := td.DynamicTasks.Validate(); err != nil {
+			return fmt.Errorf("dynamic_tasks of %s is invalid due to %w", td.TaskType, err)
+		}
+	}
 	return nil
 }
 
@@ -476,6 +538,21 @@ func (td *TaskDefinition) ValidateBeforeSave() error {
 			return err
 		}
 	}
+	if td.Join != nil {
+		if _, err := td.AddVariable(keyJoin, td.Join); err != nil {
+			return err
+		}
+	}
+	if td.SLA != nil {
+		if _, err := td.AddVariable(keySLA, td.SLA); err != nil {
+			return err
+		}
+	}
+	if td.Placement != nil {
+		if _, err := td.AddVariable(keyPlacement, td.Placement); err != nil {
+			return err
+		}
+	}
 	if td.ArtifactIDs != nil {
 		if _, err := td.AddVariable(keyArtifacts, td.ArtifactIDs); err != nil {
 			return err
@@ -679,6 +756,10 @@ func getReservedConfigProperties() []string {
 		keyExcept,
 		keyJobVersion,
 		keyDeps,
+		keyJoin,
+		keySLA,
+		keyPlacement,
+		keyQuarantineFlakyTasks,
 		keyArtifacts}
 }
 
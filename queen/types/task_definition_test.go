@@ -174,3 +174,54 @@ func Test_ShouldFallbackToRandomTaskDelayWhenNoPolicyAndNoDelay(t *testing.T) {
 	d := td.GetDelayBetweenRetries()
 	require.True(t, d >= 1*time.Second && d <= 2*time.Second, "expected delay in [1s, 2s], got %s", d)
 }
+
+func Test_ShouldSaveAndLoadTaskPlacement(t *testing.T) {
+	// GIVEN a job with placement of a task
+	job, err := NewJobDefinitionFromYaml([]byte(`
+job_type: cached-build
+tasks:
+- task_type: compile
+  script:
+    - make
+  placement:
+    strategy: spread
+    sticky: true
+    affinity:
+      labels: [ssd]
+    anti_affinity:
+      ant_ids: [ant-spot-1]
+`))
+	require.NoError(t, err)
+	require.Equal(t, common.SpreadPlacement, job.GetTask("compile").Placement.Strategy)
+
+	// WHEN saving and loading the job
+	require.NoError(t, job.ValidateBeforeSave(nil))
+	loaded := NewJobDefinition("cached-build")
+	loaded.RawYaml = job.RawYaml
+	loaded.Variables = job.Variables
+	loaded.Tasks = job.Tasks
+	for _, task := range job.Tasks {
+		task.Placement = nil
+	}
+	require.NoError(t, loaded.AfterLoad(nil))
+
+	// THEN placement is preserved and not exposed as a task variable
+	placement := loaded.GetTask("compile").Placement
+	require.NotNil(t, placement)
+	require.True(t, placement.Sticky)
+	require.Equal(t, []string{"ssd"}, placement.Affinity.Labels)
+	require.Equal(t, []string{"ant-spot-1"}, placement.AntiAffinity.AntIDs)
+	require.NotContains(t, loaded.GetTask("compile").NameValueVariables, keyPlacement)
+
+	// AND unknown strategy should fail
+	_, err = NewJobDefinitionFromYaml([]byte(`
+job_type: cached-build
+tasks:
+- task_type: compile
+  script:
+    - make
+  placement:
+    strategy: random
+`))
+	require.Error(t, err)
+}
@@ -60,6 +60,18 @@ type TaskExecution struct {
 	CountServices int `json:"count_services"`
 	// CostFactor
 	CostFactor float64 `json:"cost_factor"`
+	// Cost of compute and artifact storage of the task in currency of the cost config
+	Cost float64 `json:"cost"`
+	// CPUPeakCores is the highest cpu usage in cores sampled by the ant while the task ran
+	CPUPeakCores float64 `json:"cpu_peak_cores"`
+	// CPUAvgCores is the average cpu usage in cores sampled by the ant while the task ran
+	CPUAvgCores float64 `json:"cpu_avg_cores"`
+	// MemoryPeakBytes is the highest resident memory sampled by the ant while the task ran
+	MemoryPeakBytes int64 `json:"memory_peak_bytes"`
+	// IOReadBytes is the number of bytes read from block devices by the task
+	IOReadBytes int64 `json:"io_read_bytes"`
+	// IOWriteBytes is the number of bytes written to block devices by the task
+	IOWriteBytes int64 `json:"io_write_bytes"`
 	// StartedAt job creation time
 	StartedAt time.Time `json:"started_at"`
 	// EndedAt job update time
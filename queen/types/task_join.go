@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+)
+
+// JoinMode defines how many dependencies of a task must complete before the task runs
+type JoinMode string
+
+const (
+	// JoinAll waits for all dependencies to finish
+	JoinAll JoinMode = "all"
+	// JoinAny runs the task as soon as one dependency completes
+	JoinAny JoinMode = "any"
+	// JoinNOf runs the task as soon as count dependencies complete
+	JoinNOf JoinMode = "n_of"
+)
+
+// JoinConfig defines when a task whose dependencies run in parallel can start. Without a join
+// config the task waits for all of its dependencies.
+type JoinConfig struct {
+	// Mode is all, any or n_of
+	Mode JoinMode `yaml:"mode,omitempty" json:"mode"`
+	// Count is number of dependencies that must complete for n_of mode
+	Count int `yaml:"count,omitempty" json:"count"`
+}
+
+// Validate validates join against number of dependencies of the task
+func (j *JoinConfig) Validate(dependencies int) error {
+	if dependencies == 0 {
+		return fmt.Errorf("join requires dependencies")
+	}
+	switch j.Mode {
+	case "", JoinAll:
+		j.Mode = JoinAll
+	case JoinAny:
+		j.Count = 1
+	case JoinNOf:
+		if j.Count < 1 || j.Count > dependencies {
+			return fmt.Errorf("join count must be between 1 and %d", dependencies)
+		}
+	default:
+		return fmt.Errorf("join mode %s is not supported, use all, any or n_of", j.Mode)
+	}
+	return nil
+}
+
+// Ready returns true if the task can start given the number of completed and finished
+// (completed or failed) dependencies, and false for satisfiable if the task can never start.
+func (j *JoinConfig) Ready(completed int, finished int, dependencies int) (ready bool, satisfiable bool) {
+	if j == nil || j.Mode == "" || j.Mode == JoinAll {
+		return finished == dependencies, true
+	}
+	required := j.Count
+	if j.Mode == JoinAny {
+		required = 1
+	}
+	return completed >= required, completed+dependencies-finished >= required
+}
+
+// String defines description of join
+func (j *JoinConfig) String() string {
+	if j == nil || j.Mode == "" || j.Mode == JoinAll {
+		return string(JoinAll)
+	}
+	if j.Mode == JoinAny {
+		return string(JoinAny)
+	}
+	return fmt.Sprintf("%d of", j.Count)
+}
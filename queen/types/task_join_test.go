@@ -0,0 +1,136 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const joinJobYaml = `
+job_type: join-job
+tasks:
+- task_type: prepare
+  script:
+    - ./prepare
+- task_type: extract-a
+  script:
+    - ./extract a
+  dependencies: [prepare]
+- task_type: extract-b
+  script:
+    - ./extract b
+  dependencies: [prepare]
+- task_type: merge
+  script:
+    - ./merge
+  dependencies: [extract-a, extract-b]
+  join:
+    mode: n_of
+    count: 1
+`
+
+func Test_ShouldValidateJoin(t *testing.T) {
+	// GIVEN join without mode
+	join := &JoinConfig{}
+	// WHEN validating without dependencies
+	// THEN it should fail
+	require.Error(t, join.Validate(0))
+	// WHEN validating with dependencies
+	// THEN it should default to all
+	require.NoError(t, join.Validate(2))
+	require.Equal(t, JoinAll, join.Mode)
+
+	// WHEN validating n_of with count above number of dependencies
+	join = &JoinConfig{Mode: JoinNOf, Count: 3}
+	// THEN it should fail
+	require.Error(t, join.Validate(2))
+
+	// WHEN validating unknown mode
+	join = &JoinConfig{Mode: "some"}
+	// THEN it should fail
+	require.Error(t, join.Validate(2))
+}
+
+func Test_ShouldCheckIfJoinIsReady(t *testing.T) {
+	// GIVEN joins of three dependencies
+	var all *JoinConfig
+	anyOf := &JoinConfig{Mode: JoinAny}
+	twoOf := &JoinConfig{Mode: JoinNOf, Count: 2}
+	require.NoError(t, anyOf.Validate(3))
+
+	// WHEN one dependency completed and one failed
+	// THEN only any join is ready
+	ready, satisfiable := all.Ready(1, 2, 3)
+	require.False(t, ready)
+	require.True(t, satisfiable)
+	ready, _ = anyOf.Ready(1, 2, 3)
+	require.True(t, ready)
+	ready, satisfiable = twoOf.Ready(1, 2, 3)
+	require.False(t, ready)
+	require.True(t, satisfiable)
+
+	// WHEN two dependencies failed
+	// THEN two of join cannot be satisfied
+	_, satisfiable = twoOf.Ready(1, 3, 3)
+	require.False(t, satisfiable)
+}
+
+func Test_ShouldScheduleTasksByDependencies(t *testing.T) {
+	// GIVEN a job whose tasks define dependencies without on_completed
+	job, err := NewJobDefinitionFromYaml([]byte(joinJobYaml))
+	require.NoError(t, err)
+
+	// WHEN validating the job
+	// THEN tasks are scheduled by dependencies starting with the task without dependencies
+	require.True(t, job.ScheduledByDependencies())
+	first, err := job.GetFirstTask()
+	require.NoError(t, err)
+	require.Equal(t, "prepare", first.TaskType)
+	require.Equal(t, JoinNOf, job.GetTask("merge").Join.Mode)
+
+	// WHEN saving and loading the task with join
+	merge := job.GetTask("merge")
+	require.NoError(t, merge.ValidateBeforeSave())
+	loaded := NewTaskDefinition("merge", "")
+	loaded.Variables = merge.Variables
+	require.NoError(t, loaded.AfterLoad())
+	// THEN join is preserved
+	require.Equal(t, merge.Join, loaded.Join)
+}
+
+func Test_ShouldNotScheduleTasksWithInvalidDependencies(t *testing.T) {
+	for name, jobYaml := range map[string]string{
+		"not defined": `
+job_type: join-job
+tasks:
+- task_type: a
+- task_type: b
+  dependencies: [c]
+`,
+		"cycle": `
+job_type: join-job
+tasks:
+- task_type: a
+- task_type: b
+  dependencies: [a, c]
+- task_type: c
+  dependencies: [b]
+`,
+		"requires tasks scheduled by dependencies": `
+job_type: join-job
+tasks:
+- task_type: a
+  on_completed: b
+- task_type: b
+  dependencies: [a]
+  join:
+    mode: any
+`,
+	} {
+		// WHEN parsing a job with invalid dependencies
+		_, err := NewJobDefinitionFromYaml([]byte(jobYaml))
+		// THEN it should fail
+		require.Error(t, err, name)
+		require.Contains(t, err.Error(), name)
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TaskRightSizing summarizes resources used by profiled executions of a task and recommends cpu and memory
+// requests and limits of its container
+type TaskRightSizing struct {
+	JobType  string `json:"job_type"`
+	TaskType string `json:"task_type"`
+	// Runs is number of task executions that were profiled
+	Runs int64 `json:"runs"`
+	// CPUAvgCores is mean of average cpu usage of runs
+	CPUAvgCores float64 `json:"cpu_avg_cores"`
+	// CPUPeakCores is the highest cpu usage of all runs
+	CPUPeakCores float64 `json:"cpu_peak_cores"`
+	// MemoryAvgPeakBytes is mean of peak memory of runs
+	MemoryAvgPeakBytes int64 `json:"memory_avg_peak_bytes"`
+	// MemoryPeakBytes is the highest memory of all runs
+	MemoryPeakBytes int64 `json:"memory_peak_bytes"`
+	// IOReadBytes is mean of bytes read by runs
+	IOReadBytes int64 `json:"io_read_bytes"`
+	// IOWriteBytes is mean of bytes written by runs
+	IOWriteBytes int64 `json:"io_write_bytes"`
+	// CPURequest is recommended cpu_request of the container
+	CPURequest string `json:"cpu_request"`
+	// CPULimit is recommended cpu_limit of the container
+	CPULimit string `json:"cpu_limit"`
+	// MemoryRequest is recommended memory_request of the container
+	MemoryRequest string `json:"memory_request"`
+	// MemoryLimit is recommended memory_limit of the container
+	MemoryLimit string `json:"memory_limit"`
+}
+
+// Recommend sets requests to the average usage and limits to the peak usage with the headroom fraction added,
+// where cpu is rounded up to 10 millicores and memory to a MiB.
+func (r *TaskRightSizing) Recommend(headroom float64) {
+	factor := 1 + headroom
+	cpu := func(cores float64) string {
+		millis := int64(math.Ceil(cores*factor*100)) * 10
+		if millis < 10 {
+			millis = 10
+		}
+		return resource.NewMilliQuantity(millis, resource.DecimalSI).String()
+	}
+	memory := func(bytes int64) string {
+		mib := int64(math.Ceil(float64(bytes) * factor / (1024 * 1024)))
+		if mib < 1 {
+			mib = 1
+		}
+		return resource.NewQuantity(mib*1024*1024, resource.BinarySI).String()
+	}
+	r.CPURequest = cpu(r.CPUAvgCores)
+	r.CPULimit = cpu(r.CPUPeakCores)
+	r.MemoryRequest = memory(r.MemoryAvgPeakBytes)
+	r.MemoryLimit = memory(r.MemoryPeakBytes)
+}
+
+func (r *TaskRightSizing) String() string {
+	return fmt.Sprintf("JobType=%s TaskType=%s Runs=%d CPU=%s/%s Memory=%s/%s",
+		r.JobType, r.TaskType, r.Runs, r.CPURequest, r.CPULimit, r.MemoryRequest, r.MemoryLimit)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldRecommendRequestsAndLimitsOfTask(t *testing.T) {
+	// GIVEN resources used by a task
+	sizing := &TaskRightSizing{
+		JobType:            "build",
+		TaskType:           "compile",
+		Runs:               5,
+		CPUAvgCores:        0.5,
+		CPUPeakCores:       1.5,
+		MemoryAvgPeakBytes: 100 * 1024 * 1024,
+		MemoryPeakBytes:    1024 * 1024 * 1024,
+	}
+
+	// WHEN recommending with 20% headroom
+	sizing.Recommend(0.2)
+
+	// THEN requests should be based on averages and limits on peaks
+	require.Equal(t, "600m", sizing.CPURequest)
+	require.Equal(t, "1800m", sizing.CPULimit)
+	require.Equal(t, "120Mi", sizing.MemoryRequest)
+	require.Equal(t, "1229Mi", sizing.MemoryLimit)
+	require.Contains(t, sizing.String(), "CPU=600m/1800m")
+
+	// AND tiny usage should be rounded up to min quantities
+	idle := &TaskRightSizing{}
+	idle.Recommend(0.2)
+	require.Equal(t, "10m", idle.CPURequest)
+	require.Equal(t, "1Mi", idle.MemoryLimit)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DeadLetterFailed is the state of an event whose job request couldn't be submitted
+	DeadLetterFailed = "FAILED"
+	// DeadLetterReplayed is the state of an event that was replayed successfully
+	DeadLetterReplayed = "REPLAYED"
+	// DeadLetterDiscarded is the state of an event that was discarded by a user
+	DeadLetterDiscarded = "DISCARDED"
+)
+
+// TriggerDeadLetter persists an inbound trigger event that failed evaluation or submission, e.g.
+// due to bad template, validation error or rate limit, so that it can be inspected and replayed.
+// Replayed and discarded events are kept for auditing.
+type TriggerDeadLetter struct {
+	// ID is a 26-char ULID string.
+	ID string `json:"id" gorm:"primaryKey;size:128"`
+	// JobDefinitionID is the version of the job definition that received the event, dead-letters are
+	// looked up by job type and owner so that they can be replayed after the definition is updated.
+	JobDefinitionID string `json:"job_definition_id" gorm:"not null;size:128;index"`
+	JobType         string `json:"job_type" gorm:"not null;size:255"`
+	OrganizationID  string `json:"organization_id" gorm:"size:128"`
+	UserID          string `json:"user_id" gorm:"size:128"`
+	TriggerName     string `json:"trigger_name" gorm:"not null;size:255"`
+	TriggerType     string `json:"trigger_type" gorm:"not null;size:50"`
+	// Payload is the JSON encoded template context of the event such as Message and Properties.
+	Payload      string `json:"payload" gorm:"not null"`
+	ErrorMessage string `json:"error_message"`
+	// State is FAILED until the event is replayed or discarded.
+	State string `json:"state" gorm:"not null;size:50"`
+	// Attempts counts deliveries including replays, it's incremented when a replay claims the event.
+	Attempts int `json:"attempts" gorm:"not null;default:0"`
+	// JobRequestID is the request created by replay, which is empty if replay was filtered or deduped.
+	JobRequestID string    `json:"job_request_id" gorm:"size:128"`
+	ReplayedAt   time.Time `json:"replayed_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName overrides the GORM table name.
+func (TriggerDeadLetter) TableName() string {
+	return "formicary_trigger_dead_letters"
+}
+
+// Validate validates dead-letter
+func (dl *TriggerDeadLetter) Validate() error {
+	if dl.JobDefinitionID == "" || dl.JobType == "" || dl.TriggerName == "" {
+		return fmt.Errorf("job_definition_id, job_type and trigger_name are required")
+	}
+	if dl.State != DeadLetterFailed && dl.State != DeadLetterReplayed && dl.State != DeadLetterDiscarded {
+		return fmt.Errorf("dead-letter state %s is invalid", dl.State)
+	}
+	return nil
+}
+
+// BelongsTo returns true if dead-letter was recorded for a version of the job definition
+func (dl *TriggerDeadLetter) BelongsTo(jobDef *JobDefinition) bool {
+	return dl.JobType == jobDef.JobType &&
+		dl.OrganizationID == jobDef.OrganizationID &&
+		(jobDef.OrganizationID != "" || dl.UserID == jobDef.UserID)
+}
+
+// Failed returns true if event is waiting to be replayed or discarded
+func (dl *TriggerDeadLetter) Failed() bool {
+	return dl.State == DeadLetterFailed
+}
+
+func (dl *TriggerDeadLetter) String() string {
+	return fmt.Sprintf("ID=%s JobType=%s Trigger=%s State=%s Attempts=%d Error=%s",
+		dl.ID, dl.JobType, dl.TriggerName, dl.State, dl.Attempts, dl.ErrorMessage)
+}
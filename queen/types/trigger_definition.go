@@ -4,7 +4,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	common "plexobject.com/formicary/internal/types"
 )
 
 // TriggerAuth defines authentication for inbound webhook triggers.
@@ -23,10 +26,24 @@ type TriggerRateLimit struct {
 	Window time.Duration `yaml:"window" json:"window"`
 }
 
+// TriggerGlobFilter includes or excludes branches, tags or changed paths of git triggers by glob
+// patterns where `*` matches within a path segment and `**` matches across segments.
+type TriggerGlobFilter struct {
+	Include []string `yaml:"include,omitempty" json:"include,omitempty"`
+	Exclude []string `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+const (
+	// TriggerMatchUnknownChanges fires git triggers with paths filter when changed files are not reported
+	TriggerMatchUnknownChanges = "match"
+	// TriggerSkipUnknownChanges ignores git events for triggers with paths filter when changed files are not reported
+	TriggerSkipUnknownChanges = "skip"
+)
+
 // TriggerDefinition describes a single event trigger on a job definition.
 // Triggers are transient — parsed from raw_yaml, never persisted as a separate table row.
 type TriggerDefinition struct {
-	// Type is required: "webhook", "s3", or "queue".
+	// Type is required: "webhook", "git", "job", "http", "file", "s3", or "queue".
 	Type string `yaml:"type" json:"type"`
 	// Name is a unique identifier within the job definition.
 	Name string `yaml:"name" json:"name"`
@@ -35,7 +52,43 @@ type TriggerDefinition struct {
 	Path string       `yaml:"path,omitempty" json:"path,omitempty"`
 	Auth *TriggerAuth `yaml:"auth,omitempty" json:"auth,omitempty"`
 
-	// S3-specific fields. Mode is "poll" (default) or "notification".
+	// Git-specific fields; git triggers also use the webhook route and Auth. Provider is "github",
+	// "gitlab", "gitea" or "bitbucket" and is detected from event headers when empty. Events is any
+	// of "push", "tag" and "pull_request" (default all). ChangedFilesUnknown is "match" (default) or
+	// "skip" for events whose provider doesn't report changed files such as pull requests.
+	Provider            string             `yaml:"provider,omitempty" json:"provider,omitempty"`
+	Events              []string           `yaml:"events,omitempty" json:"events,omitempty"`
+	Branches            *TriggerGlobFilter `yaml:"branches,omitempty" json:"branches,omitempty"`
+	Tags                *TriggerGlobFilter `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Paths               *TriggerGlobFilter `yaml:"paths,omitempty" json:"paths,omitempty"`
+	ChangedFilesUnknown string             `yaml:"changed_files_unknown,omitempty" json:"changed_files_unknown,omitempty"`
+
+	// Job-specific fields. Upstream lists job types whose completion fires the trigger, States lists
+	// final states of the upstream job (default COMPLETED) and UpstreamParams must equal params of
+	// the upstream job request.
+	Upstream       []string          `yaml:"upstream,omitempty" json:"upstream,omitempty"`
+	States         []string          `yaml:"states,omitempty" json:"states,omitempty"`
+	UpstreamParams map[string]string `yaml:"upstream_params,omitempty" json:"upstream_params,omitempty"`
+
+	// HTTP-specific fields. URL is polled every PollInterval with Method (default GET) and Headers,
+	// and Auth (bearer_token or api_key_header) adds the secret to the request. The condition holds
+	// when the status is one of ExpectedStatus (default 2xx) and JSONPath of the body equals
+	// JSONValue (or is present when JSONValue is empty); the trigger fires when it flips to true.
+	URL            string            `yaml:"url,omitempty" json:"url,omitempty"`
+	Method         string            `yaml:"method,omitempty" json:"method,omitempty"`
+	Headers        map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	ExpectedStatus []int             `yaml:"expected_status,omitempty" json:"expected_status,omitempty"`
+	JSONPath       string            `yaml:"json_path,omitempty" json:"json_path,omitempty"`
+	JSONValue      string            `yaml:"json_value,omitempty" json:"json_value,omitempty"`
+
+	// File-specific fields. Directory is watched on the scheduler leader, Paths filters file names
+	// relative to it and a file fires once it hasn't changed for SettleTime (default 2s).
+	Directory  string        `yaml:"directory,omitempty" json:"directory,omitempty"`
+	Recursive  bool          `yaml:"recursive,omitempty" json:"recursive,omitempty"`
+	SettleTime time.Duration `yaml:"settle_time,omitempty" json:"settle_time,omitempty"`
+
+	// S3-specific fields. Mode is "poll" (default) or "notification". PollInterval also applies to
+	// http triggers.
 	Mode         string        `yaml:"mode,omitempty" json:"mode,omitempty"`
 	Bucket       string        `yaml:"bucket,omitempty" json:"bucket,omitempty"`
 	Prefix       string        `yaml:"prefix,omitempty" json:"prefix,omitempty"`
@@ -72,6 +125,69 @@ func (t *TriggerDefinition) Validate() error {
 		if t.Auth != nil && t.Auth.SecretConfig == "" {
 			return fmt.Errorf("trigger %q (webhook): auth.secret_config is required when auth is specified", t.Name)
 		}
+	case "git":
+		if t.Auth != nil && (t.Auth.Method == "" || t.Auth.SecretConfig == "") {
+			return fmt.Errorf("trigger %q (git): auth.method and auth.secret_config are required when auth is specified", t.Name)
+		}
+		switch t.Provider {
+		case "", "github", "gitlab", "gitea", "bitbucket":
+		default:
+			return fmt.Errorf("trigger %q (git): provider must be 'github', 'gitlab', 'gitea' or 'bitbucket', got %q", t.Name, t.Provider)
+		}
+		for _, event := range t.Events {
+			if event != "push" && event != "tag" && event != "pull_request" {
+				return fmt.Errorf("trigger %q (git): events must be 'push', 'tag' or 'pull_request', got %q", t.Name, event)
+			}
+		}
+		switch t.ChangedFilesUnknown {
+		case "", TriggerMatchUnknownChanges, TriggerSkipUnknownChanges:
+		default:
+			return fmt.Errorf("trigger %q (git): changed_files_unknown must be 'match' or 'skip', got %q", t.Name, t.ChangedFilesUnknown)
+		}
+		for name, filter := range map[string]*TriggerGlobFilter{"branches": t.Branches, "tags": t.Tags, "paths": t.Paths} {
+			if filter == nil {
+				continue
+			}
+			for _, pattern := range append(append([]string{}, filter.Include...), filter.Exclude...) {
+				if pattern == "" {
+					return fmt.Errorf("trigger %q (git): %s contains empty pattern", t.Name, name)
+				}
+			}
+		}
+	case "job":
+		if len(t.Upstream) == 0 {
+			return fmt.Errorf("trigger %q (job): upstream is required", t.Name)
+		}
+		for _, state := range t.States {
+			if !common.RequestState(state).IsTerminal() {
+				return fmt.Errorf("trigger %q (job): states must be final states such as COMPLETED or FAILED, got %q", t.Name, state)
+			}
+		}
+	case "http":
+		if !strings.HasPrefix(t.URL, "http://") && !strings.HasPrefix(t.URL, "https://") {
+			return fmt.Errorf("trigger %q (http): url must start with http:// or https://", t.Name)
+		}
+		if t.Auth != nil && t.Auth.Method != "bearer_token" && t.Auth.Method != "api_key_header" {
+			return fmt.Errorf("trigger %q (http): auth.method must be 'bearer_token' or 'api_key_header'", t.Name)
+		}
+		if t.Auth != nil && (t.Auth.SecretConfig == "" || (t.Auth.Method == "api_key_header" && t.Auth.Header == "")) {
+			return fmt.Errorf("trigger %q (http): auth.secret_config and auth.header for api_key_header are required", t.Name)
+		}
+		for _, status := range t.ExpectedStatus {
+			if status < 100 || status > 599 {
+				return fmt.Errorf("trigger %q (http): invalid expected_status %d", t.Name, status)
+			}
+		}
+		if t.JSONValue != "" && t.JSONPath == "" {
+			return fmt.Errorf("trigger %q (http): json_path is required for json_value", t.Name)
+		}
+	case "file":
+		if t.Directory == "" {
+			return fmt.Errorf("trigger %q (file): directory is required", t.Name)
+		}
+		if t.SettleTime < 0 {
+			return fmt.Errorf("trigger %q (file): settle_time must be >= 0", t.Name)
+		}
 	case "s3":
 		if t.Bucket == "" {
 			return fmt.Errorf("trigger %q (s3): bucket is required", t.Name)
@@ -87,7 +203,7 @@ func (t *TriggerDefinition) Validate() error {
 			return fmt.Errorf("trigger %q (queue): topic is required", t.Name)
 		}
 	default:
-		return fmt.Errorf("trigger %q: type must be 'webhook', 's3', or 'queue', got %q", t.Name, t.Type)
+		return fmt.Errorf("trigger %q: type must be 'webhook', 'git', 'job', 'http', 'file', 's3', or 'queue', got %q", t.Name, t.Type)
 	}
 	if t.RateLimit != nil {
 		if t.RateLimit.Max <= 0 {
@@ -99,3 +215,19 @@ func (t *TriggerDefinition) Validate() error {
 	}
 	return nil
 }
+
+// ValidateLimits checks url of http triggers and directory of file triggers against trigger limits of
+// the queen server, which are only known when the job definition is saved.
+func (t *TriggerDefinition) ValidateLimits() error {
+	switch t.Type {
+	case "http":
+		if err := CheckHTTPTriggerURL(t.URL); err != nil {
+			return fmt.Errorf("trigger %q (http): %w", t.Name, err)
+		}
+	case "file":
+		if _, err := ResolveFileTriggerDirectory(t.Directory); err != nil {
+			return fmt.Errorf("trigger %q (file): %w", t.Name, err)
+		}
+	}
+	return nil
+}
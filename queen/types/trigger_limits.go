@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// triggerLimits restricts what http and file triggers of job definitions can reach on the queen server
+var triggerLimits atomic.Value
+
+// cgnatNetwork is shared address space (RFC 6598) that isn't covered by net.IP.IsPrivate
+var cgnatNetwork = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// TriggerLimits defines hosts that http triggers can poll and directories that file triggers can watch
+// because triggers are defined by users but run on the queen server.
+type TriggerLimits struct {
+	// AllowedHosts are host names such as api.example.com or *.example.com that http triggers can poll.
+	// When it's empty, any host except localhost and internal addresses can be polled.
+	AllowedHosts []string
+	// WatchRoots are directories that file triggers can watch. File triggers can't be used when it's empty.
+	WatchRoots []string
+}
+
+// SetTriggerLimits sets hosts that http triggers can poll and directories that file triggers can watch
+func SetTriggerLimits(limits TriggerLimits) {
+	triggerLimits.Store(limits)
+}
+
+func getTriggerLimits() TriggerLimits {
+	limits, _ := triggerLimits.Load().(TriggerLimits)
+	return limits
+}
+
+// CheckHTTPTriggerURL checks that host of the url is allowed, or that it isn't localhost or an internal
+// address when allowed hosts are not configured.
+func CheckHTTPTriggerURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("url %s is invalid due to %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url %s must use http or https", rawURL)
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return fmt.Errorf("url %s doesn't have a host", rawURL)
+	}
+	limits := getTriggerLimits()
+	if len(limits.AllowedHosts) > 0 {
+		for _, allowed := range limits.AllowedHosts {
+			allowed = strings.ToLower(strings.TrimSpace(allowed))
+			if host == allowed ||
+				(strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:])) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host %s is not in jobs.http_trigger_allowed_hosts", host)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("host %s is not allowed", host)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return CheckHTTPTriggerAddress(ip)
+	}
+	return nil
+}
+
+// CheckHTTPTriggerAddress checks that an address that a host resolves to isn't internal. It's only
+// checked when allowed hosts are not configured because names of allowed hosts are trusted.
+func CheckHTTPTriggerAddress(ip net.IP) error {
+	if len(getTriggerLimits().AllowedHosts) > 0 {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() || cgnatNetwork.Contains(ip) ||
+		(ip.To4() != nil && ip.To4()[0] == 0) {
+		return fmt.Errorf("address %s is internal and not allowed", ip)
+	}
+	return nil
+}
+
+// ResolveFileTriggerDirectory returns absolute directory of a file trigger after following symlinks,
+// which must be under one of the watch roots.
+func ResolveFileTriggerDirectory(dir string) (string, error) {
+	roots := getTriggerLimits().WatchRoots
+	if len(roots) == 0 {
+		return "", fmt.Errorf("file triggers can't be used because jobs.file_trigger_watch_roots is not configured")
+	}
+	resolved, err := resolveDirectory(dir)
+	if err != nil {
+		return "", fmt.Errorf("directory %s is not accessible", dir)
+	}
+	for _, root := range roots {
+		resolvedRoot, err := resolveDirectory(root)
+		if err != nil {
+			continue
+		}
+		if rel, err := filepath.Rel(resolvedRoot, resolved); err == nil &&
+			rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return resolved, nil
+		}
+	}
+	return "", fmt.Errorf("directory %s is not under jobs.file_trigger_watch_roots", dir)
+}
+
+func resolveDirectory(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(abs)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package types
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ShouldCheckHostsOfHTTPTriggers(t *testing.T) {
+	defer SetTriggerLimits(TriggerLimits{})
+	// GIVEN no allowed hosts
+	SetTriggerLimits(TriggerLimits{})
+
+	// WHEN checking urls and addresses
+	// THEN localhost and internal addresses should be rejected
+	require.NoError(t, CheckHTTPTriggerURL("https://api.example.com/status"))
+	for _, u := range []string{"http://localhost:8080", "http://127.0.0.1", "http://10.1.2.3", "http://169.254.169.254/latest",
+		"http://[::1]:80", "http://100.64.0.1", "http://0.0.0.0", "file:///etc/passwd"} {
+		require.Error(t, CheckHTTPTriggerURL(u), u)
+	}
+	require.Error(t, CheckHTTPTriggerAddress(net.ParseIP("192.168.1.10")))
+	require.NoError(t, CheckHTTPTriggerAddress(net.ParseIP("93.184.216.34")))
+
+	// GIVEN allowed hosts
+	SetTriggerLimits(TriggerLimits{AllowedHosts: []string{"api.example.com", "*.internal.example.com"}})
+
+	// WHEN checking urls
+	// THEN only allowed hosts should be accepted
+	require.NoError(t, CheckHTTPTriggerURL("https://api.example.com/status"))
+	require.NoError(t, CheckHTTPTriggerURL("https://exports.internal.example.com/status"))
+	require.Error(t, CheckHTTPTriggerURL("https://internal.example.com.evil.io/status"))
+	require.Error(t, CheckHTTPTriggerURL("https://other.example.com/status"))
+	require.NoError(t, CheckHTTPTriggerAddress(net.ParseIP("10.1.2.3")))
+}
+
+func Test_ShouldResolveDirectoriesOfFileTriggersUnderWatchRoots(t *testing.T) {
+	defer SetTriggerLimits(TriggerLimits{})
+	// GIVEN a watch root with a directory and a symlink to a directory outside it
+	root := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, "incoming"), 0755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+
+	// WHEN watch roots are not configured
+	// THEN file triggers should be rejected
+	SetTriggerLimits(TriggerLimits{})
+	_, err := ResolveFileTriggerDirectory(filepath.Join(root, "incoming"))
+	require.Error(t, err)
+
+	// WHEN watch roots are configured
+	SetTriggerLimits(TriggerLimits{WatchRoots: []string{root}})
+
+	// THEN only directories under the root should be resolved
+	dir, err := ResolveFileTriggerDirectory(filepath.Join(root, "incoming", "..", "incoming"))
+	require.NoError(t, err)
+	resolvedRoot, _ := filepath.EvalSymlinks(root)
+	require.Equal(t, filepath.Join(resolvedRoot, "incoming"), dir)
+	_, err = ResolveFileTriggerDirectory(outside)
+	require.Error(t, err)
+	_, err = ResolveFileTriggerDirectory(filepath.Join(root, "escape"))
+	require.Error(t, err)
+	_, err = ResolveFileTriggerDirectory(filepath.Join(root, ".."))
+	require.Error(t, err)
+}
@@ -4,7 +4,8 @@ package types
 
 import "time"
 
-// TriggerState persists per-trigger runtime state: S3 poll marker and rate-limit window.
+// TriggerState persists per-trigger runtime state: poll markers of S3, http and file triggers and
+// rate-limit window.
 // This is the GORM-backed internal type; the proto type (queen.TriggerState) is used in the gRPC layer.
 //
 // The composite unique index (job_definition_id, trigger_name) is enforced by the SQL migration;
@@ -14,7 +15,8 @@ type TriggerState struct {
 	ID              string    `json:"id" gorm:"primaryKey;size:128"`
 	JobDefinitionID string    `json:"job_definition_id" gorm:"not null;size:128;uniqueIndex:uq_trigger_states_job_name"`
 	TriggerName     string    `json:"trigger_name" gorm:"not null;size:255;uniqueIndex:uq_trigger_states_job_name"`
-	// LastSeenKey is the S3 object key of the last successfully processed object (poll cursor).
+	// LastSeenKey is the poll cursor: S3 object key or file path of the last successfully processed
+	// object, or JSON encoded condition, ETag and Last-Modified of the last http poll.
 	LastSeenKey     string    `json:"last_seen_key" gorm:"not null;default:''"`
 	LastSeenTime    time.Time `json:"last_seen_time"`
 	// WindowStart is the beginning of the current rate-limit window.